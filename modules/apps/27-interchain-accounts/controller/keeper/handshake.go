@@ -71,7 +71,9 @@ func (k Keeper) OnChanOpenInit(
 			return "", errorsmod.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s must be %s", activeChannelID, portID, channeltypes.CLOSED)
 		}
 
-		if channel.Ordering != order {
+		// a channel closed by a packet timeout on an ORDERED channel may be reopened as an ORDERED_ALLOW_TIMEOUT
+		// channel, which preserves the ordering of the packets without being closed by later timeouts
+		if channel.Ordering != order && !(channel.Ordering == channeltypes.ORDERED && order == channeltypes.ORDERED_ALLOW_TIMEOUT) {
 			return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "order cannot change when reopening a channel expected %s, got %s", channel.Ordering, order)
 		}
 
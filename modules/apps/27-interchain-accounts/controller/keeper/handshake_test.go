@@ -92,6 +92,44 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			channeltypes.ErrInvalidChannelOrdering,
 		},
+		{
+			"success: reopening ORDERED channel as ORDERED_ALLOW_TIMEOUT",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.ORDERED,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+
+				path.EndpointA.SetChannel(closedChannel)
+
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			nil,
+		},
+		{
+			"failure: reopening ORDERED_ALLOW_TIMEOUT channel as ORDERED",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.ORDERED_ALLOW_TIMEOUT,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+
+				path.EndpointA.SetChannel(closedChannel)
+			},
+			channeltypes.ErrInvalidChannelOrdering,
+		},
 		{
 			"invalid metadata -  previous metadata is different",
 			func() {
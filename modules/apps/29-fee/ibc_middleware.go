@@ -17,9 +17,10 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware                  = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule            = (*IBCMiddleware)(nil)
+	_ porttypes.AcknowledgementExpiryModule = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
@@ -237,6 +238,33 @@ func (im IBCMiddleware) OnRecvPacket(
 	return types.NewIncentivizedAcknowledgement(forwardRelayer, ack.Acknowledgement(), ack.Success())
 }
 
+// OnAcknowledgementExpired implements the AcknowledgementExpiryModule interface.
+// The error acknowledgement of the underlying application is wrapped in an incentivized acknowledgement
+// if fees are enabled, as for acknowledgements written asynchronously.
+func (im IBCMiddleware) OnAcknowledgementExpired(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (exported.Acknowledgement, error) {
+	ack := exported.Acknowledgement(channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired))
+	if cbs, ok := im.app.(porttypes.AcknowledgementExpiryModule); ok {
+		var err error
+		if ack, err = cbs.OnAcknowledgementExpired(ctx, packet); err != nil {
+			return nil, err
+		}
+	}
+
+	if ack == nil || !im.keeper.IsFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel) {
+		return ack, nil
+	}
+
+	incentivizedAck, err := im.keeper.IncentivizeAsyncAcknowledgement(ctx, packet, ack)
+	if err != nil {
+		return nil, err
+	}
+
+	return incentivizedAck, nil
+}
+
 // OnAcknowledgementPacket implements the IBCMiddleware interface
 // If fees are not enabled, this callback will default to the ibc-core packet callback
 func (im IBCMiddleware) OnAcknowledgementPacket(
@@ -597,6 +597,94 @@ func (suite *FeeTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *FeeTestSuite) TestOnAcknowledgementExpired() {
+	var packet channeltypes.Packet
+
+	expiredAck := channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expAck   exported.Acknowledgement
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			types.NewIncentivizedAcknowledgement(ibctesting.TestAccAddress, expiredAck.Acknowledgement(), false),
+			nil,
+		},
+		{
+			"success: forward address is not found",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainB.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), "", suite.path.EndpointB.ChannelID)
+			},
+			types.NewIncentivizedAcknowledgement("", expiredAck.Acknowledgement(), false),
+			nil,
+		},
+		{
+			"success: fee not enabled",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+			},
+			expiredAck,
+			nil,
+		},
+		{
+			"failure: relayer address not found for async acknowledgement",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.DeleteForwardRelayerAddress(suite.chainB.GetContext(), channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			},
+			nil,
+			types.ErrRelayerNotFoundForAsyncAck,
+		},
+		{
+			"failure: underlying application fails to expire the acknowledgement",
+			func() {
+				suite.chainB.GetSimApp().FeeMockModule.IBCApp.OnAcknowledgementExpired = func(ctx sdk.Context, packet channeltypes.Packet) (exported.Acknowledgement, error) {
+					return nil, ibcmock.MockApplicationCallbackError
+				}
+			},
+			nil,
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			packet = suite.CreateMockPacket()
+
+			suite.chainB.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainB.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress, suite.path.EndpointB.ChannelID)
+			suite.chainB.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainB.GetContext(), channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), ibctesting.MockFeePort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ack, err := cbs.(porttypes.AcknowledgementExpiryModule).OnAcknowledgementExpired(suite.chainB.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAck, ack)
+
+				_, found := suite.chainB.GetSimApp().IBCFeeKeeper.GetRelayerAddressForAsyncAck(suite.chainB.GetContext(), channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+				suite.Require().Equal(!suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel()), found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(ack)
+			}
+		})
+	}
+}
+
 func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
 	var (
 		ack                 []byte
@@ -53,6 +53,9 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgementAsync() {
 				timeoutTimestamp,
 			)
 
+			// the packet has been received and its acknowledgement is pending
+			suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), channeltypes.NewPendingAcknowledgement(packet, uint64(suite.chainB.GetContext().BlockTime().UnixNano())))
+
 			ack := channeltypes.NewResultAcknowledgement([]byte("success"))
 			chanCap := suite.chainB.GetChannelCapability(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
 
@@ -94,6 +97,9 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgementAsyncFeeDisabled() {
 		timeoutTimestamp,
 	)
 
+	// the packet has been received and its acknowledgement is pending
+	suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), channeltypes.NewPendingAcknowledgement(packet, uint64(suite.chainB.GetContext().BlockTime().UnixNano())))
+
 	ack := channeltypes.NewResultAcknowledgement([]byte("success"))
 	chanCap := suite.chainB.GetChannelCapability(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
 
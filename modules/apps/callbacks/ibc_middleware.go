@@ -18,9 +18,10 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware                  = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule            = (*IBCMiddleware)(nil)
+	_ porttypes.AcknowledgementExpiryModule = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the ibc-callbacks middleware given
@@ -224,6 +225,18 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet
 	return ack
 }
 
+// OnAcknowledgementExpired implements the AcknowledgementExpiryModule interface.
+// The callback is forwarded to the underlying application. As for packets which cannot be received,
+// the contract callback is not executed for the error acknowledgement of an expired acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementExpired(ctx sdk.Context, packet channeltypes.Packet) (ibcexported.Acknowledgement, error) {
+	cbs, ok := im.app.(porttypes.AcknowledgementExpiryModule)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired), nil
+	}
+
+	return cbs.OnAcknowledgementExpired(ctx, packet)
+}
+
 // WriteAcknowledgement implements the ReceivePacket destination callbacks for the ibc-callbacks middleware
 // during asynchronous packet acknowledgement.
 // It defers to the underlying application and then calls the contract callback.
@@ -771,6 +771,9 @@ func (s *CallbacksTestSuite) TestWriteAcknowledgement() {
 
 			tc.malleate()
 
+			// the packet has been received and its acknowledgement is pending
+			s.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(ctx, channeltypes.NewPendingAcknowledgement(packet, uint64(ctx.BlockTime().UnixNano())))
+
 			// callbacks module is routed as top level middleware
 			transferStack, ok := s.chainB.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
 			s.Require().True(ok)
@@ -1034,3 +1037,31 @@ func (s *CallbacksTestSuite) TestOnRecvPacketAsyncAck() {
 	s.Require().Nil(ack)
 	s.AssertHasExecutedExpectedCallback("none", true)
 }
+
+func (s *CallbacksTestSuite) TestOnAcknowledgementExpired() {
+	s.setupChains()
+
+	// We will pass the function call down the transfer stack to the transfer module
+	// transfer stack OnAcknowledgementExpired call order: callbacks -> fee -> transfer
+	transferStack, ok := s.chainA.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+	s.Require().True(ok)
+
+	expiryStack, ok := transferStack.(porttypes.AcknowledgementExpiryModule)
+	s.Require().True(ok)
+
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData,
+		1,
+		ibctesting.TransferPort,
+		ibctesting.FirstChannelID,
+		ibctesting.TransferPort,
+		ibctesting.FirstChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	// we just check that this call is passed down to the transfer module to return an error
+	ack, err := expiryStack.OnAcknowledgementExpired(s.chainA.GetContext(), packet)
+	s.Require().ErrorIs(err, transfertypes.ErrForwardedPacketPending)
+	s.Require().Nil(ack)
+}
@@ -0,0 +1,22 @@
+/*
+Package ratelimiting implements a middleware which limits the amount of tokens transferred
+over ICS20 channels. Quotas are set by governance per channel and denomination, either as a
+percentage of the supply of the denomination or as an absolute amount, and the inflow and
+outflow of tokens are tracked separately within a window. Packets which would exceed a quota
+are rejected on send and acknowledged with an error on receive.
+
+Windows are rolling: the inflow and outflow counted against a threshold are those of the
+window ending at the current block time. A window is tracked as buckets of a twelfth of its
+duration, and a bucket only leaves the window once all of it is older than the window duration,
+so the amount transferred within any window duration never exceeds a threshold. The channel
+value is set to the current supply of the denomination whenever a new bucket is started. A
+percentage threshold is not enforced while the channel value is zero.
+
+The rate limited denomination of a token is resolved by the transfer keeper from the stored
+hops of the denomination, so that base denominations containing slashes are keyed by the
+same denomination as their bank balance.
+
+The middleware follows the pattern specified in the ICS 30 specification
+(https://github.com/cosmos/ibc/tree/main/spec/app/ics-030-middleware).
+*/
+package ratelimiting
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestInitExportGenesis() {
+	suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 100))
+
+	packet, err := suite.transfer(sdk.DefaultBondDenom, 100, suite.chainB.SenderAccount.GetAddress().String(), 0)
+	suite.Require().NoError(err)
+
+	genesisState := suite.chainA.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().NoError(genesisState.Validate())
+	suite.Require().Len(genesisState.RateLimits, 1)
+	suite.Require().Equal(
+		[]types.PendingSendPacket{types.NewPendingSendPacket(packet.SourceChannel, packet.Sequence, genesisState.PendingSendPackets[0].SendTime)},
+		genesisState.PendingSendPackets,
+	)
+
+	// import the exported genesis state into chainB
+	suite.chainB.GetSimApp().RateLimitingKeeper.InitGenesis(suite.chainB.GetContext(), *genesisState)
+	suite.Require().Equal(genesisState, suite.chainB.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainB.GetContext()))
+}
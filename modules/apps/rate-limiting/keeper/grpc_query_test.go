@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				for i := 0; i < 3; i++ {
+					suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, fmt.Sprintf("channel-%d", i), newAmountQuota(100, 100))
+				}
+
+				expRateLimits = suite.chainA.GetSimApp().RateLimitingKeeper.GetAllRateLimits(suite.chainA.GetContext())
+			},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				for i := 0; i < 3; i++ {
+					suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, fmt.Sprintf("channel-%d", i), newAmountQuota(100, 100))
+				}
+
+				req.Pagination = &query.PageRequest{Limit: 2}
+				expRateLimits = suite.chainA.GetSimApp().RateLimitingKeeper.GetAllRateLimits(suite.chainA.GetContext())[:2]
+			},
+			true,
+		},
+		{
+			"success: no rate limits",
+			func() {
+				expRateLimits = nil
+			},
+			true,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryRateLimitsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimits(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var req *types.QueryRateLimitRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				req.Denom = "atom"
+			},
+			false,
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 100))
+			req = &types.QueryRateLimitRequest{
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimit(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				expRateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), req.ChannelId, req.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(&expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimitsByChannel() {
+	var req *types.QueryRateLimitsByChannelRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expLen   int
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			2,
+			true,
+		},
+		{
+			"success: no rate limits on channel",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			0,
+			true,
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			0,
+			false,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 100))
+			suite.addRateLimit(suite.chainA, "atom", suite.path.EndpointA.ChannelID, newAmountQuota(100, 100))
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, "channel-1", newAmountQuota(100, 100))
+			req = &types.QueryRateLimitsByChannelRequest{
+				ChannelId: suite.path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimitsByChannel(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.RateLimits, tc.expLen)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Keeper defines the rate limiting keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper    porttypes.ICS4Wrapper
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+
+	// the address capable of executing rate limit governance messages. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate limiting Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper, bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper, authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		ics4Wrapper:    ics4Wrapper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		authority:      authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetAuthority returns the rate limiting module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetRateLimit returns the rate limit of the provided denomination on the provided channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the provided rate limit keyed by its channel and denomination.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.KeyRateLimit(rateLimit.Path.ChannelId, rateLimit.Path.Denom), bz)
+}
+
+// DeleteRateLimit deletes the rate limit of the provided denomination on the provided channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(channelID, denom))
+}
+
+// GetAllRateLimits returns all the rate limits stored in state.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimits(ctx, []byte(types.RateLimitKeyPrefix+"/"))
+}
+
+// GetRateLimitsByChannel returns all the rate limits of the provided channel.
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	return k.getRateLimits(ctx, types.KeyRateLimitChannelPrefix(channelID))
+}
+
+// getRateLimits returns all the rate limits stored under the provided key prefix.
+func (k Keeper) getRateLimits(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// resetFlow clears the flow of the provided rate limit at the current block time. The channel
+// value is set to the current supply of the denomination.
+func (k Keeper) resetFlow(ctx sdk.Context, rateLimit *types.RateLimit) {
+	supply := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom)
+	rateLimit.Flow = types.NewFlow(supply.Amount, ctx.BlockTime())
+}
+
+// updateFlow removes the flow which has left the window ending at the current block time from
+// the provided rate limit. The channel value is set to the current supply of the denomination
+// when the current block time starts a new bucket.
+func (k Keeper) updateFlow(ctx sdk.Context, rateLimit *types.RateLimit) {
+	rateLimit.ExpireFlow(ctx.BlockTime())
+	if !rateLimit.HasBucket(ctx.BlockTime()) {
+		rateLimit.Flow.ChannelValue = k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+	}
+}
+
+// GetPendingSendPacket returns the pending send packet of the provided channel and sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingSendPacket(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingSendPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingSendPacket)
+	return pendingSendPacket, true
+}
+
+// SetPendingSendPacket stores the provided pending send packet keyed by its channel and sequence.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingSendPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingSendPacket)
+	store.Set(types.KeyPendingSendPacket(pendingSendPacket.ChannelId, pendingSendPacket.Sequence), bz)
+}
+
+// DeletePendingSendPacket deletes the pending send packet of the provided channel and sequence.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the packets sent by this chain awaiting an acknowledgement or timeout.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingSendPackets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSendPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSendPacket)
+
+		pendingSendPackets = append(pendingSendPackets, pendingSendPacket)
+	}
+
+	return pendingSendPackets
+}
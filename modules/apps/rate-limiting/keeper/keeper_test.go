@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// addRateLimit adds a rate limit for the provided denomination and channel on the provided chain.
+func (suite *KeeperTestSuite) addRateLimit(chain *ibctesting.TestChain, denom, channelID string, quota types.Quota) {
+	rateLimitingKeeper := chain.GetSimApp().RateLimitingKeeper
+	msg := types.NewMsgAddRateLimit(rateLimitingKeeper.GetAuthority(), denom, channelID, quota)
+
+	_, err := rateLimitingKeeper.AddRateLimit(chain.GetContext(), msg)
+	suite.Require().NoError(err)
+}
+
+// newAmountQuota returns a quota with the provided absolute send and receive thresholds and a window of one day.
+func newAmountQuota(maxAmountSend, maxAmountRecv int64) types.Quota {
+	return types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(maxAmountSend), sdkmath.NewInt(maxAmountRecv), 24)
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expPass       bool
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				suite.chainA.GetSimApp().GetKey(types.StoreKey),
+				suite.chainA.GetSimApp().IBCFeeKeeper,
+				suite.chainA.GetSimApp().BankKeeper,
+				&suite.chainA.GetSimApp().TransferKeeper,
+				suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority(),
+			)
+		}, true},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				suite.chainA.GetSimApp().GetKey(types.StoreKey),
+				suite.chainA.GetSimApp().IBCFeeKeeper,
+				suite.chainA.GetSimApp().BankKeeper,
+				&suite.chainA.GetSimApp().TransferKeeper,
+				"", // authority
+			)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.expPass {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().Panics(tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRateLimitsByChannel() {
+	suite.addRateLimit(suite.chainA, "stake", "channel-0", newAmountQuota(100, 100))
+	suite.addRateLimit(suite.chainA, "atom", "channel-0", newAmountQuota(100, 100))
+	suite.addRateLimit(suite.chainA, "stake", "channel-10", newAmountQuota(100, 100))
+
+	rateLimits := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimitsByChannel(suite.chainA.GetContext(), "channel-0")
+	suite.Require().Len(rateLimits, 2)
+	for _, rateLimit := range rateLimits {
+		suite.Require().Equal("channel-0", rateLimit.Path.ChannelId)
+	}
+
+	suite.Require().Len(suite.chainA.GetSimApp().RateLimitingKeeper.GetAllRateLimits(suite.chainA.GetContext()), 3)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// AddRateLimit defines an rpc handler method for MsgAddRateLimit. The rate limit starts with no
+// flow and the channel value set to the current supply of the denomination.
+func (k Keeper) AddRateLimit(goCtx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom); found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitExists, "denom %s on channel %s", msg.Denom, msg.ChannelId)
+	}
+
+	rateLimit := types.NewRateLimit(types.NewPath(msg.Denom, msg.ChannelId), msg.Quota, types.Flow{})
+	k.resetFlow(ctx, &rateLimit)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit defines an rpc handler method for MsgUpdateRateLimit. The quota of the rate
+// limit is replaced and its flow is cleared.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Denom, msg.ChannelId)
+	}
+
+	rateLimit.Quota = msg.Quota
+	k.resetFlow(ctx, &rateLimit)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines an rpc handler method for MsgRemoveRateLimit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Denom, msg.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, msg.ChannelId, msg.Denom)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit defines an rpc handler method for MsgResetRateLimit. The flow of the rate
+// limit is cleared.
+func (k Keeper) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Denom, msg.ChannelId)
+	}
+
+	k.resetFlow(ctx, &rateLimit)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
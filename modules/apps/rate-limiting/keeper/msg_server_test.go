@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit already exists",
+			func() {
+				suite.addRateLimit(suite.chainA, msg.Denom, msg.ChannelId, newAmountQuota(1, 1))
+			},
+			types.ErrRateLimitExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			msg = types.NewMsgAddRateLimit(rateLimitingKeeper.GetAuthority(), sdk.DefaultBondDenom, ibctesting.FirstChannelID, newAmountQuota(100, 100))
+
+			tc.malleate()
+
+			_, err := rateLimitingKeeper.AddRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := rateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Quota, rateLimit.Quota)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+				suite.Require().Empty(rateLimit.Flow.Buckets)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().UTC(), rateLimit.Flow.ResetTime.UTC())
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(suite.chainA.GetContext(), msg.Denom)
+				suite.Require().Equal(supply.Amount, rateLimit.Flow.ChannelValue)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimit() {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = "atom"
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 100))
+
+			_, err := suite.transfer(sdk.DefaultBondDenom, 100, suite.chainB.SenderAccount.GetAddress().String(), 0)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgUpdateRateLimit(rateLimitingKeeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(200, 200))
+
+			tc.malleate()
+
+			_, err = rateLimitingKeeper.UpdateRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := rateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Quota, rateLimit.Quota)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, ibctesting.FirstChannelID, newAmountQuota(100, 100))
+
+			msg = types.NewMsgRemoveRateLimit(rateLimitingKeeper.GetAuthority(), sdk.DefaultBondDenom, ibctesting.FirstChannelID)
+
+			tc.malleate()
+
+			_, err := rateLimitingKeeper.RemoveRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				_, found := rateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResetRateLimit() {
+	var msg *types.MsgResetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = "atom"
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 100))
+
+			_, err := suite.transfer(sdk.DefaultBondDenom, 100, suite.chainB.SenderAccount.GetAddress().String(), 0)
+			suite.Require().NoError(err)
+
+			suite.coordinator.IncrementTimeBy(time.Hour)
+
+			msg = types.NewMsgResetRateLimit(rateLimitingKeeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			_, err = rateLimitingKeeper.ResetRateLimit(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := rateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+				suite.Require().Empty(rateLimit.Flow.Buckets)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().UTC(), rateLimit.Flow.ResetTime.UTC())
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
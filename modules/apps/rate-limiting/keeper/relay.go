@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// SendPacket wraps the ICS4Wrapper SendPacket function. The outflow of the rate limits of the
+// tokens sent is increased and an error is returned if any send threshold is exceeded. If a
+// token is rate limited, the packet is stored so that the outflow can be undone if the packet
+// fails or times out.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	packetData, err := k.unmarshalPacketData(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, err
+	}
+
+	var rateLimited bool
+	for _, token := range packetData.Tokens {
+		amount, err := parseAmount(token)
+		if err != nil {
+			return 0, err
+		}
+
+		found, err := k.addFlow(ctx, sourceChannel, k.transferKeeper.DenomFromPath(ctx, token.Denom).IBCDenom(), amount, true)
+		if err != nil {
+			return 0, err
+		}
+
+		rateLimited = rateLimited || found
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if rateLimited {
+		k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(sourceChannel, sequence, ctx.BlockTime()))
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement wraps IBC ICS4Wrapper WriteAcknowledgement function.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion wraps IBC ICS4Wrapper GetAppVersion function.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnRecvPacket increases the inflow of the rate limits of the tokens received in the provided
+// packet. An error is returned if any receive threshold is exceeded. Invalid packet data is
+// ignored as the packet is rejected by the underlying transfer application.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetData, err := k.unmarshalPacketData(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Data)
+	if err != nil || packetData.ValidateBasic() != nil {
+		return nil
+	}
+
+	for _, token := range packetData.Tokens {
+		amount, err := parseAmount(token)
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.addFlow(ctx, packet.DestinationChannel, k.receivedDenom(ctx, packet, token.Denom), amount, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket removes the pending send packet of the provided packet and undoes its
+// outflow if the acknowledgement is an error.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if ack.Success() {
+		return nil
+	}
+
+	return k.undoSend(ctx, packet, pendingSendPacket)
+}
+
+// OnTimeoutPacket removes the pending send packet of the provided packet and undoes its outflow.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	return k.undoSend(ctx, packet, pendingSendPacket)
+}
+
+// undoSend decreases the outflow of the rate limits of the tokens sent in the provided packet.
+// The outflow is only decreased if the packet was sent within the window of a rate limit.
+func (k Keeper) undoSend(ctx sdk.Context, packet channeltypes.Packet, pendingSendPacket types.PendingSendPacket) error {
+	packetData, err := k.unmarshalPacketData(ctx, packet.SourcePort, packet.SourceChannel, packet.Data)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range packetData.Tokens {
+		amount, err := parseAmount(token)
+		if err != nil {
+			return err
+		}
+
+		rateLimit, found := k.GetRateLimit(ctx, packet.SourceChannel, k.transferKeeper.DenomFromPath(ctx, token.Denom).IBCDenom())
+		if !found {
+			continue
+		}
+
+		rateLimit.UndoOutflow(amount, pendingSendPacket.SendTime)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// addFlow adds the provided amount to the outflow or inflow of the rate limit of the provided
+// channel and denomination within the window ending at the current block time. The boolean
+// returned is false if the denomination is not rate limited on the channel.
+func (k Keeper) addFlow(ctx sdk.Context, channelID, denom string, amount sdkmath.Int, send bool) (bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return false, nil
+	}
+
+	k.updateFlow(ctx, &rateLimit)
+
+	addFlow := rateLimit.AddInflow
+	if send {
+		addFlow = rateLimit.AddOutflow
+	}
+
+	if err := addFlow(amount, ctx.BlockTime()); err != nil {
+		return true, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return true, nil
+}
+
+// unmarshalPacketData unmarshals the provided ICS20 packet data according to the application
+// version of the provided channel.
+func (k Keeper) unmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (transfertypes.FungibleTokenPacketDataV2, error) {
+	ics20Version, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return transfertypes.UnmarshalPacketData(bz, ics20Version)
+}
+
+// receivedDenom returns the denomination of a token received in the provided packet as it is
+// represented on this chain.
+func (k Keeper) receivedDenom(ctx sdk.Context, packet channeltypes.Packet, denom string) string {
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+	// chain would have prefixed with DestPort and DestChannel when originally
+	// receiving this coin as seen in the "sender chain is the source" condition.
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		return k.transferKeeper.DenomFromPath(ctx, denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// the voucher is prefixed with the destination port and channel as the most recent hop of the trace
+	receivedDenom := transfertypes.ExtractDenomFromPath(denom)
+	voucher := transfertypes.NewDenom(
+		receivedDenom.Base,
+		append([]transfertypes.Hop{transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)}, receivedDenom.Trace...)...,
+	)
+
+	return voucher.IBCDenom()
+}
+
+// parseAmount parses the amount of the provided token.
+func parseAmount(token transfertypes.Token) (sdkmath.Int, error) {
+	amount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return sdkmath.Int{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+	}
+
+	return amount, nil
+}
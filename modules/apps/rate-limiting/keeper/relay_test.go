@@ -0,0 +1,323 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// transfer sends the provided amount of the provided denomination from chainA to chainB and returns the packet sent.
+func (suite *KeeperTestSuite) transfer(denom string, amount int64, receiver string, timeoutTimestamp uint64) (channeltypes.Packet, error) {
+	timeoutHeight := suite.chainB.GetTimeoutHeight()
+	if timeoutTimestamp != 0 {
+		timeoutHeight = clienttypes.ZeroHeight()
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(denom, sdkmath.NewInt(amount)), suite.chainA.SenderAccount.GetAddress().String(), receiver,
+		timeoutHeight, timeoutTimestamp, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return ibctesting.ParsePacketFromEvents(res.Events)
+}
+
+func (suite *KeeperTestSuite) TestSendPacket() {
+	var amount int64
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: within quota",
+			func() {
+				amount = 100
+			},
+			nil,
+		},
+		{
+			"success: denomination not rate limited on channel",
+			func() {
+				suite.chainA.GetSimApp().RateLimitingKeeper.DeleteRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				amount = 1000
+			},
+			nil,
+		},
+		{
+			"success: window expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(24 * time.Hour)
+				amount = 100
+			},
+			nil,
+		},
+		{
+			"failure: send threshold exceeded",
+			func() {
+				amount = 101
+			},
+			types.ErrQuotaExceeded,
+		},
+		{
+			"failure: send threshold exceeded within the rolling window",
+			func() {
+				suite.coordinator.IncrementTimeBy(23 * time.Hour)
+				amount = 101
+			},
+			types.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(200, 0))
+
+			// fill half of the quota within the current window
+			_, err := suite.transfer(sdk.DefaultBondDenom, 100, suite.chainB.SenderAccount.GetAddress().String(), 0)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			packet, err := suite.transfer(sdk.DefaultBondDenom, amount, suite.chainB.SenderAccount.GetAddress().String(), 0)
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			rateLimit, found := rateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				if found {
+					suite.Require().True(rateLimit.Flow.Outflow.LTE(sdkmath.NewInt(200)))
+
+					_, found = rateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+			}
+		})
+	}
+}
+
+// TestSendPacketBaseDenomWithSlashes tests that the rate limit of a native denomination is enforced
+// when its base denomination resembles a trace.
+func (suite *KeeperTestSuite) TestSendPacketBaseDenomWithSlashes() {
+	baseDenom := "factory/channel-7/utoken"
+
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))))
+	suite.addRateLimit(suite.chainA, baseDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 0))
+
+	_, err := suite.transfer(baseDenom, 100, suite.chainB.SenderAccount.GetAddress().String(), 0)
+	suite.Require().NoError(err)
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, baseDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	_, err = suite.transfer(baseDenom, 1, suite.chainB.SenderAccount.GetAddress().String(), 0)
+	suite.Require().ErrorContains(err, types.ErrQuotaExceeded.Error())
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		amount       int64
+		voucherDenom string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: within quota",
+			func() {
+				amount = 100
+			},
+			true,
+		},
+		{
+			"success: percentage threshold not enforced while the channel value is zero",
+			func() {
+				rateLimitingKeeper := suite.chainB.GetSimApp().RateLimitingKeeper
+				rateLimit, found := rateLimitingKeeper.GetRateLimit(suite.chainB.GetContext(), suite.path.EndpointB.ChannelID, voucherDenom)
+				suite.Require().True(found)
+				suite.Require().True(rateLimit.Flow.ChannelValue.IsZero())
+
+				rateLimit.Quota = types.NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(10), sdkmath.ZeroInt(), sdkmath.ZeroInt(), 24)
+				rateLimitingKeeper.SetRateLimit(suite.chainB.GetContext(), rateLimit)
+
+				amount = 1000
+			},
+			true,
+		},
+		{
+			"failure: receive threshold exceeded",
+			func() {
+				amount = 101
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			voucherDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+			)).IBCDenom()
+			suite.addRateLimit(suite.chainB, voucherDenom, suite.path.EndpointB.ChannelID, newAmountQuota(0, 100))
+
+			tc.malleate()
+
+			packet, err := suite.transfer(sdk.DefaultBondDenom, amount, suite.chainB.SenderAccount.GetAddress().String(), 0)
+			suite.Require().NoError(err)
+
+			err = suite.path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+			rateLimit, found := suite.chainB.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainB.GetContext(), suite.path.EndpointB.ChannelID, voucherDenom)
+			suite.Require().True(found)
+
+			if tc.expPass {
+				suite.Require().Equal(sdkmath.NewInt(amount), balance.Amount)
+				suite.Require().Equal(sdkmath.NewInt(amount), rateLimit.Flow.Inflow)
+			} else {
+				suite.Require().True(balance.Amount.IsZero())
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+
+				// the tokens are refunded to the sender on chainA upon receiving the error acknowledgement
+				escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+				suite.Require().True(escrowBalance.Amount.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndoSend() {
+	var (
+		receiver         string
+		timeoutTimestamp uint64
+		packet           channeltypes.Packet
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		complete   func()
+		expOutflow sdkmath.Int
+	}{
+		{
+			"successful acknowledgement does not undo outflow",
+			func() {},
+			func() {
+				err := suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			},
+			sdkmath.NewInt(100),
+		},
+		{
+			"error acknowledgement undoes outflow",
+			func() {
+				receiver = "invalid-receiver"
+			},
+			func() {
+				err := suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			},
+			sdkmath.ZeroInt(),
+		},
+		{
+			"timeout undoes outflow",
+			func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			},
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				err := suite.path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = suite.path.EndpointA.TimeoutPacket(packet)
+				suite.Require().NoError(err)
+			},
+			sdkmath.ZeroInt(),
+		},
+		{
+			"error acknowledgement does not undo outflow sent before the rate limit was reset",
+			func() {
+				receiver = "invalid-receiver"
+			},
+			func() {
+				suite.coordinator.IncrementTime()
+
+				rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+				msg := types.NewMsgResetRateLimit(rateLimitingKeeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+				_, err := rateLimitingKeeper.ResetRateLimit(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				// send after the reset so that its outflow is not undone
+				_, err = suite.transfer(sdk.DefaultBondDenom, 50, suite.chainB.SenderAccount.GetAddress().String(), 0)
+				suite.Require().NoError(err)
+
+				err = suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			},
+			sdkmath.NewInt(50),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			timeoutTimestamp = 0
+
+			suite.addRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, newAmountQuota(100, 0))
+
+			tc.malleate()
+
+			var err error
+			packet, err = suite.transfer(sdk.DefaultBondDenom, 100, receiver, timeoutTimestamp)
+			suite.Require().NoError(err)
+
+			tc.complete()
+
+			rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+			rateLimit, found := rateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+
+			_, found = rateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// rate limiting sentinel errors
+var (
+	ErrInvalidQuota         = errorsmod.Register(ModuleName, 2, "invalid quota")
+	ErrInvalidPath          = errorsmod.Register(ModuleName, 3, "invalid rate limit path")
+	ErrRateLimitNotFound    = errorsmod.Register(ModuleName, 4, "rate limit not found")
+	ErrRateLimitExists      = errorsmod.Register(ModuleName, 5, "rate limit already exists")
+	ErrQuotaExceeded        = errorsmod.Register(ModuleName, 6, "quota exceeded")
+	ErrUnsupportedAction    = errorsmod.Register(ModuleName, 7, "unsupported action")
+	ErrInvalidPendingPacket = errorsmod.Register(ModuleName, 8, "invalid pending send packet")
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// TransferKeeper defines the expected transfer keeper
+type TransferKeeper interface {
+	DenomFromPath(ctx sdk.Context, fullDenomPath string) transfertypes.Denom
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a rate limiting GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no rate limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenPaths := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		path := rateLimit.Path.String()
+		if seenPaths[path] {
+			return fmt.Errorf("duplicate rate limit found for path %s", path)
+		}
+
+		seenPaths[path] = true
+	}
+
+	for _, pendingSendPacket := range gs.PendingSendPackets {
+		if err := pendingSendPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestValidateDefaultGenesis(t *testing.T) {
+	err := types.DefaultGenesisState().Validate()
+	require.NoError(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	rateLimit := types.NewRateLimit(types.NewPath(ibcDenom, ibctesting.FirstChannelID), validQuota, types.NewFlow(sdkmath.NewInt(1000), time.Now()))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid rate limit path",
+			func() {
+				genState.RateLimits[0].Path.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid rate limit flow",
+			func() {
+				genState.RateLimits[0].Flow.Outflow = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"duplicate rate limit",
+			func() {
+				genState.RateLimits = append(genState.RateLimits, rateLimit)
+			},
+			false,
+		},
+		{
+			"invalid pending send packet",
+			func() {
+				genState.PendingSendPackets[0].Sequence = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genState = types.NewGenesisState(
+				[]types.RateLimit{rateLimit},
+				[]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.FirstChannelID, 1, time.Now())},
+			)
+
+			tc.malleate()
+
+			err := genState.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
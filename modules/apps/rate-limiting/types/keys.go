@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+const (
+	// ModuleName defines the rate limiting module name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting module
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for the rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for the packets sent by this chain awaiting
+	// an acknowledgement or timeout
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimitChannelPrefix returns the key prefix for the rate limits of the given channel
+func KeyRateLimitChannelPrefix(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RateLimitKeyPrefix, channelID))
+}
+
+// KeyRateLimit returns the key for the rate limit of the given channel and denomination.
+// The denomination is the last element of the key as it may contain separators.
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyPendingSendPacket returns the key for the pending send packet of the given channel and sequence
+func KeyPendingSendPacket(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", PendingSendPacketKeyPrefix, channelID, sequence))
+}
+
+// ParseKeyPendingSendPacket parses the key used to store a pending send packet and returns
+// the channel identifier and sequence of the packet
+func ParseKeyPendingSendPacket(key string) (channelID string, sequence uint64, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", 0, errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != PendingSendPacketKeyPrefix {
+		return "", 0, errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", PendingSendPacketKeyPrefix, keySplit[0])
+	}
+
+	sequence, err = strconv.ParseUint(keySplit[2], 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return keySplit[1], sequence, nil
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestKeyRateLimit(t *testing.T) {
+	key := types.KeyRateLimit(ibctesting.FirstChannelID, ibcDenom)
+	require.Equal(t, fmt.Sprintf("%s/%s/%s", types.RateLimitKeyPrefix, ibctesting.FirstChannelID, ibcDenom), string(key))
+	require.True(t, len(key) > len(types.KeyRateLimitChannelPrefix(ibctesting.FirstChannelID)))
+}
+
+func TestParseKeyPendingSendPacket(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyPendingSendPacket(ibctesting.FirstChannelID, 1)),
+			true,
+		},
+		{
+			"incorrect key prefix",
+			fmt.Sprintf("%s/%s/%d", types.RateLimitKeyPrefix, ibctesting.FirstChannelID, 1),
+			false,
+		},
+		{
+			"incorrect key length",
+			fmt.Sprintf("%s/%s", types.PendingSendPacketKeyPrefix, ibctesting.FirstChannelID),
+			false,
+		},
+		{
+			"invalid sequence",
+			fmt.Sprintf("%s/%s/%s", types.PendingSendPacketKeyPrefix, ibctesting.FirstChannelID, "sequence"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			channelID, sequence, err := types.ParseKeyPendingSendPacket(tc.key)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.FirstChannelID, channelID)
+				require.Equal(t, uint64(1), sequence)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
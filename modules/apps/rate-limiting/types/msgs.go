@@ -0,0 +1,111 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgAddRateLimit)(nil)
+	_ sdk.Msg              = (*MsgUpdateRateLimit)(nil)
+	_ sdk.Msg              = (*MsgRemoveRateLimit)(nil)
+	_ sdk.Msg              = (*MsgResetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgAddRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgResetRateLimit)(nil)
+)
+
+// NewMsgAddRateLimit creates a new MsgAddRateLimit instance
+func NewMsgAddRateLimit(signer, denom, channelID string, quota Quota) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Signer:    signer,
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+// NewMsgUpdateRateLimit creates a new MsgUpdateRateLimit instance
+func NewMsgUpdateRateLimit(signer, denom, channelID string, quota Quota) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Signer:    signer,
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelId).Validate(); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(signer, denom, channelID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer:    signer,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelId).Validate()
+}
+
+// NewMsgResetRateLimit creates a new MsgResetRateLimit instance
+func NewMsgResetRateLimit(signer, denom, channelID string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Signer:    signer,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelId).Validate()
+}
+
+// validateSigner returns an error if the signer is not a valid bech32 address.
+func validateSigner(signer string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var (
+	defaultAccAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	ibcDenom          = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+)
+
+func TestMsgAddRateLimitValidation(t *testing.T) {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: ibc denomination",
+			func() {
+				msg.Denom = ibcDenom
+			},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid denomination",
+			func() {
+				msg.Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = "channel/0"
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				msg.Quota.DurationHours = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgAddRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID, validQuota)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateRateLimitValidation(t *testing.T) {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				msg.Quota.MaxPercentSend = msg.Quota.MaxPercentSend.MulRaw(100)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgUpdateRateLimit(defaultAccAddress, sdk.DefaultBondDenom, ibctesting.FirstChannelID, validQuota)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateLimitValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRemoveRateLimit
+		expPass bool
+	}{
+		{"success", types.NewMsgRemoveRateLimit(defaultAccAddress, ibcDenom, ibctesting.FirstChannelID), true},
+		{"invalid signer address", types.NewMsgRemoveRateLimit("invalid-address", ibcDenom, ibctesting.FirstChannelID), false},
+		{"invalid denomination", types.NewMsgRemoveRateLimit(defaultAccAddress, "1stake", ibctesting.FirstChannelID), false},
+		{"invalid channel ID", types.NewMsgRemoveRateLimit(defaultAccAddress, ibcDenom, ""), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgResetRateLimitValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgResetRateLimit
+		expPass bool
+	}{
+		{"success", types.NewMsgResetRateLimit(defaultAccAddress, ibcDenom, ibctesting.FirstChannelID), true},
+		{"invalid signer address", types.NewMsgResetRateLimit("invalid-address", ibcDenom, ibctesting.FirstChannelID), false},
+		{"invalid denomination", types.NewMsgResetRateLimit(defaultAccAddress, "1stake", ibctesting.FirstChannelID), false},
+		{"invalid channel ID", types.NewMsgResetRateLimit(defaultAccAddress, ibcDenom, ""), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
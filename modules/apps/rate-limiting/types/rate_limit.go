@@ -0,0 +1,316 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxPercent is the maximum percentage of the channel value which may be set as a quota threshold
+var MaxPercent = sdkmath.NewInt(100)
+
+// FlowBuckets is the number of buckets into which the window of a rate limit is divided
+const FlowBuckets = 12
+
+// NewPath creates a new Path instance
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the Path fields
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPath, "invalid denomination %s: %s", p.Denom, err)
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPath, "invalid channel ID %s: %s", p.ChannelId, err)
+	}
+
+	return nil
+}
+
+// String returns the Path in the format:
+// <channelID>/<denom>
+func (p Path) String() string {
+	return fmt.Sprintf("%s/%s", p.ChannelId, p.Denom)
+}
+
+// NewQuota creates a new Quota instance. A threshold set to zero is disabled.
+func NewQuota(maxPercentSend, maxPercentRecv, maxAmountSend, maxAmountRecv sdkmath.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs a basic validation of the Quota fields. The percentages must be between
+// 0 and 100, the amounts must not be negative, at least one threshold must be set and the
+// duration must be non-zero.
+func (q Quota) Validate() error {
+	for _, percent := range []sdkmath.Int{q.MaxPercentSend, q.MaxPercentRecv} {
+		if percent.IsNil() || percent.IsNegative() || percent.GT(MaxPercent) {
+			return errorsmod.Wrapf(ErrInvalidQuota, "percentage must be between 0 and %s, got %s", MaxPercent, percent)
+		}
+	}
+
+	for _, amount := range []sdkmath.Int{q.MaxAmountSend, q.MaxAmountRecv} {
+		if amount.IsNil() || amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidQuota, "amount must not be negative, got %s", amount)
+		}
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() && q.MaxAmountSend.IsZero() && q.MaxAmountRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidQuota, "at least one threshold must be set")
+	}
+
+	if q.DurationHours == 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "duration must be greater than zero")
+	}
+
+	return nil
+}
+
+// Duration returns the length of the rate limit window.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// BucketDuration returns the length of a bucket of the rate limit window.
+func (q Quota) BucketDuration() time.Duration {
+	return q.Duration() / FlowBuckets
+}
+
+// SendThreshold returns the maximum outflow allowed within a window given the channel value.
+// The boolean returned is false if no send threshold is set.
+func (q Quota) SendThreshold(channelValue sdkmath.Int) (sdkmath.Int, bool) {
+	return threshold(q.MaxPercentSend, q.MaxAmountSend, channelValue)
+}
+
+// RecvThreshold returns the maximum inflow allowed within a window given the channel value.
+// The boolean returned is false if no receive threshold is set.
+func (q Quota) RecvThreshold(channelValue sdkmath.Int) (sdkmath.Int, bool) {
+	return threshold(q.MaxPercentRecv, q.MaxAmountRecv, channelValue)
+}
+
+// threshold returns the lowest of the percentage and absolute thresholds which are set. The
+// percentage threshold is ignored while the channel value is zero, as it would otherwise
+// block all transfers of the denomination until the channel value is updated.
+func threshold(percent, amount, channelValue sdkmath.Int) (sdkmath.Int, bool) {
+	var (
+		limit sdkmath.Int
+		found bool
+	)
+
+	if percent.IsPositive() && channelValue.IsPositive() {
+		limit, found = channelValue.Mul(percent).Quo(MaxPercent), true
+	}
+
+	if amount.IsPositive() && (!found || amount.LT(limit)) {
+		limit, found = amount, true
+	}
+
+	return limit, found
+}
+
+// NewFlow creates a new Flow instance with no inflow or outflow, reset at the provided time.
+func NewFlow(channelValue sdkmath.Int, resetTime time.Time) Flow {
+	return Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+		ResetTime:    resetTime,
+	}
+}
+
+// Validate performs a basic validation of the Flow fields. The buckets must be in ascending
+// order of their start time and their amounts must add up to the inflow and outflow.
+func (f Flow) Validate() error {
+	for _, amount := range []sdkmath.Int{f.Inflow, f.Outflow, f.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidQuota, "flow amounts must not be negative, got %s", amount)
+		}
+	}
+
+	inflow, outflow := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for i, bucket := range f.Buckets {
+		for _, amount := range []sdkmath.Int{bucket.Inflow, bucket.Outflow} {
+			if amount.IsNil() || amount.IsNegative() {
+				return errorsmod.Wrapf(ErrInvalidQuota, "flow amounts must not be negative, got %s", amount)
+			}
+		}
+
+		if i > 0 && !bucket.Start.After(f.Buckets[i-1].Start) {
+			return errorsmod.Wrapf(ErrInvalidQuota, "flow bucket starting at %s is not after the previous bucket", bucket.Start)
+		}
+
+		inflow, outflow = inflow.Add(bucket.Inflow), outflow.Add(bucket.Outflow)
+	}
+
+	if !inflow.Equal(f.Inflow) || !outflow.Equal(f.Outflow) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "flow buckets add up to inflow %s and outflow %s, expected %s and %s", inflow, outflow, f.Inflow, f.Outflow)
+	}
+
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(path Path, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  flow,
+	}
+}
+
+// Validate performs a basic validation of the RateLimit fields.
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// ExpireFlow removes the buckets which have left the window ending at the provided time from
+// the flow of the rate limit. A bucket is only removed once it has entirely left the window, so
+// the flow counted against a threshold covers at least the full window duration.
+func (rl *RateLimit) ExpireFlow(blockTime time.Time) {
+	windowStart := blockTime.Add(-rl.Quota.Duration())
+
+	var expired int
+	for _, bucket := range rl.Flow.Buckets {
+		if bucket.Start.Add(rl.Quota.BucketDuration()).After(windowStart) {
+			break
+		}
+
+		rl.Flow.Inflow = rl.Flow.Inflow.Sub(bucket.Inflow)
+		rl.Flow.Outflow = rl.Flow.Outflow.Sub(bucket.Outflow)
+		expired++
+	}
+
+	rl.Flow.Buckets = rl.Flow.Buckets[expired:]
+}
+
+// HasBucket returns true if the flow of the rate limit has a bucket containing the provided time.
+func (rl RateLimit) HasBucket(blockTime time.Time) bool {
+	_, found := rl.bucketIndex(blockTime)
+	return found
+}
+
+// AddOutflow adds the provided amount to the outflow of the rate limit at the provided time. An
+// error is returned if the outflow within the window exceeds the send threshold of the quota.
+func (rl *RateLimit) AddOutflow(amount sdkmath.Int, blockTime time.Time) error {
+	outflow := rl.Flow.Outflow.Add(amount)
+	if limit, found := rl.Quota.SendThreshold(rl.Flow.ChannelValue); found && outflow.GT(limit) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded, "outflow of %s on %s would exceed the send threshold of %s", outflow, rl.Path, limit,
+		)
+	}
+
+	bucket := rl.bucket(blockTime)
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	rl.Flow.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds the provided amount to the inflow of the rate limit at the provided time. An
+// error is returned if the inflow within the window exceeds the receive threshold of the quota.
+func (rl *RateLimit) AddInflow(amount sdkmath.Int, blockTime time.Time) error {
+	inflow := rl.Flow.Inflow.Add(amount)
+	if limit, found := rl.Quota.RecvThreshold(rl.Flow.ChannelValue); found && inflow.GT(limit) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded, "inflow of %s on %s would exceed the receive threshold of %s", inflow, rl.Path, limit,
+		)
+	}
+
+	bucket := rl.bucket(blockTime)
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	rl.Flow.Inflow = inflow
+	return nil
+}
+
+// UndoOutflow subtracts the provided amount from the outflow of the bucket containing the
+// provided send time. Nothing is undone if the tokens were sent before the flow was last reset
+// or if the bucket has left the window. The outflow cannot become negative.
+func (rl *RateLimit) UndoOutflow(amount sdkmath.Int, sendTime time.Time) {
+	if sendTime.Before(rl.Flow.ResetTime) {
+		return
+	}
+
+	i, found := rl.bucketIndex(sendTime)
+	if !found {
+		return
+	}
+
+	bucket := &rl.Flow.Buckets[i]
+	amount = sdkmath.MinInt(amount, bucket.Outflow)
+	bucket.Outflow = bucket.Outflow.Sub(amount)
+	rl.Flow.Outflow = rl.Flow.Outflow.Sub(amount)
+}
+
+// bucketIndex returns the index of the bucket containing the provided time.
+func (rl RateLimit) bucketIndex(blockTime time.Time) (int, bool) {
+	start := blockTime.Truncate(rl.Quota.BucketDuration())
+	for i, bucket := range rl.Flow.Buckets {
+		if bucket.Start.Equal(start) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// bucket returns the bucket containing the provided time, appending a new bucket to the flow
+// if it does not exist yet. The provided time must not be before the start of the last bucket.
+func (rl *RateLimit) bucket(blockTime time.Time) *FlowBucket {
+	if i, found := rl.bucketIndex(blockTime); found {
+		return &rl.Flow.Buckets[i]
+	}
+
+	rl.Flow.Buckets = append(rl.Flow.Buckets, FlowBucket{
+		Start:   blockTime.Truncate(rl.Quota.BucketDuration()),
+		Inflow:  sdkmath.ZeroInt(),
+		Outflow: sdkmath.ZeroInt(),
+	})
+
+	return &rl.Flow.Buckets[len(rl.Flow.Buckets)-1]
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(channelID string, sequence uint64, sendTime time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		SendTime:  sendTime,
+	}
+}
+
+// Validate performs a basic validation of the PendingSendPacket fields.
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPendingPacket, "invalid channel ID %s: %s", p.ChannelId, err)
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingPacket, "sequence cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var validQuota = types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.ZeroInt(), sdkmath.ZeroInt(), 24)
+
+func TestQuotaValidate(t *testing.T) {
+	var quota types.Quota
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: only absolute amount set",
+			func() {
+				quota = types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(1000), sdkmath.ZeroInt(), 1)
+			},
+			true,
+		},
+		{
+			"success: percentage of 100",
+			func() {
+				quota.MaxPercentSend = sdkmath.NewInt(100)
+			},
+			true,
+		},
+		{
+			"failure: percentage greater than 100",
+			func() {
+				quota.MaxPercentRecv = sdkmath.NewInt(101)
+			},
+			false,
+		},
+		{
+			"failure: negative percentage",
+			func() {
+				quota.MaxPercentSend = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"failure: negative amount",
+			func() {
+				quota.MaxAmountRecv = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"failure: nil amount",
+			func() {
+				quota.MaxAmountSend = sdkmath.Int{}
+			},
+			false,
+		},
+		{
+			"failure: no threshold set",
+			func() {
+				quota = types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt(), 24)
+			},
+			false,
+		},
+		{
+			"failure: zero duration",
+			func() {
+				quota.DurationHours = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			quota = validQuota
+
+			tc.malleate()
+
+			err := quota.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidQuota)
+			}
+		})
+	}
+}
+
+func TestQuotaThresholds(t *testing.T) {
+	channelValue := sdkmath.NewInt(1000)
+
+	testCases := []struct {
+		name         string
+		quota        types.Quota
+		expSend      sdkmath.Int
+		expSendFound bool
+		expRecv      sdkmath.Int
+		expRecvFound bool
+	}{
+		{
+			"percentage thresholds",
+			types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(50), sdkmath.ZeroInt(), sdkmath.ZeroInt(), 1),
+			sdkmath.NewInt(100), true,
+			sdkmath.NewInt(500), true,
+		},
+		{
+			"absolute thresholds",
+			types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(10), sdkmath.ZeroInt(), 1),
+			sdkmath.NewInt(10), true,
+			sdkmath.Int{}, false,
+		},
+		{
+			"lowest of percentage and absolute thresholds",
+			types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(50), sdkmath.NewInt(500), 1),
+			sdkmath.NewInt(50), true,
+			sdkmath.NewInt(100), true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			send, found := tc.quota.SendThreshold(channelValue)
+			require.Equal(t, tc.expSendFound, found)
+			if found {
+				require.Equal(t, tc.expSend, send)
+			}
+
+			recv, found := tc.quota.RecvThreshold(channelValue)
+			require.Equal(t, tc.expRecvFound, found)
+			if found {
+				require.Equal(t, tc.expRecv, recv)
+			}
+		})
+	}
+}
+
+func TestQuotaThresholdsZeroChannelValue(t *testing.T) {
+	quota := types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(50), sdkmath.ZeroInt(), 1)
+
+	// the percentage thresholds are ignored, falling back to the absolute thresholds
+	send, found := quota.SendThreshold(sdkmath.ZeroInt())
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(50), send)
+
+	_, found = quota.RecvThreshold(sdkmath.ZeroInt())
+	require.False(t, found)
+}
+
+func TestFlowValidate(t *testing.T) {
+	var flow types.Flow
+
+	start := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"negative bucket amount",
+			func() {
+				flow.Buckets[0].Inflow = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"buckets not in ascending order",
+			func() {
+				flow.Buckets[1].Start = start
+			},
+			false,
+		},
+		{
+			"buckets do not add up to the outflow",
+			func() {
+				flow.Outflow = sdkmath.NewInt(20)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			flow = types.NewFlow(sdkmath.NewInt(1000), start)
+			flow.Inflow, flow.Outflow = sdkmath.NewInt(5), sdkmath.NewInt(30)
+			flow.Buckets = []types.FlowBucket{
+				{Start: start, Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(10)},
+				{Start: start.Add(time.Minute), Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.NewInt(20)},
+			}
+
+			tc.malleate()
+
+			err := flow.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidQuota)
+			}
+		})
+	}
+}
+
+func TestRateLimitFlow(t *testing.T) {
+	// one hour windows are divided into buckets of five minutes
+	start := time.Unix(1700000000, 0).Truncate(5 * time.Minute)
+	rateLimit := types.NewRateLimit(
+		types.NewPath("stake", ibctesting.FirstChannelID),
+		types.NewQuota(sdkmath.NewInt(10), sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(5), 1),
+		types.NewFlow(sdkmath.NewInt(1000), start),
+	)
+	require.NoError(t, rateLimit.Validate())
+
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(60), start))
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(40), start.Add(30*time.Minute)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.NewInt(1), start.Add(30*time.Minute)), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+	require.Len(t, rateLimit.Flow.Buckets, 2)
+
+	require.NoError(t, rateLimit.AddInflow(sdkmath.NewInt(5), start))
+	require.ErrorIs(t, rateLimit.AddInflow(sdkmath.NewInt(1), start), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(5), rateLimit.Flow.Inflow)
+	require.NoError(t, rateLimit.Validate())
+
+	// the first bucket remains within the window until all of it is older than an hour
+	rateLimit.ExpireFlow(start.Add(time.Hour + 5*time.Minute - time.Nanosecond))
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	rateLimit.ExpireFlow(start.Add(time.Hour + 5*time.Minute))
+	require.Equal(t, sdkmath.NewInt(40), rateLimit.Flow.Outflow)
+	require.True(t, rateLimit.Flow.Inflow.IsZero())
+	require.Len(t, rateLimit.Flow.Buckets, 1)
+	require.NoError(t, rateLimit.Validate())
+
+	// nothing is undone for tokens sent in a bucket which has left the window
+	rateLimit.UndoOutflow(sdkmath.NewInt(60), start)
+	require.Equal(t, sdkmath.NewInt(40), rateLimit.Flow.Outflow)
+
+	// nothing is undone for tokens sent before the flow was reset
+	rateLimit.Flow.ResetTime = start.Add(31 * time.Minute)
+	rateLimit.UndoOutflow(sdkmath.NewInt(10), start.Add(30*time.Minute))
+	require.Equal(t, sdkmath.NewInt(40), rateLimit.Flow.Outflow)
+	rateLimit.Flow.ResetTime = start
+
+	rateLimit.UndoOutflow(sdkmath.NewInt(10), start.Add(30*time.Minute))
+	require.Equal(t, sdkmath.NewInt(30), rateLimit.Flow.Outflow)
+
+	rateLimit.UndoOutflow(sdkmath.NewInt(100), start.Add(30*time.Minute))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.NoError(t, rateLimit.Validate())
+
+	rateLimit.ExpireFlow(start.Add(2 * time.Hour))
+	require.Empty(t, rateLimit.Flow.Buckets)
+}
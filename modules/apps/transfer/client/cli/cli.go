@@ -22,6 +22,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryEscrowReconciliation(),
+		GetCmdQueryDenomsByBase(),
+		GetCmdQueryDenomsByChannel(),
+		GetCmdQueryDenomMetadata(),
+		GetCmdQueryChannelDenomFilter(),
 	)
 
 	return queryCmd
@@ -39,6 +44,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewTransferAllTxCmd(),
 	)
 
 	return txCmd
@@ -201,3 +201,177 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDenomsByBase defines the command to query all the denominations with a given base denomination.
+func GetCmdQueryDenomsByBase() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denoms-by-base [base-denom]",
+		Short:   "Query all the token denominations with a given base denomination",
+		Long:    "Query all the token denominations with a given base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denoms-by-base uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsByBaseRequest{
+				BaseDenom:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomsByBase(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations by base")
+
+	return cmd
+}
+
+// GetCmdQueryDenomsByChannel defines the command to query all the denominations which have
+// travelled through a given channel.
+func GetCmdQueryDenomsByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denoms-by-channel [port-id] [channel-id]",
+		Short:   "Query all the token denominations which have travelled through a channel",
+		Long:    "Query all the token denominations which have travelled through a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer denoms-by-channel transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsByChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomsByChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations by channel")
+
+	return cmd
+}
+
+// GetCmdQueryDenomMetadata defines the command to query the resolved bank metadata of an IBC denomination.
+func GetCmdQueryDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-metadata [denom]",
+		Short:   "Query the bank metadata of an IBC denomination",
+		Long:    "Query the bank metadata of an IBC denomination. The default metadata derived from the trace is returned if no metadata is stored.",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-metadata ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomMetadataRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelDenomFilter defines the command to query the denomination restrictions applied to a channel.
+func GetCmdQueryChannelDenomFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-denom-filter [port-id] [channel-id]",
+		Short:   "Query the denomination restrictions applied to a channel",
+		Long:    "Query the denominations allowed and disabled for sending over a channel, along with the denominations which cannot be received over any channel",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-denom-filter transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelDenomFilterRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelDenomFilter(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowReconciliation defines the command to query the escrow reconciliation report.
+func GetCmdQueryEscrowReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-reconciliation",
+		Short:   "Query the balances of all channel escrow accounts next to the tracked total escrow of each denom",
+		Long:    "Query the balances of all channel escrow accounts next to the tracked total escrow of each denom. The difference of each denom is its actual amount in escrow minus its tracked amount.",
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-reconciliation", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowReconciliation(cmd.Context(), &types.QueryEscrowReconciliationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
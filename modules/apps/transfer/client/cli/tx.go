@@ -26,20 +26,27 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagForwarding             = "forwarding"
+	flagUnwind                 = "unwind"
+	flagAllOrNothing           = "all-or-nothing"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
-		Short: "Transfer a fungible token through IBC",
-		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified
+		Use:   "transfer [src-port] [src-channel] [receiver] [coins]",
+		Short: "Transfer fungible tokens through IBC",
+		Long: strings.TrimSpace(`Transfer fungible tokens through IBC. Multiple comma separated coins may be provided
+when the channel was negotiated with the ics20-2 version. Timeouts can be specified
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
-		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
+corresponding to the counterparty channel. Any timeout set to 0 is disabled. Tokens may be forwarded through
+intermediate chains by passing a comma separated list of {portID}/{channelID} hops using the "forwarding" flag.
+The "unwind" flag sends the tokens back to their native chain before forwarding; the source port and channel are
+then derived from the token trace and the provided ones are only used to compute relative timeouts.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [coins]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -51,105 +58,122 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
+				}
 			}
 
-			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			memo, err := cmd.Flags().GetString(flagMemo)
 			if err != nil {
 				return err
 			}
-			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+
+			forwardingHopsStr, err := cmd.Flags().GetString(flagForwarding)
 			if err != nil {
 				return err
 			}
-
-			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			forwardingHops, err := types.ParseHops(forwardingHopsStr)
 			if err != nil {
 				return err
 			}
 
-			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			unwind, err := cmd.Flags().GetBool(flagUnwind)
 			if err != nil {
 				return err
 			}
 
-			memo, err := cmd.Flags().GetString(flagMemo)
+			timeoutHeight, timeoutTimestamp, err := getTimeouts(cmd, clientCtx, srcPort, srcChannel)
 			if err != nil {
 				return err
 			}
 
-			// if the timeouts are not absolute, retrieve latest block height and block timestamp
-			// for the consensus state connected to the destination port/channel.
-			// localhost clients must rely solely on local clock time in order to use relative timestamps.
-			if !absoluteTimeouts {
-				clientRes, err := channelutils.QueryChannelClientState(clientCtx, srcPort, srcChannel, false)
-				if err != nil {
-					return err
-				}
+			if len(coins) == 0 {
+				return errors.New("at least one coin must be provided")
+			}
 
-				var clientState exported.ClientState
-				if err := clientCtx.InterfaceRegistry.UnpackAny(clientRes.IdentifiedClientState.ClientState, &clientState); err != nil {
-					return err
-				}
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+			)
+			if len(coins) > 1 {
+				// multiple coins may only be transferred over ics20-2 channels
+				msg.Token = sdk.Coin{}
+				msg.Tokens = coins
+			}
 
-				clientHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
-				if !ok {
-					return fmt.Errorf("invalid height type. expected type: %T, got: %T", clienttypes.Height{}, clientState.GetLatestHeight())
-				}
+			msg.Forwarding = types.NewForwarding(unwind, forwardingHops...)
+			if unwind {
+				// the source port and channel are derived from the token trace when unwinding
+				msg.SourcePort = ""
+				msg.SourceChannel = ""
+			}
 
-				var consensusState exported.ConsensusState
-				if clientState.ClientType() != exported.Localhost {
-					consensusStateRes, err := clientutils.QueryConsensusState(clientCtx, clientRes.IdentifiedClientState.ClientId, clientHeight, false, true)
-					if err != nil {
-						return err
-					}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-					if err := clientCtx.InterfaceRegistry.UnpackAny(consensusStateRes.ConsensusState, &consensusState); err != nil {
-						return err
-					}
-				}
+	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagForwarding, "", "Forwarding hops in the format {portID}/{channelID},{portID}/{channelID} through which the tokens are forwarded.")
+	cmd.Flags().Bool(flagUnwind, false, "Unwind the tokens to their native chain before forwarding.")
+	flags.AddTxFlagsToCmd(cmd)
 
-				if !timeoutHeight.IsZero() {
-					absoluteHeight := clientHeight
-					absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
-					absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
-					timeoutHeight = absoluteHeight
-				}
+	return cmd
+}
 
-				// use local clock time as reference time if it is later than the
-				// consensus state timestamp of the counterparty chain, otherwise
-				// still use consensus state timestamp as reference.
-				// for localhost clients local clock time is always used.
-				if timeoutTimestamp != 0 {
-					var consensusStateTimestamp uint64
-					if consensusState != nil {
-						consensusStateTimestamp = consensusState.GetTimestamp()
-					}
-
-					now := time.Now().UnixNano()
-					if now > 0 {
-						now := uint64(now)
-						if now > consensusStateTimestamp {
-							timeoutTimestamp = now + timeoutTimestamp
-						} else {
-							timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
-						}
-					} else {
-						return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
-					}
-				}
+// NewTransferAllTxCmd returns the command to create a MsgTransferAll transaction
+func NewTransferAllTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-all [src-port] [src-channel] [receiver]",
+		Short: "Transfer the entire balance of every transferable denomination through IBC",
+		Long: strings.TrimSpace(`Transfer the entire spendable balance of every denomination held by the sender through IBC.
+Denominations which cannot be sent over the channel are skipped, unless the "all-or-nothing" flag is set in which
+case the transfer fails. A single denomination is sent per packet over ics20-1 channels. Timeouts are specified in
+the same way as for the "transfer" command.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer transfer-all [src-port] [src-channel] [receiver]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
+			sender := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			receiver := args[2]
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			allOrNothing, err := cmd.Flags().GetBool(flagAllOrNothing)
+			if err != nil {
+				return err
+			}
+
+			partialFillPolicy := types.SKIP_REJECTED
+			if allOrNothing {
+				partialFillPolicy = types.ALL_OR_NOTHING
+			}
+
+			timeoutHeight, timeoutTimestamp, err := getTimeouts(cmd, clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferAll(
+				srcPort, srcChannel, sender, receiver, timeoutHeight, timeoutTimestamp, memo, partialFillPolicy,
 			)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -157,8 +181,97 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
-	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with every packet.")
+	cmd.Flags().Bool(flagAllOrNothing, false, "Fail the transfer if any denomination cannot be transferred.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// getTimeouts returns the packet timeout height and timestamp set by the timeout flags of the command.
+// If the timeouts are relative, they are converted to absolute timeouts using the latest consensus state
+// of the client of the given channel.
+func getTimeouts(cmd *cobra.Command, clientCtx client.Context, srcPort, srcChannel string) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	// if the timeouts are not absolute, retrieve latest block height and block timestamp
+	// for the consensus state connected to the destination port/channel.
+	// localhost clients must rely solely on local clock time in order to use relative timestamps.
+	if !absoluteTimeouts {
+		clientRes, err := channelutils.QueryChannelClientState(clientCtx, srcPort, srcChannel, false)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		var clientState exported.ClientState
+		if err := clientCtx.InterfaceRegistry.UnpackAny(clientRes.IdentifiedClientState.ClientState, &clientState); err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		clientHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+		if !ok {
+			return clienttypes.Height{}, 0, fmt.Errorf("invalid height type. expected type: %T, got: %T", clienttypes.Height{}, clientState.GetLatestHeight())
+		}
+
+		var consensusState exported.ConsensusState
+		if clientState.ClientType() != exported.Localhost {
+			consensusStateRes, err := clientutils.QueryConsensusState(clientCtx, clientRes.IdentifiedClientState.ClientId, clientHeight, false, true)
+			if err != nil {
+				return clienttypes.Height{}, 0, err
+			}
+
+			if err := clientCtx.InterfaceRegistry.UnpackAny(consensusStateRes.ConsensusState, &consensusState); err != nil {
+				return clienttypes.Height{}, 0, err
+			}
+		}
+
+		if !timeoutHeight.IsZero() {
+			absoluteHeight := clientHeight
+			absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
+			absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
+			timeoutHeight = absoluteHeight
+		}
+
+		// use local clock time as reference time if it is later than the
+		// consensus state timestamp of the counterparty chain, otherwise
+		// still use consensus state timestamp as reference.
+		// for localhost clients local clock time is always used.
+		if timeoutTimestamp != 0 {
+			var consensusStateTimestamp uint64
+			if consensusState != nil {
+				consensusStateTimestamp = consensusState.GetTimestamp()
+			}
+
+			now := time.Now().UnixNano()
+			if now > 0 {
+				now := uint64(now)
+				if now > consensusStateTimestamp {
+					timeoutTimestamp = now + timeoutTimestamp
+				} else {
+					timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
+				}
+			} else {
+				return clienttypes.Height{}, 0, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+			}
+		}
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -21,9 +22,10 @@ import (
 )
 
 var (
-	_ porttypes.IBCModule             = (*IBCModule)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCModule)(nil)
-	_ porttypes.UpgradableModule      = (*IBCModule)(nil)
+	_ porttypes.IBCModule                   = (*IBCModule)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCModule)(nil)
+	_ porttypes.UpgradableModule            = (*IBCModule)(nil)
+	_ porttypes.AcknowledgementExpiryModule = (*IBCModule)(nil)
 )
 
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
@@ -89,8 +91,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	if !slices.Contains(types.SupportedVersions, version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, version)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -121,13 +123,13 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		// Propose the current version
-		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing current app version", "counterpartyVersion", counterpartyVersion, "version", types.Version)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		// Propose the default version
+		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing default app version", "counterpartyVersion", counterpartyVersion, "version", types.Version)
 		return types.Version, nil
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -138,8 +140,8 @@ func (IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 	return nil
 }
@@ -183,14 +185,28 @@ func (im IBCModule) OnRecvPacket(
 	logger := im.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
-	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+	var (
+		data   types.FungibleTokenPacketDataV2
+		ackErr error
+	)
+
+	ics20Version, found := im.keeper.GetICS4Wrapper().GetAppVersion(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		ackErr = errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", packet.DestinationPort, packet.DestinationChannel)
 		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		ack = channeltypes.NewErrorAcknowledgement(ackErr)
 	}
 
+	if ack.Success() {
+		var err error
+		data, err = types.UnmarshalPacketData(packet.GetData(), ics20Version)
+		if err != nil {
+			ackErr = err
+			logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
+			ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		}
+	}
+
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
@@ -208,10 +224,15 @@ func (im IBCModule) OnRecvPacket(
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount)...)
+	eventAttributes = append(eventAttributes,
 		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+	)
+
+	if data.HasForwarding() {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyForwardingHops, forwardingHopsAttribute(data.Forwarding.Hops)))
 	}
 
 	if ackErr != nil {
@@ -225,6 +246,12 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	// NOTE: the acknowledgement of a forwarded packet will be written asynchronously
+	// once the packet sent on the next hop is acknowledged or times out.
+	if ack.Success() && data.HasForwarding() {
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
@@ -240,9 +267,9 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	data, err := im.unmarshalPacketData(ctx, packet)
+	if err != nil {
+		return err
 	}
 
 	bz := types.ModuleCdc.MustMarshalJSON(&ack)
@@ -254,16 +281,21 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 	)
 
@@ -293,37 +325,55 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	data, err := im.unmarshalPacketData(ctx, packet)
+	if err != nil {
+		return err
 	}
+
 	// refund tokens
 	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+	}
+	eventAttributes = append(eventAttributes, tokenAttributes(data.Tokens, types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			eventAttributes...,
 		),
 	)
 
 	return nil
 }
 
+// OnAcknowledgementExpired implements the AcknowledgementExpiryModule interface.
+// The acknowledgement of a received packet is only written asynchronously if its tokens are forwarded, in which
+// case it is written once the forwarded packet is acknowledged or times out. The expiry is rejected as the tokens
+// cannot be refunded to the sender while the forwarded packet may still be received by the next hop.
+func (IBCModule) OnAcknowledgementExpired(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (ibcexported.Acknowledgement, error) {
+	return nil, errorsmod.Wrapf(
+		types.ErrForwardedPacketPending, "acknowledgement of packet with sequence %d on channel %s is written once the forwarded packet is acknowledged or times out",
+		packet.Sequence, packet.DestinationChannel,
+	)
+}
+
 // OnChanUpgradeInit implements the IBCModule interface
 func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
 	if err := ValidateTransferChannelParams(ctx, im.keeper, proposedOrder, portID, channelID); err != nil {
 		return "", err
 	}
 
-	if proposedVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, proposedVersion)
+	if !slices.Contains(types.SupportedVersions, proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, proposedVersion)
 	}
 
 	return proposedVersion, nil
@@ -335,8 +385,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return counterpartyVersion, nil
@@ -344,8 +394,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 
 // OnChanUpgradeAck implements the IBCModule interface
 func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return nil
@@ -356,13 +406,58 @@ func (IBCModule) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, pr
 }
 
 // UnmarshalPacketData attempts to unmarshal the provided packet data bytes
-// into a FungibleTokenPacketData. This function implements the optional
-// PacketDataUnmarshaler interface required for ADR 008 support.
+// into a FungibleTokenPacketData or a FungibleTokenPacketDataV2. This function
+// implements the optional PacketDataUnmarshaler interface required for ADR 008 support.
 func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	var packetData types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err == nil {
+		return packetData, nil
+	}
+
+	var packetDataV2 types.FungibleTokenPacketDataV2
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetDataV2); err != nil {
 		return nil, err
 	}
 
-	return packetData, nil
+	return packetDataV2, nil
+}
+
+// unmarshalPacketData unmarshals the data of a packet sent by this chain according
+// to the ICS20 version of the source channel.
+func (im IBCModule) unmarshalPacketData(ctx sdk.Context, packet channeltypes.Packet) (types.FungibleTokenPacketDataV2, error) {
+	ics20Version, found := im.keeper.GetICS4Wrapper().GetAppVersion(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found {
+		return types.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", packet.SourcePort, packet.SourceChannel)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), ics20Version)
+	if err != nil {
+		return types.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	return data, nil
+}
+
+// tokenAttributes returns a denomination and amount event attribute pair for each of the provided tokens.
+func tokenAttributes(tokens []types.Token, denomKey, amountKey string) []sdk.Attribute {
+	attributes := make([]sdk.Attribute, 0, 2*len(tokens))
+	for _, token := range tokens {
+		attributes = append(attributes,
+			sdk.NewAttribute(denomKey, token.Denom),
+			sdk.NewAttribute(amountKey, token.Amount),
+		)
+	}
+
+	return attributes
+}
+
+// forwardingHopsAttribute returns the forwarding hops formatted as a comma separated list
+// of <portID>/<channelID> pairs.
+func forwardingHopsAttribute(hops []types.Hop) string {
+	hopStrs := make([]string, len(hops))
+	for i, hop := range hops {
+		hopStrs[i] = hop.String()
+	}
+
+	return strings.Join(hopStrs, ",")
 }
@@ -27,6 +27,7 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 		path         *ibctesting.Path
 		chanCap      *capabilitytypes.Capability
 		counterparty channeltypes.Counterparty
+		expVersion   string
 	)
 
 	testCases := []struct {
@@ -49,6 +50,12 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, true,
 		},
+		{
+			"success: ics20-2 version", func() {
+				channel.Version = types.V2
+				expVersion = types.V2
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -94,6 +101,7 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				ConnectionHops: []string{path.EndpointA.ConnectionID},
 				Version:        types.Version,
 			}
+			expVersion = types.Version
 
 			var err error
 			chanCap, err = suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(ibctesting.TransferPort, path.EndpointA.ChannelID))
@@ -108,7 +116,7 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -124,6 +132,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		path                *ibctesting.Path
 		counterparty        channeltypes.Counterparty
 		counterpartyVersion string
+		expVersion          string
 	)
 
 	testCases := []struct {
@@ -134,6 +143,12 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: ics20-2 counterparty version", func() {
+				counterpartyVersion = types.V2
+				expVersion = types.V2
+			}, true,
+		},
 		{
 			"success: invalid counterparty version proposes new version", func() {
 				// transfer module will propose the default version
@@ -182,6 +197,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				Version:        types.Version,
 			}
 			counterpartyVersion = types.Version
+			expVersion = types.Version
 
 			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.TransferPort)
 			suite.Require().NoError(err)
@@ -200,7 +216,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -284,8 +300,6 @@ func (suite *TransferTestSuite) TestOnRecvPacket() {
 					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 					sdk.NewAttribute(sdk.AttributeKeySender, ""),
 					sdk.NewAttribute(types.AttributeKeyReceiver, ""),
-					sdk.NewAttribute(types.AttributeKeyDenom, ""),
-					sdk.NewAttribute(types.AttributeKeyAmount, ""),
 					sdk.NewAttribute(types.AttributeKeyMemo, ""),
 					sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
 					sdk.NewAttribute(types.AttributeKeyAckError, "cannot unmarshal ICS-20 transfer packet data: invalid type"),
@@ -397,7 +411,7 @@ func (suite *TransferTestSuite) TestOnAcknowledgePacket() {
 
 				packet.SourceChannel = "channel-100"
 			},
-			errors.New("unable to unescrow tokens"),
+			ibcerrors.ErrNotFound,
 			false,
 		},
 		{
@@ -503,7 +517,7 @@ func (suite *TransferTestSuite) TestOnTimeoutPacket() {
 			func() {
 				packet.SourceChannel = "channel-100"
 			},
-			errors.New("unable to unescrow tokens"),
+			ibcerrors.ErrNotFound,
 		},
 		{
 			"invalid packet data",
@@ -34,3 +34,28 @@ func (k Keeper) MarshalDenomTrace(denomTrace types.DenomTrace) ([]byte, error) {
 func (k Keeper) MustMarshalDenomTrace(denomTrace types.DenomTrace) []byte {
 	return k.cdc.MustMarshal(&denomTrace)
 }
+
+// UnmarshalDenom attempts to decode and return a Denom object from
+// raw encoded bytes.
+func (k Keeper) UnmarshalDenom(bz []byte) (types.Denom, error) {
+	var denom types.Denom
+	if err := k.cdc.Unmarshal(bz, &denom); err != nil {
+		return types.Denom{}, err
+	}
+
+	return denom, nil
+}
+
+// MustUnmarshalDenom attempts to decode and return a Denom object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalDenom(bz []byte) types.Denom {
+	var denom types.Denom
+	k.cdc.MustUnmarshal(bz, &denom)
+	return denom
+}
+
+// MustMarshalDenom attempts to encode a Denom object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalDenom(denom types.Denom) []byte {
+	return k.cdc.MustMarshal(&denom)
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// forwardPacket forwards the tokens received in the provided packet to the next hop of the
+// forwarding path. The tokens are held by the forward address of the destination channel
+// and sent on to the final receiver. The received packet is stored so that its acknowledgement
+// can be written asynchronously once the forwarded packet is acknowledged or times out.
+func (k Keeper) forwardPacket(ctx sdk.Context, data types.FungibleTokenPacketDataV2, packet channeltypes.Packet, receivedCoins sdk.Coins) error {
+	var (
+		nextForwarding types.ForwardingPacketData
+		memo           string
+	)
+
+	nextHop := data.Forwarding.Hops[0]
+	if len(data.Forwarding.Hops) > 1 {
+		nextForwarding = types.NewForwardingPacketData(data.Forwarding.DestinationMemo, data.Forwarding.Hops[1:]...)
+	} else {
+		// the next hop is the final destination which consumes the destination memo
+		memo = data.Forwarding.DestinationMemo
+	}
+
+	// the forwarded packet inherits the timeout timestamp of the received packet, a timeout
+	// height cannot be used as it is relative to a different counterparty chain
+	timeoutTimestamp := packet.GetTimeoutTimestamp()
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + types.DefaultRelativePacketTimeoutTimestamp
+	}
+
+	forwardAddress := types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	sequence, err := k.sendTransfer(
+		ctx, nextHop.PortId, nextHop.ChannelId, receivedCoins, forwardAddress, data.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo, nextForwarding,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to forward packet on %s", nextHop)
+	}
+
+	k.setForwardedPacket(ctx, nextHop.PortId, nextHop.ChannelId, sequence, packet)
+
+	return nil
+}
+
+// acknowledgeForwardedPacket writes the provided acknowledgement for the packet received from the
+// previous hop and removes the forwarded packet from the store.
+func (k Keeper) acknowledgeForwardedPacket(ctx sdk.Context, prevPacket, forwardedPacket channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	capability, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(prevPacket.DestinationPort, prevPacket.DestinationChannel))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, capability, prevPacket, ack); err != nil {
+		return err
+	}
+
+	k.deleteForwardedPacket(ctx, forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+
+	return nil
+}
+
+// revertForwardedPacket reverts the state changes of a forwarded packet which failed or timed out
+// on the next hop. The tokens are first refunded to the forward address, the tokens received from
+// the previous hop are then escrowed or burned again so that the previous hop may refund them
+// upon receiving the error acknowledgement.
+func (k Keeper) revertForwardedPacket(ctx sdk.Context, prevPacket, forwardedPacket channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	// the sender of the forwarded packet is the forward address
+	if err := k.refundPacketTokens(ctx, forwardedPacket, data); err != nil {
+		return err
+	}
+
+	forwardAddress := types.GetForwardAddress(prevPacket.DestinationPort, prevPacket.DestinationChannel)
+	for _, token := range data.Tokens {
+		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+		coin := sdk.NewCoin(k.DenomFromPath(ctx, token.Denom).IBCDenom(), transferAmount)
+
+		// the tokens were unescrowed on receive if this chain is the source of the tokens
+		// with respect to the previous hop, otherwise vouchers were minted
+		if types.SenderChainIsSource(prevPacket.DestinationPort, prevPacket.DestinationChannel, token.Denom) {
+			escrowAddress := types.GetEscrowAddress(prevPacket.DestinationPort, prevPacket.DestinationChannel)
+			if err := k.escrowToken(ctx, forwardAddress, escrowAddress, coin); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			// NOTE: should not happen as the module account was
+			// retrieved on the step above and it has enough balance
+			// to burn.
+			panic(fmt.Errorf("cannot burn coins after a successful send from forward address to module account: %v", err))
+		}
+	}
+
+	return nil
+}
+
+// unwindHops returns the source port and channel on which the provided coins must be sent in
+// order to unwind them to their native chain, along with the remaining hops of the unwinding
+// path followed by the provided forwarding hops. All coins must share the same trace path.
+func (k Keeper) unwindHops(ctx sdk.Context, coins sdk.Coins, forwardingHops []types.Hop) (string, string, []types.Hop, error) {
+	var unwindHops []types.Hop
+	for i, coin := range coins {
+		if !strings.HasPrefix(coin.Denom, types.DenomPrefix+"/") {
+			return "", "", nil, errorsmod.Wrapf(types.ErrInvalidForwarding, "cannot unwind native denomination %s", coin.Denom)
+		}
+
+		denom, err := k.denomFromIBCDenom(ctx, coin.Denom)
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		hops := denom.Trace
+		if i == 0 {
+			unwindHops = hops
+			continue
+		}
+
+		if !slices.Equal(unwindHops, hops) {
+			return "", "", nil, errorsmod.Wrap(types.ErrInvalidForwarding, "cannot unwind tokens with different trace paths")
+		}
+	}
+
+	if len(unwindHops) == 0 {
+		return "", "", nil, errorsmod.Wrap(types.ErrInvalidForwarding, "cannot unwind tokens without trace path")
+	}
+
+	hops := append(unwindHops[1:], forwardingHops...)
+	if len(hops) > types.MaximumNumberOfForwardingHops {
+		return "", "", nil, errorsmod.Wrapf(types.ErrInvalidForwarding, "number of hops including unwinding cannot exceed %d", types.MaximumNumberOfForwardingHops)
+	}
+
+	return unwindHops[0].PortId, unwindHops[0].ChannelId, hops, nil
+}
+
+// getForwardedPacket gets the packet received from the previous hop which has been forwarded
+// on the packet sent with the provided port ID, channel ID and sequence.
+func (k Keeper) getForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ForwardedPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return channeltypes.Packet{}, false
+	}
+
+	var packet channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// setForwardedPacket stores the packet received from the previous hop keyed by the port ID,
+// channel ID and sequence of the packet sent on the next hop.
+func (k Keeper) setForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.ForwardedPacketKey(portID, channelID, sequence), bz)
+}
+
+// deleteForwardedPacket deletes the forwarded packet stored for the provided port ID, channel ID and sequence.
+func (k Keeper) deleteForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ForwardedPacketKey(portID, channelID, sequence))
+}
+
+// GetAllForwardedPackets returns all the packets awaiting the acknowledgement of the next hop.
+func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket {
+	var forwardedPackets []types.ForwardedPacket
+	k.iterateForwardedPackets(ctx, func(forwardedPacket types.ForwardedPacket) bool {
+		forwardedPackets = append(forwardedPackets, forwardedPacket)
+		return false
+	})
+
+	return forwardedPackets
+}
+
+// iterateForwardedPackets iterates over the forwarded packets in the store and performs a callback function.
+func (k Keeper) iterateForwardedPackets(ctx sdk.Context, cb func(forwardedPacket types.ForwardedPacket) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyForwardedPacketPrefix+"/"))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		portID, channelID, sequence, err := types.ParseForwardedPacketKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		forwardedPacket := types.ForwardedPacket{
+			PortId:    portID,
+			ChannelId: channelID,
+			Sequence:  sequence,
+			Packet:    packet,
+		}
+
+		if cb(forwardedPacket) {
+			break
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// setupForwardingPaths sets up ics20-2 transfer paths between chainA and chainB
+// and between chainB and chainC.
+func (suite *KeeperTestSuite) setupForwardingPaths() (*ibctesting.Path, *ibctesting.Path) {
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathAtoB)
+
+	pathBtoC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+	pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathBtoC)
+
+	return pathAtoB, pathBtoC
+}
+
+// sendAndForward sends the provided transfer from chainA and receives it on chainB,
+// returning the packet sent by chainA and the packet forwarded by chainB.
+func (suite *KeeperTestSuite) sendAndForward(pathAtoB *ibctesting.Path, msg *types.MsgTransfer) (channeltypes.Packet, channeltypes.Packet) {
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written asynchronously
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+
+	forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	return packet, forwardedPacket
+}
+
+func (suite *KeeperTestSuite) TestForwarding() {
+	suite.SetupTest()
+
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+	amount := sdkmath.NewInt(100)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	msg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0, "destination memo",
+	)
+	msg.Forwarding = types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+
+	packet, forwardedPacket := suite.sendAndForward(pathAtoB, msg)
+
+	// the memo is only delivered to the final destination
+	var packetData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Empty(packetData.Memo)
+	suite.Require().Equal("destination memo", packetData.Forwarding.DestinationMemo)
+
+	var forwardedPacketData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(forwardedPacket.GetData(), &forwardedPacketData))
+	suite.Require().Equal("destination memo", forwardedPacketData.Memo)
+	suite.Require().False(forwardedPacketData.HasForwarding())
+	suite.Require().Equal(types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID).String(), forwardedPacketData.Sender)
+
+	forwardedPackets := suite.chainB.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainB.GetContext())
+	suite.Require().Len(forwardedPackets, 1)
+	suite.Require().Equal(packet, forwardedPackets[0].Packet)
+
+	// relay the forwarded packet to chainC and acknowledge it on chainB
+	err := pathBtoC.RelayPacket(forwardedPacket)
+	suite.Require().NoError(err)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(
+		pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID,
+		types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	)).IBCDenom()
+	balance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().Equal(amount, balance.Amount)
+
+	forwardAddress := types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+	suite.Require().Empty(suite.chainB.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainB.GetContext()))
+
+	// the acknowledgement of the original packet has been written on chainB
+	ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()), ack)
+}
+
+func (suite *KeeperTestSuite) TestForwardingFailure() {
+	var (
+		pathAtoB        *ibctesting.Path
+		pathBtoC        *ibctesting.Path
+		forwardedPacket channeltypes.Packet
+		expAckErr       error
+	)
+
+	testCases := []struct {
+		name     string
+		receiver func() string
+		fail     func()
+	}{
+		{
+			"error acknowledgement on next hop",
+			func() string { return "invalid-receiver" },
+			func() {
+				err := pathBtoC.RelayPacket(forwardedPacket)
+				suite.Require().NoError(err)
+
+				expAckErr = types.ErrForwardedPacketFailed
+			},
+		},
+		{
+			"timeout on next hop",
+			func() string { return suite.chainC.SenderAccount.GetAddress().String() },
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				err := pathBtoC.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = pathBtoC.EndpointA.TimeoutPacket(forwardedPacket)
+				suite.Require().NoError(err)
+
+				expAckErr = types.ErrForwardedPacketTimedOut
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pathAtoB, pathBtoC = suite.setupForwardingPaths()
+			amount := sdkmath.NewInt(100)
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+			originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			msg := types.NewMsgTransfer(
+				pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress().String(), tc.receiver(),
+				suite.chainA.GetTimeoutHeight(), timeoutTimestamp, "",
+			)
+			msg.Forwarding = types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+
+			var packet channeltypes.Packet
+			packet, forwardedPacket = suite.sendAndForward(pathAtoB, msg)
+
+			tc.fail()
+
+			// the vouchers received by the forward address on chainB have been burned
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom)
+			suite.Require().True(supply.IsZero())
+			suite.Require().Empty(suite.chainB.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainB.GetContext()))
+
+			// an error acknowledgement has been written for the original packet
+			expAck := channeltypes.NewErrorAcknowledgement(expAckErr)
+			ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ack)
+
+			// relay the error acknowledgement to chainA and assert the sender is refunded
+			err := pathAtoB.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = pathAtoB.EndpointA.AcknowledgePacket(packet, expAck.Acknowledgement())
+			suite.Require().NoError(err)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(originalBalance, balance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForwardingUnwind() {
+	suite.SetupTest()
+
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+	amount := sdkmath.NewInt(100)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	// send from chainA to chainC through chainB
+	msg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(),
+		suite.chainA.GetTimeoutHeight(), 0, "",
+	)
+	msg.Forwarding = types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+
+	packet, forwardedPacket := suite.sendAndForward(pathAtoB, msg)
+	err := pathBtoC.RelayPacket(forwardedPacket)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = pathAtoB.EndpointA.AcknowledgePacket(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	suite.Require().NoError(err)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(
+		pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID,
+		types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	)).IBCDenom()
+
+	// unwind the voucher from chainC back to chainA without specifying the path
+	msg = types.NewMsgTransfer(
+		"", "", sdk.NewCoin(voucherDenom, amount),
+		suite.chainC.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainC.GetTimeoutHeight(), 0, "",
+	)
+	msg.Forwarding = types.NewForwarding(true)
+
+	res, err := suite.chainC.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pathBtoC.EndpointB.ChannelID, packet.GetSourceChannel())
+
+	err = pathBtoC.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = pathBtoC.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	forwardedPacket, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pathAtoB.EndpointB.ChannelID, forwardedPacket.GetSourceChannel())
+
+	err = pathAtoB.RelayPacket(forwardedPacket)
+	suite.Require().NoError(err)
+
+	// the native tokens have been unescrowed on chainA
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+
+	balance = suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenom)
+	suite.Require().True(balance.IsZero())
+
+	// the acknowledgement of the unwinding packet has been written on chainB
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+}
@@ -14,7 +14,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	for _, trace := range state.DenomTraces {
 		k.SetDenomTrace(ctx, trace)
-		k.setDenomMetadata(ctx, trace)
+
+		// the metadata may have been set by the sending chain or governance and
+		// imported through the bank genesis, in which case it must not be overwritten
+		if !k.bankKeeper.HasDenomMetaData(ctx, trace.IBCDenom()) {
+			k.setDenomMetadata(ctx, trace.ToDenom(), nil)
+		}
 	}
 
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -35,14 +40,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, forwardedPacket := range state.ForwardedPackets {
+		k.setForwardedPacket(ctx, forwardedPacket.PortId, forwardedPacket.ChannelId, forwardedPacket.Sequence, forwardedPacket.Packet)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		DenomTraces:   k.GetAllDenomTraces(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:           k.GetPort(ctx),
+		DenomTraces:      k.GetAllDenomTraces(ctx),
+		Params:           k.GetParams(ctx),
+		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets: k.GetAllForwardedPackets(ctx),
 	}
 }
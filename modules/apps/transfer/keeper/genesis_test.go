@@ -8,6 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -60,3 +63,24 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.Require().True(found)
 	}
 }
+
+func (suite *KeeperTestSuite) TestGenesisForwardedPackets() {
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, types.PortID, ibctesting.FirstChannelID, types.PortID, ibctesting.FirstChannelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+	forwardedPackets := []types.ForwardedPacket{
+		{PortId: types.PortID, ChannelId: ibctesting.FirstChannelID, Sequence: 1, Packet: packet},
+		{PortId: types.PortID, ChannelId: ibctesting.FirstChannelID, Sequence: 2, Packet: packet},
+	}
+
+	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Empty(genesis.ForwardedPackets)
+
+	genesis.ForwardedPackets = forwardedPackets
+	suite.Require().NoError(genesis.Validate())
+	suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	genesis = suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(forwardedPackets, genesis.ForwardedPackets)
+}
@@ -53,15 +53,15 @@ func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	ctx := sdk.UnwrapSDKContext(c)
 
 	var traces types.Traces
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
 
 	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
-		result, err := k.UnmarshalDenomTrace(value)
+		result, err := k.UnmarshalDenom(value)
 		if err != nil {
 			return err
 		}
 
-		traces = append(traces, result)
+		traces = append(traces, result.ToDenomTrace())
 		return nil
 	})
 	if err != nil {
@@ -74,6 +74,74 @@ func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomsByBase implements the Query/DenomsByBase gRPC method
+func (k Keeper) DenomsByBase(c context.Context, req *types.QueryDenomsByBaseRequest) (*types.QueryDenomsByBaseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denomination cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBaseIndexPrefix(req.BaseDenom))
+	denoms, pageRes, err := k.paginateDenomIndex(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsByBaseResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomsByChannel implements the Query/DenomsByChannel gRPC method
+func (k Keeper) DenomsByChannel(c context.Context, req *types.QueryDenomsByChannelRequest) (*types.QueryDenomsByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewHop(req.PortId, req.ChannelId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomChannelIndexPrefix(req.PortId, req.ChannelId))
+	denoms, pageRes, err := k.paginateDenomIndex(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsByChannelResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateDenomIndex paginates over a denomination index store, whose keys are
+// denomination hashes, and returns the indexed denominations.
+func (k Keeper) paginateDenomIndex(ctx sdk.Context, store prefix.Store, pagination *query.PageRequest) (types.Denoms, *query.PageResponse, error) {
+	var denoms types.Denoms
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		denom, found := k.GetDenom(ctx, key)
+		if !found {
+			return errorsmod.Wrapf(types.ErrTraceNotFound, "indexed denomination with hash %X", key)
+		}
+
+		denoms = append(denoms, denom)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return denoms, pageRes, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -142,3 +210,72 @@ func (k Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscr
 		Amount: amount,
 	}, nil
 }
+
+// DenomMetadata implements the DenomMetadata gRPC method.
+func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !strings.HasPrefix(req.Denom, types.DenomPrefix+"/") {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "denomination must be an IBC denomination: %s", req.Denom).Error())
+	}
+
+	if err := types.ValidateIBCDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, err := k.denomFromIBCDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, req.Denom)
+	if !found {
+		metadata = types.DefaultDenomMetadata(denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{
+		Metadata: metadata,
+	}, nil
+}
+
+// ChannelDenomFilter implements the ChannelDenomFilter gRPC method.
+func (k Keeper) ChannelDenomFilter(c context.Context, req *types.QueryChannelDenomFilterRequest) (*types.QueryChannelDenomFilterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewHop(req.PortId, req.ChannelId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	filter, found := params.GetChannelDenomFilter(req.PortId, req.ChannelId)
+	if !found {
+		filter = types.NewChannelDenomFilter(req.PortId, req.ChannelId, nil, nil)
+	}
+
+	return &types.QueryChannelDenomFilterResponse{
+		DenomFilter:          filter,
+		BlockedReceiveDenoms: params.BlockedReceiveDenoms,
+	}, nil
+}
+
+// EscrowReconciliation implements the EscrowReconciliation gRPC method.
+func (k Keeper) EscrowReconciliation(c context.Context, req *types.QueryEscrowReconciliationRequest) (*types.QueryEscrowReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	escrowAccounts := k.GetAllEscrowAccounts(ctx)
+
+	return &types.QueryEscrowReconciliationResponse{
+		EscrowAccounts: escrowAccounts,
+		Denoms:         k.getEscrowReconciliation(ctx, escrowAccounts),
+	}, nil
+}
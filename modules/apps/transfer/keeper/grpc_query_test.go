@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -153,6 +155,181 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomsByBase() {
+	var (
+		req       *types.QueryDenomsByBaseRequest
+		expDenoms types.Denoms
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no denominations",
+			func() {
+				req = &types.QueryDenomsByBaseRequest{BaseDenom: "uatom"}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expDenoms = types.Denoms{
+					types.NewDenom("uatom"),
+					types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-0")),
+					types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-1"), types.NewHop(ibctesting.TransferPort, "channel-0")),
+				}
+
+				for _, denom := range expDenoms {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+				}
+
+				// denominations with a different base denomination must not be returned
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), types.NewDenom("uosmo", types.NewHop(ibctesting.TransferPort, "channel-0")))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), types.NewDenom("uatom/1", types.NewHop(ibctesting.TransferPort, "channel-0")))
+
+				req = &types.QueryDenomsByBaseRequest{
+					BaseDenom: "uatom",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: base denom with slashes",
+			func() {
+				expDenoms = types.Denoms{
+					types.NewDenom("gamm/pool/1", types.NewHop(ibctesting.TransferPort, "channel-0")),
+				}
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), expDenoms[0])
+
+				req = &types.QueryDenomsByBaseRequest{BaseDenom: "gamm/pool/1"}
+			},
+			true,
+		},
+		{
+			"failure: blank base denom",
+			func() {
+				req = &types.QueryDenomsByBaseRequest{BaseDenom: " "}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expDenoms = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomsByBase(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expDenoms, res.Denoms)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomsByChannel() {
+	var (
+		req       *types.QueryDenomsByChannelRequest
+		expDenoms types.Denoms
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no denominations",
+			func() {
+				req = &types.QueryDenomsByChannelRequest{PortId: ibctesting.TransferPort, ChannelId: "channel-0"}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expDenoms = types.Denoms{
+					types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-0")),
+					types.NewDenom("gamm/pool/1", types.NewHop(ibctesting.TransferPort, "channel-0")),
+					// the channel is not the most recent hop of the trace
+					types.NewDenom("uosmo", types.NewHop(ibctesting.TransferPort, "channel-1"), types.NewHop(ibctesting.TransferPort, "channel-0")),
+				}
+
+				for _, denom := range expDenoms {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+				}
+
+				// denominations which have not travelled through the channel must not be returned
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), types.NewDenom("uatom"))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-1")))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-10")))
+
+				req = &types.QueryDenomsByChannelRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "channel-0",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"failure: invalid port ID",
+			func() {
+				req = &types.QueryDenomsByChannelRequest{PortId: "", ChannelId: "channel-0"}
+			},
+			false,
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				req = &types.QueryDenomsByChannelRequest{PortId: ibctesting.TransferPort, ChannelId: ""}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expDenoms = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomsByChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expDenoms, res.Denoms)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
@@ -364,3 +541,183 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDenomMetadata() {
+	var (
+		req         *types.QueryDenomMetadataRequest
+		expMetadata banktypes.Metadata
+	)
+
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-0"))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: stored metadata",
+			func() {
+				expMetadata = types.NewTokenMetadata("Stake", "STK", 6, "stk").ToBankMetadata(denom)
+				suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), expMetadata)
+			},
+			true,
+		},
+		{
+			"success: default metadata",
+			func() {
+				expMetadata = types.DefaultDenomMetadata(denom)
+			},
+			true,
+		},
+		{
+			"failure: native denomination",
+			func() {
+				req.Denom = sdk.DefaultBondDenom
+			},
+			false,
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req.Denom = "ibc/!@#$!@#"
+			},
+			false,
+		},
+		{
+			"failure: denomination trace not found",
+			func() {
+				req.Denom = types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-1")).IBCDenom()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+			req = &types.QueryDenomMetadataRequest{
+				Denom: denom.IBCDenom(),
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomMetadata(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expMetadata, res.Metadata)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelDenomFilter() {
+	var (
+		req            *types.QueryChannelDenomFilterRequest
+		expDenomFilter types.ChannelDenomFilter
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: channel with denom filter",
+			func() {
+				expDenomFilter = types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, []string{"uatom"}, []string{sdk.DefaultBondDenom})
+			},
+			true,
+		},
+		{
+			"success: channel without denom filter",
+			func() {
+				req.ChannelId = "channel-1"
+				expDenomFilter = types.NewChannelDenomFilter(ibctesting.TransferPort, "channel-1", nil, nil)
+			},
+			true,
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			params := types.DefaultParams()
+			params.BlockedReceiveDenoms = []string{"uosmo"}
+			params.ChannelDenomFilters = []types.ChannelDenomFilter{
+				types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, []string{"uatom"}, []string{sdk.DefaultBondDenom}),
+			}
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			req = &types.QueryChannelDenomFilterRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelDenomFilter(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expDenomFilter, res.DenomFilter)
+				suite.Require().Equal([]string{"uosmo"}, res.BlockedReceiveDenoms)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowReconciliation() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	escrowBalances := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("uosmo", sdkmath.NewInt(5)))
+	suite.Require().NoError(banktestutil.FundAccount(ctx, suite.chainA.GetSimApp().BankKeeper, escrowAddress, escrowBalances))
+
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80)))
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin("uatom", sdkmath.NewInt(30)))
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.EscrowReconciliation(ctx, &types.QueryEscrowReconciliationRequest{})
+	suite.Require().NoError(err)
+
+	expEscrowAccounts := []types.EscrowAccount{
+		types.NewEscrowAccount(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, escrowBalances),
+	}
+	suite.Require().Equal(expEscrowAccounts, res.EscrowAccounts)
+
+	expDenoms := []types.DenomEscrowReconciliation{
+		types.NewDenomEscrowReconciliation(sdk.DefaultBondDenom, sdkmath.NewInt(80), sdkmath.NewInt(100)),
+		types.NewDenomEscrowReconciliation("uatom", sdkmath.NewInt(30), sdkmath.ZeroInt()),
+		types.NewDenomEscrowReconciliation("uosmo", sdkmath.ZeroInt(), sdkmath.NewInt(5)),
+	}
+	suite.Require().Equal(expDenoms, res.Denoms)
+	suite.Require().Equal(sdkmath.NewInt(-30), res.Denoms[1].Difference)
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.EscrowReconciliation(ctx, nil)
+	suite.Require().Error(err)
+}
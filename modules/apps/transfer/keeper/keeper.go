@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -12,7 +13,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 
@@ -36,6 +36,12 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	scopedKeeper  exported.ScopedKeeper
 
+	// memoHandlers holds the memo hooks executed upon receipt, keyed by top-level memo key
+	memoHandlers map[string]types.MemoHandler
+
+	// addressCodec decodes the local account addresses found in the packet data
+	addressCodec address.Codec
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -73,6 +79,8 @@ func NewKeeper(
 		authKeeper:     authKeeper,
 		bankKeeper:     bankKeeper,
 		scopedKeeper:   scopedKeeper,
+		memoHandlers:   make(map[string]types.MemoHandler),
+		addressCodec:   types.NewReceiverAddressCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
 		authority:      authority,
 	}
 }
@@ -84,6 +92,23 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// WithAddressCodec sets the address codec used to decode the receiver address of incoming
+// packets and the sender address of refunded packets. By default, both bech32 and 0x-prefixed
+// hexadecimal addresses are accepted.
+func (k *Keeper) WithAddressCodec(addressCodec address.Codec) {
+	k.addressCodec = addressCodec
+}
+
+// GetAddressCodec returns the address codec.
+func (k Keeper) GetAddressCodec() address.Codec {
+	return k.addressCodec
+}
+
 // GetAuthority returns the transfer module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -141,27 +166,23 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetDenomTrace retreives the full identifiers trace and base denomination from the store.
 func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
-	bz := store.Get(denomTraceHash)
-	if len(bz) == 0 {
+	denom, found := k.GetDenom(ctx, denomTraceHash)
+	if !found {
 		return types.DenomTrace{}, false
 	}
 
-	denomTrace := k.MustUnmarshalDenomTrace(bz)
-	return denomTrace, true
+	return denom.ToDenomTrace(), true
 }
 
 // HasDenomTrace checks if a the key with the given denomination trace hash exists on the store.
 func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
-	return store.Has(denomTraceHash)
+	return k.HasDenom(ctx, denomTraceHash)
 }
 
-// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store. The trace is stored
+// as a Denom, splitting the trace path into its hops.
 func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
-	bz := k.MustMarshalDenomTrace(denomTrace)
-	store.Set(denomTrace.Hash(), bz)
+	k.SetDenom(ctx, denomTrace.ToDenom())
 }
 
 // GetAllDenomTraces returns the trace information for all the denominations.
@@ -178,35 +199,76 @@ func (k Keeper) GetAllDenomTraces(ctx sdk.Context) types.Traces {
 // IterateDenomTraces iterates over the denomination traces in the store
 // and performs a callback function.
 func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.DenomTrace) bool) {
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		return cb(denom.ToDenomTrace())
+	})
+}
+
+// GetDenom retrieves the denomination with the given hash from the store.
+func (k Keeper) GetDenom(ctx sdk.Context, denomHash tmbytes.HexBytes) (types.Denom, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
+	bz := store.Get(denomHash)
+	if len(bz) == 0 {
+		return types.Denom{}, false
+	}
+
+	denom := k.MustUnmarshalDenom(bz)
+	return denom, true
+}
+
+// HasDenom checks if the denomination with the given hash exists on the store.
+func (k Keeper) HasDenom(ctx sdk.Context, denomHash tmbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
+	return store.Has(denomHash)
+}
+
+// SetDenom sets a new {denom hash -> denom} pair to the store and indexes the denomination
+// by its base denomination and by each of the channels it has travelled through.
+func (k Keeper) SetDenom(ctx sdk.Context, denom types.Denom) {
+	store := ctx.KVStore(k.storeKey)
+	hash := denom.Hash()
+
+	prefix.NewStore(store, types.DenomKey).Set(hash, k.MustMarshalDenom(denom))
+	store.Set(types.DenomBaseIndex(denom), []byte{byte(1)})
+	for _, hop := range denom.Trace {
+		store.Set(types.DenomChannelIndex(hop.PortId, hop.ChannelId, denom), []byte{byte(1)})
+	}
+}
+
+// GetAllDenoms returns all the denominations stored.
+func (k Keeper) GetAllDenoms(ctx sdk.Context) types.Denoms {
+	denoms := types.Denoms{}
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	return denoms.Sort()
+}
+
+// IterateDenoms iterates over the denominations in the store and performs a callback function.
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom types.Denom) bool) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.DenomTraceKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomKey)
 
 	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
 	for ; iterator.Valid(); iterator.Next() {
-		denomTrace := k.MustUnmarshalDenomTrace(iterator.Value())
-		if cb(denomTrace) {
+		denom := k.MustUnmarshalDenom(iterator.Value())
+		if cb(denom) {
 			break
 		}
 	}
 }
 
-// setDenomMetadata sets an IBC token's denomination metadata
-func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
-	metadata := banktypes.Metadata{
-		Description: fmt.Sprintf("IBC token from %s", denomTrace.GetFullDenomPath()),
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    denomTrace.BaseDenom,
-				Exponent: 0,
-			},
-		},
-		// Setting base as IBC hash denom since bank keepers's SetDenomMetadata uses
-		// Base as key path and the IBC hash is what gives this token uniqueness
-		// on the executing chain
-		Base:    denomTrace.IBCDenom(),
-		Display: denomTrace.GetFullDenomPath(),
-		Name:    fmt.Sprintf("%s IBC token", denomTrace.GetFullDenomPath()),
-		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
+// setDenomMetadata sets an IBC token's denomination metadata. If token metadata is provided by
+// the sending chain and it results in valid bank metadata, it is used to set the name, symbol and
+// display unit of the token. Otherwise the default metadata derived from the trace is set.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denom types.Denom, tokenMetadata *types.TokenMetadata) {
+	metadata := types.DefaultDenomMetadata(denom)
+	if tokenMetadata != nil {
+		if tm := tokenMetadata.ToBankMetadata(denom); tm.Validate() == nil {
+			metadata = tm
+		}
 	}
 
 	k.bankKeeper.SetDenomMetaData(ctx, metadata)
@@ -287,6 +349,47 @@ func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, storeprefix []byte, cb fu
 	}
 }
 
+// GetAllEscrowAccounts returns the escrow accounts of all channels bound to the transfer port
+// along with their balances.
+func (k Keeper) GetAllEscrowAccounts(ctx sdk.Context) []types.EscrowAccount {
+	portID := k.GetPort(ctx)
+	transferChannels := k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+
+	escrowAccounts := make([]types.EscrowAccount, 0, len(transferChannels))
+	for _, channel := range transferChannels {
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		escrowBalances := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+		escrowAccounts = append(escrowAccounts, types.NewEscrowAccount(channel.PortId, channel.ChannelId, escrowBalances))
+	}
+
+	return escrowAccounts
+}
+
+// getEscrowReconciliation returns, for every denomination which is either tracked as escrowed or
+// held by one of the provided escrow accounts, the tracked total escrow next to the total balance held by
+// the escrow accounts. The results are sorted by denomination.
+func (k Keeper) getEscrowReconciliation(ctx sdk.Context, escrowAccounts []types.EscrowAccount) []types.DenomEscrowReconciliation {
+	var actualTotalEscrowed sdk.Coins
+	for _, escrowAccount := range escrowAccounts {
+		actualTotalEscrowed = actualTotalEscrowed.Add(escrowAccount.Balances...)
+	}
+
+	trackedTotalEscrowed := k.GetAllTotalEscrowed(ctx)
+
+	// the sum of the coins contains every denomination either tracked or held in escrow, sorted by denomination
+	allDenoms := actualTotalEscrowed.Add(trackedTotalEscrowed...)
+
+	reconciliations := make([]types.DenomEscrowReconciliation, 0, len(allDenoms))
+	for _, coin := range allDenoms {
+		reconciliations = append(reconciliations, types.NewDenomEscrowReconciliation(
+			coin.Denom, trackedTotalEscrowed.AmountOf(coin.Denom), actualTotalEscrowed.AmountOf(coin.Denom),
+		))
+	}
+
+	return reconciliations
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// RegisterMemoHandler registers a memo hook executed upon the receipt of packets whose memo is
+// a JSON object containing the given top-level key. It panics if the key is empty or if a
+// handler is already registered for the key. Memo handlers must be registered during app
+// initialisation.
+func (k Keeper) RegisterMemoHandler(key string, handler types.MemoHandler) {
+	if strings.TrimSpace(key) == "" {
+		panic(errors.New("memo hook key cannot be blank"))
+	}
+	if handler == nil {
+		panic(fmt.Errorf("memo handler for key %s cannot be nil", key))
+	}
+	if _, found := k.memoHandlers[key]; found {
+		panic(fmt.Errorf("memo handler already registered for key %s", key))
+	}
+
+	k.memoHandlers[key] = handler
+}
+
+// HasMemoHandler returns true if a memo handler is registered for the given key.
+func (k Keeper) HasMemoHandler(key string) bool {
+	_, found := k.memoHandlers[key]
+	return found
+}
+
+// getMemoHandler returns the memo handler registered for a top-level key of the memo along
+// with the value of the key. A nil handler is returned if the memo does not contain any of the
+// registered keys. An error is returned if the memo contains more than one registered key.
+func (k Keeper) getMemoHandler(memo string) (types.MemoHandler, json.RawMessage, error) {
+	if len(k.memoHandlers) == 0 {
+		return nil, nil, nil
+	}
+
+	keys := make([]string, 0, len(k.memoHandlers))
+	for key := range k.memoHandlers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := types.GetMemoValues(memo, keys)
+	if len(values) > 1 {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidMemo, "memo must not contain more than one memo hook key, got %d", len(values))
+	}
+
+	for key, value := range values {
+		return k.memoHandlers[key], value, nil
+	}
+
+	return nil, nil, nil
+}
+
+// executeMemoHandler executes the memo handler with the coins received by the intermediate
+// account. The coins remaining in the intermediate account once the handler returns are sent
+// to the receiver of the packet.
+func (k Keeper) executeMemoHandler(
+	ctx sdk.Context,
+	handler types.MemoHandler,
+	memoValue json.RawMessage,
+	packet channeltypes.Packet,
+	data types.FungibleTokenPacketDataV2,
+	intermediateAddress, receiver sdk.AccAddress,
+	receivedCoins sdk.Coins,
+) error {
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	if err := handler.OnRecvMemo(ctx, packet, data, intermediateAddress, receivedCoins, memoValue); err != nil {
+		return errorsmod.Wrap(err, "memo hook failed")
+	}
+
+	remainingCoins := sdk.NewCoins()
+	for _, coin := range receivedCoins {
+		remainingCoins = remainingCoins.Add(k.bankKeeper.GetBalance(ctx, intermediateAddress, coin.Denom))
+	}
+
+	if remainingCoins.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, intermediateAddress, receiver, remainingCoins)
+}
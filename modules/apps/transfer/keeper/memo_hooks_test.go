@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var _ types.MemoHandler = (*mockMemoHandler)(nil)
+
+// mockMemoHandler is a memo handler executing the provided function.
+type mockMemoHandler struct {
+	onRecvMemo func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, intermediateAddress sdk.AccAddress, receivedCoins sdk.Coins, memoValue json.RawMessage) error
+}
+
+func (m mockMemoHandler) OnRecvMemo(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, intermediateAddress sdk.AccAddress, receivedCoins sdk.Coins, memoValue json.RawMessage) error {
+	return m.onRecvMemo(ctx, packet, data, intermediateAddress, receivedCoins, memoValue)
+}
+
+func (suite *KeeperTestSuite) TestMemoHooks() {
+	var (
+		memo           string
+		handler        mockMemoHandler
+		handlerCalled  bool
+		expReceived    sdkmath.Int
+		expHookBalance sdkmath.Int
+	)
+
+	amount := sdkmath.NewInt(100)
+	hookRecipient := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: memo hook executed, remaining tokens sent to receiver",
+			func() {
+				memo = `{"swap":{"min_amount":"10"}}`
+				expReceived = sdkmath.NewInt(60)
+				expHookBalance = sdkmath.NewInt(40)
+			},
+			true,
+		},
+		{
+			"success: memo without registered key",
+			func() {
+				memo = `{"stake":{}}`
+				expReceived = amount
+				expHookBalance = sdkmath.ZeroInt()
+			},
+			true,
+		},
+		{
+			"success: memo which is not a JSON object",
+			func() {
+				memo = "swap"
+				expReceived = amount
+				expHookBalance = sdkmath.ZeroInt()
+			},
+			true,
+		},
+		{
+			"failure: memo hook returns error",
+			func() {
+				memo = `{"swap":{}}`
+				handler.onRecvMemo = func(_ sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketDataV2, _ sdk.AccAddress, _ sdk.Coins, _ json.RawMessage) error {
+					handlerCalled = true
+					return errors.New("swap failed")
+				}
+			},
+			false,
+		},
+		{
+			"failure: memo contains more than one registered key",
+			func() {
+				memo = `{"swap":{},"other":{}}`
+				suite.chainB.GetSimApp().TransferKeeper.RegisterMemoHandler("other", handler)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			intermediateAddress := types.GetIntermediateAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sender.String())
+			voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)).IBCDenom()
+
+			handlerCalled = false
+			expReceived, expHookBalance = sdkmath.ZeroInt(), sdkmath.ZeroInt()
+
+			// the default handler sends 40 tokens to the hook recipient
+			handler = mockMemoHandler{
+				onRecvMemo: func(ctx sdk.Context, _ channeltypes.Packet, data types.FungibleTokenPacketDataV2, intermediate sdk.AccAddress, receivedCoins sdk.Coins, memoValue json.RawMessage) error {
+					handlerCalled = true
+
+					suite.Require().Equal(intermediateAddress, intermediate)
+					suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(voucherDenom, amount)), receivedCoins)
+					suite.Require().Equal(receiver.String(), data.Receiver)
+					suite.Require().JSONEq(`{"min_amount":"10"}`, string(memoValue))
+
+					return suite.chainB.GetSimApp().BankKeeper.SendCoins(ctx, intermediate, hookRecipient, sdk.NewCoins(sdk.NewCoin(voucherDenom, sdkmath.NewInt(40))))
+				},
+			}
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().TransferKeeper.RegisterMemoHandler("swap", handler)
+
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), sender.String(), receiver.String(), suite.chainB.GetTimeoutHeight(), 0, memo)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			_, ackBz, err := path.RelayPacketWithResults(packet)
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+
+			bankKeeper := suite.chainB.GetSimApp().BankKeeper
+			ctx := suite.chainB.GetContext()
+
+			// the intermediate account never holds any tokens once the packet is received
+			suite.Require().True(bankKeeper.GetBalance(ctx, intermediateAddress, voucherDenom).IsZero())
+
+			if tc.expPass {
+				suite.Require().True(ack.Success())
+				suite.Require().Equal(expReceived, bankKeeper.GetBalance(ctx, receiver, voucherDenom).Amount)
+				suite.Require().Equal(expHookBalance, bankKeeper.GetBalance(ctx, hookRecipient, voucherDenom).Amount)
+				suite.Require().Equal(expHookBalance.IsPositive(), handlerCalled)
+			} else {
+				suite.Require().False(ack.Success())
+				suite.Require().True(bankKeeper.GetBalance(ctx, receiver, voucherDenom).IsZero())
+				suite.Require().True(bankKeeper.GetBalance(ctx, hookRecipient, voucherDenom).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterMemoHandler() {
+	suite.SetupTest() // reset
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	handler := mockMemoHandler{}
+
+	suite.Require().False(transferKeeper.HasMemoHandler("swap"))
+	suite.Require().NotPanics(func() {
+		transferKeeper.RegisterMemoHandler("swap", handler)
+	})
+	suite.Require().True(transferKeeper.HasMemoHandler("swap"))
+
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterMemoHandler("swap", handler)
+	}, "duplicate key")
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterMemoHandler(" ", handler)
+	}, "blank key")
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterMemoHandler("stake", nil)
+	}, "nil handler")
+}
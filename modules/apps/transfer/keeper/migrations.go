@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
@@ -30,50 +35,70 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	return nil
 }
 
-// MigrateTraces migrates the DenomTraces to the correct format, accounting for slashes in the BaseDenom.
+// MigrateTraces migrates the legacy DenomTraces, which store the trace path as a single string,
+// to Denoms storing the base denomination and the explicit list of hops, accounting for slashes
+// in the BaseDenom. The legacy DenomTraces are removed from the store.
 func (m Migrator) MigrateTraces(ctx sdk.Context) error {
-	// list of traces that must replace the old traces in store
-	var newTraces []types.DenomTrace
-	m.keeper.IterateDenomTraces(ctx,
-		func(dt types.DenomTrace) (stop bool) {
-			// check if the new way of splitting FullDenom
-			// is the same as the current DenomTrace.
-			// If it isn't then store the new DenomTrace in the list of new traces.
-			newTrace := types.ParseDenomTrace(dt.GetFullDenomPath())
-			err := newTrace.Validate()
+	var (
+		legacyHashes [][]byte
+		newDenoms    []types.Denom
+	)
+	m.iterateLegacyDenomTraces(ctx,
+		func(hash []byte, dt types.DenomTrace) (stop bool) {
+			// parse the full path of the denomination trace into a denomination with an
+			// explicit trace, such that base denominations containing slashes are handled.
+			denom := types.ExtractDenomFromPath(dt.GetFullDenomPath())
+			err := denom.Validate()
 			if err != nil {
 				panic(err)
 			}
 
-			if dt.IBCDenom() != newTrace.IBCDenom() {
+			if dt.IBCDenom() != denom.IBCDenom() {
 				// The new form of parsing will result in a token denomination change.
 				// A bank migration is required. A panic should occur to prevent the
 				// chain from using corrupted state.
-				panic(fmt.Errorf("migration will result in corrupted state. Previous IBC token (%s) requires a bank migration. Expected denom trace (%s)", dt, newTrace))
-			}
-
-			if !equalTraces(newTrace, dt) {
-				newTraces = append(newTraces, newTrace)
+				panic(fmt.Errorf("migration will result in corrupted state. Previous IBC token (%s) requires a bank migration. Expected denom (%s)", dt, denom.FullPath()))
 			}
 
+			legacyHashes = append(legacyHashes, hash)
+			newDenoms = append(newDenoms, denom)
 			return false
 		})
 
-	// replace the outdated traces with the new trace information
-	for _, nt := range newTraces {
-		m.keeper.SetDenomTrace(ctx, nt)
+	// replace the legacy traces with the new denominations
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.DenomTraceKey)
+	for _, hash := range legacyHashes {
+		store.Delete(hash)
+	}
+
+	for _, denom := range newDenoms {
+		m.keeper.SetDenom(ctx, denom)
 	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated denomination traces", "number of denominations", len(newDenoms))
 	return nil
 }
 
 // MigrateDenomMetadata sets token metadata for all the IBC denom traces
 func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
+	setDenomMetadata := func(dt types.DenomTrace) {
+		// check if the metadata for the given denom trace does not already exist
+		if !m.keeper.bankKeeper.HasDenomMetaData(ctx, dt.IBCDenom()) {
+			m.keeper.setDenomMetadata(ctx, dt.ToDenom(), nil)
+		}
+	}
+
+	// denomination traces which have not yet been migrated to denominations
+	// are still stored under the legacy key
+	m.iterateLegacyDenomTraces(ctx,
+		func(_ []byte, dt types.DenomTrace) (stop bool) {
+			setDenomMetadata(dt)
+			return false
+		})
+
 	m.keeper.IterateDenomTraces(ctx,
 		func(dt types.DenomTrace) (stop bool) {
-			// check if the metadata for the given denom trace does not already exist
-			if !m.keeper.bankKeeper.HasDenomMetaData(ctx, dt.IBCDenom()) {
-				m.keeper.setDenomMetadata(ctx, dt)
-			}
+			setDenomMetadata(dt)
 			return false
 		})
 
@@ -102,6 +127,61 @@ func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 	return nil
 }
 
-func equalTraces(dtA, dtB types.DenomTrace) bool {
-	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
+// iterateLegacyDenomTraces iterates over the denomination traces stored under the legacy
+// DenomTraceKey and performs a callback function with the hash and the denomination trace.
+func (m Migrator) iterateLegacyDenomTraces(ctx sdk.Context, cb func(hash []byte, denomTrace types.DenomTrace) bool) {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.DenomTraceKey)
+	iterator := store.Iterator(nil, nil)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		denomTrace := m.keeper.MustUnmarshalDenomTrace(iterator.Value())
+		if cb(iterator.Key(), denomTrace) {
+			break
+		}
+	}
+}
+
+// MigrateTransferAuthorizations migrates the allow list of the allocations of all transfer authorization
+// grants to their allowed receivers. As the transfer module does not depend on the authz keeper, the
+// migration is expected to be run from the upgrade handler of the chain. Expired grants are not migrated.
+func MigrateTransferAuthorizations(ctx sdk.Context, authzKeeper types.AuthzKeeper) error {
+	type migratedGrant struct {
+		granter, grantee sdk.AccAddress
+		authorization    *types.TransferAuthorization
+		expiration       *time.Time
+	}
+
+	// grants are collected before being saved, as the store must not be written while iterating
+	var migratedGrants []migratedGrant
+	authzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
+		if grant.Expiration != nil && !grant.Expiration.After(ctx.BlockTime()) {
+			return false
+		}
+
+		authorization, err := grant.GetAuthorization()
+		if err != nil {
+			return false
+		}
+
+		transferAuthorization, ok := authorization.(*types.TransferAuthorization)
+		if !ok {
+			return false
+		}
+
+		if migrated, ok := transferAuthorization.Migrate(); ok {
+			migratedGrants = append(migratedGrants, migratedGrant{granter, grantee, migrated, grant.Expiration})
+		}
+
+		return false
+	})
+
+	for _, grant := range migratedGrants {
+		if err := authzKeeper.SaveGrant(ctx, grant.grantee, grant.granter, grant.authorization, grant.expiration); err != nil {
+			return errorsmod.Wrapf(err, "failed to migrate transfer authorization granted by %s to %s", grant.granter, grant.grantee)
+		}
+	}
+
+	ctx.Logger().Info("successfully migrated transfer authorizations", "number of grants", len(migratedGrants))
+	return nil
 }
@@ -2,10 +2,13 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -57,8 +60,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: two slashes in base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
-					suite.chainA.GetContext(),
+				suite.setLegacyDenomTrace(
 					transfertypes.DenomTrace{
 						BaseDenom: "pool/1", Path: "transfer/channel-0/gamm",
 					})
@@ -72,8 +74,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: one slash in base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
-					suite.chainA.GetContext(),
+				suite.setLegacyDenomTrace(
 					transfertypes.DenomTrace{
 						BaseDenom: "0x85bcBCd7e79Ec36f4fBBDc54F90C643d921151AA", Path: "transfer/channel-149/erc",
 					})
@@ -87,8 +88,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: multiple slashes in a row in base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
-					suite.chainA.GetContext(),
+				suite.setLegacyDenomTrace(
 					transfertypes.DenomTrace{
 						BaseDenom: "1", Path: "transfer/channel-5/gamm//pool",
 					})
@@ -102,8 +102,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: multihop base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
-					suite.chainA.GetContext(),
+				suite.setLegacyDenomTrace(
 					transfertypes.DenomTrace{
 						BaseDenom: "transfer/channel-1/uatom", Path: "transfer/channel-0",
 					})
@@ -117,8 +116,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: non-standard port",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
-					suite.chainA.GetContext(),
+				suite.setLegacyDenomTrace(
 					transfertypes.DenomTrace{
 						BaseDenom: "customport/channel-7/uatom", Path: "transfer/channel-0/transfer/channel-1",
 					})
@@ -144,6 +142,18 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 
 			traces := suite.chainA.GetSimApp().TransferKeeper.GetAllDenomTraces(suite.chainA.GetContext())
 			suite.Require().Equal(tc.expectedTraces, traces)
+
+			// the legacy denom traces must have been removed from the store
+			legacyStore := prefix.NewStore(suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey)), transfertypes.DenomTraceKey)
+			iterator := legacyStore.Iterator(nil, nil)
+			suite.Require().False(iterator.Valid())
+			suite.Require().NoError(iterator.Close())
+
+			for _, trace := range tc.expectedTraces {
+				denom, found := suite.chainA.GetSimApp().TransferKeeper.GetDenom(suite.chainA.GetContext(), trace.Hash())
+				suite.Require().True(found)
+				suite.Require().Equal(trace.Hops(), denom.Trace)
+			}
 		})
 	}
 }
@@ -154,7 +164,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTracesCorruptionDetection() {
 		BaseDenom: "customport/channel-0/uatom",
 		Path:      "",
 	}
-	suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), corruptedDenomTrace)
+	suite.setLegacyDenomTrace(corruptedDenomTrace)
 
 	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
 	suite.Panics(func() {
@@ -422,3 +432,54 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 		})
 	}
 }
+
+// setLegacyDenomTrace stores the denom trace under the legacy key, as it was stored before
+// the migration to denominations with an explicit trace.
+func (suite *KeeperTestSuite) TestMigrateTransferAuthorizations() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	authzKeeper := suite.chainA.GetSimApp().AuthzKeeper
+
+	granter := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+	grantee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	unrestrictedGrantee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+	genericGrantee := suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+
+	allocation := transfertypes.Allocation{
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: ibctesting.FirstChannelID,
+		SpendLimit:    ibctesting.TestCoins,
+		AllowList:     []string{ibctesting.TestAccAddress},
+	}
+	unrestrictedAllocation := allocation
+	unrestrictedAllocation.AllowList = nil
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(authzKeeper.SaveGrant(ctx, grantee, granter, transfertypes.NewTransferAuthorization(allocation), &expiration))
+	suite.Require().NoError(authzKeeper.SaveGrant(ctx, unrestrictedGrantee, granter, transfertypes.NewTransferAuthorization(unrestrictedAllocation), nil))
+	suite.Require().NoError(authzKeeper.SaveGrant(ctx, genericGrantee, granter, authz.NewGenericAuthorization(sdk.MsgTypeURL(&transfertypes.MsgTransfer{})), nil))
+
+	err := transferkeeper.MigrateTransferAuthorizations(ctx, authzKeeper)
+	suite.Require().NoError(err)
+
+	expAllocation := allocation
+	expAllocation.AllowList = nil
+	expAllocation.AllowedReceivers = []string{ibctesting.TestAccAddress}
+
+	authorization, grantExpiration := authzKeeper.GetAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(&transfertypes.MsgTransfer{}))
+	suite.Require().Equal(transfertypes.NewTransferAuthorization(expAllocation), authorization)
+	suite.Require().Equal(expiration, *grantExpiration)
+
+	authorization, _ = authzKeeper.GetAuthorization(ctx, unrestrictedGrantee, granter, sdk.MsgTypeURL(&transfertypes.MsgTransfer{}))
+	suite.Require().Equal(transfertypes.NewTransferAuthorization(unrestrictedAllocation), authorization)
+
+	authorization, _ = authzKeeper.GetAuthorization(ctx, genericGrantee, granter, sdk.MsgTypeURL(&transfertypes.MsgTransfer{}))
+	suite.Require().IsType(&authz.GenericAuthorization{}, authorization)
+}
+
+func (suite *KeeperTestSuite) setLegacyDenomTrace(denomTrace transfertypes.DenomTrace) {
+	storeKey := suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey)
+	store := prefix.NewStore(suite.chainA.GetContext().KVStore(storeKey), transfertypes.DenomTraceKey)
+	store.Set(denomTrace.Hash(), suite.chainA.GetSimApp().TransferKeeper.MustMarshalDenomTrace(denomTrace))
+}
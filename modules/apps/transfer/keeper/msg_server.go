@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -26,39 +27,195 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
-	if !k.bankKeeper.IsSendEnabledCoin(ctx, msg.Token) {
-		return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", msg.Token.Denom)
+	coins := msg.GetCoins()
+	for _, coin := range coins {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
 	}
 
 	if k.bankKeeper.BlockedAddr(sender) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	sourcePort, sourceChannel := msg.SourcePort, msg.SourceChannel
+	hops := msg.Forwarding.Hops
+	if msg.Forwarding.Unwind {
+		sourcePort, sourceChannel, hops, err = k.unwindHops(ctx, coins, hops)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the memo is delivered to the final destination when the tokens are forwarded
+	memo := msg.Memo
+	var forwarding types.ForwardingPacketData
+	if len(hops) > 0 {
+		forwarding = types.NewForwardingPacketData(msg.Memo, hops...)
+		memo = ""
+	}
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo)
+		ctx, sourcePort, sourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		memo, forwarding)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+
+	emitTransferEvent(ctx, msg.Sender, msg.Receiver, coins, msg.Memo)
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// TransferAll defines an rpc handler method for MsgTransferAll. The entire spendable balance of every
+// denomination held by the sender is transferred over the source channel. The denominations which cannot
+// be sent are skipped or fail the transfer depending on the partial fill policy of the message.
+// A single denomination is sent per packet over ics20-1 channels, while up to MaximumTokensLength
+// denominations are sent per packet over ics20-2 channels.
+func (k Keeper) TransferAll(goCtx context.Context, msg *types.MsgTransferAll) (*types.MsgTransferAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.SendEnabled {
+		return nil, types.ErrSendDisabled
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(sender) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
+	}
+
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", msg.SourcePort, msg.SourceChannel)
+	}
+
+	tokensPerPacket := types.MaximumTokensLength
+	if appVersion == types.V1 {
+		// ics20-1 only supports a single coin per packet
+		tokensPerPacket = 1
+	}
+
+	allOrNothing := msg.PartialFillPolicy == types.ALL_OR_NOTHING
+
+	balances := k.bankKeeper.SpendableCoins(ctx, sender)
+	results := make([]types.DenomTransferResult, len(balances))
+
+	var pending []int
+	for i, coin := range balances {
+		results[i] = types.DenomTransferResult{Coin: coin}
+
+		if err := k.checkTransferAllAllowed(ctx, params, msg.SourcePort, msg.SourceChannel, coin); err != nil {
+			if allOrNothing {
+				return nil, errorsmod.Wrapf(err, "cannot transfer %s", coin.Denom)
+			}
+
+			results[i].SkipReason = err.Error()
+			continue
+		}
+
+		pending = append(pending, i)
+	}
+
+	var transferred sdk.Coins
+	for start := 0; start < len(pending); start += tokensPerPacket {
+		chunk := pending[start:min(start+tokensPerPacket, len(pending))]
+
+		coins := make(sdk.Coins, len(chunk))
+		for j, idx := range chunk {
+			coins[j] = results[idx].Coin
+		}
+
+		// each packet is sent in a cached context such that a failure only discards
+		// the state changes of the coins carried by that packet
+		cacheCtx, writeFn := ctx.CacheContext()
+		sequence, err := k.sendTransfer(
+			cacheCtx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+			msg.Memo, types.ForwardingPacketData{})
+		if err != nil {
+			if allOrNothing {
+				return nil, errorsmod.Wrapf(err, "cannot transfer %s", coins)
+			}
+
+			for _, idx := range chunk {
+				results[idx].SkipReason = err.Error()
+			}
+			continue
+		}
+
+		writeFn()
+
+		for _, idx := range chunk {
+			results[idx].Transferred = true
+			results[idx].Sequence = sequence
+		}
+		transferred = append(transferred, coins...)
+	}
+
+	if transferred.Empty() {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "%s holds no denomination which can be transferred over channel %s/%s", msg.Sender, msg.SourcePort, msg.SourceChannel)
+	}
+
+	k.Logger(ctx).Info("IBC fungible token transfer of all balances", "tokens", transferred.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+
+	emitTransferEvent(ctx, msg.Sender, msg.Receiver, transferred, msg.Memo)
+
+	return &types.MsgTransferAllResponse{Results: results}, nil
+}
+
+// checkTransferAllAllowed returns an error if the given coin held by the sender of a
+// MsgTransferAll cannot be sent over the provided channel.
+func (k Keeper) checkTransferAllAllowed(ctx sdk.Context, params types.Params, portID, channelID string, coin sdk.Coin) error {
+	if err := types.ValidateIBCDenom(coin.Denom); err != nil {
+		return err
+	}
+
+	if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+		return errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+	}
+
+	fullDenomPath := coin.Denom
+	if strings.HasPrefix(coin.Denom, types.DenomPrefix+"/") {
+		var err error
+		fullDenomPath, err = k.DenomPathFromHash(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkSendAllowed(params, portID, channelID, coin.Denom, fullDenomPath)
+}
+
+// emitTransferEvent emits the events of a fungible token transfer initiated by a message.
+func emitTransferEvent(ctx sdk.Context, sender, receiver string, coins sdk.Coins, memo string) {
+	transferAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+	}
+	for _, coin := range coins {
+		transferAttributes = append(transferAttributes,
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+		)
+	}
+	transferAttributes = append(transferAttributes, sdk.NewAttribute(types.AttributeKeyMemo, memo))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Token.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Token.Denom),
-			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+			transferAttributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		),
 	})
-
-	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
@@ -72,3 +229,43 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateDenomMetadata defines an rpc handler method for MsgUpdateDenomMetadata. Overrides the bank metadata of an IBC voucher.
+func (k Keeper) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.denomFromIBCDenom(ctx, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+// ReconcileTotalEscrow defines an rpc handler method for MsgReconcileTotalEscrow. Sets the total amount
+// in escrow tracked for each denomination to the total balance held by the channel escrow accounts.
+func (k Keeper) ReconcileTotalEscrow(goCtx context.Context, msg *types.MsgReconcileTotalEscrow) (*types.MsgReconcileTotalEscrowResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var corrections []types.DenomEscrowReconciliation
+	for _, reconciliation := range k.getEscrowReconciliation(ctx, k.GetAllEscrowAccounts(ctx)) {
+		if reconciliation.IsReconciled() {
+			continue
+		}
+
+		k.SetTotalEscrowForDenom(ctx, sdk.NewCoin(reconciliation.Denom, reconciliation.ActualAmount))
+		corrections = append(corrections, reconciliation)
+
+		k.Logger(ctx).Info("reconciled total escrow", "denom", reconciliation.Denom, "tracked", reconciliation.TrackedAmount, "actual", reconciliation.ActualAmount)
+	}
+
+	return &types.MsgReconcileTotalEscrowResponse{Corrections: corrections}, nil
+}
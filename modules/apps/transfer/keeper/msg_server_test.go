@@ -1,12 +1,17 @@
 package keeper_test
 
 import (
+	"slices"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -131,6 +136,192 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 	}
 }
 
+// TestMsgTransferAll tests TransferAll rpc handler
+func (suite *KeeperTestSuite) TestMsgTransferAll() {
+	var (
+		msg          *types.MsgTransferAll
+		sender       sdk.AccAddress
+		balances     sdk.Coins
+		expSkipped   []string
+		expSequences int
+	)
+
+	stakeCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	atomCoin := sdk.NewCoin("uatom", sdkmath.NewInt(50))
+	// voucher without a denomination stored in the transfer module
+	unknownVoucher := sdk.NewCoin(types.NewDenom("uosmo", types.NewHop(ibctesting.TransferPort, "channel-9")).IBCDenom(), sdkmath.NewInt(10))
+
+	fundUnknownVoucher := func() {
+		suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, sender, sdk.NewCoins(unknownVoucher)))
+		balances = balances.Add(unknownVoucher)
+	}
+
+	setChannelDenomFilter := func(allowedDenoms ...string) {
+		params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+		params.ChannelDenomFilters = []types.ChannelDenomFilter{
+			types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, allowedDenoms, nil),
+		}
+		suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	testCases := []struct {
+		name     string
+		version  string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: all denominations sent in a single packet over ics20-2",
+			types.V2,
+			func() {
+				expSequences = 1
+			},
+			true,
+		},
+		{
+			"success: one packet per denomination over ics20-1",
+			types.V1,
+			func() {
+				expSequences = 2
+			},
+			true,
+		},
+		{
+			"success: voucher with unknown denomination is skipped",
+			types.V2,
+			func() {
+				fundUnknownVoucher()
+				expSkipped = []string{unknownVoucher.Denom}
+				expSequences = 1
+			},
+			true,
+		},
+		{
+			"success: bank send disabled denomination is skipped",
+			types.V2,
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SetParams(suite.chainA.GetContext(),
+					banktypes.Params{
+						SendEnabled:        []*banktypes.SendEnabled{{Denom: atomCoin.Denom, Enabled: false}},
+						DefaultSendEnabled: true,
+					},
+				)
+				suite.Require().NoError(err)
+				expSkipped = []string{atomCoin.Denom}
+				expSequences = 1
+			},
+			true,
+		},
+		{
+			"success: denomination not allowed on channel is skipped",
+			types.V2,
+			func() {
+				setChannelDenomFilter(sdk.DefaultBondDenom)
+				expSkipped = []string{atomCoin.Denom}
+				expSequences = 1
+			},
+			true,
+		},
+		{
+			"failure: rejected denomination with all or nothing policy",
+			types.V2,
+			func() {
+				fundUnknownVoucher()
+				msg.PartialFillPolicy = types.ALL_OR_NOTHING
+			},
+			false,
+		},
+		{
+			"failure: no denomination can be transferred",
+			types.V2,
+			func() {
+				setChannelDenomFilter("uusdc")
+			},
+			false,
+		},
+		{
+			"failure: send transfers disabled",
+			types.V2,
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(),
+					types.Params{
+						SendEnabled: false,
+					},
+				)
+			},
+			false,
+		},
+		{
+			"failure: channel does not exist",
+			types.V2,
+			func() {
+				msg.SourceChannel = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			balances = sdk.NewCoins(stakeCoin, atomCoin)
+			expSkipped = nil
+			expSequences = 0
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = tc.version
+			path.EndpointB.ChannelConfig.Version = tc.version
+			suite.coordinator.Setup(path)
+
+			sender = sdk.AccAddress([]byte("transfer-all-sender"))
+			suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, sender, balances))
+
+			msg = types.NewMsgTransferAll(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, // only use timeout height
+				"memo", types.SKIP_REJECTED,
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TransferAll(ctx, msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Results, len(balances))
+
+				sequences := make(map[uint64]bool)
+				for _, result := range res.Results {
+					skipped := slices.Contains(expSkipped, result.Coin.Denom)
+					suite.Require().Equal(!skipped, result.Transferred, result.Coin.Denom)
+					suite.Require().Equal(balances.AmountOf(result.Coin.Denom), result.Coin.Amount)
+
+					// skipped denominations remain with the sender
+					expBalance := sdkmath.ZeroInt()
+					if skipped {
+						suite.Require().NotEmpty(result.SkipReason)
+						expBalance = result.Coin.Amount
+					} else {
+						suite.Require().NotZero(result.Sequence)
+						sequences[result.Sequence] = true
+					}
+					suite.Require().Equal(expBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, result.Coin.Denom).Amount)
+				}
+				suite.Require().Len(sequences, expSequences)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				suite.Require().Equal(balances, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, sender))
+			}
+		})
+	}
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
@@ -179,3 +370,139 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// TestUpdateDenomMetadata tests UpdateDenomMetadata rpc handler
+func (suite *KeeperTestSuite) TestUpdateDenomMetadata() {
+	var msg *types.MsgUpdateDenomMetadata
+
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-0"))
+	metadata := types.NewTokenMetadata("Stake", "STK", 6, "stk").ToBankMetadata(denom)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denomination trace not found",
+			func() {
+				unknownDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-1"))
+				msg.Metadata = types.NewTokenMetadata("Stake", "STK", 6, "stk").ToBankMetadata(unknownDenom)
+			},
+			types.ErrTraceNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+			msg = types.NewMsgUpdateDenomMetadata(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), metadata)
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateDenomMetadata(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				storedMetadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denom.IBCDenom())
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Metadata, storedMetadata)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestReconcileTotalEscrow tests ReconcileTotalEscrow rpc handler
+func (suite *KeeperTestSuite) TestReconcileTotalEscrow() {
+	var msg *types.MsgReconcileTotalEscrow
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			ctx := suite.chainA.GetContext()
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			escrowBalances := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("uosmo", sdkmath.NewInt(5)))
+			suite.Require().NoError(banktestutil.FundAccount(ctx, suite.chainA.GetSimApp().BankKeeper, escrowAddress, escrowBalances))
+
+			transferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80)))
+			transferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin("uatom", sdkmath.NewInt(30)))
+			transferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin("uosmo", sdkmath.NewInt(5)))
+			trackedTotalEscrowed := transferKeeper.GetAllTotalEscrowed(ctx)
+
+			msg = types.NewMsgReconcileTotalEscrow(transferKeeper.GetAuthority())
+
+			tc.malleate()
+
+			res, err := transferKeeper.ReconcileTotalEscrow(ctx, msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				expCorrections := []types.DenomEscrowReconciliation{
+					types.NewDenomEscrowReconciliation(sdk.DefaultBondDenom, sdkmath.NewInt(80), sdkmath.NewInt(100)),
+					types.NewDenomEscrowReconciliation("uatom", sdkmath.NewInt(30), sdkmath.ZeroInt()),
+				}
+				suite.Require().Equal(expCorrections, res.Corrections)
+				suite.Require().Equal(escrowBalances, transferKeeper.GetAllTotalEscrowed(ctx))
+
+				// the invariant holds and a second reconciliation is a no-op
+				_, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(ctx)
+				suite.Require().False(broken)
+
+				res, err = transferKeeper.ReconcileTotalEscrow(ctx, msg)
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.Corrections)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().Equal(trackedTotalEscrowed, transferKeeper.GetAllTotalEscrowed(ctx))
+			}
+		})
+	}
+}
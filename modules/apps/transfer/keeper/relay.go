@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	metrics "github.com/hashicorp/go-metrics"
@@ -56,18 +57,34 @@ func (k Keeper) sendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token sdk.Coin,
+	coins sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
+	forwarding types.ForwardingPacketData,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
+	}
+
+	if appVersion == types.V1 && len(coins) != 1 {
+		// ics20-1 only supports a single coin per packet
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "cannot transfer multiple coins with %s", types.V1)
+	}
+
+	if appVersion == types.V1 && len(forwarding.Hops) > 0 {
+		// ics20-1 does not support forwarding
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "cannot forward coins with %s", types.V1)
+	}
+
 	destinationPort := channel.GetCounterparty().GetPortID()
 	destinationChannel := channel.GetCounterparty().GetChannelID()
 
@@ -78,116 +95,209 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
-	fullDenomPath := token.Denom
-
-	var err error
-
-	// deconstruct the token denomination into the denomination trace info
-	// to determine if the sender is the source chain
-	if strings.HasPrefix(token.Denom, "ibc/") {
-		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
-		if err != nil {
-			return 0, err
-		}
-	}
-
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
 	}
 
-	// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
-	if token.Amount.Equal(types.UnboundedSpendLimit()) {
-		token.Amount = k.bankKeeper.GetBalance(ctx, sender, token.Denom).Amount
-	}
+	params := k.GetParams(ctx)
+
+	tokens := make([]types.Token, 0, len(coins))
+	senderIsSource := make([]bool, 0, len(coins))
+	for _, coin := range coins {
+		// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
+		fullDenomPath := coin.Denom
 
-	// NOTE: SendTransfer simply sends the denomination as it exists on its own
-	// chain inside the packet data. The receiving chain will perform denom
-	// prefixing as necessary.
+		var err error
 
-	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
+		// deconstruct the token denomination into the denomination trace info
+		// to determine if the sender is the source chain
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			fullDenomPath, err = k.DenomPathFromHash(ctx, coin.Denom)
+			if err != nil {
+				return 0, err
+			}
+		}
 
-		// obtain the escrow address for the source channel end
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
+		if err := checkSendAllowed(params, sourcePort, sourceChannel, coin.Denom, fullDenomPath); err != nil {
 			return 0, err
 		}
 
-	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
+		// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
+		if coin.Amount.Equal(types.UnboundedSpendLimit()) {
+			coin.Amount = k.bankKeeper.GetBalance(ctx, sender, coin.Denom).Amount
+		}
 
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return 0, err
+		// NOTE: SendTransfer simply sends the denomination as it exists on its own
+		// chain inside the packet data. The receiving chain will perform denom
+		// prefixing as necessary.
+
+		isSource := types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath)
+		if isSource {
+			// obtain the escrow address for the source channel end
+			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+			if err := k.escrowToken(ctx, sender, escrowAddress, coin); err != nil {
+				return 0, err
+			}
+
+		} else {
+			// transfer the coins to the module account and burn them
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(
+				ctx, sender, types.ModuleName, sdk.NewCoins(coin),
+			); err != nil {
+				return 0, err
+			}
+
+			if err := k.bankKeeper.BurnCoins(
+				ctx, types.ModuleName, sdk.NewCoins(coin),
+			); err != nil {
+				// NOTE: should not happen as the module account was
+				// retrieved on the step above and it has enough balace
+				// to burn.
+				panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+			}
 		}
 
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balace
-			// to burn.
-			panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+		token := types.Token{Denom: fullDenomPath, Amount: coin.Amount.String()}
+
+		// the metadata of the token is sent along with it over ics20-2 channels, such that the
+		// receiving chain is able to display the vouchers with the same name, symbol and decimals
+		if appVersion == types.V2 {
+			if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom); found {
+				token.Metadata = types.TokenMetadataFromBankMetadata(metadata)
+			}
 		}
+
+		tokens = append(tokens, token)
+		senderIsSource = append(senderIsSource, isSource)
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
-	)
+	var packetDataBytes []byte
+	switch appVersion {
+	case types.V1:
+		packetData := types.NewFungibleTokenPacketData(tokens[0].Denom, tokens[0].Amount, sender.String(), receiver, memo)
+		packetDataBytes = packetData.GetBytes()
+	case types.V2:
+		packetData := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), receiver, memo, forwarding)
+		packetDataBytes = packetData.GetBytes()
+	default:
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "unsupported ICS-20 version: %s", appVersion)
+	}
 
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
 	if err != nil {
 		return 0, err
 	}
 
 	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
+		for i, token := range tokens {
+			amount, ok := sdkmath.NewIntFromString(token.Amount)
+			if ok && amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, token.Denom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				append(
+					labels, telemetry.NewLabel(coretypes.LabelSource, strconv.FormatBool(senderIsSource[i])),
+				),
 			)
 		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
 	}()
 
 	return sequence, nil
 }
 
-// OnRecvPacket processes a cross chain fungible token transfer. If the
-// sender chain is the source of minted tokens then vouchers will be minted
-// and sent to the receiving address. Otherwise if the sender chain is sending
-// back tokens this chain originally transferred to it, the tokens are
+// OnRecvPacket processes a cross chain fungible token transfer. For each token
+// in the packet, if the sender chain is the source of minted tokens then vouchers
+// will be minted and sent to the receiving address. Otherwise if the sender chain
+// is sending back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+//
+// If the packet contains forwarding hops, the tokens are received by the forward address of
+// the destination channel and sent on to the next hop. The acknowledgement of the packet is
+// then written once the forwarded packet is acknowledged or times out.
+//
+// If the memo contains the top-level key of a registered memo hook, the tokens are received by
+// the intermediate account derived from the destination channel and the sender, and the memo
+// handler is executed. The tokens remaining once the handler returns are sent to the receiver.
+//
+// NOTE: all tokens are handled atomically, an error for any token will result in an
+// error acknowledgement and the state changes of all tokens will be discarded by core IBC.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
 	}
 
-	if !k.GetParams(ctx).ReceiveEnabled {
+	params := k.GetParams(ctx)
+	if !params.ReceiveEnabled {
 		return types.ErrReceiveDisabled
 	}
 
-	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	for _, token := range data.Tokens {
+		if err := checkReceiveAllowed(params, packet.GetDestPort(), packet.GetDestChannel(), token.Denom); err != nil {
+			return err
+		}
+	}
+
+	var receiver sdk.AccAddress
+	if data.HasForwarding() {
+		// the tokens are held by the forward address until they are sent on the next hop
+		receiver = types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	} else {
+		// decode the receiver address
+		receiverBz, err := k.addressCodec.StringToBytes(data.Receiver)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		}
+		receiver = receiverBz
+	}
+
+	// the tokens are held by the intermediate account while the memo hook is executed
+	tokenReceiver := receiver
+	memoHandler, memoValue, err := k.getMemoHandler(data.Memo)
 	if err != nil {
-		return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		return err
+	}
+	if memoHandler != nil {
+		tokenReceiver = types.GetIntermediateAddress(packet.GetDestPort(), packet.GetDestChannel(), data.Sender)
 	}
 
+	receivedCoins := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := k.receiveToken(ctx, packet, tokenReceiver, token)
+		if err != nil {
+			return err
+		}
+
+		receivedCoins = receivedCoins.Add(coin)
+	}
+
+	if data.HasForwarding() {
+		return k.forwardPacket(ctx, data, packet, receivedCoins)
+	}
+
+	if memoHandler != nil {
+		return k.executeMemoHandler(ctx, memoHandler, memoValue, packet, data, tokenReceiver, receiver, receivedCoins)
+	}
+
+	return nil
+}
+
+// receiveToken unescrows or mints the provided token to the receiver depending
+// on whether the receiving chain is the source of the token. The coin received
+// is returned.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, receiver sdk.AccAddress, token types.Token) (sdk.Coin, error) {
 	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
+	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
 	}
 
 	labels := []metrics.Label{
@@ -202,31 +312,24 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
 	// chain would have prefixed with DestPort and DestChannel when originally
 	// receiving this coin as seen in the "sender chain is the source" condition.
-	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
 		// sender chain is not the source, unescrow tokens
 
 		// remove prefix added by sender chain
 		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		unprefixedDenom := token.Denom[len(voucherPrefix):]
 
-		// coin denomination used in sending from the escrow address
-		denom := unprefixedDenom
-
-		// The denomination used to send the coins is either the native denom or the hash of the path
-		// if the denomination is not native.
-		denomTrace := types.ParseDenomTrace(unprefixedDenom)
-		if !denomTrace.IsNativeDenom() {
-			denom = denomTrace.IBCDenom()
-		}
-		token := sdk.NewCoin(denom, transferAmount)
+		// The denomination used to send the coins from the escrow address is either the native denom
+		// or the hash of the path if the denomination is not native.
+		coin := sdk.NewCoin(k.DenomFromPath(ctx, unprefixedDenom).IBCDenom(), transferAmount)
 
 		if k.bankKeeper.BlockedAddr(receiver) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+			return sdk.Coin{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 		}
 
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
-			return err
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, coin); err != nil {
+			return sdk.Coin{}, err
 		}
 
 		defer func() {
@@ -247,27 +350,27 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return coin, nil
 	}
 
 	// sender chain is the source, mint vouchers
 
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
-	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-	// NOTE: sourcePrefix contains the trailing "/"
-	prefixedDenom := sourcePrefix + data.Denom
-
-	// construct the denomination trace from the full raw denomination
-	denomTrace := types.ParseDenomTrace(prefixedDenom)
+	// by adding the destination port and channel as the most recent hop of the trace
+	receivedDenom := types.ExtractDenomFromPath(token.Denom)
+	denom := types.NewDenom(
+		receivedDenom.Base,
+		append([]types.Hop{types.NewHop(packet.GetDestPort(), packet.GetDestChannel())}, receivedDenom.Trace...)...,
+	)
 
-	traceHash := denomTrace.Hash()
-	if !k.HasDenomTrace(ctx, traceHash) {
-		k.SetDenomTrace(ctx, denomTrace)
+	traceHash := denom.Hash()
+	if !k.HasDenom(ctx, traceHash) {
+		k.SetDenom(ctx, denom)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
+	voucherDenom := denom.IBCDenom()
 	if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
-		k.setDenomMetadata(ctx, denomTrace)
+		k.setDenomMetadata(ctx, denom, token.Metadata)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -283,14 +386,14 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	if err := k.bankKeeper.MintCoins(
 		ctx, types.ModuleName, sdk.NewCoins(voucher),
 	); err != nil {
-		return errorsmod.Wrap(err, "failed to mint IBC tokens")
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint IBC tokens")
 	}
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
 	); err != nil {
-		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
 	}
 
 	defer func() {
@@ -298,7 +401,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			telemetry.SetGaugeWithLabels(
 				[]string{"ibc", types.ModuleName, "packet", "receive"},
 				float32(transferAmount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, data.Denom)},
+				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, token.Denom)},
 			)
 		}
 
@@ -311,18 +414,34 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return voucher, nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
-func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+// the sender is refunded their tokens using the refundPacketTokens function.
+// If the packet was forwarded on behalf of a previous hop, the acknowledgement
+// of the packet received from the previous hop is written.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
+	prevPacket, isForwarded := k.getForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if isForwarded {
+			if err := k.revertForwardedPacket(ctx, prevPacket, packet, data); err != nil {
+				return err
+			}
+
+			return k.acknowledgeForwardedPacket(ctx, prevPacket, packet, channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketFailed))
+		}
+
+		return k.refundPacketTokens(ctx, packet, data)
 	default:
+		if isForwarded {
+			return k.acknowledgeForwardedPacket(ctx, prevPacket, packet, ack)
+		}
+
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
 		return nil
@@ -330,49 +449,67 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+// never received and has been timed out. If the packet was forwarded on
+// behalf of a previous hop, an error acknowledgement is written for the
+// packet received from the previous hop.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	prevPacket, isForwarded := k.getForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if isForwarded {
+		if err := k.revertForwardedPacket(ctx, prevPacket, packet, data); err != nil {
+			return err
+		}
+
+		return k.acknowledgeForwardedPacket(ctx, prevPacket, packet, channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimedOut))
+	}
+
+	return k.refundPacketTokens(ctx, packet, data)
 }
 
-// refundPacketToken will unescrow and send back the tokens back to sender
+// refundPacketTokens will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// the sending address. All tokens contained in the packet are refunded.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
-
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
-	}
-	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
-
 	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	senderBz, err := k.addressCodec.StringToBytes(data.Sender)
 	if err != nil {
 		return err
 	}
+	sender := sdk.AccAddress(senderBz)
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
-		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		return k.unescrowToken(ctx, escrowAddress, sender, token)
-	}
+	for _, token := range data.Tokens {
+		// resolve the denomination from the full denom path
+		denom := k.DenomFromPath(ctx, token.Denom)
 
-	// mint vouchers back to sender
-	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(token),
-	); err != nil {
-		return err
-	}
+		// parse the transfer amount
+		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+		coin := sdk.NewCoin(denom.IBCDenom(), transferAmount)
+
+		if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+			// unescrow tokens back to sender
+			escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+			if err := k.unescrowToken(ctx, escrowAddress, sender, coin); err != nil {
+				return err
+			}
+
+			continue
+		}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(token)); err != nil {
-		panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+		// mint vouchers back to sender
+		if err := k.bankKeeper.MintCoins(
+			ctx, types.ModuleName, sdk.NewCoins(coin),
+		); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin)); err != nil {
+			panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+		}
 	}
 
 	return nil
@@ -416,19 +553,76 @@ func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAd
 // DenomPathFromHash returns the full denomination path prefix from an ibc denom with a hash
 // component.
 func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
+	ibcDenom, err := k.denomFromIBCDenom(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	return ibcDenom.FullPath(), nil
+}
+
+// denomFromIBCDenom returns the stored denomination of an ibc denom with a hash component.
+func (k Keeper) denomFromIBCDenom(ctx sdk.Context, denom string) (types.Denom, error) {
 	// trim the denomination prefix, by default "ibc/"
 	hexHash := denom[len(types.DenomPrefix+"/"):]
 
 	hash, err := types.ParseHexHash(hexHash)
 	if err != nil {
-		return "", errorsmod.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
+		return types.Denom{}, errorsmod.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
 	}
 
-	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	ibcDenom, found := k.GetDenom(ctx, hash)
 	if !found {
-		return "", errorsmod.Wrap(types.ErrTraceNotFound, hexHash)
+		return types.Denom{}, errorsmod.Wrap(types.ErrTraceNotFound, hexHash)
 	}
 
-	fullDenomPath := denomTrace.GetFullDenomPath()
-	return fullDenomPath, nil
+	return ibcDenom, nil
+}
+
+// DenomFromPath returns the denomination of a token with the given full denomination path, as set by
+// this chain in the data of a packet it sent. Vouchers are stored under the hash of their full path, so
+// their hops are looked up rather than parsed from the path. Any other path is the base denomination of
+// a native token, which may itself contain slashes.
+func (k Keeper) DenomFromPath(ctx sdk.Context, fullDenomPath string) types.Denom {
+	denom := types.NewDenom(fullDenomPath)
+	if ibcDenom, found := k.GetDenom(ctx, denom.Hash()); found {
+		return ibcDenom
+	}
+
+	return denom
+}
+
+// checkSendAllowed returns an error if the denomination filters of the params do not allow the
+// token with the given denomination and full denomination path to be sent over the channel.
+func checkSendAllowed(params types.Params, portID, channelID, denom, fullDenomPath string) error {
+	filter, found := params.GetChannelDenomFilter(portID, channelID)
+	if !found {
+		return nil
+	}
+
+	if filter.IsSendDisabled(denom) {
+		return errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are disabled on channel %s/%s", denom, portID, channelID)
+	}
+
+	baseDenom := types.ExtractDenomFromPath(fullDenomPath).Base
+	if !filter.IsDenomAllowed(baseDenom) {
+		return errorsmod.Wrapf(types.ErrDenomNotAllowed, "base denomination %s is not allowed on channel %s/%s", baseDenom, portID, channelID)
+	}
+
+	return nil
+}
+
+// checkReceiveAllowed returns an error if the denomination filters of the params do not allow the
+// token with the given full denomination path, as sent in the packet, to be received over the channel.
+func checkReceiveAllowed(params types.Params, portID, channelID, fullDenomPath string) error {
+	baseDenom := types.ExtractDenomFromPath(fullDenomPath).Base
+	if params.IsReceiveBlocked(baseDenom) {
+		return errorsmod.Wrapf(types.ErrDenomNotAllowed, "base denomination %s cannot be received", baseDenom)
+	}
+
+	if filter, found := params.GetChannelDenomFilter(portID, channelID); found && !filter.IsDenomAllowed(baseDenom) {
+		return errorsmod.Wrapf(types.ErrDenomNotAllowed, "base denomination %s is not allowed on channel %s/%s", baseDenom, portID, channelID)
+	}
+
+	return nil
 }
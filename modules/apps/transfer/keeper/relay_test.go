@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -70,6 +71,53 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.Require().True(ok)
 			}, true,
 		},
+		{
+			"successful transfer with native token, send disabled on another channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelDenomFilters = []types.ChannelDenomFilter{
+					types.NewChannelDenomFilter(path.EndpointA.ChannelConfig.PortID, "channel-1", nil, []string{sdk.DefaultBondDenom}),
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true,
+		},
+		{
+			"successful transfer with IBC token, base denom allowed on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelDenomFilters = []types.ChannelDenomFilter{
+					types.NewChannelDenomFilter(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []string{sdk.DefaultBondDenom}, nil),
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				// send IBC token back to chainB
+				coin = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom, coin.Amount)
+			}, true,
+		},
+		{
+			"failure: native token send disabled on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelDenomFilters = []types.ChannelDenomFilter{
+					types.NewChannelDenomFilter(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nil, []string{sdk.DefaultBondDenom}),
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			}, false,
+		},
+		{
+			"failure: base denom not allowed on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelDenomFilters = []types.ChannelDenomFilter{
+					types.NewChannelDenomFilter(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []string{"uatom"}, nil),
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				// send IBC token back to chainB
+				coin = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom, coin.Amount)
+			}, false,
+		},
 		{
 			"source channel not found",
 			func() {
@@ -304,6 +352,12 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				expEscrowAmount = sdkmath.NewInt(100)
 			}, true, false,
 		},
+		{
+			"success receive with receiver address in hex form",
+			func() {
+				receiver = types.HexAddressPrefix + hex.EncodeToString(suite.chainB.SenderAccount.GetAddress())
+			}, false, true,
+		},
 		{
 			"invalid receiver address",
 			func() {
@@ -312,6 +366,44 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}, true, false,
 		},
 
+		{
+			"success: base denom allowed on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelDenomFilters = []types.ChannelDenomFilter{
+					types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, []string{sdk.DefaultBondDenom}, nil),
+				}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false, true,
+		},
+		{
+			"failure: base denom blocked for receive",
+			func() {
+				params := types.DefaultParams()
+				params.BlockedReceiveDenoms = []string{sdk.DefaultBondDenom}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false, false,
+		},
+		{
+			"failure: base denom blocked for receive on source chain",
+			func() {
+				params := types.DefaultParams()
+				params.BlockedReceiveDenoms = []string{sdk.DefaultBondDenom}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true, false,
+		},
+		{
+			"failure: base denom not allowed on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelDenomFilters = []types.ChannelDenomFilter{
+					types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, []string{"uatom"}, nil),
+				}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false, false,
+		},
+
 		// onRecvPacket
 		// - coin from chain chainA
 		{
@@ -412,7 +504,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, memo)
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			// check total amount in escrow of received token denom on receiving chain
 			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
@@ -436,6 +528,151 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestDenomMetadataFromPacket tests that the bank metadata of the token on the sending chain
+// is sent in the packet data and used to set the metadata of the voucher on the receiving chain.
+func (suite *KeeperTestSuite) TestDenomMetadataFromPacket() {
+	var (
+		senderMetadata      banktypes.Metadata
+		expTokenMetadata    *types.TokenMetadata
+		expReceiverMetadata func(denom types.Denom) banktypes.Metadata
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: token metadata sent and set on receiving chain",
+			func() {
+				expTokenMetadata = types.NewTokenMetadata("Stake", "STK", 6, "stk")
+				expReceiverMetadata = func(denom types.Denom) banktypes.Metadata {
+					return banktypes.Metadata{
+						Description: fmt.Sprintf("IBC token from %s", denom.FullPath()),
+						DenomUnits: []*banktypes.DenomUnit{
+							{Denom: denom.IBCDenom(), Exponent: 0, Aliases: []string{sdk.DefaultBondDenom}},
+							{Denom: "stk", Exponent: 6},
+						},
+						Base:    denom.IBCDenom(),
+						Display: "stk",
+						Name:    "Stake",
+						Symbol:  "STK",
+					}
+				}
+			},
+		},
+		{
+			"success: display unit not in denomination units, no token metadata sent",
+			func() {
+				senderMetadata.Display = "atom"
+				expTokenMetadata = nil
+				expReceiverMetadata = types.DefaultDenomMetadata
+			},
+		},
+		{
+			"success: blank symbol, no token metadata sent",
+			func() {
+				senderMetadata.Symbol = ""
+				expTokenMetadata = nil
+				expReceiverMetadata = types.DefaultDenomMetadata
+			},
+		},
+		{
+			"success: display unit collides with voucher denomination, default metadata set",
+			func() {
+				voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-0")).IBCDenom()
+				senderMetadata.DenomUnits[1].Denom = voucherDenom
+				senderMetadata.Display = voucherDenom
+				expTokenMetadata = types.NewTokenMetadata("Stake", "STK", 6, voucherDenom)
+				expReceiverMetadata = types.DefaultDenomMetadata
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.V2
+			path.EndpointB.ChannelConfig.Version = types.V2
+			suite.coordinator.Setup(path)
+
+			senderMetadata = banktypes.Metadata{
+				Description: "The native staking token",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: sdk.DefaultBondDenom, Exponent: 0},
+					{Denom: "stk", Exponent: 6},
+				},
+				Base:    sdk.DefaultBondDenom,
+				Display: "stk",
+				Name:    "Stake",
+				Symbol:  "STK",
+			}
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), senderMetadata)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			data, err := types.UnmarshalPacketData(packet.GetData(), types.V2)
+			suite.Require().NoError(err)
+			suite.Require().Len(data.Tokens, 1)
+			suite.Require().Equal(expTokenMetadata, data.Tokens[0].Metadata)
+
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denom.IBCDenom())
+			suite.Require().True(found)
+			suite.Require().Equal(expReceiverMetadata(denom), metadata)
+		})
+	}
+}
+
+// TestDenomMetadataNotOverwritten tests that the metadata of a voucher is only set on first receipt.
+func (suite *KeeperTestSuite) TestDenomMetadataNotOverwritten() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(path)
+
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	existingMetadata := types.NewTokenMetadata("Existing", "EXT", 0, "").ToBankMetadata(denom)
+	suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), existingMetadata)
+
+	suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: sdk.DefaultBondDenom, Exponent: 0}},
+		Base:       sdk.DefaultBondDenom,
+		Display:    sdk.DefaultBondDenom,
+		Name:       "Stake",
+		Symbol:     "STK",
+	})
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denom.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(existingMetadata, metadata)
+}
+
 func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCToken() {
 	/*
 		Given the following flow of tokens:
@@ -505,6 +742,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 		BaseDenom: sdk.DefaultBondDenom,
 		Path:      fmt.Sprintf("%s/%s", path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID),
 	}
+	suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), denomTrace.ToDenom())
 	escrowAddress := types.GetEscrowAddress(path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID)
 	coin := sdk.NewCoin(denomTrace.IBCDenom(), amount)
 	suite.Require().NoError(
@@ -521,7 +759,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
 	// execute onRecvPacket, when chaninB receives the source token the escrow amount should decrease
-	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on reveiving chain
@@ -588,6 +826,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			func() {
 				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 				trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), trace.ToDenom())
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrow, sdk.NewCoins(coin)))
@@ -611,7 +850,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data), tc.ack)
 
 			// check total amount in escrow of sent token denom on sending chain
 			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
@@ -681,6 +920,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 		BaseDenom: sdk.DefaultBondDenom,
 		Path:      fmt.Sprintf("%s/%s", path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID),
 	}
+	suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), denomTrace.ToDenom())
 	escrowAddress := types.GetEscrowAddress(path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID)
 	coin := sdk.NewCoin(denomTrace.IBCDenom(), amount)
 	suite.Require().NoError(
@@ -712,7 +952,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
-	err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, data, ack)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data), ack)
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on sending chain
@@ -757,6 +997,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			func() {
 				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 				trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), trace.ToDenom())
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 				expEscrowAmount = sdkmath.ZeroInt()
 
@@ -812,7 +1053,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
@@ -875,6 +1116,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 		BaseDenom: sdk.DefaultBondDenom,
 		Path:      fmt.Sprintf("%s/%s", path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID),
 	}
+	suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), denomTrace.ToDenom())
 	escrowAddress := types.GetEscrowAddress(path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID)
 	coin := sdk.NewCoin(denomTrace.IBCDenom(), amount)
 	suite.Require().NoError(
@@ -906,10 +1148,118 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
-	err := suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, data)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on sending chain
 	totalEscrowChainB = suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.ZeroInt(), totalEscrowChainB.Amount)
 }
+
+// TestRefundAndUnescrowBaseDenomWithSlashes tests that the denomination of refunded and unescrowed
+// tokens is resolved from the stored hops when the base denomination itself resembles a trace.
+func (suite *KeeperTestSuite) TestRefundAndUnescrowBaseDenomWithSlashes() {
+	var (
+		path   *ibctesting.Path
+		amount sdkmath.Int
+	)
+
+	// the base denomination cannot be told apart from a trace by parsing the full denomination path
+	baseDenom := "factory/channel-7/utoken"
+
+	// transfer sends the coin over the path from the sender of the given endpoint and returns the packet
+	transfer := func(endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver string) (channeltypes.Packet, types.FungibleTokenPacketDataV2) {
+		msg := types.NewMsgTransfer(
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+			endpoint.Chain.SenderAccount.GetAddress().String(), receiver,
+			endpoint.Chain.GetTimeoutHeight(), 0, "",
+		)
+
+		res, err := endpoint.Chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+
+		var data types.FungibleTokenPacketDataV2
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+		return packet, data
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func() (sdk.Coin, error)
+	}{
+		{
+			"native token refunded on error acknowledgement",
+			func() (sdk.Coin, error) {
+				coin := sdk.NewCoin(baseDenom, amount)
+				packet, data := transfer(path.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String())
+				suite.Require().Equal(baseDenom, data.Tokens[0].Denom)
+
+				err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed packet transfer")))
+				return suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), baseDenom), err
+			},
+		},
+		{
+			"native token refunded on timeout",
+			func() (sdk.Coin, error) {
+				coin := sdk.NewCoin(baseDenom, amount)
+				packet, data := transfer(path.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String())
+
+				err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+				return suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), baseDenom), err
+			},
+		},
+		{
+			"native token unescrowed when returned to its source",
+			func() (sdk.Coin, error) {
+				coin := sdk.NewCoin(baseDenom, amount)
+				packet, _ := transfer(path.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String())
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				voucher := types.NewDenom(baseDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				packet, data := transfer(path.EndpointB, sdk.NewCoin(voucher.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().Equal(voucher.FullPath(), data.Tokens[0].Denom)
+
+				err := path.RelayPacket(packet)
+				return suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), baseDenom), err
+			},
+		},
+		{
+			"voucher refunded on error acknowledgement",
+			func() (sdk.Coin, error) {
+				coin := sdk.NewCoin(baseDenom, amount)
+				packet, _ := transfer(path.EndpointA, coin, suite.chainB.SenderAccount.GetAddress().String())
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				voucher := types.NewDenom(baseDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				packet, data := transfer(path.EndpointB, sdk.NewCoin(voucher.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String())
+
+				err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, data, channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed packet transfer")))
+				return suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.IBCDenom()), err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.V2
+			path.EndpointB.ChannelConfig.Version = types.V2
+			suite.coordinator.Setup(path)
+			amount = sdkmath.NewInt(100)
+
+			suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(baseDenom, amount))))
+
+			balance, err := tc.malleate()
+			suite.Require().NoError(err)
+			suite.Require().Equal(amount, balance.Amount)
+		})
+	}
+}
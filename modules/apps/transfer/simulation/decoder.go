@@ -12,10 +12,11 @@ import (
 // TransferUnmarshaler defines the expected encoding store functions.
 type TransferUnmarshaler interface {
 	MustUnmarshalDenomTrace([]byte) types.DenomTrace
+	MustUnmarshalDenom([]byte) types.Denom
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding DenomTrace type.
+// Value to the corresponding DenomTrace or Denom type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -27,6 +28,11 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.DenomKey):
+			denomA := cdc.MustUnmarshalDenom(kvA.Value)
+			denomB := cdc.MustUnmarshalDenom(kvB.Value)
+			return fmt.Sprintf("Denom A: %s\nDenom B: %s", denomA.IBCDenom(), denomB.IBCDenom())
+
 		default:
 			panic(fmt.Errorf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
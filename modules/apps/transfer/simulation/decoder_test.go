@@ -32,6 +32,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.DenomKey,
+				Value: app.TransferKeeper.MustMarshalDenom(trace.ToDenom()),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -44,6 +48,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"Denom", fmt.Sprintf("Denom A: %s\nDenom B: %s", trace.IBCDenom(), trace.IBCDenom())},
 		{"other", ""},
 	}
 
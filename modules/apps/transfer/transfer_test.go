@@ -143,6 +143,86 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// Constructs the following sends over ics20-2 channels
+// 1 - native token from chainA to chainB
+// 2 - voucher received from chainA together with the native token of chainB from chainB to chainA in a single packet
+func (suite *TransferTestSuite) TestHandleMsgTransferMultiDenom() {
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathAtoB)
+
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	amount := sdkmath.NewInt(100)
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransfer(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	var packetData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Len(packetData.Tokens, 1)
+
+	err = pathAtoB.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	coinSentFromAToB := types.GetTransferCoin(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), coinSentFromAToB.Denom)
+	suite.Require().Equal(coinSentFromAToB, balance)
+
+	// send the voucher back to chainA together with the native token of chainB
+	nativeCoinB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	msg = types.NewMsgTransfer(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.Coin{}, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	msg.Tokens = sdk.NewCoins(coinSentFromAToB, nativeCoinB)
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Len(packetData.Tokens, 2)
+
+	err = pathAtoB.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the native token of chainA was unescrowed
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+
+	escrowAddress := types.GetEscrowAddress(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID)
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Zero(balance.Amount.Int64())
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom).IsZero())
+
+	// check that a voucher for the native token of chainB was minted
+	coinSentFromBToA := types.GetTransferCoin(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.DefaultBondDenom, amount)
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coinSentFromBToA.Denom)
+	suite.Require().Equal(coinSentFromBToA, balance)
+
+	// check that the voucher was burned on chainB
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), coinSentFromAToB.Denom)
+	suite.Require().Zero(balance.Amount.Int64())
+}
+
+// TestHandleMsgTransferMultiDenomV1Channel asserts that multiple tokens cannot be sent over ics20-1 channels.
+func (suite *TransferTestSuite) TestHandleMsgTransferMultiDenomV1Channel() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(100)))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "")
+	msg.Tokens = coins
+
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().ErrorContains(err, types.ErrInvalidVersion.Error())
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(TransferTestSuite))
 }
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// HexAddressPrefix is the prefix of account addresses given in hexadecimal form.
+const HexAddressPrefix = "0x"
+
+var _ address.Codec = (*ReceiverAddressCodec)(nil)
+
+// ReceiverAddressCodec is the default address codec of the transfer module. It decodes
+// account addresses given either in bech32 form or in the 0x-prefixed hexadecimal form
+// used by EVM chains, and encodes addresses in bech32 form.
+type ReceiverAddressCodec struct {
+	bech32Prefix string
+}
+
+// NewReceiverAddressCodec returns a ReceiverAddressCodec for the given bech32 prefix. If the
+// prefix is empty, bech32 addresses with any prefix are decoded and addresses are encoded using
+// the account address prefix of the sdk config.
+func NewReceiverAddressCodec(bech32Prefix string) ReceiverAddressCodec {
+	return ReceiverAddressCodec{bech32Prefix: bech32Prefix}
+}
+
+// StringToBytes decodes a bech32 or 0x-prefixed hexadecimal address to its bytes.
+func (c ReceiverAddressCodec) StringToBytes(text string) ([]byte, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "empty address string is not allowed")
+	}
+
+	if IsHexAddress(text) {
+		bz, err := hex.DecodeString(text[len(HexAddressPrefix):])
+		if err != nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid hex address %s: %v", text, err)
+		}
+
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return nil, err
+		}
+
+		return bz, nil
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(text)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid bech32 address %s: %v", text, err)
+	}
+
+	if c.bech32Prefix != "" && hrp != c.bech32Prefix {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid bech32 prefix; expected %s, got %s", c.bech32Prefix, hrp)
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// BytesToString encodes the address bytes in bech32 form.
+func (c ReceiverAddressCodec) BytesToString(bz []byte) (string, error) {
+	if len(bz) == 0 {
+		return "", nil
+	}
+
+	prefix := c.bech32Prefix
+	if prefix == "" {
+		prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+	}
+
+	return bech32.ConvertAndEncode(prefix, bz)
+}
+
+// IsHexAddress returns true if the address is given in 0x-prefixed hexadecimal form.
+func IsHexAddress(addr string) bool {
+	return len(addr) >= len(HexAddressPrefix) && strings.EqualFold(addr[:len(HexAddressPrefix)], HexAddressPrefix)
+}
+
+// defaultAddressCodec is used by stateless validation, which has no access to the keeper's
+// address codec. It decodes bech32 addresses of any chain, as the addresses of transfer
+// receivers belong to the counterparty chain.
+var defaultAddressCodec = NewReceiverAddressCodec("")
+
+// validateReceiver validates the format of a receiver address given in hexadecimal form.
+// Receivers in any other form are not validated, as the address format of the counterparty
+// chain is not known.
+func validateReceiver(receiver string) error {
+	if !IsHexAddress(receiver) {
+		return nil
+	}
+
+	if _, err := defaultAddressCodec.StringToBytes(receiver); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid recipient address: %v", err)
+	}
+
+	return nil
+}
+
+// receiversEqual returns true if both receivers are equal or decode to the same address bytes,
+// e.g. the bech32 and hexadecimal forms of the same account.
+func receiversEqual(receiver, other string) bool {
+	if receiver == other {
+		return true
+	}
+
+	receiverBz, err := defaultAddressCodec.StringToBytes(receiver)
+	if err != nil {
+		return false
+	}
+
+	otherBz, err := defaultAddressCodec.StringToBytes(other)
+	if err != nil {
+		return false
+	}
+
+	return sdk.AccAddress(receiverBz).Equals(sdk.AccAddress(otherBz))
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestReceiverAddressCodec(t *testing.T) {
+	addr := sdk.MustAccAddressFromBech32(ibctesting.TestAccAddress)
+	hexAddr := types.HexAddressPrefix + hex.EncodeToString(addr)
+
+	testCases := []struct {
+		name         string
+		bech32Prefix string
+		address      string
+		expPass      bool
+	}{
+		{"bech32 address", "cosmos", ibctesting.TestAccAddress, true},
+		{"hex address", "cosmos", hexAddr, true},
+		{"upper case hex address", "cosmos", strings.ToUpper(hexAddr), true},
+		{"bech32 address with any prefix", "", ibctesting.TestAccAddress, true},
+		{"bech32 address with different prefix", "osmo", ibctesting.TestAccAddress, false},
+		{"empty address", "cosmos", "", false},
+		{"empty hex address", "cosmos", types.HexAddressPrefix, false},
+		{"invalid hex address", "cosmos", "0xnothex", false},
+		{"odd length hex address", "cosmos", hexAddr[:len(hexAddr)-1], false},
+		{"invalid bech32 address", "cosmos", ibctesting.InvalidID, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			codec := types.NewReceiverAddressCodec(tc.bech32Prefix)
+
+			bz, err := codec.StringToBytes(tc.address)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, []byte(addr), bz)
+
+				encoded, err := codec.BytesToString(bz)
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.TestAccAddress, encoded)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
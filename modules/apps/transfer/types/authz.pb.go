@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,11 +39,24 @@ type Allocation struct {
 	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
 	// spend limitation on the channel
 	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
-	// allow list of receivers, an empty allow list permits any receiver address
+	// allow list of receivers, an empty allow list permits any receiver address.
+	// This field is superseded by allowed_receivers and may not be combined with it,
+	// existing grants are migrated by MigrateTransferAuthorizations.
 	AllowList []string `protobuf:"bytes,4,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
 	// allow list of memo strings, an empty list prohibits all memo strings;
 	// a list only with "*" permits any memo string
 	AllowedPacketData []string `protobuf:"bytes,5,rep,name=allowed_packet_data,json=allowedPacketData,proto3" json:"allowed_packet_data,omitempty"`
+	// allow list of receiver patterns, an empty list permits any receiver address.
+	// A pattern matches the receiver exactly, unless it contains the "*" wildcard
+	// which matches any sequence of characters (e.g. "cosmos1*" or "*abc").
+	AllowedReceivers []string `protobuf:"bytes,6,rep,name=allowed_receivers,json=allowedReceivers,proto3" json:"allowed_receivers,omitempty"`
+	// optional spend limit which is reset every period
+	PeriodicSpendLimit *PeriodicSpendLimit `protobuf:"bytes,7,opt,name=periodic_spend_limit,json=periodicSpendLimit,proto3" json:"periodic_spend_limit,omitempty"`
+	// time windows in which the allocation may be used, an empty list permits any time
+	AllowedTimeWindows []TimeWindow `protobuf:"bytes,8,rep,name=allowed_time_windows,json=allowedTimeWindows,proto3" json:"allowed_time_windows"`
+	// maximum duration between the block time and the packet timeout timestamp.
+	// Transfers without a timeout timestamp are rejected if set. The limit is disabled when set to 0.
+	MaxTimeout time.Duration `protobuf:"bytes,9,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout"`
 }
 
 func (m *Allocation) Reset()         { *m = Allocation{} }
@@ -109,6 +127,162 @@ func (m *Allocation) GetAllowedPacketData() []string {
 	return nil
 }
 
+func (m *Allocation) GetAllowedReceivers() []string {
+	if m != nil {
+		return m.AllowedReceivers
+	}
+	return nil
+}
+
+func (m *Allocation) GetPeriodicSpendLimit() *PeriodicSpendLimit {
+	if m != nil {
+		return m.PeriodicSpendLimit
+	}
+	return nil
+}
+
+func (m *Allocation) GetAllowedTimeWindows() []TimeWindow {
+	if m != nil {
+		return m.AllowedTimeWindows
+	}
+	return nil
+}
+
+func (m *Allocation) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
+	}
+	return 0
+}
+
+// PeriodicSpendLimit defines a spend limit which is reset at the end of every period.
+// Denominations which are not part of the period spend limit are not limited per period.
+type PeriodicSpendLimit struct {
+	// duration of a period, e.g. 24h for a daily spend limit
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// amount which may be spent in every period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// amount left to be spent in the current period, tracked by the grant
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// time at which the current period ends, tracked by the grant. The first period
+	// starts with the first transfer if left empty.
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicSpendLimit) Reset()         { *m = PeriodicSpendLimit{} }
+func (m *PeriodicSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSpendLimit) ProtoMessage()    {}
+func (*PeriodicSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{1}
+}
+func (m *PeriodicSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSpendLimit.Merge(m, src)
+}
+func (m *PeriodicSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSpendLimit proto.InternalMessageInfo
+
+func (m *PeriodicSpendLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicSpendLimit) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSpendLimit) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicSpendLimit) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+// TimeWindow defines a time interval, starting at start_time (inclusive) and ending at end_time (exclusive).
+type TimeWindow struct {
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{2}
+}
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(m, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
+func (m *TimeWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *TimeWindow) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // TransferAuthorization allows the grantee to spend up to spend_limit coins from
 // the granter's account for ibc transfer on a specific channel
 type TransferAuthorization struct {
@@ -120,7 +294,7 @@ func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
 func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TransferAuthorization) ProtoMessage()    {}
 func (*TransferAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{1}
+	return fileDescriptor_b1a28b55d17325aa, []int{3}
 }
 func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,6 +332,8 @@ func (m *TransferAuthorization) GetAllocations() []Allocation {
 
 func init() {
 	proto.RegisterType((*Allocation)(nil), "ibc.applications.transfer.v1.Allocation")
+	proto.RegisterType((*PeriodicSpendLimit)(nil), "ibc.applications.transfer.v1.PeriodicSpendLimit")
+	proto.RegisterType((*TimeWindow)(nil), "ibc.applications.transfer.v1.TimeWindow")
 	proto.RegisterType((*TransferAuthorization)(nil), "ibc.applications.transfer.v1.TransferAuthorization")
 }
 
@@ -166,35 +342,52 @@ func init() {
 }
 
 var fileDescriptor_b1a28b55d17325aa = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x9b, 0xed, 0x82, 0x54, 0x57, 0x20, 0x11, 0x40, 0xca, 0xae, 0x20, 0xad, 0x2a, 0x81,
-	0x72, 0xa9, 0x4d, 0xe1, 0x00, 0xe2, 0xb6, 0x5d, 0x8e, 0x7b, 0x28, 0x15, 0x27, 0x2e, 0x91, 0xe3,
-	0x98, 0xd6, 0x5a, 0x27, 0x13, 0xc5, 0x93, 0x20, 0xf6, 0x29, 0xd8, 0xd7, 0xe0, 0xcc, 0x43, 0xac,
-	0x38, 0xed, 0x91, 0x13, 0xa0, 0xf6, 0x45, 0x50, 0x6c, 0x17, 0x8a, 0x90, 0xf6, 0x94, 0xf8, 0x9b,
-	0xcf, 0xf3, 0xe7, 0xe7, 0x21, 0x89, 0xca, 0x04, 0xe3, 0x55, 0xa5, 0x95, 0xe0, 0xa8, 0xa0, 0x34,
-	0x0c, 0x6b, 0x5e, 0x9a, 0x0f, 0xb2, 0x66, 0xed, 0x8c, 0xf1, 0x06, 0xd7, 0x17, 0xb4, 0xaa, 0x01,
-	0x21, 0x7c, 0xa4, 0x32, 0x41, 0xf7, 0x9d, 0x74, 0xe7, 0xa4, 0xed, 0xec, 0xf8, 0x48, 0x80, 0x29,
-	0xc0, 0xa4, 0xd6, 0xcb, 0xdc, 0xc1, 0x5d, 0x3c, 0x7e, 0xb0, 0x82, 0x15, 0x38, 0xbd, 0xfb, 0xf3,
-	0x6a, 0xec, 0x3c, 0x2c, 0xe3, 0x46, 0xb2, 0x76, 0x96, 0x49, 0xe4, 0x33, 0x26, 0x40, 0x95, 0x2e,
-	0x3e, 0xb9, 0x3c, 0x20, 0xe4, 0x44, 0x6b, 0x70, 0xc5, 0xc2, 0x11, 0x19, 0x1a, 0x68, 0x6a, 0x21,
-	0xd3, 0x0a, 0x6a, 0x8c, 0x82, 0x71, 0x90, 0x0c, 0x96, 0xc4, 0x49, 0x0b, 0xa8, 0x31, 0x7c, 0x42,
-	0xee, 0x7a, 0x83, 0x58, 0xf3, 0xb2, 0x94, 0x3a, 0x3a, 0xb0, 0x9e, 0x3b, 0x4e, 0x3d, 0x75, 0x62,
-	0xa8, 0xc9, 0xd0, 0x54, 0xb2, 0xcc, 0x53, 0xad, 0x0a, 0x85, 0x51, 0x7f, 0xdc, 0x4f, 0x86, 0xcf,
-	0x8f, 0xa8, 0x6f, 0xb8, 0x6b, 0x86, 0xfa, 0x66, 0xe8, 0x29, 0xa8, 0x72, 0xfe, 0xec, 0xea, 0xc7,
-	0xa8, 0xf7, 0xe5, 0xe7, 0x28, 0x59, 0x29, 0x5c, 0x37, 0x19, 0x15, 0x50, 0xf8, 0xe9, 0xfc, 0x67,
-	0x6a, 0xf2, 0x73, 0x86, 0x9f, 0x2a, 0x69, 0xec, 0x05, 0xb3, 0x24, 0x36, 0xff, 0x59, 0x97, 0x3e,
-	0x7c, 0x4c, 0x08, 0xd7, 0x1a, 0x3e, 0xa6, 0x5a, 0x19, 0x8c, 0x0e, 0xc7, 0xfd, 0x64, 0xb0, 0x1c,
-	0x58, 0xe5, 0x4c, 0x19, 0x0c, 0x29, 0xb9, 0x6f, 0x0f, 0x32, 0x4f, 0x2b, 0x2e, 0xce, 0x25, 0xa6,
-	0x39, 0x47, 0x1e, 0xdd, 0xb2, 0xbe, 0x7b, 0x3e, 0xb4, 0xb0, 0x91, 0x37, 0x1c, 0xf9, 0xe4, 0x32,
-	0x20, 0x0f, 0xdf, 0x79, 0xe8, 0x27, 0x0d, 0xae, 0xa1, 0x56, 0x17, 0x0e, 0xcf, 0x82, 0x0c, 0xf9,
-	0x1f, 0x58, 0x26, 0x0a, 0xec, 0x58, 0x09, 0xbd, 0xe9, 0xc9, 0xe8, 0x5f, 0xba, 0xf3, 0xc3, 0x6e,
-	0xca, 0xe5, 0x7e, 0x8a, 0xd7, 0x4f, 0xbf, 0x7d, 0x9d, 0x4e, 0x3c, 0x16, 0xb7, 0x06, 0x3b, 0x2e,
-	0xff, 0x54, 0x9e, 0xbf, 0xbd, 0xda, 0xc4, 0xc1, 0xf5, 0x26, 0x0e, 0x7e, 0x6d, 0xe2, 0xe0, 0xf3,
-	0x36, 0xee, 0x5d, 0x6f, 0xe3, 0xde, 0xf7, 0x6d, 0xdc, 0x7b, 0xff, 0xf2, 0x7f, 0x64, 0x2a, 0x13,
-	0xd3, 0x15, 0xb0, 0xf6, 0x15, 0x2b, 0x20, 0x6f, 0xb4, 0x34, 0xdd, 0xea, 0xed, 0xad, 0x9c, 0xe5,
-	0x98, 0xdd, 0xb6, 0x1b, 0xf0, 0xe2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xb7, 0x0f, 0x97,
-	0x9c, 0x02, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x09, 0x17, 0xc8, 0xc9, 0xbd, 0x88, 0x3b, 0xa5, 0x92, 0x41, 0x6d, 0x12, 0x45, 0x6a,
+	0x65, 0xa9, 0xc2, 0x26, 0x74, 0xd1, 0xaa, 0x5d, 0x54, 0x24, 0x48, 0xdd, 0xb0, 0x48, 0xdd, 0x48,
+	0x95, 0xba, 0x71, 0xc7, 0xf6, 0x90, 0x8c, 0xb0, 0x3d, 0x96, 0x67, 0x1c, 0x7e, 0x9e, 0xa1, 0x0b,
+	0xda, 0x55, 0x9f, 0xa1, 0xeb, 0x3e, 0x04, 0x62, 0xc5, 0xb2, 0xab, 0x52, 0xc1, 0x8b, 0x54, 0x9e,
+	0x19, 0x43, 0x68, 0x24, 0x04, 0x52, 0xbb, 0x82, 0xf9, 0xce, 0x39, 0xdf, 0x99, 0xf3, 0x9d, 0x2f,
+	0x63, 0xb0, 0xa8, 0x1f, 0x38, 0x38, 0x4d, 0x23, 0x1a, 0x60, 0x41, 0x59, 0xc2, 0x1d, 0x91, 0xe1,
+	0x84, 0xef, 0x90, 0xcc, 0x19, 0x77, 0x1c, 0x9c, 0x8b, 0xd1, 0xa1, 0x9d, 0x66, 0x4c, 0x30, 0xf4,
+	0x80, 0xfa, 0x81, 0x3d, 0x99, 0x69, 0x97, 0x99, 0xf6, 0xb8, 0xb3, 0xba, 0x12, 0x30, 0x1e, 0x33,
+	0xee, 0xc9, 0x5c, 0x47, 0x1d, 0x54, 0xe1, 0xea, 0xf2, 0x90, 0x0d, 0x99, 0xc2, 0x8b, 0xff, 0x34,
+	0xda, 0x50, 0x39, 0x8e, 0x8f, 0x39, 0x71, 0xc6, 0x1d, 0x9f, 0x08, 0xdc, 0x71, 0x02, 0x46, 0x93,
+	0x32, 0x3e, 0x64, 0x6c, 0x18, 0x11, 0x47, 0x9e, 0xfc, 0x7c, 0xc7, 0x09, 0xf3, 0x4c, 0xf6, 0xd5,
+	0xf1, 0xe6, 0xef, 0x71, 0x41, 0x63, 0xc2, 0x05, 0x8e, 0x53, 0x95, 0xd0, 0x3e, 0x99, 0x05, 0xd8,
+	0x8c, 0x22, 0xa6, 0x6e, 0x8b, 0x9a, 0x50, 0xe7, 0x2c, 0xcf, 0x02, 0xe2, 0xa5, 0x2c, 0x13, 0xa6,
+	0xd1, 0x32, 0xac, 0x9a, 0x0b, 0x0a, 0xea, 0xb3, 0x4c, 0xa0, 0x47, 0xb0, 0xa8, 0x13, 0x82, 0x11,
+	0x4e, 0x12, 0x12, 0x99, 0x33, 0x32, 0xe7, 0x3f, 0x85, 0xf6, 0x14, 0x88, 0x22, 0xa8, 0xf3, 0x94,
+	0x24, 0xa1, 0x17, 0xd1, 0x98, 0x0a, 0xb3, 0xda, 0xaa, 0x5a, 0xf5, 0x8d, 0x15, 0x5b, 0x4f, 0x5c,
+	0x4c, 0x63, 0xeb, 0x69, 0xec, 0x1e, 0xa3, 0x49, 0x77, 0xfd, 0xf8, 0x47, 0xb3, 0xf2, 0xf5, 0xac,
+	0x69, 0x0d, 0xa9, 0x18, 0xe5, 0xbe, 0x1d, 0xb0, 0x58, 0xcb, 0xa3, 0xff, 0xac, 0xf1, 0x70, 0xd7,
+	0x11, 0x07, 0x29, 0xe1, 0xb2, 0x80, 0xbb, 0x20, 0xf9, 0xb7, 0x0b, 0x7a, 0xf4, 0x10, 0x00, 0x47,
+	0x11, 0xdb, 0xf3, 0x22, 0xca, 0x85, 0x39, 0xdb, 0xaa, 0x5a, 0x35, 0xb7, 0x26, 0x91, 0x6d, 0xca,
+	0x05, 0xb2, 0xe1, 0x9e, 0x3c, 0x90, 0xd0, 0x4b, 0x71, 0xb0, 0x4b, 0x84, 0x17, 0x62, 0x81, 0xcd,
+	0x7f, 0x64, 0xde, 0xff, 0x3a, 0xd4, 0x97, 0x91, 0x2d, 0x2c, 0x30, 0x7a, 0x02, 0x25, 0xe8, 0x65,
+	0x24, 0x20, 0x74, 0x4c, 0x32, 0x6e, 0xce, 0xc9, 0xec, 0x25, 0x1d, 0x70, 0x4b, 0x1c, 0xf9, 0xb0,
+	0x9c, 0x92, 0x8c, 0xb2, 0x90, 0x06, 0xde, 0xe4, 0xc8, 0xf3, 0x2d, 0xc3, 0xaa, 0x6f, 0xac, 0xdb,
+	0x37, 0xf9, 0xc1, 0xee, 0xeb, 0xca, 0xb7, 0x97, 0xb3, 0xb8, 0x28, 0x9d, 0xc2, 0xd0, 0x07, 0x58,
+	0x2e, 0x2f, 0x54, 0xec, 0xcf, 0xdb, 0xa3, 0x49, 0xc8, 0xf6, 0xb8, 0xb9, 0x20, 0x65, 0xb5, 0x6e,
+	0xee, 0x31, 0xa0, 0x31, 0x79, 0x27, 0x0b, 0xba, 0xb3, 0x85, 0xca, 0x2e, 0xd2, 0x5c, 0x57, 0x01,
+	0x8e, 0xb6, 0xa0, 0x1e, 0xe3, 0x7d, 0xc9, 0xce, 0x72, 0x61, 0xd6, 0xe4, 0xe5, 0x57, 0x6c, 0xe5,
+	0x1e, 0xbb, 0x74, 0x8f, 0xbd, 0xa5, 0xdd, 0xd5, 0x5d, 0x28, 0x98, 0xbe, 0x9c, 0x35, 0x0d, 0x17,
+	0x62, 0xbc, 0x3f, 0x50, 0x65, 0xed, 0x8f, 0x55, 0x40, 0xd3, 0x23, 0xa1, 0x97, 0x30, 0xa7, 0x86,
+	0x32, 0x8d, 0xdb, 0xf3, 0xea, 0x12, 0x74, 0x00, 0x5a, 0x91, 0x6b, 0xea, 0xce, 0xfc, 0x79, 0x43,
+	0x2d, 0xa9, 0x36, 0x13, 0xf7, 0xce, 0x41, 0x63, 0x5e, 0x80, 0x13, 0xd5, 0xfe, 0x6f, 0x38, 0x79,
+	0x51, 0x35, 0xe9, 0xe1, 0x44, 0xf6, 0x46, 0xaf, 0xe1, 0x5f, 0xdd, 0x36, 0x23, 0x9c, 0x14, 0x7e,
+	0x2e, 0x44, 0x5b, 0x9d, 0x12, 0x6d, 0x50, 0xfe, 0x94, 0x95, 0x6a, 0x47, 0x85, 0x6a, 0x75, 0x55,
+	0xe9, 0x16, 0x85, 0xed, 0xcf, 0x06, 0xc0, 0xd5, 0x92, 0x51, 0x0f, 0x80, 0x0b, 0x9c, 0x09, 0xb9,
+	0x65, 0xd3, 0xb8, 0x03, 0x6b, 0x4d, 0xd6, 0x15, 0x11, 0xf4, 0x0a, 0x16, 0x8a, 0x2d, 0x48, 0x8a,
+	0x99, 0x3b, 0x50, 0xcc, 0x93, 0x44, 0x1a, 0xae, 0xfd, 0xc9, 0x80, 0xfb, 0x03, 0x6d, 0xcf, 0xcd,
+	0x5c, 0x8c, 0x58, 0x46, 0x0f, 0xd5, 0xdb, 0xd3, 0x87, 0x3a, 0xbe, 0x7c, 0x89, 0xb8, 0x69, 0xdc,
+	0xc6, 0xdc, 0x57, 0x4f, 0x97, 0x36, 0xf7, 0x24, 0xc5, 0x8b, 0xc7, 0x27, 0xdf, 0xd6, 0xda, 0x7a,
+	0x53, 0xea, 0x91, 0x2e, 0x57, 0x75, 0xad, 0x73, 0xf7, 0xcd, 0xf1, 0x79, 0xc3, 0x38, 0x3d, 0x6f,
+	0x18, 0x3f, 0xcf, 0x1b, 0xc6, 0xd1, 0x45, 0xa3, 0x72, 0x7a, 0xd1, 0xa8, 0x7c, 0xbf, 0x68, 0x54,
+	0xde, 0x3f, 0x9b, 0xde, 0x22, 0xf5, 0x83, 0xb5, 0x21, 0x73, 0xc6, 0xcf, 0x9d, 0x98, 0x85, 0x79,
+	0x44, 0x78, 0xf1, 0x61, 0x98, 0xf8, 0x20, 0xc8, 0xd5, 0xfa, 0x73, 0x52, 0x8d, 0xa7, 0xbf, 0x06,
+	0x00, 0xe0, 0x88, 0xa4, 0x6c, 0x3a, 0x06, 0x00, 0x00,
 }
 
 func (m *Allocation) Marshal() (dAtA []byte, err error) {
@@ -217,6 +410,49 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.AllowedTimeWindows) > 0 {
+		for iNdEx := len(m.AllowedTimeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedTimeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PeriodicSpendLimit != nil {
+		{
+			size, err := m.PeriodicSpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AllowedReceivers) > 0 {
+		for iNdEx := len(m.AllowedReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedReceivers[iNdEx])
+			copy(dAtA[i:], m.AllowedReceivers[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.AllowedPacketData) > 0 {
 		for iNdEx := len(m.AllowedPacketData) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedPacketData[iNdEx])
@@ -266,6 +502,112 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuthz(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuthz(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TransferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -346,6 +688,62 @@ func (m *Allocation) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if len(m.AllowedReceivers) > 0 {
+		for _, s := range m.AllowedReceivers {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.PeriodicSpendLimit != nil {
+		l = m.PeriodicSpendLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedTimeWindows) > 0 {
+		for _, e := range m.AllowedTimeWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *PeriodicSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *TimeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -561,6 +959,441 @@ func (m *Allocation) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedPacketData = append(m.AllowedPacketData, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedReceivers = append(m.AllowedReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodicSpendLimit == nil {
+				m.PeriodicSpendLimit = &PeriodicSpendLimit{}
+			}
+			if err := m.PeriodicSpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTimeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTimeWindows = append(m.AllowedTimeWindows, TimeWindow{})
+			if err := m.AllowedTimeWindows[len(m.AllowedTimeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
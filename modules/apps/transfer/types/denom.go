@@ -0,0 +1,149 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+// NewDenom creates a new Denom instance given the base denomination and a variable number of hops.
+// The hops must be ordered from the most recent hop to the first hop the token travelled through.
+func NewDenom(base string, trace ...Hop) Denom {
+	return Denom{
+		Base:  base,
+		Trace: trace,
+	}
+}
+
+// ExtractDenomFromPath returns the denom from the full path. The path is split into
+// port ID, channel ID pairs for as long as the channel identifiers follow the format
+// specified by ibc-go, the remaining elements form the base denomination.
+//
+// Examples:
+//
+// - "transfer/channel-0/uatom" => Denom{Base: "uatom", Trace: [transfer/channel-0]}
+// - "transfer/channel-0/gamm/pool/1" => Denom{Base: "gamm/pool/1", Trace: [transfer/channel-0]}
+// - "gamm/pool/1" => Denom{Base: "gamm/pool/1"}
+func ExtractDenomFromPath(fullPath string) Denom {
+	denomSplit := strings.Split(fullPath, "/")
+	if denomSplit[0] == fullPath {
+		return NewDenom(fullPath)
+	}
+
+	path, base := extractPathAndBaseFromFullDenom(denomSplit)
+	return DenomTrace{Path: path, BaseDenom: base}.ToDenom()
+}
+
+// Validate performs a basic validation of the Denom fields.
+func (d Denom) Validate() error {
+	if strings.TrimSpace(d.Base) == "" {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, "base denomination cannot be blank")
+	}
+
+	for _, hop := range d.Trace {
+		if err := hop.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "invalid trace: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Path returns the trace path of the denomination, composed of the port ID, channel ID
+// pairs of each hop joined by slashes. If there exists no trace then an empty string is returned.
+func (d Denom) Path() string {
+	hops := make([]string, len(d.Trace))
+	for i, hop := range d.Trace {
+		hops[i] = hop.String()
+	}
+
+	return strings.Join(hops, "/")
+}
+
+// FullPath returns the full denomination according to the ICS20 specification:
+// tracePath + "/" + baseDenom
+// If there exists no trace then the base denomination is returned.
+func (d Denom) FullPath() string {
+	if d.IsNative() {
+		return d.Base
+	}
+
+	return d.Path() + "/" + d.Base
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the full path of the denomination.
+// The hash is equal to the hash of the DenomTrace with the same full path.
+func (d Denom) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(d.FullPath()))
+	return hash[:]
+}
+
+// IBCDenom a coin denomination for an ICS20 fungible token in the format
+// 'ibc/{hash(tracePath + baseDenom)}'. If the trace is empty, it will return the base denomination.
+func (d Denom) IBCDenom() string {
+	if d.IsNative() {
+		return d.Base
+	}
+
+	return fmt.Sprintf("%s/%s", DenomPrefix, d.Hash())
+}
+
+// IsNative returns true if the denomination is native, thus containing no trace history.
+func (d Denom) IsNative() bool {
+	return len(d.Trace) == 0
+}
+
+// ToDenomTrace returns the DenomTrace representation of the denomination.
+func (d Denom) ToDenomTrace() DenomTrace {
+	return DenomTrace{
+		Path:      d.Path(),
+		BaseDenom: d.Base,
+	}
+}
+
+// ToDenom returns the Denom representation of the denomination trace.
+func (dt DenomTrace) ToDenom() Denom {
+	return NewDenom(dt.BaseDenom, dt.Hops()...)
+}
+
+// Denoms defines a wrapper type for a slice of Denom.
+type Denoms []Denom
+
+// Validate performs a basic validation of each denomination.
+func (d Denoms) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for i, denom := range d {
+		hash := denom.Hash().String()
+		if seenDenoms[hash] {
+			return fmt.Errorf("duplicated denomination with hash %s", denom.Hash())
+		}
+
+		if err := denom.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed denom %d validation", i)
+		}
+		seenDenoms[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = (*Denoms)(nil)
+
+// Len implements sort.Interface for Denoms
+func (d Denoms) Len() int { return len(d) }
+
+// Less implements sort.Interface for Denoms
+func (d Denoms) Less(i, j int) bool { return d[i].FullPath() < d[j].FullPath() }
+
+// Swap implements sort.Interface for Denoms
+func (d Denoms) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+
+// Sort is a helper function to sort the set of denominations in place
+func (d Denoms) Sort() Denoms {
+	sort.Sort(d)
+	return d
+}
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestExtractDenomFromPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fullPath string
+		expDenom types.Denom
+	}{
+		{"base denom", "uatom", types.NewDenom("uatom")},
+		{"base denom with single '/'s", "gamm/pool/1", types.NewDenom("gamm/pool/1")},
+		{"trace info", "transfer/channel-1/uatom", types.NewDenom("uatom", types.NewHop("transfer", "channel-1"))},
+		{"trace info with custom port", "customtransfer/channel-1/uatom", types.NewDenom("uatom", types.NewHop("customtransfer", "channel-1"))},
+		{"trace info with multiple '/'s in base denom", "transfer/channel-1/gamm/pool/1", types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channel-1"))},
+		{"trace info with multiple port/channel pairs", "transfer/channel-1/transfer/channel-2/uatom", types.NewDenom("uatom", types.NewHop("transfer", "channel-1"), types.NewHop("transfer", "channel-2"))},
+		{"incomplete path", "transfer/uatom", types.NewDenom("transfer/uatom")},
+		{"non-standard channel identifier", "transfer/channelToA/uatom", types.NewDenom("transfer/channelToA/uatom")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		denom := types.ExtractDenomFromPath(tc.fullPath)
+		require.Equal(t, tc.expDenom, denom, tc.name)
+		require.Equal(t, tc.fullPath, denom.FullPath(), tc.name)
+	}
+}
+
+func TestDenom_IBCDenom(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    types.Denom
+		expDenom string
+	}{
+		{"base denom", types.NewDenom("uatom"), "uatom"},
+		{"trace info", types.NewDenom("uatom", types.NewHop("transfer", "channel-1")), "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		require.Equal(t, tc.expDenom, tc.denom.IBCDenom(), tc.name)
+	}
+}
+
+func TestDenom_DenomTraceConversion(t *testing.T) {
+	traces := types.Traces{
+		{BaseDenom: "uatom"},
+		{BaseDenom: "gamm/pool/1", Path: "transfer/channel-1"},
+		{BaseDenom: "uatom", Path: "transfer/channel-1/customtransfer/channel-2"},
+	}
+
+	for _, trace := range traces {
+		denom := trace.ToDenom()
+		require.Equal(t, trace, denom.ToDenomTrace())
+		require.Equal(t, trace.Hash(), denom.Hash())
+		require.Equal(t, trace.IBCDenom(), denom.IBCDenom())
+		require.Equal(t, trace.GetFullDenomPath(), denom.FullPath())
+	}
+}
+
+func TestDenom_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    types.Denom
+		expError bool
+	}{
+		{"base denom only", types.NewDenom("uatom"), false},
+		{"base denom with slashes", types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channel-1")), false},
+		{"multiple hops", types.NewDenom("uatom", types.NewHop("transfer", "channel-1"), types.NewHop("transfer", "channel-2")), false},
+		{"empty base denom", types.NewDenom("", types.NewHop("transfer", "channel-1")), true},
+		{"blank base denom", types.NewDenom("   "), true},
+		{"invalid port ID", types.NewDenom("uatom", types.NewHop("", "channel-1")), true},
+		{"invalid channel ID", types.NewDenom("uatom", types.NewHop("transfer", "c")), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.denom.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestDenoms_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denoms   types.Denoms
+		expError bool
+	}{
+		{"empty denoms", types.Denoms{}, false},
+		{"valid multiple denoms", types.Denoms{types.NewDenom("uatom"), types.NewDenom("uatom", types.NewHop("transfer", "channel-1"))}, false},
+		{"duplicate denoms", types.Denoms{types.NewDenom("uatom", types.NewHop("transfer", "channel-1")), types.NewDenom("uatom", types.NewHop("transfer", "channel-1"))}, true},
+		{"invalid denom", types.Denoms{types.NewDenom("")}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.denoms.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEscrowAccount creates a new EscrowAccount instance
+func NewEscrowAccount(portID, channelID string, balances sdk.Coins) EscrowAccount {
+	return EscrowAccount{
+		PortId:    portID,
+		ChannelId: channelID,
+		Address:   GetEscrowAddress(portID, channelID).String(),
+		Balances:  balances,
+	}
+}
+
+// NewDenomEscrowReconciliation creates a new DenomEscrowReconciliation instance. The difference
+// is computed as the actual amount minus the tracked amount.
+func NewDenomEscrowReconciliation(denom string, trackedAmount, actualAmount sdkmath.Int) DenomEscrowReconciliation {
+	return DenomEscrowReconciliation{
+		Denom:         denom,
+		TrackedAmount: trackedAmount,
+		ActualAmount:  actualAmount,
+		Difference:    actualAmount.Sub(trackedAmount),
+	}
+}
+
+// IsReconciled returns true if the tracked amount is equal to the actual amount in escrow.
+func (d DenomEscrowReconciliation) IsReconciled() bool {
+	return d.Difference.IsZero()
+}
@@ -19,4 +19,5 @@ const (
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyForwardingHops = "forwarding_hops"
 )
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaximumNumberOfForwardingHops specifies the maximum number of hops which a packet may be forwarded through
+const MaximumNumberOfForwardingHops = 8
+
+// NewForwarding creates a new Forwarding instance given an unwind value and a variable number of hops.
+func NewForwarding(unwind bool, hops ...Hop) Forwarding {
+	return Forwarding{
+		Unwind: unwind,
+		Hops:   hops,
+	}
+}
+
+// Validate performs a basic validation of the Forwarding fields.
+func (f Forwarding) Validate() error {
+	if err := validateHops(f.GetHops()); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "invalid hops in forwarding: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewForwardingPacketData creates a new ForwardingPacketData instance given a memo and a variable number of hops.
+func NewForwardingPacketData(destinationMemo string, hops ...Hop) ForwardingPacketData {
+	return ForwardingPacketData{
+		DestinationMemo: destinationMemo,
+		Hops:            hops,
+	}
+}
+
+// Validate performs a basic validation of the ForwardingPacketData fields.
+func (fpd ForwardingPacketData) Validate() error {
+	if err := validateHops(fpd.Hops); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "invalid hops in forwarding packet data: %s", err.Error())
+	}
+
+	if len(fpd.DestinationMemo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "destination memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	if len(fpd.Hops) == 0 && fpd.DestinationMemo != "" {
+		return errorsmod.Wrap(ErrInvalidForwarding, "memo specified when forwarding packet data hops is empty")
+	}
+
+	return nil
+}
+
+// NewHop creates a Hop with the given port ID and channel ID.
+func NewHop(portID, channelID string) Hop {
+	return Hop{portID, channelID}
+}
+
+// Validate performs a basic validation of the Hop fields.
+func (h Hop) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source port ID %s", h.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source channel ID %s", h.ChannelId)
+	}
+
+	return nil
+}
+
+// String returns the Hop in the format:
+// <portID>/<channelID>
+func (h Hop) String() string {
+	return fmt.Sprintf("%s/%s", h.PortId, h.ChannelId)
+}
+
+// ParseHops parses a comma separated list of hops in the format
+// <portID>/<channelID>,<portID>/<channelID> into a slice of Hops.
+func ParseHops(hopsStr string) ([]Hop, error) {
+	if strings.TrimSpace(hopsStr) == "" {
+		return nil, nil
+	}
+
+	var hops []Hop
+	for _, hopStr := range strings.Split(hopsStr, ",") {
+		identifiers := strings.Split(strings.TrimSpace(hopStr), "/")
+		if len(identifiers) != 2 {
+			return nil, errorsmod.Wrapf(ErrInvalidForwarding, "expected hop in the format {portID}/{channelID}, got %s", hopStr)
+		}
+
+		hop := NewHop(identifiers[0], identifiers[1])
+		if err := hop.Validate(); err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, hop)
+	}
+
+	return hops, nil
+}
+
+// validateHops performs a basic validation of the hops.
+// It checks that the number of hops does not exceed the maximum allowed and that each hop is valid.
+func validateHops(hops []Hop) error {
+	if len(hops) > MaximumNumberOfForwardingHops {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of hops cannot exceed %d", MaximumNumberOfForwardingHops)
+	}
+
+	for _, hop := range hops {
+		if err := hop.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
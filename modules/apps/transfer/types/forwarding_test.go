@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestForwardingValidation(t *testing.T) {
+	validHop := types.NewHop(types.PortID, ibctesting.FirstChannelID)
+
+	var tooManyHops []types.Hop
+	for i := 0; i <= types.MaximumNumberOfForwardingHops; i++ {
+		tooManyHops = append(tooManyHops, validHop)
+	}
+
+	testCases := []struct {
+		name       string
+		forwarding types.Forwarding
+		expPass    bool
+	}{
+		{"valid empty forwarding", types.NewForwarding(false), true},
+		{"valid unwind without hops", types.NewForwarding(true), true},
+		{"valid forwarding with hops", types.NewForwarding(false, validHop, types.NewHop(types.PortID, "channel-1")), true},
+		{"invalid hop port ID", types.NewForwarding(false, types.NewHop("invalid/port", ibctesting.FirstChannelID)), false},
+		{"invalid hop channel ID", types.NewForwarding(false, types.NewHop(types.PortID, "c")), false},
+		{"too many hops", types.NewForwarding(false, tooManyHops...), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.forwarding.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidForwarding, tc.name)
+		}
+	}
+}
+
+func TestParseHops(t *testing.T) {
+	testCases := []struct {
+		name    string
+		hopsStr string
+		expHops []types.Hop
+		expPass bool
+	}{
+		{"empty string", "", nil, true},
+		{"single hop", "transfer/channel-0", []types.Hop{types.NewHop("transfer", "channel-0")}, true},
+		{"multiple hops", "transfer/channel-0, transfer/channel-1", []types.Hop{types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")}, true},
+		{"missing channel ID", "transfer", nil, false},
+		{"too many identifiers", "transfer/channel-0/transfer", nil, false},
+		{"invalid channel ID", "transfer/c", nil, false},
+	}
+
+	for _, tc := range testCases {
+		hops, err := types.ParseHops(tc.hopsStr)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expHops, hops, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestDenomTraceHops(t *testing.T) {
+	denomTrace := types.ParseDenomTrace(fmt.Sprintf("transfer/%s/transfer/%s/uatom", ibctesting.FirstChannelID, "channel-1"))
+	require.Equal(t, []types.Hop{
+		types.NewHop(types.PortID, ibctesting.FirstChannelID),
+		types.NewHop(types.PortID, "channel-1"),
+	}, denomTrace.Hops())
+
+	require.Empty(t, types.ParseDenomTrace("uatom").Hops())
+}
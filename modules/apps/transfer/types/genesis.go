@@ -35,5 +35,21 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, forwardedPacket := range gs.ForwardedPackets {
+		if err := NewHop(forwardedPacket.PortId, forwardedPacket.ChannelId).Validate(); err != nil {
+			return err
+		}
+		if err := forwardedPacket.Packet.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -33,6 +33,9 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// forwarded_packets contains the packets received by the transfer module
+	// which are awaiting the acknowledgement of the next hop
+	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +99,13 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -105,31 +115,33 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x93, 0xc8, 0x08, 0x27, 0xa4, 0xb0, 0x90, 0x30, 0x11, 0x72, 0x22, 0x44, 0x61, 0x81,
-	0xb2, 0x8b, 0x43, 0x01, 0xb5, 0x01, 0x21, 0x3a, 0x30, 0x54, 0x50, 0x44, 0xeb, 0xf5, 0x62, 0x56,
-	0xc4, 0x1e, 0x6b, 0x67, 0x63, 0x74, 0x7f, 0x71, 0xdf, 0x71, 0xdf, 0x70, 0x1f, 0x90, 0x32, 0xe5,
-	0x55, 0x77, 0xa7, 0xe4, 0x47, 0x4e, 0x5e, 0xfb, 0xa2, 0x48, 0x27, 0xa5, 0xf2, 0x8c, 0xe7, 0xbd,
-	0x37, 0xb3, 0xef, 0xb9, 0xaf, 0x65, 0xca, 0x29, 0xab, 0xaa, 0x95, 0xe4, 0x4c, 0x4b, 0x28, 0x91,
-	0x6a, 0xc5, 0x4a, 0xfc, 0x23, 0x14, 0xad, 0x23, 0x9a, 0x8b, 0x52, 0xa0, 0x44, 0x52, 0x29, 0xd0,
-	0xe0, 0xbd, 0x90, 0x29, 0x27, 0xc7, 0x58, 0x72, 0x8f, 0x25, 0x75, 0x34, 0x79, 0x73, 0x52, 0xe9,
-	0x80, 0x34, 0x52, 0x93, 0x80, 0x03, 0x16, 0x80, 0x34, 0x65, 0x28, 0x68, 0x1d, 0xa5, 0x42, 0xb3,
-	0x88, 0x72, 0x90, 0x65, 0x37, 0x7f, 0x9a, 0x43, 0x0e, 0xa6, 0xa4, 0x4d, 0xd5, 0xfe, 0x7d, 0x79,
-	0xd9, 0x73, 0x47, 0x5f, 0xda, 0x93, 0x7e, 0x68, 0xa6, 0x85, 0xf7, 0xcc, 0x7d, 0x54, 0x81, 0xd2,
-	0x4b, 0x99, 0xf9, 0xf6, 0xcc, 0x0e, 0x1f, 0x27, 0x4e, 0xd3, 0x7e, 0xcd, 0xbc, 0xdf, 0xee, 0x28,
-	0x13, 0x25, 0x14, 0x4b, 0xad, 0x18, 0x17, 0xe8, 0xf7, 0x66, 0xfd, 0x70, 0xb8, 0x08, 0xc9, 0xa9,
-	0x17, 0x90, 0x4f, 0x0d, 0xe3, 0x67, 0x43, 0x88, 0xc7, 0x9b, 0xeb, 0xa9, 0x75, 0x71, 0x33, 0x75,
-	0x4c, 0x8b, 0xc9, 0x30, 0x3b, 0xcc, 0xd0, 0x8b, 0x5d, 0xa7, 0x62, 0x8a, 0x15, 0xe8, 0xf7, 0x67,
-	0x76, 0x38, 0x5c, 0xbc, 0x3a, 0x2d, 0xfb, 0xcd, 0x60, 0xe3, 0x41, 0x23, 0x99, 0x74, 0x4c, 0x4f,
-	0xb9, 0x63, 0x0d, 0x9a, 0xad, 0x96, 0x02, 0xb9, 0x82, 0xff, 0x22, 0xf3, 0x07, 0xe6, 0xc4, 0xe7,
-	0xa4, 0x75, 0x86, 0x34, 0xce, 0x90, 0xce, 0x19, 0xf2, 0x11, 0x64, 0x19, 0xbf, 0xed, 0x6e, 0x0a,
-	0x73, 0xa9, 0xff, 0xae, 0x53, 0xc2, 0xa1, 0xa0, 0x9d, 0x8d, 0xed, 0x67, 0x8e, 0xd9, 0x3f, 0xaa,
-	0xcf, 0x2a, 0x81, 0x86, 0x80, 0xc9, 0x13, 0xb3, 0xe2, 0x73, 0xb7, 0x21, 0xfe, 0xbe, 0xd9, 0x05,
-	0xf6, 0x76, 0x17, 0xd8, 0xb7, 0xbb, 0xc0, 0x3e, 0xdf, 0x07, 0xd6, 0x76, 0x1f, 0x58, 0x57, 0xfb,
-	0xc0, 0xfa, 0xf5, 0xfe, 0xa1, 0xa4, 0x4c, 0xf9, 0x3c, 0x07, 0x5a, 0x7f, 0xa0, 0x05, 0x64, 0xeb,
-	0x95, 0xc0, 0x26, 0xdb, 0xa3, 0x4c, 0xcd, 0x9e, 0xd4, 0x31, 0xc1, 0xbc, 0xbb, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xfe, 0xf0, 0xde, 0x54, 0x47, 0x02, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcd, 0x6e, 0xd4, 0x30,
+	0x18, 0x4c, 0xd8, 0x12, 0x44, 0xb6, 0x54, 0x10, 0x21, 0x11, 0x2a, 0x94, 0xae, 0x10, 0x87, 0x08,
+	0xb4, 0x36, 0x29, 0x07, 0x38, 0x87, 0x3f, 0x71, 0x2b, 0x81, 0x13, 0x1c, 0x82, 0x63, 0x7b, 0x83,
+	0xd5, 0x4d, 0xbe, 0xc8, 0x9f, 0x9b, 0x8a, 0xb7, 0xe0, 0x09, 0x78, 0x00, 0x9e, 0xa4, 0xc7, 0x1e,
+	0x39, 0x01, 0xda, 0x7d, 0x11, 0x14, 0x27, 0xbb, 0x5a, 0x54, 0x29, 0xa7, 0xf8, 0x67, 0x66, 0xbe,
+	0x99, 0x89, 0xfd, 0xc7, 0xaa, 0xe0, 0x94, 0x35, 0xcd, 0x52, 0x71, 0x66, 0x14, 0xd4, 0x48, 0x8d,
+	0x66, 0x35, 0x2e, 0xa4, 0xa6, 0x6d, 0x42, 0x4b, 0x59, 0x4b, 0x54, 0x48, 0x1a, 0x0d, 0x06, 0x82,
+	0x07, 0xaa, 0xe0, 0x64, 0x17, 0x4b, 0x36, 0x58, 0xd2, 0x26, 0x87, 0x4f, 0x46, 0x95, 0xb6, 0x48,
+	0x2b, 0x75, 0x18, 0x71, 0xc0, 0x0a, 0x90, 0x16, 0x0c, 0x25, 0x6d, 0x93, 0x42, 0x1a, 0x96, 0x50,
+	0x0e, 0xaa, 0x1e, 0xee, 0xef, 0x96, 0x50, 0x82, 0x5d, 0xd2, 0x6e, 0xd5, 0x9f, 0x3e, 0xfc, 0x31,
+	0xf1, 0xf7, 0xdf, 0xf6, 0x96, 0x3e, 0x18, 0x66, 0x64, 0x70, 0xcf, 0xbf, 0xd1, 0x80, 0x36, 0xb9,
+	0x12, 0xa1, 0x3b, 0x73, 0xe3, 0x9b, 0x99, 0xd7, 0x6d, 0xdf, 0x89, 0xe0, 0xb3, 0xbf, 0x2f, 0x64,
+	0x0d, 0x55, 0x6e, 0x34, 0xe3, 0x12, 0xc3, 0x6b, 0xb3, 0x49, 0x3c, 0x3d, 0x8e, 0xc9, 0x58, 0x02,
+	0xf2, 0xaa, 0x63, 0x7c, 0xec, 0x08, 0xe9, 0xc1, 0xc5, 0xef, 0x23, 0xe7, 0xe7, 0x9f, 0x23, 0xcf,
+	0x6e, 0x31, 0x9b, 0x8a, 0xed, 0x1d, 0x06, 0xa9, 0xef, 0x35, 0x4c, 0xb3, 0x0a, 0xc3, 0xc9, 0xcc,
+	0x8d, 0xa7, 0xc7, 0x8f, 0xc6, 0x65, 0x4f, 0x2c, 0x36, 0xdd, 0xeb, 0x24, 0xb3, 0x81, 0x19, 0x68,
+	0xff, 0xc0, 0x80, 0x61, 0xcb, 0x5c, 0x22, 0xd7, 0x70, 0x2e, 0x45, 0xb8, 0x67, 0x2d, 0xde, 0x27,
+	0x7d, 0x33, 0xa4, 0x6b, 0x86, 0x0c, 0xcd, 0x90, 0x97, 0xa0, 0xea, 0xf4, 0xe9, 0xe0, 0x29, 0x2e,
+	0x95, 0xf9, 0x7a, 0x56, 0x10, 0x0e, 0x15, 0x1d, 0x6a, 0xec, 0x3f, 0x73, 0x14, 0xa7, 0xd4, 0x7c,
+	0x6b, 0x24, 0x5a, 0x02, 0x66, 0xb7, 0xec, 0x88, 0xd7, 0xc3, 0x84, 0xe0, 0x8b, 0x7f, 0x67, 0x01,
+	0xfa, 0x9c, 0x69, 0x21, 0x45, 0xde, 0x30, 0x7e, 0x2a, 0x0d, 0x86, 0xd7, 0xed, 0xd8, 0xf9, 0x78,
+	0x84, 0x37, 0x1b, 0xda, 0x89, 0x65, 0x0d, 0x59, 0x6e, 0x2f, 0xfe, 0x3f, 0xc6, 0xf4, 0xfd, 0xc5,
+	0x2a, 0x72, 0x2f, 0x57, 0x91, 0xfb, 0x77, 0x15, 0xb9, 0xdf, 0xd7, 0x91, 0x73, 0xb9, 0x8e, 0x9c,
+	0x5f, 0xeb, 0xc8, 0xf9, 0xf4, 0xfc, 0xaa, 0x69, 0x55, 0xf0, 0x79, 0x09, 0xb4, 0x7d, 0x41, 0x2b,
+	0x10, 0x67, 0x4b, 0x89, 0xdd, 0xeb, 0xd9, 0x79, 0x35, 0x36, 0x49, 0xe1, 0xd9, 0x5f, 0xff, 0xec,
+	0xdf, 0x00, 0x8d, 0x59, 0x2b, 0xd8, 0xa9, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +164,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,6 +261,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +438,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacket{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,9 +13,16 @@ const (
 	// ModuleName defines the IBC transfer name
 	ModuleName = "transfer"
 
-	// Version defines the current version the IBC tranfer
-	// module supports
-	Version = "ics20-1"
+	// V1 defines the first version of the IBC transfer module
+	V1 = "ics20-1"
+
+	// V2 defines the transfer version which introduces multi-denom
+	// transfers through the FungibleTokenPacketDataV2 packet data type
+	V2 = "ics20-2"
+
+	// Version defines the default version the IBC tranfer
+	// module negotiates when no version is provided
+	Version = V1
 
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
@@ -35,14 +44,33 @@ const (
 
 	KeyTotalEscrowPrefix = "totalEscrowForDenom"
 
+	// KeyForwardedPacketPrefix defines the key prefix under which the packets awaiting
+	// the acknowledgement of the next hop are stored
+	KeyForwardedPacketPrefix = "forwardedPacket"
+
+	// KeyMemoHookPrefix defines the domain prefix of the intermediate accounts holding the
+	// tokens received while the memo hooks are executed
+	KeyMemoHookPrefix = "memoHook"
+
 	ParamsKey = "params"
 )
 
 var (
+	// SupportedVersions defines all versions that are supported by the module
+	SupportedVersions = []string{V2, V1}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
-	// DenomTraceKey defines the key to store the denomination trace info in store
+	// DenomTraceKey defines the key under which the legacy denomination trace info was stored.
+	// Denomination traces are now stored as Denoms under DenomKey.
 	DenomTraceKey = []byte{0x02}
+	// DenomKey defines the key to store the denomination info in store
+	DenomKey = []byte{0x03}
+	// DenomBaseIndexKey defines the key prefix of the index of denominations by base denomination
+	DenomBaseIndexKey = []byte{0x04}
+	// DenomChannelIndexKey defines the key prefix of the index of denominations by the channels
+	// they have travelled through
+	DenomChannelIndexKey = []byte{0x05}
 )
 
 // GetEscrowAddress returns the escrow address for the specified channel.
@@ -54,7 +82,61 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	contents := fmt.Sprintf("%s/%s", portID, channelID)
 
 	// ADR 028 AddressHash construction
-	preImage := []byte(Version)
+	// NOTE: the escrow address is independent of the channel version, the first
+	// version is always used so that escrow addresses remain stable across versions
+	preImage := []byte(V1)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// GetForwardAddress returns the address of the account which holds the tokens received on
+// the specified channel while they are being forwarded to the next hop. The address is
+// derived in the same way as the escrow address, using a different domain prefix.
+func GetForwardAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s/%s", KeyForwardedPacketPrefix, portID, channelID)
+
+	preImage := []byte(V1)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// DenomBaseIndexPrefix returns the key prefix under which the hashes of all the denominations
+// with the given base denomination are indexed. The base denomination is hashed to obtain a
+// fixed length prefix, as base denominations may contain slashes.
+func DenomBaseIndexPrefix(baseDenom string) []byte {
+	hash := sha256.Sum256([]byte(baseDenom))
+	return append(append([]byte{}, DenomBaseIndexKey...), hash[:]...)
+}
+
+// DenomBaseIndex returns the key under which the hash of the given denomination is indexed
+// by its base denomination.
+func DenomBaseIndex(denom Denom) []byte {
+	return append(DenomBaseIndexPrefix(denom.Base), denom.Hash()...)
+}
+
+// DenomChannelIndexPrefix returns the key prefix under which the hashes of all the denominations
+// which have travelled through the given port and channel are indexed.
+func DenomChannelIndexPrefix(portID, channelID string) []byte {
+	return append(append([]byte{}, DenomChannelIndexKey...), fmt.Sprintf("%s/%s/", portID, channelID)...)
+}
+
+// DenomChannelIndex returns the key under which the hash of the given denomination is indexed
+// by one of the channels it has travelled through.
+func DenomChannelIndex(portID, channelID string, denom Denom) []byte {
+	return append(DenomChannelIndexPrefix(portID, channelID), denom.Hash()...)
+}
+
+// GetIntermediateAddress returns the address of the intermediate account which holds the tokens
+// received on the specified channel from the given sender while a memo hook is executed. The
+// address is derived in the same way as the escrow address, using a different domain prefix.
+func GetIntermediateAddress(portID, channelID, sender string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s/%s/%s", KeyMemoHookPrefix, portID, channelID, sender)
+
+	preImage := []byte(V1)
 	preImage = append(preImage, 0)
 	preImage = append(preImage, contents...)
 	hash := sha256.Sum256(preImage)
@@ -66,3 +148,24 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 func TotalEscrowForDenomKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyTotalEscrowPrefix, denom))
 }
+
+// ForwardedPacketKey returns the store key under which the packet received from the previous
+// hop is stored, given the port ID, channel ID and sequence of the packet sent on the next hop.
+func ForwardedPacketKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", KeyForwardedPacketPrefix, portID, channelID, sequence))
+}
+
+// ParseForwardedPacketKey parses the port ID, channel ID and sequence from a forwarded packet store key.
+func ParseForwardedPacketKey(key []byte) (string, string, uint64, error) {
+	keySplit := strings.Split(string(key), "/")
+	if len(keySplit) != 4 || keySplit[0] != KeyForwardedPacketPrefix {
+		return "", "", 0, fmt.Errorf("key is not a forwarded packet key: %s", key)
+	}
+
+	sequence, err := strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to parse forwarded packet sequence from key %s: %w", key, err)
+	}
+
+	return keySplit[1], keySplit[2], sequence, nil
+}
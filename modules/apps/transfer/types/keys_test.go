@@ -22,3 +22,34 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+// Test that the forward address of a channel end does not collide with its escrow address
+func TestGetForwardAddress(t *testing.T) {
+	forwardAddress := types.GetForwardAddress(types.PortID, "channel-0")
+	require.NotEqual(t, types.GetEscrowAddress(types.PortID, "channel-0"), forwardAddress)
+	require.NotEqual(t, types.GetForwardAddress(types.PortID, "channel-1"), forwardAddress)
+}
+
+// Test that the intermediate address used by memo hooks does not collide with the escrow or
+// forward addresses of the channel end and is unique per sender
+func TestGetIntermediateAddress(t *testing.T) {
+	intermediateAddress := types.GetIntermediateAddress(types.PortID, "channel-0", "cosmos1sender")
+	require.NotEqual(t, types.GetEscrowAddress(types.PortID, "channel-0"), intermediateAddress)
+	require.NotEqual(t, types.GetForwardAddress(types.PortID, "channel-0"), intermediateAddress)
+	require.NotEqual(t, types.GetIntermediateAddress(types.PortID, "channel-0", "cosmos1other"), intermediateAddress)
+	require.NotEqual(t, types.GetIntermediateAddress(types.PortID, "channel-1", "cosmos1sender"), intermediateAddress)
+}
+
+func TestParseForwardedPacketKey(t *testing.T) {
+	portID, channelID, sequence, err := types.ParseForwardedPacketKey(types.ForwardedPacketKey(types.PortID, "channel-0", 5))
+	require.NoError(t, err)
+	require.Equal(t, types.PortID, portID)
+	require.Equal(t, "channel-0", channelID)
+	require.Equal(t, uint64(5), sequence)
+
+	_, _, _, err = types.ParseForwardedPacketKey([]byte(types.KeyTotalEscrowPrefix + "/transfer/channel-0/5"))
+	require.Error(t, err)
+
+	_, _, _, err = types.ParseForwardedPacketKey([]byte(types.KeyForwardedPacketPrefix + "/transfer/channel-0/sequence"))
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// MemoHandler defines the interface which must be implemented by the modules registering a
+// memo hook with the transfer module. A memo hook is executed upon the receipt of a packet
+// whose memo is a JSON object containing the top-level key the handler is registered for.
+type MemoHandler interface {
+	// OnRecvMemo is executed after the tokens of the packet have been received by the
+	// intermediate account, which is derived from the destination channel and the sender of
+	// the packet. The value associated with the registered key in the memo is provided. Any
+	// tokens remaining in the intermediate account once the handler returns are sent to the
+	// receiver of the packet. Returning an error reverts the receipt of the tokens and results
+	// in an error acknowledgement.
+	OnRecvMemo(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		data FungibleTokenPacketDataV2,
+		intermediateAddress sdk.AccAddress,
+		receivedCoins sdk.Coins,
+		memoValue json.RawMessage,
+	) error
+}
+
+// parseMemoObject returns the top-level keys of the memo with their values. Nil is returned
+// if the memo is not a JSON object.
+func parseMemoObject(memo string) map[string]json.RawMessage {
+	if len(memo) == 0 {
+		return nil
+	}
+
+	var memoObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoObject); err != nil {
+		return nil
+	}
+
+	return memoObject
+}
+
+// GetMemoValues returns the values of the top-level keys of the memo matching any of the
+// provided keys. An empty map is returned if the memo is not a JSON object.
+func GetMemoValues(memo string, keys []string) map[string]json.RawMessage {
+	memoObject := parseMemoObject(memo)
+
+	values := make(map[string]json.RawMessage)
+	for _, key := range keys {
+		if value, found := memoObject[key]; found {
+			values[key] = value
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	MaximumTokenMetadataNameLength   = 128 // maximum length of the token metadata name in bytes (value chosen arbitrarily)
+	MaximumTokenMetadataSymbolLength = 32  // maximum length of the token metadata symbol in bytes (value chosen arbitrarily)
+	MaximumTokenMetadataDecimals     = 18  // maximum exponent of the display unit of a token
+)
+
+// NewTokenMetadata creates a new TokenMetadata instance
+func NewTokenMetadata(name, symbol string, decimals uint32, display string) *TokenMetadata {
+	return &TokenMetadata{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+		Display:  display,
+	}
+}
+
+// TokenMetadataFromBankMetadata returns the token metadata to be sent in the packet data given the
+// bank metadata of the token on the sending chain. The bank metadata is only used if its display
+// denomination is one of its denomination units, as the exponent of the display unit defines the
+// decimals of the token. Nil is returned if no valid token metadata can be derived.
+func TokenMetadataFromBankMetadata(metadata banktypes.Metadata) *TokenMetadata {
+	for _, unit := range metadata.DenomUnits {
+		if unit == nil || unit.Denom != metadata.Display {
+			continue
+		}
+
+		tokenMetadata := NewTokenMetadata(metadata.Name, metadata.Symbol, unit.Exponent, unit.Denom)
+		if err := tokenMetadata.Validate(); err != nil {
+			return nil
+		}
+
+		return tokenMetadata
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the TokenMetadata fields.
+func (tm TokenMetadata) Validate() error {
+	if strings.TrimSpace(tm.Name) == "" {
+		return errorsmod.Wrap(ErrInvalidMetadata, "name cannot be blank")
+	}
+	if len(tm.Name) > MaximumTokenMetadataNameLength {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "name must not exceed %d bytes", MaximumTokenMetadataNameLength)
+	}
+	if strings.TrimSpace(tm.Symbol) == "" {
+		return errorsmod.Wrap(ErrInvalidMetadata, "symbol cannot be blank")
+	}
+	if len(tm.Symbol) > MaximumTokenMetadataSymbolLength {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "symbol must not exceed %d bytes", MaximumTokenMetadataSymbolLength)
+	}
+	if tm.Decimals > MaximumTokenMetadataDecimals {
+		return errorsmod.Wrapf(ErrInvalidMetadata, "decimals must not exceed %d: got %d", MaximumTokenMetadataDecimals, tm.Decimals)
+	}
+	if tm.Decimals > 0 || tm.Display != "" {
+		if err := sdk.ValidateDenom(tm.Display); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMetadata, "invalid display denomination: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// ToBankMetadata returns the bank metadata of the voucher of the given denomination on the
+// receiving chain. The voucher IBC denomination is used as base unit, aliased by the base
+// denomination of the token, and the display unit is added if its exponent is non-zero.
+func (tm TokenMetadata) ToBankMetadata(denom Denom) banktypes.Metadata {
+	ibcDenom := denom.IBCDenom()
+
+	denomUnits := []*banktypes.DenomUnit{
+		{
+			Denom:    ibcDenom,
+			Exponent: 0,
+			Aliases:  []string{denom.Base},
+		},
+	}
+
+	display := ibcDenom
+	if tm.Decimals > 0 {
+		display = tm.Display
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    tm.Display,
+			Exponent: tm.Decimals,
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denom.FullPath()),
+		DenomUnits:  denomUnits,
+		Base:        ibcDenom,
+		Display:     display,
+		Name:        tm.Name,
+		Symbol:      tm.Symbol,
+	}
+}
+
+// DefaultDenomMetadata returns the bank metadata set for the voucher of the given denomination
+// on the receiving chain when no token metadata is provided by the sending chain.
+func DefaultDenomMetadata(denom Denom) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denom.FullPath()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom.Base,
+				Exponent: 0,
+			},
+		},
+		// Setting base as IBC hash denom since bank keepers's SetDenomMetadata uses
+		// Base as key path and the IBC hash is what gives this token uniqueness
+		// on the executing chain
+		Base:    denom.IBCDenom(),
+		Display: denom.FullPath(),
+		Name:    fmt.Sprintf("%s IBC token", denom.FullPath()),
+		Symbol:  strings.ToUpper(denom.Base),
+	}
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestTokenMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata *types.TokenMetadata
+		expPass  bool
+	}{
+		{"valid metadata", types.NewTokenMetadata("Atom", "ATOM", 6, "atom"), true},
+		{"valid metadata without display unit", types.NewTokenMetadata("Atom", "ATOM", 0, ""), true},
+		{"blank name", types.NewTokenMetadata(" ", "ATOM", 6, "atom"), false},
+		{"name too long", types.NewTokenMetadata(strings.Repeat("a", types.MaximumTokenMetadataNameLength+1), "ATOM", 6, "atom"), false},
+		{"blank symbol", types.NewTokenMetadata("Atom", "", 6, "atom"), false},
+		{"symbol too long", types.NewTokenMetadata("Atom", strings.Repeat("A", types.MaximumTokenMetadataSymbolLength+1), 6, "atom"), false},
+		{"too many decimals", types.NewTokenMetadata("Atom", "ATOM", types.MaximumTokenMetadataDecimals+1, "atom"), false},
+		{"decimals without display unit", types.NewTokenMetadata("Atom", "ATOM", 6, ""), false},
+		{"invalid display unit", types.NewTokenMetadata("Atom", "ATOM", 6, "1atom"), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.metadata.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMetadata, tc.name)
+		}
+	}
+}
+
+func TestTokenMetadataFromBankMetadata(t *testing.T) {
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+	}
+	require.Equal(t, types.NewTokenMetadata("Cosmos Hub Atom", "ATOM", 6, "atom"), types.TokenMetadataFromBankMetadata(metadata))
+
+	metadata.Display = "uatom"
+	require.Equal(t, types.NewTokenMetadata("Cosmos Hub Atom", "ATOM", 0, "uatom"), types.TokenMetadataFromBankMetadata(metadata))
+
+	metadata.Display = "transfer/channel-0/uatom"
+	require.Nil(t, types.TokenMetadataFromBankMetadata(metadata))
+
+	metadata.Display = "atom"
+	metadata.Name = ""
+	require.Nil(t, types.TokenMetadataFromBankMetadata(metadata))
+}
+
+func TestTokenMetadataToBankMetadata(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))
+
+	metadata := types.NewTokenMetadata("Cosmos Hub Atom", "ATOM", 6, "atom").ToBankMetadata(denom)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, denom.IBCDenom(), metadata.Base)
+	require.Equal(t, "atom", metadata.Display)
+	require.Equal(t, []string{"uatom"}, metadata.DenomUnits[0].Aliases)
+
+	metadata = types.NewTokenMetadata("Cosmos Hub Atom", "ATOM", 0, "uatom").ToBankMetadata(denom)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, denom.IBCDenom(), metadata.Display)
+	require.Len(t, metadata.DenomUnits, 1)
+}
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -15,13 +16,20 @@ import (
 const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
+	MaximumTokensLength   = 100   // maximum number of tokens that can be transferred in a single message (value chosen arbitrarily)
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgUpdateDenomMetadata)(nil)
+	_ sdk.Msg              = (*MsgTransferAll)(nil)
+	_ sdk.Msg              = (*MsgReconcileTotalEscrow)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateDenomMetadata)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferAll)(nil)
+	_ sdk.HasValidateBasic = (*MsgReconcileTotalEscrow)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -39,7 +47,7 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
-	return nil
+	return msg.Params.Validate()
 }
 
 // GetSigners implements sdk.Msg
@@ -52,6 +60,53 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{accAddr}
 }
 
+// NewMsgUpdateDenomMetadata creates a new MsgUpdateDenomMetadata instance
+func NewMsgUpdateDenomMetadata(signer string, metadata banktypes.Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Signer:   signer,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if !strings.HasPrefix(msg.Metadata.Base, DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "base denomination must be an IBC denomination: %s", msg.Metadata.Base)
+	}
+
+	if err := ValidateIBCDenom(msg.Metadata.Base); err != nil {
+		return err
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgReconcileTotalEscrow creates a new MsgReconcileTotalEscrow instance
+func NewMsgReconcileTotalEscrow(signer string) *MsgReconcileTotalEscrow {
+	return &MsgReconcileTotalEscrow{
+		Signer: signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgReconcileTotalEscrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
 // NewMsgTransfer creates a new MsgTransfer instance
 func NewMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -76,18 +131,118 @@ func NewMsgTransfer(
 // NOTE: The recipient addresses format is not validated as the format defined by
 // the chain is not known to IBC.
 func (msg MsgTransfer) ValidateBasic() error {
+	if err := msg.Forwarding.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Forwarding.Unwind {
+		// the source port and channel are derived from the denomination trace when unwinding
+		if msg.SourcePort != "" || msg.SourceChannel != "" {
+			return errorsmod.Wrapf(ErrInvalidForwarding, "source port and channel must be empty when unwinding: got %s/%s", msg.SourcePort, msg.SourceChannel)
+		}
+	} else {
+		if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+			return errorsmod.Wrap(err, "invalid source port ID")
+		}
+		if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+			return errorsmod.Wrap(err, "invalid source channel ID")
+		}
+	}
+	if len(msg.Tokens) == 0 {
+		if err := validateIBCCoin(msg.Token); err != nil {
+			return err
+		}
+	} else {
+		if !msg.Token.IsNil() && !msg.Token.IsZero() {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "cannot fill both token and tokens")
+		}
+		if len(msg.Tokens) > MaximumTokensLength {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+		}
+
+		seenDenoms := make(map[string]bool, len(msg.Tokens))
+		for _, coin := range msg.Tokens {
+			if err := validateIBCCoin(coin); err != nil {
+				return err
+			}
+			if seenDenoms[coin.Denom] {
+				return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "duplicate token denomination: %s", coin.Denom)
+			}
+			seenDenoms[coin.Denom] = true
+		}
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(msg.Receiver) > MaximumReceiverLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", MaximumReceiverLength)
+	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
+	if len(msg.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ShouldBeForwarded determines if the transfer should be forwarded to the next hop
+// or unwound to the native chain of the tokens.
+func (msg MsgTransfer) ShouldBeForwarded() bool {
+	return msg.Forwarding.Unwind || len(msg.Forwarding.Hops) > 0
+}
+
+// GetCoins returns the tokens which will be transferred. If the Token field
+// is populated, it is returned as the only element of the coin array.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if len(msg.Tokens) == 0 {
+		return sdk.Coins{msg.Token}
+	}
+	return sdk.NewCoins(msg.Tokens...)
+}
+
+// NewMsgTransferAll creates a new MsgTransferAll instance
+func NewMsgTransferAll(
+	sourcePort, sourceChannel string,
+	sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string, partialFillPolicy PartialFillPolicy,
+) *MsgTransferAll {
+	return &MsgTransferAll{
+		SourcePort:        sourcePort,
+		SourceChannel:     sourceChannel,
+		Sender:            sender,
+		Receiver:          receiver,
+		TimeoutHeight:     timeoutHeight,
+		TimeoutTimestamp:  timeoutTimestamp,
+		Memo:              memo,
+		PartialFillPolicy: partialFillPolicy,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgTransferAll fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgTransferAll) ValidateBasic() error {
 	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
 		return errorsmod.Wrap(err, "invalid source port ID")
 	}
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return errorsmod.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
-	}
-	if !msg.Token.IsPositive() {
-		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, msg.Token.String())
-	}
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -99,17 +254,36 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if len(msg.Receiver) > MaximumReceiverLength {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", MaximumReceiverLength)
 	}
+	if err := validateReceiver(msg.Receiver); err != nil {
+		return err
+	}
 	if len(msg.Memo) > MaximumMemoLength {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+	if _, ok := PartialFillPolicy_name[int32(msg.PartialFillPolicy)]; !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "invalid partial fill policy: %d", msg.PartialFillPolicy)
+	}
+	return nil
 }
 
 // GetSigners implements sdk.Msg
-func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+func (msg MsgTransferAll) GetSigners() []sdk.AccAddress {
 	signer, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		panic(err)
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// validateIBCCoin returns an error if the provided coin is not valid to be
+// transferred. The coin amount must be positive and its denomination must be
+// a valid base or IBC denomination.
+func validateIBCCoin(coin sdk.Coin) error {
+	if !coin.IsValid() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, coin.String())
+	}
+	if !coin.IsPositive() {
+		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, coin.String())
+	}
+	return ValidateIBCDenom(coin.Denom)
+}
@@ -67,7 +67,20 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing sender address", types.NewMsgTransfer(validPort, validChannel, coin, emptyAddr, receiver, timeoutHeight, 0, ""), false},
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", timeoutHeight, 0, ""), false},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, ""), false},
+		{"valid hex recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "0x1e62ad4ba1cbb9aa6b4bbd8da9ad0bb1b10d5a2e", timeoutHeight, 0, ""), true},
+		{"invalid hex recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "0xnothex", timeoutHeight, 0, ""), false},
+		{"empty hex recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, types.HexAddressPrefix, timeoutHeight, 0, ""), false},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"valid msg with multiple tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.NewCoins(coin, ibcCoin)), true},
+		{"token and tokens both set", newMsgTransferWithTokens(coin, sdk.NewCoins(ibcCoin)), false},
+		{"invalid ibc denom in tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{coin, invalidIBCCoin}), false},
+		{"zero coin in tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{coin, zeroCoin}), false},
+		{"duplicate denoms in tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{coin, coin}), false},
+		{"valid msg with forwarding", newMsgTransferWithForwarding(validPort, validChannel, types.NewForwarding(false, types.NewHop(validPort, validChannel))), true},
+		{"valid msg with unwind", newMsgTransferWithForwarding("", "", types.NewForwarding(true)), true},
+		{"unwind with source port and channel", newMsgTransferWithForwarding(validPort, validChannel, types.NewForwarding(true)), false},
+		{"empty source channel without unwind", newMsgTransferWithForwarding(validPort, "", types.NewForwarding(false)), false},
+		{"invalid forwarding hop", newMsgTransferWithForwarding(validPort, validChannel, types.NewForwarding(false, types.NewHop(validPort, invalidChannel))), false},
 	}
 
 	for i, tc := range testCases {
@@ -82,6 +95,20 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// newMsgTransferWithTokens returns a valid MsgTransfer with the provided token and tokens fields.
+func newMsgTransferWithTokens(token sdk.Coin, tokens sdk.Coins) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(validPort, validChannel, token, sender, receiver, timeoutHeight, 0, "")
+	msg.Tokens = tokens
+	return msg
+}
+
+// newMsgTransferWithForwarding returns a valid MsgTransfer with the provided source port, channel and forwarding fields.
+func newMsgTransferWithForwarding(sourcePort, sourceChannel string, forwarding types.Forwarding) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(sourcePort, sourceChannel, ibcCoin, sender, receiver, timeoutHeight, 0, "")
+	msg.Forwarding = forwarding
+	return msg
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -116,7 +143,95 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	}
 }
 
+// TestMsgTransferAllValidation tests ValidateBasic for MsgTransferAll
+func TestMsgTransferAllValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgTransferAll
+		expPass bool
+	}{
+		{"valid msg", types.NewMsgTransferAll(validPort, validChannel, sender, receiver, timeoutHeight, 0, "", types.SKIP_REJECTED), true},
+		{"valid msg with all or nothing policy", types.NewMsgTransferAll(validPort, validChannel, sender, receiver, timeoutHeight, 0, "memo", types.ALL_OR_NOTHING), true},
+		{"invalid port", types.NewMsgTransferAll(invalidPort, validChannel, sender, receiver, timeoutHeight, 0, "", types.SKIP_REJECTED), false},
+		{"invalid channel", types.NewMsgTransferAll(validPort, invalidChannel, sender, receiver, timeoutHeight, 0, "", types.SKIP_REJECTED), false},
+		{"invalid sender", types.NewMsgTransferAll(validPort, validChannel, invalidAddress, receiver, timeoutHeight, 0, "", types.SKIP_REJECTED), false},
+		{"missing recipient address", types.NewMsgTransferAll(validPort, validChannel, sender, "", timeoutHeight, 0, "", types.SKIP_REJECTED), false},
+		{"too long recipient address", types.NewMsgTransferAll(validPort, validChannel, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, "", types.SKIP_REJECTED), false},
+		{"too long memo", types.NewMsgTransferAll(validPort, validChannel, sender, receiver, timeoutHeight, 0, ibctesting.GenerateString(types.MaximumMemoLength+1), types.SKIP_REJECTED), false},
+		{"unknown partial fill policy", types.NewMsgTransferAll(validPort, validChannel, sender, receiver, timeoutHeight, 0, "", types.PartialFillPolicy(2)), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsGetSigners tests GetSigners for MsgUpdateParams
+func TestMsgUpdateDenomMetadataValidateBasic(t *testing.T) {
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-0"))
+	metadata := types.NewTokenMetadata("Stake", "STK", 6, "stk").ToBankMetadata(denom)
+
+	nativeMetadata := metadata
+	nativeMetadata.Base = sdk.DefaultBondDenom
+
+	invalidMetadata := metadata
+	invalidMetadata.Display = "atom"
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateDenomMetadata
+		expPass bool
+	}{
+		{"success: valid signer and valid metadata", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, metadata), true},
+		{"failure: invalid signer with valid metadata", types.NewMsgUpdateDenomMetadata(invalidAddress, metadata), false},
+		{"failure: empty signer with valid metadata", types.NewMsgUpdateDenomMetadata(emptyAddr, metadata), false},
+		{"failure: native base denomination", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, nativeMetadata), false},
+		{"failure: display unit not in denomination units", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, invalidMetadata), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgReconcileTotalEscrowValidateBasic tests ValidateBasic for MsgReconcileTotalEscrow
+func TestMsgReconcileTotalEscrowValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReconcileTotalEscrow
+		expPass bool
+	}{
+		{"success: valid signer", types.NewMsgReconcileTotalEscrow(ibctesting.TestAccAddress), true},
+		{"failure: invalid signer", types.NewMsgReconcileTotalEscrow(invalidAddress), false},
+		{"failure: empty signer", types.NewMsgReconcileTotalEscrow(emptyAddr), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 func TestMsgUpdateParamsGetSigners(t *testing.T) {
 	testCases := []struct {
 		name    string
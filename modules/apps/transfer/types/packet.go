@@ -17,6 +17,8 @@ import (
 var (
 	_ ibcexported.PacketData         = (*FungibleTokenPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.PacketData         = (*FungibleTokenPacketDataV2)(nil)
+	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketDataV2)(nil)
 )
 
 var (
@@ -87,12 +89,158 @@ func (ftpd FungibleTokenPacketData) GetPacketSender(sourcePortID string) string
 // and returns the value associated with the given key.
 // If the key is missing or the memo is not properly formatted, then nil is returned.
 func (ftpd FungibleTokenPacketData) GetCustomPacketData(key string) interface{} {
-	if len(ftpd.Memo) == 0 {
+	return getCustomMemoData(ftpd.Memo, key)
+}
+
+// NewFungibleTokenPacketDataV2 constructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+	forwarding ForwardingPacketData,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:     tokens,
+		Sender:     sender,
+		Receiver:   receiver,
+		Memo:       memo,
+		Forwarding: forwarding,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if len(ftpd.Tokens) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "tokens cannot be empty")
+	}
+	if len(ftpd.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	seenDenoms := make(map[string]bool, len(ftpd.Tokens))
+	for _, token := range ftpd.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[token.Denom] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "duplicate token denomination: %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if len(ftpd.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	if err := ftpd.Forwarding.Validate(); err != nil {
+		return err
+	}
+
+	// the memo is delivered to the final destination through the forwarding destination memo
+	if ftpd.HasForwarding() && ftpd.Memo != "" {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must be empty if forwarding hops are set: %s", ftpd.Memo)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (ftpd FungibleTokenPacketDataV2) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (ftpd FungibleTokenPacketDataV2) GetCustomPacketData(key string) interface{} {
+	return getCustomMemoData(ftpd.Memo, key)
+}
+
+// HasForwarding determines if the packet should be forwarded to the next hop.
+func (ftpd FungibleTokenPacketDataV2) HasForwarding() bool {
+	return len(ftpd.Forwarding.Hops) > 0
+}
+
+// Validate performs a basic validation of the token denomination and amount.
+func (t Token) Validate() error {
+	amount, ok := sdkmath.NewIntFromString(t.Amount)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+	if t.Metadata != nil {
+		if err := t.Metadata.Validate(); err != nil {
+			return err
+		}
+	}
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// PacketDataV1ToV2 converts a FungibleTokenPacketData into a FungibleTokenPacketDataV2
+// containing a single token.
+func PacketDataV1ToV2(packetData FungibleTokenPacketData) FungibleTokenPacketDataV2 {
+	return NewFungibleTokenPacketDataV2(
+		[]Token{{Denom: packetData.Denom, Amount: packetData.Amount}},
+		packetData.Sender, packetData.Receiver, packetData.Memo,
+		ForwardingPacketData{},
+	)
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes according to
+// the provided ICS20 channel version. Packet data sent over ics20-1 channels is converted
+// into a FungibleTokenPacketDataV2 so that it may be handled uniformly.
+func UnmarshalPacketData(bz []byte, ics20Version string) (FungibleTokenPacketDataV2, error) {
+	switch ics20Version {
+	case V1:
+		var packetData FungibleTokenPacketData
+		if err := ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+		}
+
+		return PacketDataV1ToV2(packetData), nil
+	case V2:
+		var packetData FungibleTokenPacketDataV2
+		if err := ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+		}
+
+		return packetData, nil
+	default:
+		return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ErrInvalidVersion, "unsupported ICS-20 version: %s", ics20Version)
+	}
+}
+
+// getCustomMemoData interprets the memo as a JSON object and returns the value
+// associated with the given key. If the key is missing or the memo is not
+// properly formatted, then nil is returned.
+func getCustomMemoData(memo, key string) interface{} {
+	if len(memo) == 0 {
 		return nil
 	}
 
 	jsonObject := make(map[string]interface{})
-	err := json.Unmarshal([]byte(ftpd.Memo), &jsonObject)
+	err := json.Unmarshal([]byte(memo), &jsonObject)
 	if err != nil {
 		return nil
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 const (
@@ -134,3 +135,69 @@ func (suite *TypesTestSuite) TestPacketDataProvider() {
 		suite.Require().Equal(tc.expCustomData, customData)
 	}
 }
+
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, receiver, "", types.ForwardingPacketData{}), true},
+		{"valid packet with multiple tokens", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}, {Denom: "uatom", Amount: largeAmount}}, sender, receiver, "memo", types.ForwardingPacketData{}), true},
+		{"invalid empty tokens", types.NewFungibleTokenPacketDataV2(nil, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid duplicate denoms", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}, {Denom: denom, Amount: amount}}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid denom", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: "", Amount: amount}}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid zero amount", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: "0"}}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid large amount", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: invalidLargeAmount}}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"missing sender address", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, emptyAddr, receiver, "", types.ForwardingPacketData{}), false},
+		{"missing recipient address", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, emptyAddr, "", types.ForwardingPacketData{}), false},
+		{"valid packet with forwarding", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, receiver, "", types.NewForwardingPacketData("memo", types.NewHop(types.PortID, "channel-1"))), true},
+		{"invalid memo set with forwarding", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, receiver, "memo", types.NewForwardingPacketData("", types.NewHop(types.PortID, "channel-1"))), false},
+		{"invalid destination memo without hops", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, receiver, "", types.NewForwardingPacketData("memo")), false},
+		{"invalid forwarding hop", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, receiver, "", types.NewForwardingPacketData("", types.NewHop(types.PortID, "invalid"))), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestUnmarshalPacketData tests unmarshalling packet data bytes for each supported ICS20 version
+func TestUnmarshalPacketData(t *testing.T) {
+	packetDataV1 := types.NewFungibleTokenPacketData(denom, amount, sender, receiver, "memo")
+	packetDataV2 := types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}, {Denom: "uatom", Amount: amount}}, sender, receiver, "memo", types.ForwardingPacketData{})
+
+	testCases := []struct {
+		name          string
+		bz            []byte
+		version       string
+		expPacketData types.FungibleTokenPacketDataV2
+		expError      error
+	}{
+		{"success: v1 packet data", packetDataV1.GetBytes(), types.V1, types.PacketDataV1ToV2(packetDataV1), nil},
+		{"success: v2 packet data", packetDataV2.GetBytes(), types.V2, packetDataV2, nil},
+		{"failure: v2 packet data on v1 channel", packetDataV2.GetBytes(), types.V1, types.FungibleTokenPacketDataV2{}, ibcerrors.ErrInvalidType},
+		{"failure: v1 packet data on v2 channel", packetDataV1.GetBytes(), types.V2, types.FungibleTokenPacketDataV2{}, ibcerrors.ErrInvalidType},
+		{"failure: unsupported version", packetDataV1.GetBytes(), "ics20-100", types.FungibleTokenPacketDataV2{}, types.ErrInvalidVersion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetData, err := types.UnmarshalPacketData(tc.bz, tc.version)
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expPacketData, packetData, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
@@ -1,5 +1,15 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
@@ -19,3 +29,105 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// Validate validates all ibc-transfer module parameters
+func (p Params) Validate() error {
+	if err := validateDenomList(p.BlockedReceiveDenoms); err != nil {
+		return fmt.Errorf("invalid blocked receive denominations: %w", err)
+	}
+
+	seenChannels := make(map[string]bool, len(p.ChannelDenomFilters))
+	for _, filter := range p.ChannelDenomFilters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+
+		channel := NewHop(filter.PortId, filter.ChannelId).String()
+		if seenChannels[channel] {
+			return fmt.Errorf("duplicate denomination filter for channel %s", channel)
+		}
+		seenChannels[channel] = true
+	}
+
+	return nil
+}
+
+// IsReceiveBlocked returns true if the base denomination cannot be received over any channel.
+func (p Params) IsReceiveBlocked(baseDenom string) bool {
+	return slices.Contains(p.BlockedReceiveDenoms, baseDenom)
+}
+
+// GetChannelDenomFilter returns the denomination filter of the given channel, if any.
+func (p Params) GetChannelDenomFilter(portID, channelID string) (ChannelDenomFilter, bool) {
+	for _, filter := range p.ChannelDenomFilters {
+		if filter.PortId == portID && filter.ChannelId == channelID {
+			return filter, true
+		}
+	}
+
+	return ChannelDenomFilter{}, false
+}
+
+// NewChannelDenomFilter creates a new ChannelDenomFilter instance
+func NewChannelDenomFilter(portID, channelID string, allowedDenoms, sendDisabledDenoms []string) ChannelDenomFilter {
+	return ChannelDenomFilter{
+		PortId:             portID,
+		ChannelId:          channelID,
+		AllowedDenoms:      allowedDenoms,
+		SendDisabledDenoms: sendDisabledDenoms,
+	}
+}
+
+// Validate performs a basic validation of the ChannelDenomFilter fields.
+func (f ChannelDenomFilter) Validate() error {
+	if err := host.PortIdentifierValidator(f.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return err
+	}
+	if err := validateDenomList(f.AllowedDenoms); err != nil {
+		return fmt.Errorf("invalid allowed denominations for channel %s/%s: %w", f.PortId, f.ChannelId, err)
+	}
+	if err := validateDenomList(f.SendDisabledDenoms); err != nil {
+		return fmt.Errorf("invalid send disabled denominations for channel %s/%s: %w", f.PortId, f.ChannelId, err)
+	}
+
+	for _, denom := range f.SendDisabledDenoms {
+		// only native denominations can be disabled for sending
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if strings.HasPrefix(denom, DenomPrefix+"/") {
+			return fmt.Errorf("send disabled denomination must be a native denomination: %s", denom)
+		}
+	}
+
+	return nil
+}
+
+// IsDenomAllowed returns true if the base denomination can be sent and received over the channel.
+func (f ChannelDenomFilter) IsDenomAllowed(baseDenom string) bool {
+	return len(f.AllowedDenoms) == 0 || slices.Contains(f.AllowedDenoms, baseDenom)
+}
+
+// IsSendDisabled returns true if the native denomination cannot be sent over the channel.
+func (f ChannelDenomFilter) IsSendDisabled(denom string) bool {
+	return slices.Contains(f.SendDisabledDenoms, denom)
+}
+
+// validateDenomList checks that the denominations are not blank and are not duplicated.
+func validateDenomList(denoms []string) error {
+	seenDenoms := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if strings.TrimSpace(denom) == "" {
+			return fmt.Errorf("denomination cannot be blank")
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate denomination %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	validFilter := types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, []string{"uatom", "gamm/pool/1"}, []string{"stake"})
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"valid denom filters", types.Params{SendEnabled: true, ReceiveEnabled: true, BlockedReceiveDenoms: []string{"uosmo"}, ChannelDenomFilters: []types.ChannelDenomFilter{validFilter}}, true},
+		{"blank blocked receive denom", types.Params{BlockedReceiveDenoms: []string{" "}}, false},
+		{"duplicate blocked receive denom", types.Params{BlockedReceiveDenoms: []string{"uosmo", "uosmo"}}, false},
+		{"duplicate channel filters", types.Params{ChannelDenomFilters: []types.ChannelDenomFilter{validFilter, validFilter}}, false},
+		{"invalid port ID", types.Params{ChannelDenomFilters: []types.ChannelDenomFilter{types.NewChannelDenomFilter("", ibctesting.FirstChannelID, nil, nil)}}, false},
+		{"invalid channel ID", types.Params{ChannelDenomFilters: []types.ChannelDenomFilter{types.NewChannelDenomFilter(ibctesting.TransferPort, "", nil, nil)}}, false},
+		{"duplicate allowed denom", types.Params{ChannelDenomFilters: []types.ChannelDenomFilter{types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, []string{"uatom", "uatom"}, nil)}}, false},
+		{"IBC denom send disabled", types.Params{ChannelDenomFilters: []types.ChannelDenomFilter{types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, nil, []string{"ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"})}}, false},
+		{"invalid send disabled denom", types.Params{ChannelDenomFilters: []types.ChannelDenomFilter{types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, nil, []string{"1stake"})}}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestChannelDenomFilter(t *testing.T) {
+	filter := types.NewChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID, nil, []string{"stake"})
+	require.True(t, filter.IsDenomAllowed("uatom"))
+	require.True(t, filter.IsSendDisabled("stake"))
+	require.False(t, filter.IsSendDisabled("uatom"))
+
+	filter.AllowedDenoms = []string{"uatom"}
+	require.True(t, filter.IsDenomAllowed("uatom"))
+	require.False(t, filter.IsDenomAllowed("stake"))
+
+	params := types.DefaultParams()
+	params.ChannelDenomFilters = []types.ChannelDenomFilter{filter}
+
+	found, ok := params.GetChannelDenomFilter(ibctesting.TransferPort, ibctesting.FirstChannelID)
+	require.True(t, ok)
+	require.Equal(t, filter, found)
+
+	_, ok = params.GetChannelDenomFilter(ibctesting.TransferPort, "channel-1")
+	require.False(t, ok)
+}
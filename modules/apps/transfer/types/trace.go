@@ -82,6 +82,23 @@ func (dt DenomTrace) IsNativeDenom() bool {
 	return dt.Path == ""
 }
 
+// Hops returns the port ID, channel ID pairs contained in the trace path, ordered from the
+// most recent hop to the first hop the token travelled through. Sending the token through
+// the returned hops in order unwinds the token back to its native chain.
+func (dt DenomTrace) Hops() []Hop {
+	if dt.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(dt.Path, "/")
+	hops := make([]Hop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		hops = append(hops, NewHop(identifiers[i], identifiers[i+1]))
+	}
+
+	return hops
+}
+
 // extractPathAndBaseFromFullDenom returns the trace path and the base denom from
 // the elements that constitute the complete denom.
 func extractPathAndBaseFromFullDenom(fullDenomItems []string) (string, string) {
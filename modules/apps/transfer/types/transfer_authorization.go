@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -32,34 +33,66 @@ func (TransferAuthorization) MsgTypeURL() string {
 }
 
 // Accept implements Authorization.Accept.
-func (a TransferAuthorization) Accept(ctx context.Context, msg proto.Message) (authz.AcceptResponse, error) {
+func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message) (authz.AcceptResponse, error) {
 	msgTransfer, ok := msg.(*MsgTransfer)
 	if !ok {
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidType, "type mismatch")
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime()
+
 	for index, allocation := range a.Allocations {
 		if !(allocation.SourceChannel == msgTransfer.SourceChannel && allocation.SourcePort == msgTransfer.SourcePort) {
 			continue
 		}
 
-		if !isAllowedAddress(sdk.UnwrapSDKContext(ctx), msgTransfer.Receiver, allocation.AllowList) {
+		if !isWithinTimeWindows(blockTime, allocation.AllowedTimeWindows) {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ErrInvalidAuthorization, "allocation cannot be used at %s", blockTime)
+		}
+
+		if err := validateTimeout(blockTime, msgTransfer.TimeoutTimestamp, allocation.MaxTimeout); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		if !isAllowedAddress(ctx, msgTransfer.Receiver, allocation.AllowList) {
+			return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
+		}
+
+		if !isAllowedReceiver(ctx, msgTransfer.Receiver, allocation.AllowedReceivers) {
 			return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
 		}
 
-		err := validateMemo(sdk.UnwrapSDKContext(ctx), msgTransfer.Memo, allocation.AllowedPacketData)
+		err := validateMemo(ctx, msgTransfer.Memo, allocation.AllowedPacketData)
 		if err != nil {
 			return authz.AcceptResponse{}, err
 		}
 
-		// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
-		if allocation.SpendLimit.AmountOf(msgTransfer.Token.Denom).Equal(UnboundedSpendLimit()) {
-			return authz.AcceptResponse{Accept: true, Delete: false, Updated: nil}, nil
+		limitLeft := allocation.SpendLimit
+		for _, coin := range msgTransfer.GetCoins() {
+			// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
+			if allocation.SpendLimit.AmountOf(coin.Denom).Equal(UnboundedSpendLimit()) {
+				continue
+			}
+
+			var isNegative bool
+			limitLeft, isNegative = limitLeft.SafeSub(coin)
+			if isNegative {
+				return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of %s is more than spend limit", coin.Denom)
+			}
 		}
 
-		limitLeft, isNegative := allocation.SpendLimit.SafeSub(msgTransfer.Token)
-		if isNegative {
-			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		// the periodic spend limit is tracked independently of the spend limit
+		if allocation.PeriodicSpendLimit != nil {
+			periodicSpendLimit, err := allocation.PeriodicSpendLimit.spend(blockTime, msgTransfer.GetCoins())
+			if err != nil {
+				return authz.AcceptResponse{}, err
+			}
+
+			allocation.PeriodicSpendLimit = &periodicSpendLimit
+		} else if limitLeft.Equal(allocation.SpendLimit) {
+			// the spend limit is unchanged if all transferred denominations have an unbounded spend limit
+			return authz.AcceptResponse{Accept: true, Delete: false, Updated: nil}, nil
 		}
 
 		if limitLeft.IsZero() {
@@ -71,13 +104,9 @@ func (a TransferAuthorization) Accept(ctx context.Context, msg proto.Message) (a
 				Allocations: a.Allocations,
 			}}, nil
 		}
-		a.Allocations[index] = Allocation{
-			SourcePort:        allocation.SourcePort,
-			SourceChannel:     allocation.SourceChannel,
-			SpendLimit:        limitLeft,
-			AllowList:         allocation.AllowList,
-			AllowedPacketData: allocation.AllowedPacketData,
-		}
+
+		allocation.SpendLimit = limitLeft
+		a.Allocations[index] = allocation
 
 		return authz.AcceptResponse{Accept: true, Delete: false, Updated: &TransferAuthorization{
 			Allocations: a.Allocations,
@@ -125,12 +154,181 @@ func (a TransferAuthorization) ValidateBasic() error {
 			}
 			found[allocation.AllowList[i]] = true
 		}
+
+		if len(allocation.AllowList) > 0 && len(allocation.AllowedReceivers) > 0 {
+			return errorsmod.Wrap(ErrInvalidAuthorization, "allow list and allowed receivers cannot both be set")
+		}
+
+		foundPatterns := make(map[string]bool, 0)
+		for _, pattern := range allocation.AllowedReceivers {
+			if strings.TrimSpace(pattern) == "" {
+				return errorsmod.Wrap(ErrInvalidAuthorization, "allowed receiver pattern cannot be blank")
+			}
+			if foundPatterns[pattern] {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in allowed receivers %s", pattern)
+			}
+			foundPatterns[pattern] = true
+		}
+
+		if allocation.PeriodicSpendLimit != nil {
+			if err := allocation.PeriodicSpendLimit.Validate(); err != nil {
+				return err
+			}
+		}
+
+		for _, window := range allocation.AllowedTimeWindows {
+			if err := window.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if allocation.MaxTimeout < 0 {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "max timeout cannot be negative: %s", allocation.MaxTimeout)
+		}
+	}
+
+	return nil
+}
+
+// Migrate returns the authorization with the allow list of every allocation moved to its
+// allowed receivers, and a boolean indicating if any allocation was updated. Allocations
+// with an allow list entry containing the "*" wildcard are left unchanged, as the entry
+// would otherwise match receivers other than the exact address.
+func (a TransferAuthorization) Migrate() (*TransferAuthorization, bool) {
+	migrated := false
+	allocations := make([]Allocation, len(a.Allocations))
+	for i, allocation := range a.Allocations {
+		containsWildcard := slices.ContainsFunc(allocation.AllowList, func(addr string) bool {
+			return strings.Contains(addr, AllowAllPacketDataKeys)
+		})
+
+		if len(allocation.AllowList) > 0 && !containsWildcard {
+			allocation.AllowedReceivers = slices.Concat(allocation.AllowedReceivers, allocation.AllowList)
+			allocation.AllowList = nil
+			migrated = true
+		}
+
+		allocations[i] = allocation
+	}
+
+	return NewTransferAuthorization(allocations...), migrated
+}
+
+// NewPeriodicSpendLimit creates a new PeriodicSpendLimit instance. The first period
+// starts with the first transfer using the spend limit.
+func NewPeriodicSpendLimit(period time.Duration, periodSpendLimit sdk.Coins) *PeriodicSpendLimit {
+	return &PeriodicSpendLimit{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Validate performs a basic validation of the PeriodicSpendLimit fields.
+func (p PeriodicSpendLimit) Validate() error {
+	if p.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "period must be positive: %s", p.Period)
+	}
+
+	if p.PeriodSpendLimit.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "period spend limit cannot be empty")
+	}
+
+	if err := p.PeriodSpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period spend limit: %s", err)
+	}
+
+	if err := p.PeriodCanSpend.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period can spend: %s", err)
+	}
+
+	return nil
+}
+
+// spend returns the periodic spend limit after the given coins are spent at the provided block time.
+// The amount which can be spent is reset to the period spend limit once the current period has ended,
+// an error is returned if the coins exceed the amount which can be spent in the period.
+func (p PeriodicSpendLimit) spend(blockTime time.Time, coins sdk.Coins) (PeriodicSpendLimit, error) {
+	if !blockTime.Before(p.PeriodReset) {
+		p.PeriodCanSpend = p.PeriodSpendLimit
+		p.PeriodReset = p.PeriodReset.Add(p.Period)
+		// start a new period from the block time if more than one period has passed
+		if blockTime.After(p.PeriodReset) {
+			p.PeriodReset = blockTime.Add(p.Period)
+		}
+	}
+
+	for _, coin := range coins {
+		// denominations which are not part of the period spend limit are not limited per period
+		if p.PeriodSpendLimit.AmountOf(coin.Denom).IsZero() {
+			continue
+		}
+
+		var isNegative bool
+		p.PeriodCanSpend, isNegative = p.PeriodCanSpend.SafeSub(coin)
+		if isNegative {
+			return PeriodicSpendLimit{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of %s is more than period spend limit, period resets at %s", coin.Denom, p.PeriodReset)
+		}
+	}
+
+	return p, nil
+}
+
+// NewTimeWindow creates a new TimeWindow instance
+func NewTimeWindow(startTime, endTime time.Time) TimeWindow {
+	return TimeWindow{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+// Validate performs a basic validation of the TimeWindow fields.
+func (w TimeWindow) Validate() error {
+	if !w.EndTime.After(w.StartTime) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "time window end time %s must be after start time %s", w.EndTime, w.StartTime)
+	}
+
+	return nil
+}
+
+// Contains returns true if the provided time is within the time window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	return !t.Before(w.StartTime) && t.Before(w.EndTime)
+}
+
+// isWithinTimeWindows returns true if the block time is contained in any of the time windows,
+// or if no time windows are provided.
+func isWithinTimeWindows(blockTime time.Time, windows []TimeWindow) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(windows, func(window TimeWindow) bool {
+		return window.Contains(blockTime)
+	})
+}
+
+// validateTimeout returns an error if the timeout timestamp of the transfer exceeds the maximum
+// timeout relative to the block time. Transfers without timeout timestamp are rejected if the
+// maximum timeout is set.
+func validateTimeout(blockTime time.Time, timeoutTimestamp uint64, maxTimeout time.Duration) error {
+	if maxTimeout == 0 {
+		return nil
+	}
+
+	if timeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "timeout timestamp must be set when the allocation has a maximum timeout")
+	}
+
+	maxTimeoutTimestamp := uint64(blockTime.Add(maxTimeout).UnixNano())
+	if timeoutTimestamp > maxTimeoutTimestamp {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "timeout timestamp %d exceeds maximum timeout of %s", timeoutTimestamp, maxTimeout)
 	}
 
 	return nil
 }
 
 // isAllowedAddress returns a boolean indicating if the receiver address is valid for transfer.
+// Addresses are compared by their bytes if both can be decoded by the receiver address codec.
 // gasCostPerIteration gas is consumed for each iteration.
 func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) bool {
 	if len(allowedAddrs) == 0 {
@@ -141,13 +339,58 @@ func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) b
 
 	for _, addr := range allowedAddrs {
 		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer authorization")
-		if addr == receiver {
+		if receiversEqual(addr, receiver) {
 			return true
 		}
 	}
 	return false
 }
 
+// isAllowedReceiver returns a boolean indicating if the receiver address matches any of the
+// allowed receiver patterns. gasCostPerIteration gas is consumed for each iteration.
+func isAllowedReceiver(ctx sdk.Context, receiver string, allowedReceivers []string) bool {
+	if len(allowedReceivers) == 0 {
+		return true
+	}
+
+	gasCostPerIteration := ctx.KVGasConfig().IterNextCostFlat
+
+	return slices.ContainsFunc(allowedReceivers, func(pattern string) bool {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "transfer authorization")
+
+		return matchReceiverPattern(pattern, receiver)
+	})
+}
+
+// matchReceiverPattern returns true if the receiver matches the pattern. The "*" wildcard
+// in the pattern matches any sequence of characters, including the empty sequence, while
+// all other characters must match exactly. Patterns without wildcard are compared by their
+// address bytes if both can be decoded by the receiver address codec.
+func matchReceiverPattern(pattern, receiver string) bool {
+	parts := strings.Split(pattern, AllowAllPacketDataKeys)
+	if len(parts) == 1 {
+		return receiversEqual(pattern, receiver)
+	}
+
+	// the receiver must start with the first part and end with the last part,
+	// the parts in between must be found in order
+	if !strings.HasPrefix(receiver, parts[0]) {
+		return false
+	}
+	receiver = receiver[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(receiver, part)
+		if idx < 0 {
+			return false
+		}
+		receiver = receiver[idx+len(part):]
+	}
+
+	return strings.HasSuffix(receiver, last)
+}
+
 // validateMemo returns a nil error indicating if the memo is valid for transfer.
 func validateMemo(ctx sdk.Context, memo string, allowedMemos []string) error {
 	// if the allow list is empty, then the memo must be an empty string
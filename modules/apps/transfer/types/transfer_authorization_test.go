@@ -1,7 +1,10 @@
 package types_test
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -9,6 +12,7 @@ import (
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -202,6 +206,138 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().True(sdkmath.NewInt(100).Equal(remainder))
 			},
 		},
+		{
+			"success: receiver matches allowed receiver pattern",
+			func() {
+				transferAuthz.Allocations[0].AllowList = nil
+				transferAuthz.Allocations[0].AllowedReceivers = []string{"osmo1*", "cosmos1*"}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+			},
+		},
+		{
+			"success: periodic spend limit is tracked",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(24*time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80))))
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicSpendLimit := updatedAuthz.Allocations[0].PeriodicSpendLimit
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30))), periodicSpendLimit.PeriodCanSpend)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(24*time.Hour), periodicSpendLimit.PeriodReset)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), updatedAuthz.Allocations[0].SpendLimit)
+			},
+		},
+		{
+			"success: periodic spend limit is reset after the period ends",
+			func() {
+				blockTime := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicSpendLimit = &types.PeriodicSpendLimit{
+					Period:           24 * time.Hour,
+					PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80))),
+					PeriodCanSpend:   sdk.NewCoins(),
+					PeriodReset:      blockTime.Add(-time.Hour),
+				}
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				// the next period starts where the previous one ended
+				periodicSpendLimit := updatedAuthz.Allocations[0].PeriodicSpendLimit
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30))), periodicSpendLimit.PeriodCanSpend)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(23*time.Hour), periodicSpendLimit.PeriodReset)
+			},
+		},
+		{
+			"success: transfer within allowed time window",
+			func() {
+				blockTime := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].AllowedTimeWindows = []types.TimeWindow{
+					types.NewTimeWindow(blockTime.Add(-2*time.Hour), blockTime.Add(-time.Hour)),
+					types.NewTimeWindow(blockTime, blockTime.Add(time.Hour)),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"success: timeout timestamp within max timeout",
+			func() {
+				transferAuthz.Allocations[0].MaxTimeout = time.Hour
+				msgTransfer.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"requested transfer amount is more than the period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(24*time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(40))))
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"transfer outside of allowed time windows",
+			func() {
+				blockTime := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].AllowedTimeWindows = []types.TimeWindow{
+					types.NewTimeWindow(blockTime.Add(time.Hour), blockTime.Add(2*time.Hour)),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"timeout timestamp exceeds max timeout",
+			func() {
+				transferAuthz.Allocations[0].MaxTimeout = time.Hour
+				msgTransfer.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour + 1).UnixNano())
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"timeout timestamp not set with max timeout",
+			func() {
+				transferAuthz.Allocations[0].MaxTimeout = time.Hour
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"receiver address does not match allowed receiver patterns",
+			func() {
+				transferAuthz.Allocations[0].AllowList = nil
+				transferAuthz.Allocations[0].AllowedReceivers = []string{"osmo1*"}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInvalidAddress)
+			},
+		},
 		{
 			"no spend limit set for MsgTransfer port/channel",
 			func() {
@@ -221,6 +357,16 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().Error(err)
 			},
 		},
+		{
+			"success: allow list contains hex form of receiver",
+			func() {
+				transferAuthz.Allocations[0].AllowList = []string{types.HexAddressPrefix + hex.EncodeToString(sdk.MustAccAddressFromBech32(ibctesting.TestAccAddress))}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
 		{
 			"receiver address not permitted via allow list",
 			func() {
@@ -322,6 +468,68 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			true,
 		},
+		{
+			"success: with allowed receivers, periodic spend limit, time windows and max timeout",
+			func() {
+				transferAuthz.Allocations[0].AllowList = nil
+				transferAuthz.Allocations[0].AllowedReceivers = []string{"cosmos1*", ibctesting.TestAccAddress}
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(24*time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))))
+				transferAuthz.Allocations[0].AllowedTimeWindows = []types.TimeWindow{types.NewTimeWindow(time.Unix(0, 0), time.Unix(3600, 0))}
+				transferAuthz.Allocations[0].MaxTimeout = time.Hour
+			},
+			true,
+		},
+		{
+			"allow list and allowed receivers both set",
+			func() {
+				transferAuthz.Allocations[0].AllowedReceivers = []string{"cosmos1*"}
+			},
+			false,
+		},
+		{
+			"blank allowed receiver pattern",
+			func() {
+				transferAuthz.Allocations[0].AllowList = nil
+				transferAuthz.Allocations[0].AllowedReceivers = []string{" "}
+			},
+			false,
+		},
+		{
+			"duplicate entry in allowed receivers",
+			func() {
+				transferAuthz.Allocations[0].AllowList = nil
+				transferAuthz.Allocations[0].AllowedReceivers = []string{"cosmos1*", "cosmos1*"}
+			},
+			false,
+		},
+		{
+			"periodic spend limit with zero period",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(0, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))))
+			},
+			false,
+		},
+		{
+			"periodic spend limit with empty period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins())
+			},
+			false,
+		},
+		{
+			"time window ending before it starts",
+			func() {
+				transferAuthz.Allocations[0].AllowedTimeWindows = []types.TimeWindow{types.NewTimeWindow(time.Unix(3600, 0), time.Unix(0, 0))}
+			},
+			false,
+		},
+		{
+			"negative max timeout",
+			func() {
+				transferAuthz.Allocations[0].MaxTimeout = -time.Hour
+			},
+			false,
+		},
 		{
 			"empty allocations",
 			func() {
@@ -414,3 +622,81 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestTransferAuthorizationAllowedReceivers() {
+	receiver := ibctesting.TestAccAddress
+	hexReceiver := types.HexAddressPrefix + hex.EncodeToString(sdk.MustAccAddressFromBech32(receiver))
+
+	testCases := []struct {
+		name    string
+		pattern string
+		expPass bool
+	}{
+		{"exact address", receiver, true},
+		{"hex form of address", hexReceiver, true},
+		{"upper case hex form of address", strings.ToUpper(hexReceiver), true},
+		{"prefix", "cosmos1*", true},
+		{"suffix", "*" + receiver[len(receiver)-6:], true},
+		{"prefix and suffix", receiver[:10] + "*" + receiver[len(receiver)-6:], true},
+		{"multiple wildcards", "cosmos*" + receiver[15:20] + "*", true},
+		{"wildcard only", "*", true},
+		{"different address", ibctesting.InvalidID, false},
+		{"different prefix", "osmo1*", false},
+		{"different suffix", "*abc", false},
+		{"overlapping prefix and suffix", receiver + "*" + receiver, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			transferAuthz := types.NewTransferAuthorization(types.Allocation{
+				SourcePort:        types.PortID,
+				SourceChannel:     ibctesting.FirstChannelID,
+				SpendLimit:        sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit())),
+				AllowedReceivers:  []string{tc.pattern},
+				AllowedPacketData: []string{},
+			})
+
+			msgTransfer := types.NewMsgTransfer(types.PortID, ibctesting.FirstChannelID, ibctesting.TestCoin, ibctesting.TestAccAddress, receiver, suite.chainB.GetTimeoutHeight(), 0, "")
+
+			_, err := transferAuthz.Accept(suite.chainA.GetContext(), msgTransfer)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInvalidAddress)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestTransferAuthorizationMigrate() {
+	allocation := types.Allocation{
+		SourcePort:    types.PortID,
+		SourceChannel: ibctesting.FirstChannelID,
+		SpendLimit:    ibctesting.TestCoins,
+		AllowList:     []string{ibctesting.TestAccAddress},
+	}
+
+	unrestrictedAllocation := allocation
+	unrestrictedAllocation.SourceChannel = "channel-1"
+	unrestrictedAllocation.AllowList = nil
+
+	wildcardAllocation := allocation
+	wildcardAllocation.SourceChannel = "channel-2"
+	wildcardAllocation.AllowList = []string{"cosmos1*"}
+
+	migrated, ok := types.NewTransferAuthorization(allocation, unrestrictedAllocation, wildcardAllocation).Migrate()
+	suite.Require().True(ok)
+	suite.Require().NoError(migrated.ValidateBasic())
+
+	expAllocation := allocation
+	expAllocation.AllowList = nil
+	expAllocation.AllowedReceivers = []string{ibctesting.TestAccAddress}
+
+	// allow list entries containing the wildcard are not migrated as they only match the exact entry
+	suite.Require().Equal(types.NewTransferAuthorization(expAllocation, unrestrictedAllocation, wildcardAllocation), migrated)
+
+	_, ok = migrated.Migrate()
+	suite.Require().False(ok)
+}
@@ -29,14 +29,19 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			// SetUpgradedConsensusState always returns nil, hence the blank here.
 			_ = k.SetUpgradedConsensusState(ctx, plan.Height, bz)
 
-			keeper.EmitUpgradeChainEvent(ctx, plan.Height)
+			k.EmitUpgradeChainEvent(ctx, plan.Height)
 		}
 	}
 
 	// update the localhost client with the latest block height if it is active.
-	if clientState, found := k.GetClientState(ctx, exported.Localhost); found {
-		if k.GetClientStatus(ctx, clientState, exported.Localhost) == exported.Active {
-			k.UpdateLocalhostClient(ctx, clientState)
+	if _, found := k.GetClientState(ctx, exported.Localhost); found {
+		if k.GetClientStatus(ctx, exported.Localhost) == exported.Active {
+			k.UpdateLocalhostClient(ctx)
 		}
 	}
 }
+
+// EndBlocker emits the client expiry events for the clients which crossed the expiry warning threshold or expired.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.EmitClientExpiryEvents(ctx)
+}
@@ -114,6 +114,25 @@ func (suite *ClientTestSuite) TestBeginBlockerUpgradeEventsAbsence() {
 	suite.requireContainsEvent(cacheCtx.EventManager().Events(), types.EventTypeUpgradeChain, false)
 }
 
+func (suite *ClientTestSuite) TestEndBlockerClientExpiryEvents() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.ExpiryWarningThreshold = ibctesting.TrustingPeriod
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// the client is within the expiry warning threshold since it was created
+	ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	client.EndBlocker(ctx, suite.chainA.App.GetIBCKeeper().ClientKeeper)
+	suite.requireContainsEvent(ctx.EventManager().Events(), types.EventTypeClientExpiryWarning, true)
+
+	// the expiry warning is only emitted once
+	ctx = suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	client.EndBlocker(ctx, suite.chainA.App.GetIBCKeeper().ClientKeeper)
+	suite.requireContainsEvent(ctx.EventManager().Events(), types.EventTypeClientExpiryWarning, false)
+}
+
 // requireContainsEvent verifies if an event of a specific type was emitted.
 func (suite *ClientTestSuite) requireContainsEvent(events sdk.Events, eventType string, shouldContain bool) {
 	found := false
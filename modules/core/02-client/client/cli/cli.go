@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientStorage(),
+		GetCmdQueryExpiringClients(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
@@ -49,6 +51,7 @@ func NewTxCmd() *cobra.Command {
 		newSubmitMisbehaviourCmd(), // Deprecated
 		newUpgradeClientCmd(),
 		newSubmitRecoverClientProposalCmd(),
+		newSubmitPruneConsensusStatesProposalCmd(),
 		newScheduleIBCUpgradeProposalCmd(),
 	)
 
@@ -17,6 +17,7 @@ import (
 
 const (
 	flagLatestHeight = "latest-height"
+	flagWithin       = "within"
 )
 
 // GetCmdQueryClientStates defines the command to query all the light clients
@@ -125,6 +126,88 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientStorage defines the command to query the storage used by a client.
+func GetCmdQueryClientStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "storage [client-id]",
+		Short:   "Query client storage",
+		Long:    "Query the number of consensus states, keys and bytes stored by a client",
+		Example: fmt.Sprintf("%s query %s %s storage [client-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClientStorageRequest{
+				ClientId: clientID,
+			}
+
+			res, err := queryClient.ClientStorage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExpiringClients defines the command to query the expiry of the clients.
+func GetCmdQueryExpiringClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expiring",
+		Short:   "Query the expiry of all clients",
+		Long:    "Query the latest consensus timestamp, trusting period and time remaining before expiry of all clients which may expire, along with the connections and channels depending on them",
+		Example: fmt.Sprintf("%s query %s %s expiring --%s 72h", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagWithin),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			within, err := cmd.Flags().GetDuration(flagWithin)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryExpiringClientsRequest{
+				Within:     within,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ExpiringClients(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(flagWithin, 0, "only query the clients which expire within the given duration, or which are expired")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring clients")
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -302,6 +302,66 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// newSubmitPruneConsensusStatesProposalCmd defines the command to prune the consensus states of IBC light clients.
+func newSubmitPruneConsensusStatesProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-consensus-states [client-id] [flags]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "prune the consensus states of IBC clients",
+		Long: `Submit a proposal to prune the expired consensus states of an IBC client and the ones which are not
+		retained by the consensus state retention policy, along with an initial deposit.
+		The consensus states of all clients are pruned if no client identifier is specified.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var clientID string
+			if len(args) == 1 {
+				clientID = args[0]
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgPruneConsensusStates(authority, clientID)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgPruneConsensusStates{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create prune consensus states proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
 // newScheduleIBCUpgradeProposalCmd defines the command for submitting an IBC software upgrade proposal.
 func newScheduleIBCUpgradeProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
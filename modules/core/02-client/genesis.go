@@ -49,6 +49,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	for _, client := range gs.Clients {
+		k.IndexClientExpiry(ctx, client.ClientId)
+	}
+
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
 	// if the localhost already exists in state (included in the genesis file),
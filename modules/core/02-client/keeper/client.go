@@ -31,16 +31,22 @@ func (k Keeper) CreateClient(
 	}
 
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
-	clientStore := k.ClientStore(ctx, clientID)
 
-	if err := clientState.Initialize(ctx, k.cdc, clientStore, consensusState); err != nil {
+	lightClientModule, found := k.Route(clientID)
+	if !found {
+		return "", errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	if err := lightClientModule.Initialize(ctx, clientID, clientState, consensusState); err != nil {
 		return "", err
 	}
 
-	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
 		return "", errorsmod.Wrapf(types.ErrClientNotActive, "cannot create client (%s) with status %s", clientID, status)
 	}
 
+	k.IndexClientExpiry(ctx, clientID)
+
 	k.Logger(ctx).Info("client created at height", "client-id", clientID, "height", clientState.GetLatestHeight().String())
 
 	defer telemetry.IncrCounterWithLabels(
@@ -49,31 +55,72 @@ func (k Keeper) CreateClient(
 		[]metrics.Label{telemetry.NewLabel(types.LabelClientType, clientState.ClientType())},
 	)
 
-	emitCreateClientEvent(ctx, clientID, clientState)
+	k.emitCreateClientEvent(ctx, clientID, clientState)
 
 	return clientID, nil
 }
 
 // UpdateClient updates the consensus state and the state root from a provided header.
+// A client message implementing exported.ClientMessageBatch is applied using UpdateClientBatch.
 func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	if _, ok := clientMsg.(exported.ClientMessageBatch); ok {
+		return k.UpdateClientBatch(ctx, clientID, []exported.ClientMessage{clientMsg})
+	}
+
+	return k.updateClient(ctx, clientID, clientMsg)
+}
+
+// UpdateClientBatch applies an ordered list of client messages to the client. Each client message is
+// verified against the client state produced by the previous one. All updates are performed in a cache
+// context which is only written once every client message has been applied, an error therefore discards
+// the whole batch. If a client message is detected as misbehaviour the client is frozen and the remaining
+// client messages are not applied.
+func (k Keeper) UpdateClientBatch(ctx sdk.Context, clientID string, clientMsgs []exported.ClientMessage) error {
+	clientMsgs, err := types.FlattenClientMessages(clientMsgs)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	for i, clientMsg := range clientMsgs {
+		if err := k.updateClient(cacheCtx, clientID, clientMsg); err != nil {
+			return errorsmod.Wrapf(err, "failed to apply client message %d of %d", i, len(clientMsgs))
+		}
+
+		if status := k.GetClientStatus(cacheCtx, clientID); status != exported.Active {
+			k.Logger(ctx).Info("client batch update stopped", "client-id", clientID, "status", status, "applied", i+1, "total", len(clientMsgs))
+			break
+		}
+	}
+
+	writeFn()
+
+	return nil
+}
+
+// updateClient verifies the client message and updates the client state, or freezes the client if misbehaviour is detected.
+func (k Keeper) updateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
 	clientState, found := k.GetClientState(ctx, clientID)
 	if !found {
 		return errorsmod.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
+	lightClientModule, found := k.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
 
-	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
-	if err := clientState.VerifyClientMessage(ctx, k.cdc, clientStore, clientMsg); err != nil {
+	if err := lightClientModule.VerifyClientMessage(ctx, clientID, clientMsg); err != nil {
 		return err
 	}
 
-	foundMisbehaviour := clientState.CheckForMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+	foundMisbehaviour := lightClientModule.CheckForMisbehaviour(ctx, clientID, clientMsg)
 	if foundMisbehaviour {
-		clientState.UpdateStateOnMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+		lightClientModule.UpdateStateOnMisbehaviour(ctx, clientID, clientMsg)
 
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
 
@@ -87,12 +134,22 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 			},
 		)
 
-		emitSubmitMisbehaviourEvent(ctx, clientID, clientState)
+		k.emitSubmitMisbehaviourEvent(ctx, clientID, clientState)
 
 		return nil
 	}
 
-	consensusHeights := clientState.UpdateState(ctx, k.cdc, clientStore, clientMsg)
+	consensusHeights := lightClientModule.UpdateState(ctx, clientID, clientMsg)
+	k.IndexClientExpiry(ctx, clientID)
+
+	// incrementally prune the consensus states which are not retained by the consensus state retention policy
+	if retention := k.GetParams(ctx).ConsensusStateRetention; !retention.IsEmpty() {
+		if pruner, ok := lightClientModule.(types.ConsensusStatePruner); ok {
+			if _, err := pruner.PruneConsensusStates(ctx, clientID, retention, k.getMaxDelayPeriod(ctx, clientID), types.MaxConsensusStatesPrunedPerUpdate); err != nil {
+				return err
+			}
+		}
+	}
 
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
@@ -107,7 +164,7 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 	)
 
 	// emitting events in the keeper emits for both begin block and handler client updates
-	emitUpdateClientEvent(ctx, clientID, clientState.ClientType(), consensusHeights, k.cdc, clientMsg)
+	k.emitUpdateClientEvent(ctx, clientID, clientState.ClientType(), consensusHeights, k.cdc, clientMsg)
 
 	return nil
 }
@@ -117,23 +174,27 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exporte
 func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient exported.ClientState, upgradedConsState exported.ConsensusState,
 	upgradeClientProof, upgradeConsensusStateProof []byte,
 ) error {
-	clientState, found := k.GetClientState(ctx, clientID)
-	if !found {
+	if _, found := k.GetClientState(ctx, clientID); !found {
 		return errorsmod.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
+	lightClientModule, found := k.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
 
-	if status := k.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
-	if err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
+	if err := lightClientModule.VerifyUpgradeAndUpdateState(ctx, clientID,
 		upgradedClient, upgradedConsState, upgradeClientProof, upgradeConsensusStateProof,
 	); err != nil {
 		return errorsmod.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
 
+	k.IndexClientExpiry(ctx, clientID)
+
 	k.Logger(ctx).Info("client state upgraded", "client-id", clientID, "height", upgradedClient.GetLatestHeight().String())
 
 	defer telemetry.IncrCounterWithLabels(
@@ -145,15 +206,14 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 		},
 	)
 
-	emitUpgradeClientEvent(ctx, clientID, upgradedClient)
+	k.emitUpgradeClientEvent(ctx, clientID, upgradedClient)
 
 	return nil
 }
 
 // RecoverClient will retrieve the subject and substitute client.
-// A callback will occur to the subject client state with the client
-// prefixed store being provided for both the subject and the substitute client.
-// The IBC client implementations are responsible for validating the parameters of the
+// The recovery is routed to the light client module of the subject client.
+// The light client modules are responsible for validating the parameters of the
 // substitute (ensuring they match the subject's parameters) as well as copying
 // the necessary consensus states from the substitute to the subject client
 // store. The substitute must be Active and the subject must not be Active.
@@ -163,9 +223,12 @@ func (k Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClient
 		return errorsmod.Wrapf(types.ErrClientNotFound, "subject client with ID %s", subjectClientID)
 	}
 
-	subjectClientStore := k.ClientStore(ctx, subjectClientID)
+	lightClientModule, found := k.Route(subjectClientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
+	}
 
-	if status := k.GetClientStatus(ctx, subjectClientState, subjectClientID); status == exported.Active {
+	if status := k.GetClientStatus(ctx, subjectClientID); status == exported.Active {
 		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover %s subject client", exported.Active)
 	}
 
@@ -178,16 +241,16 @@ func (k Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClient
 		return errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
 	}
 
-	substituteClientStore := k.ClientStore(ctx, substituteClientID)
-
-	if status := k.GetClientStatus(ctx, substituteClientState, substituteClientID); status != exported.Active {
+	if status := k.GetClientStatus(ctx, substituteClientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "substitute client is not %s, status is %s", exported.Active, status)
 	}
 
-	if err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState); err != nil {
+	if err := lightClientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
 		return errorsmod.Wrap(err, "failed to validate substitute client")
 	}
 
+	k.IndexClientExpiry(ctx, subjectClientID)
+
 	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
 
 	defer telemetry.IncrCounterWithLabels(
@@ -201,7 +264,63 @@ func (k Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClient
 	)
 
 	// emitting events in the keeper for recovering clients
-	emitRecoverClientEvent(ctx, subjectClientID, substituteClientState.ClientType())
+	k.emitRecoverClientEvent(ctx, subjectClientID, substituteClientState.ClientType())
 
 	return nil
 }
+
+// PruneConsensusStates prunes the expired consensus states of the client and the ones which are not retained by the
+// consensus state retention policy of the client parameters. A limit of zero prunes all of them. The client must be
+// routed to a light client module implementing types.ConsensusStatePruner. The number of consensus states pruned is returned.
+func (k Keeper) PruneConsensusStates(ctx sdk.Context, clientID string, limit uint64) (uint64, error) {
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return 0, errorsmod.Wrapf(types.ErrClientNotFound, "cannot prune consensus states of client with ID %s", clientID)
+	}
+
+	lightClientModule, found := k.Route(clientID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	pruner, ok := lightClientModule.(types.ConsensusStatePruner)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrClientTypeNotSupported, "light client module of client %s does not support pruning consensus states", clientID)
+	}
+
+	totalPruned, err := pruner.PruneConsensusStates(ctx, clientID, k.GetParams(ctx).ConsensusStateRetention, k.getMaxDelayPeriod(ctx, clientID), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	k.Logger(ctx).Info("consensus states pruned", "client-id", clientID, "total-pruned", totalPruned)
+
+	return totalPruned, nil
+}
+
+// PruneAllConsensusStates prunes, for every client whose light client module implements types.ConsensusStatePruner,
+// the expired consensus states and the ones which are not retained by the consensus state retention policy.
+// The total number of consensus states pruned is returned.
+func (k Keeper) PruneAllConsensusStates(ctx sdk.Context) (uint64, error) {
+	var clientIDs []string
+	k.IterateClientStates(ctx, nil, func(clientID string, _ exported.ClientState) bool {
+		if lightClientModule, found := k.Route(clientID); found {
+			if _, ok := lightClientModule.(types.ConsensusStatePruner); ok {
+				clientIDs = append(clientIDs, clientID)
+			}
+		}
+
+		return false
+	})
+
+	var totalPruned uint64
+	for _, clientID := range clientIDs {
+		pruned, err := k.PruneConsensusStates(ctx, clientID, 0)
+		if err != nil {
+			return 0, errorsmod.Wrapf(err, "failed to prune consensus states of client %s", clientID)
+		}
+
+		totalPruned += pruned
+	}
+
+	return totalPruned, nil
+}
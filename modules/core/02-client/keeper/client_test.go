@@ -306,6 +306,170 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientBatch() {
+	var (
+		path       *ibctesting.Path
+		clientMsgs []exported.ClientMessage
+		expHeights []clienttypes.Height
+	)
+
+	// createHeadersFn creates n sequential headers for chainB, each trusted at the height of the previous one
+	createHeadersFn := func(n int) []*ibctm.Header {
+		trustedHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+		consState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, trustedHeight)
+		suite.Require().True(found)
+
+		timestamp := consState.(*ibctm.ConsensusState).Timestamp
+
+		var headers []*ibctm.Header
+		for i := 0; i < n; i++ {
+			height := trustedHeight.Increment().(clienttypes.Height)
+			timestamp = timestamp.Add(time.Second)
+
+			headers = append(headers, suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(height.RevisionHeight), trustedHeight, timestamp,
+				suite.chainB.Vals, suite.chainB.Vals, suite.chainB.Vals, suite.chainB.Signers))
+			trustedHeight = height
+		}
+
+		return headers
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expError  error
+		expFreeze bool
+	}{
+		{
+			"success: sequential headers",
+			func() {
+				for _, header := range createHeadersFn(3) {
+					clientMsgs = append(clientMsgs, header)
+					expHeights = append(expHeights, header.GetHeight().(clienttypes.Height))
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"success: header chain sharing the validator sets of the first header",
+			func() {
+				headers := createHeadersFn(3)
+				for i, header := range headers {
+					if i > 0 {
+						header.TrustedHeight = clienttypes.ZeroHeight()
+						header.ValidatorSet = nil
+						header.TrustedValidators = nil
+					}
+					expHeights = append(expHeights, header.GetHeight().(clienttypes.Height))
+				}
+
+				clientMsgs = []exported.ClientMessage{&ibctm.HeaderChain{Headers: headers}}
+			},
+			nil,
+			false,
+		},
+		{
+			"success: misbehaviour freezes the client and skips the remaining client messages",
+			func() {
+				headers := createHeadersFn(3)
+
+				// set a conflicting consensus state at the height of the second header
+				conflictConsState := headers[1].ConsensusState()
+				conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+				path.EndpointA.SetConsensusState(conflictConsState, headers[1].GetHeight())
+
+				clientMsgs = []exported.ClientMessage{headers[0], headers[1], headers[2]}
+				expHeights = []clienttypes.Height{headers[0].GetHeight().(clienttypes.Height)}
+			},
+			nil,
+			true,
+		},
+		{
+			"failure: empty batch",
+			func() {},
+			clienttypes.ErrInvalidClientMessageBatch,
+			false,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientMsgs = []exported.ClientMessage{createHeadersFn(1)[0]}
+				path.EndpointA.ClientID = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+			false,
+		},
+		{
+			"failure: header chain is empty",
+			func() {
+				clientMsgs = []exported.ClientMessage{&ibctm.HeaderChain{}}
+			},
+			clienttypes.ErrInvalidHeader,
+			false,
+		},
+		{
+			"failure: last header fails verification and the whole batch is discarded",
+			func() {
+				headers := createHeadersFn(3)
+				headers[2].TrustedHeight = headers[2].GetHeight().Increment().(clienttypes.Height)
+
+				clientMsgs = []exported.ClientMessage{headers[0], headers[1], headers[2]}
+				expHeights = []clienttypes.Height{headers[0].GetHeight().(clienttypes.Height), headers[1].GetHeight().(clienttypes.Height)}
+			},
+			clienttypes.ErrConsensusStateNotFound,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			suite.coordinator.CommitNBlocks(suite.chainB, 3)
+
+			clientMsgs = nil
+			expHeights = nil
+			prevClientState := path.EndpointA.GetClientState()
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClientBatch(suite.chainA.GetContext(), path.EndpointA.ClientID, clientMsgs)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				for _, height := range expHeights {
+					_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, height)
+					suite.Require().True(found)
+				}
+
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				if tc.expFreeze {
+					suite.Require().False(clientState.FrozenHeight.IsZero())
+				} else {
+					suite.Require().Equal(expHeights[len(expHeights)-1], clientState.LatestHeight)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				if path.EndpointA.ClientID != ibctesting.InvalidID {
+					// no intermediate update must have been written
+					suite.Require().Equal(prevClientState, path.EndpointA.GetClientState())
+					for _, height := range expHeights {
+						_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, height)
+						suite.Require().False(found)
+					}
+				}
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path                                             *ibctesting.Path
@@ -686,3 +850,153 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateClientConsensusStateRetention() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(2, 0, 0)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	for i := 0; i < 4; i++ {
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+
+		res, err := suite.chainA.QueryServer.ClientStorage(suite.chainA.GetContext(), &clienttypes.QueryClientStorageRequest{ClientId: path.EndpointA.ClientID})
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(2), res.ConsensusStates)
+	}
+
+	// the latest consensus state is retained
+	_, found := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, path.EndpointA.GetClientState().GetLatestHeight())
+	suite.Require().True(found)
+}
+
+// TestUpdateClientConsensusStateRetentionDelayPeriod tests that the consensus states processed within the delay period
+// of a connection of the client are not pruned by the max consensus states rule.
+func (suite *KeeperTestSuite) TestUpdateClientConsensusStateRetentionDelayPeriod() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ConnectionConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+	path.EndpointB.ConnectionConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+	suite.coordinator.SetupConnections(path)
+
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(2, 0, 0)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	for i := 0; i < 2; i++ {
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+	}
+
+	res, err := suite.chainA.QueryServer.ClientStorage(suite.chainA.GetContext(), &clienttypes.QueryClientStorageRequest{ClientId: path.EndpointA.ClientID})
+	suite.Require().NoError(err)
+	suite.Require().Greater(res.ConsensusStates, uint64(2))
+
+	// the consensus states may be pruned once the delay period has passed
+	suite.coordinator.IncrementTimeBy(time.Hour)
+
+	_, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, 0)
+	suite.Require().NoError(err)
+
+	res, err = suite.chainA.QueryServer.ClientStorage(suite.chainA.GetContext(), &clienttypes.QueryClientStorageRequest{ClientId: path.EndpointA.ClientID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.ConsensusStates)
+}
+
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPruned uint64
+		expErr    error
+	}{
+		{
+			"success",
+			func() {},
+			3,
+			nil,
+		},
+		{
+			"success: no consensus states pruned with an empty retention policy",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.ConsensusStateRetention = clienttypes.ConsensusStateRetention{}
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			0,
+			nil,
+		},
+		{
+			"client does not exist",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"light client module does not support pruning",
+			func() {
+				clientID = suite.solomachine.CreateClient(suite.chainA)
+			},
+			0,
+			clienttypes.ErrClientTypeNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientID = path.EndpointA.ClientID
+
+			for i := 0; i < 3; i++ {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			}
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(1, 0, 0)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			tc.malleate()
+
+			pruned, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneConsensusStates(suite.chainA.GetContext(), clientID, 0)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneAllConsensusStates() {
+	paths := []*ibctesting.Path{ibctesting.NewPath(suite.chainA, suite.chainB), ibctesting.NewPath(suite.chainA, suite.chainB)}
+	for _, path := range paths {
+		suite.coordinator.SetupClients(path)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+	}
+
+	// clients whose light client module does not support pruning are skipped
+	suite.solomachine.CreateClient(suite.chainA)
+
+	params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(1, 0, 0)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	pruned, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.PruneAllConsensusStates(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), pruned)
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -16,7 +19,7 @@ import (
 )
 
 // emitCreateClientEvent emits a create client event
-func emitCreateClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+func (k Keeper) emitCreateClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateClient,
@@ -29,10 +32,16 @@ func emitCreateClientEvent(ctx sdk.Context, clientID string, clientState exporte
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventCreateClient{
+		ClientId:        clientID,
+		ClientType:      clientState.ClientType(),
+		ConsensusHeight: newHeightFromHeightI(clientState.GetLatestHeight()),
+	})
 }
 
 // emitUpdateClientEvent emits an update client event
-func emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string, consensusHeights []exported.Height, cdc codec.BinaryCodec, clientMsg exported.ClientMessage) {
+func (k Keeper) emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string, consensusHeights []exported.Height, cdc codec.BinaryCodec, clientMsg exported.ClientMessage) {
 	// Marshal the ClientMessage as an Any and encode the resulting bytes to hex.
 	// This prevents the event value from containing invalid UTF-8 characters
 	// which may cause data to be lost when JSON encoding/decoding.
@@ -64,10 +73,28 @@ func emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	clientMsgAny, err := types.PackClientMessage(clientMsg)
+	if err != nil {
+		k.Logger(ctx).Error("failed to pack client message for typed event", "client-id", clientID, "error", err)
+		return
+	}
+
+	typedConsensusHeights := make([]types.Height, len(consensusHeights))
+	for i, height := range consensusHeights {
+		typedConsensusHeights[i] = newHeightFromHeightI(height)
+	}
+
+	k.emitTypedEvent(ctx, &types.EventUpdateClient{
+		ClientId:         clientID,
+		ClientType:       clientType,
+		ConsensusHeights: typedConsensusHeights,
+		ClientMessage:    clientMsgAny,
+	})
 }
 
 // emitUpgradeClientEvent emits an upgrade client event
-func emitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+func (k Keeper) emitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUpgradeClient,
@@ -80,10 +107,16 @@ func emitUpgradeClientEvent(ctx sdk.Context, clientID string, clientState export
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventUpgradeClient{
+		ClientId:        clientID,
+		ClientType:      clientState.ClientType(),
+		ConsensusHeight: newHeightFromHeightI(clientState.GetLatestHeight()),
+	})
 }
 
 // emitSubmitMisbehaviourEvent emits a client misbehaviour event
-func emitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+func (k Keeper) emitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSubmitMisbehaviour,
@@ -95,10 +128,15 @@ func emitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientState e
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventSubmitMisbehaviour{
+		ClientId:   clientID,
+		ClientType: clientState.ClientType(),
+	})
 }
 
 // emitRecoverClientEvent emits a recover client event
-func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
+func (k Keeper) emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRecoverClient,
@@ -110,10 +148,15 @@ func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventRecoverClient{
+		SubjectClientId: clientID,
+		ClientType:      clientType,
+	})
 }
 
 // emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
-func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
+func (k Keeper) emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeScheduleIBCSoftwareUpgrade,
@@ -125,10 +168,15 @@ func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height i
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventScheduleIBCSoftwareUpgrade{
+		Title:  title,
+		Height: height,
+	})
 }
 
 // EmitUpgradeChainEvent emits an upgrade chain event.
-func EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
+func (k Keeper) EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUpgradeChain,
@@ -140,4 +188,61 @@ func EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventUpgradeChain{
+		Height:       height,
+		UpgradeStore: upgradetypes.StoreKey,
+	})
+}
+
+// emitClientExpiryWarningEvent emits a client expiry warning event
+func (k Keeper) emitClientExpiryWarningEvent(ctx sdk.Context, clientID, clientType string, expiryTime time.Time) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClientExpiryWarning,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyExpiryTime, strconv.FormatInt(expiryTime.UnixNano(), 10)),
+		),
+	)
+
+	k.emitTypedEvent(ctx, &types.EventClientExpiryWarning{
+		ClientId:   clientID,
+		ClientType: clientType,
+		ExpiryTime: expiryTime,
+	})
+}
+
+// emitClientExpiredEvent emits a client expired event
+func (k Keeper) emitClientExpiredEvent(ctx sdk.Context, clientID, clientType string, expiryTime time.Time) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClientExpired,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyExpiryTime, strconv.FormatInt(expiryTime.UnixNano(), 10)),
+		),
+	)
+
+	k.emitTypedEvent(ctx, &types.EventClientExpired{
+		ClientId:   clientID,
+		ClientType: clientType,
+		ExpiryTime: expiryTime,
+	})
+}
+
+// emitTypedEvent emits the given typed event if typed events are enabled in the client params.
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if !k.GetParams(ctx).EmitTypedEvents {
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit typed event", "type", proto.MessageName(event), "error", err)
+	}
+}
+
+// newHeightFromHeightI returns the height with the revision number and height of the given height.
+func newHeightFromHeightI(height exported.Height) types.Height {
+	return types.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
 }
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	testCases := []struct {
+		name            string
+		emitTypedEvents bool
+	}{
+		{"typed events enabled", true},
+		{"typed events disabled", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.EmitTypedEvents = tc.emitTypedEvents
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			trustedHeight, ok := path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+			suite.Require().True(ok)
+
+			header, err := suite.chainA.ConstructUpdateTMClientHeaderWithTrustedHeight(path.EndpointB.Chain, path.EndpointA.ClientID, trustedHeight)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+			suite.Require().NoError(err)
+
+			typedEvents, err := ibctesting.ParseTypedEvents(ctx.EventManager().ABCIEvents())
+			suite.Require().NoError(err)
+
+			if tc.emitTypedEvents {
+				clientMsgAny, err := types.PackClientMessage(header)
+				suite.Require().NoError(err)
+
+				ibctesting.AssertTypedEvents(&suite.Suite, []proto.Message{
+					&types.EventUpdateClient{
+						ClientId:         path.EndpointA.ClientID,
+						ClientType:       exported.Tendermint,
+						ConsensusHeights: []types.Height{header.GetHeight().(types.Height)},
+						ClientMessage:    clientMsgAny,
+					},
+				}, ctx.EventManager().ABCIEvents())
+			} else {
+				suite.Require().Empty(typedEvents)
+			}
+		})
+	}
+}
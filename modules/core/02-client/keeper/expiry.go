@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// GetClientExpiry returns the expiry of the client, computed from the timestamp of its latest consensus state and its trusting
+// period. False is returned if the light client module of the client does not implement types.TrustingPeriodProvider or if
+// the expiry cannot be computed. The connections and channels which depend on the client are not set.
+func (k Keeper) GetClientExpiry(ctx sdk.Context, clientID string, clientState exported.ClientState) (types.IdentifiedClientExpiry, bool) {
+	lightClientModule, found := k.Route(clientID)
+	if !found {
+		return types.IdentifiedClientExpiry{}, false
+	}
+
+	trustingPeriodProvider, ok := lightClientModule.(types.TrustingPeriodProvider)
+	if !ok {
+		return types.IdentifiedClientExpiry{}, false
+	}
+
+	trustingPeriod, err := trustingPeriodProvider.TrustingPeriod(ctx, clientID)
+	if err != nil {
+		return types.IdentifiedClientExpiry{}, false
+	}
+
+	latestHeight := clientState.GetLatestHeight()
+	timestamp, err := lightClientModule.TimestampAtHeight(ctx, clientID, latestHeight)
+	if err != nil {
+		return types.IdentifiedClientExpiry{}, false
+	}
+
+	latestTimestamp := time.Unix(0, int64(timestamp)).UTC()
+	timeRemaining := max(latestTimestamp.Add(trustingPeriod).Sub(ctx.BlockTime()), 0)
+
+	return types.IdentifiedClientExpiry{
+		ClientId:        clientID,
+		Status:          k.GetClientStatus(ctx, clientID).String(),
+		LatestHeight:    newHeightFromHeightI(latestHeight),
+		LatestTimestamp: latestTimestamp,
+		TrustingPeriod:  trustingPeriod,
+		TimeRemaining:   timeRemaining,
+	}, true
+}
+
+// IndexClientExpiry queues the client by the expiry time computed from its latest consensus state and trusting period,
+// replacing its previous entry in the client expiry queue. It must be called whenever the latest consensus state or the
+// trusting period of the client may change. The client is removed from the queue if its expiry cannot be computed.
+func (k Keeper) IndexClientExpiry(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.ClientExpiryTimeKey(clientID)); len(bz) != 0 {
+		store.Delete(types.ClientExpiryQueueKey(sdk.BigEndianToUint64(bz), clientID))
+		store.Delete(types.ClientExpiryTimeKey(clientID))
+	}
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return
+	}
+
+	expiry, ok := k.GetClientExpiry(ctx, clientID, clientState)
+	if !ok {
+		return
+	}
+
+	expiryTime := uint64(expiry.LatestTimestamp.Add(expiry.TrustingPeriod).UnixNano())
+	store.Set(types.ClientExpiryQueueKey(expiryTime, clientID), []byte(clientID))
+	store.Set(types.ClientExpiryTimeKey(clientID), sdk.Uint64ToBigEndian(expiryTime))
+}
+
+// EmitClientExpiryEvents emits an expiry warning event for every client whose time remaining before expiry fell below the
+// expiry warning threshold since the last check, and an expired event for every client which expired since the last check.
+// Only the entries of the client expiry queue which crossed either boundary since the last check are read. Frozen clients
+// are ignored. Nothing is emitted if the expiry warning threshold is zero.
+func (k Keeper) EmitClientExpiryEvents(ctx sdk.Context) {
+	threshold := k.GetParams(ctx).ExpiryWarningThreshold
+	if threshold == 0 {
+		return
+	}
+
+	lastCheckTime := k.getClientExpiryCheckTime(ctx)
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if blockTime <= lastCheckTime {
+		return
+	}
+
+	// the clients expiring in (lastCheckTime, blockTime] expired since the last check
+	k.iterateClientExpiryQueue(ctx, lastCheckTime, blockTime, func(clientID string, expiryTime time.Time) {
+		k.emitClientExpiryEvent(ctx, clientID, expiryTime, k.emitClientExpiredEvent)
+	})
+
+	// the clients expiring in (lastCheckTime+threshold, blockTime+threshold] crossed the expiry warning threshold since
+	// the last check, the clients which also expired since the last check are excluded as their expiry was emitted instead
+	warningStart := max(lastCheckTime+uint64(threshold), blockTime)
+	k.iterateClientExpiryQueue(ctx, warningStart, blockTime+uint64(threshold), func(clientID string, expiryTime time.Time) {
+		k.emitClientExpiryEvent(ctx, clientID, expiryTime, k.emitClientExpiryWarningEvent)
+	})
+
+	k.setClientExpiryCheckTime(ctx, blockTime)
+}
+
+// emitClientExpiryEvent emits the given client expiry event for the client if it is active or expired.
+func (k Keeper) emitClientExpiryEvent(ctx sdk.Context, clientID string, expiryTime time.Time, emit func(ctx sdk.Context, clientID, clientType string, expiryTime time.Time)) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return
+	}
+
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active && status != exported.Expired {
+		return
+	}
+
+	emit(ctx, clientID, clientState.ClientType(), expiryTime)
+}
+
+// iterateClientExpiryQueue calls cb, in order of expiry time, for every client of the client expiry queue whose
+// expiry time (in nanoseconds) is in the range (start, end].
+func (k Keeper) iterateClientExpiryQueue(ctx sdk.Context, start, end uint64, cb func(clientID string, expiryTime time.Time)) {
+	if start >= end {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ClientExpiryQueueTimePrefix(start+1), types.ClientExpiryQueueTimePrefix(end+1))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	prefixLen := len(types.ClientExpiryQueueTimePrefix(0))
+	for ; iterator.Valid(); iterator.Next() {
+		expiryTime := sdk.BigEndianToUint64(iterator.Key()[prefixLen-8 : prefixLen])
+		cb(string(iterator.Value()), time.Unix(0, int64(expiryTime)).UTC())
+	}
+}
+
+// getClientExpiryCheckTime returns the block time (in nanoseconds) at which the client expiry events were last
+// checked for, or zero if they were never checked for.
+func (k Keeper) getClientExpiryCheckTime(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyClientExpiryCheckTime))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setClientExpiryCheckTime sets the block time (in nanoseconds) at which the client expiry events were last checked for.
+func (k Keeper) setClientExpiryCheckTime(ctx sdk.Context, checkTime uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyClientExpiryCheckTime), sdk.Uint64ToBigEndian(checkTime))
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestGetClientExpiry() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	clientState := path.EndpointA.GetClientState()
+	latestTimestamp := path.EndpointA.GetConsensusState(clientState.GetLatestHeight()).(*ibctm.ConsensusState).Timestamp
+
+	ctx := suite.chainA.GetContext().WithBlockTime(latestTimestamp.Add(time.Hour))
+	expiry, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientExpiry(ctx, path.EndpointA.ClientID, clientState)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ClientID, expiry.ClientId)
+	suite.Require().Equal(exported.Active.String(), expiry.Status)
+	suite.Require().Equal(clientState.GetLatestHeight(), expiry.LatestHeight)
+	suite.Require().True(latestTimestamp.Equal(expiry.LatestTimestamp))
+	suite.Require().Equal(ibctesting.TrustingPeriod, expiry.TrustingPeriod)
+	suite.Require().Equal(ibctesting.TrustingPeriod-time.Hour, expiry.TimeRemaining)
+
+	// the time remaining of an expired client is zero
+	ctx = suite.chainA.GetContext().WithBlockTime(latestTimestamp.Add(ibctesting.TrustingPeriod + time.Hour))
+	expiry, found = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientExpiry(ctx, path.EndpointA.ClientID, clientState)
+	suite.Require().True(found)
+	suite.Require().Equal(exported.Expired.String(), expiry.Status)
+	suite.Require().Zero(expiry.TimeRemaining)
+
+	// clients which do not expire have no expiry
+	smClientID := suite.solomachine.CreateClient(suite.chainA)
+	_, found = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientExpiry(suite.chainA.GetContext(), smClientID, suite.chainA.GetClientState(smClientID))
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestIndexClientExpiry() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	expiryTime := func() uint64 {
+		clientState := path.EndpointA.GetClientState()
+		latestTimestamp := path.EndpointA.GetConsensusState(clientState.GetLatestHeight()).(*ibctm.ConsensusState).Timestamp
+		return uint64(latestTimestamp.Add(ibctesting.TrustingPeriod).UnixNano())
+	}
+
+	// the client is queued by its expiry time on creation
+	prevExpiryTime := expiryTime()
+	suite.Require().True(store.Has(types.ClientExpiryQueueKey(prevExpiryTime, path.EndpointA.ClientID)))
+
+	// the client is queued again by its new expiry time on update
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	store = suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	suite.Require().Greater(expiryTime(), prevExpiryTime)
+	suite.Require().False(store.Has(types.ClientExpiryQueueKey(prevExpiryTime, path.EndpointA.ClientID)))
+	suite.Require().True(store.Has(types.ClientExpiryQueueKey(expiryTime(), path.EndpointA.ClientID)))
+	suite.Require().Equal(sdk.Uint64ToBigEndian(expiryTime()), store.Get(types.ClientExpiryTimeKey(path.EndpointA.ClientID)))
+
+	// clients which do not expire are not queued
+	smClientID := suite.solomachine.CreateClient(suite.chainA)
+	suite.Require().False(store.Has(types.ClientExpiryTimeKey(smClientID)))
+}
+
+func (suite *KeeperTestSuite) TestEmitClientExpiryEvents() {
+	var (
+		path            *ibctesting.Path
+		threshold       time.Duration
+		latestTimestamp time.Time
+	)
+
+	// checkTimes are the offsets, relative to the latest consensus state timestamp, of the block
+	// times at which the client expiry events are successively checked for
+	testCases := []struct {
+		name       string
+		malleate   func()
+		checkTimes []time.Duration
+		expEvents  []string
+	}{
+		{
+			"no events before the expiry warning threshold",
+			func() {},
+			[]time.Duration{time.Minute, 30 * time.Minute},
+			[]string{"", ""},
+		},
+		{
+			"expiry warning emitted once when the threshold is crossed",
+			func() {},
+			[]time.Duration{time.Minute, time.Hour, 2 * time.Hour},
+			[]string{"", types.EventTypeClientExpiryWarning, ""},
+		},
+		{
+			"expired event emitted once when the client expires",
+			func() {},
+			[]time.Duration{2 * time.Hour, ibctesting.TrustingPeriod, ibctesting.TrustingPeriod + time.Hour},
+			[]string{types.EventTypeClientExpiryWarning, types.EventTypeClientExpired, ""},
+		},
+		{
+			"expired event emitted instead of the expiry warning if both are crossed",
+			func() {},
+			[]time.Duration{time.Minute, ibctesting.TrustingPeriod + time.Hour},
+			[]string{"", types.EventTypeClientExpired},
+		},
+		{
+			"expiry warning postponed when the client is updated",
+			func() {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			},
+			[]time.Duration{time.Minute, time.Hour, 2 * time.Hour},
+			[]string{"", "", types.EventTypeClientExpiryWarning},
+		},
+		{
+			"no events for frozen clients",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			[]time.Duration{time.Hour, ibctesting.TrustingPeriod},
+			[]string{"", ""},
+		},
+		{
+			"no events if the expiry warning threshold is zero",
+			func() {
+				threshold = 0
+			},
+			[]time.Duration{time.Hour, ibctesting.TrustingPeriod},
+			[]string{"", ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			clientState := path.EndpointA.GetClientState()
+			latestTimestamp = path.EndpointA.GetConsensusState(clientState.GetLatestHeight()).(*ibctm.ConsensusState).Timestamp
+			threshold = ibctesting.TrustingPeriod - time.Hour
+
+			tc.malleate()
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ExpiryWarningThreshold = threshold
+			params.EmitTypedEvents = true
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			for i, checkTime := range tc.checkTimes {
+				ctx := suite.chainA.GetContext().WithBlockTime(latestTimestamp.Add(checkTime)).WithEventManager(sdk.NewEventManager())
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.EmitClientExpiryEvents(ctx)
+
+				var eventTypes []string
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeClientExpiryWarning || event.Type == types.EventTypeClientExpired {
+						eventTypes = append(eventTypes, event.Type)
+
+						clientID, found := event.GetAttribute(types.AttributeKeyClientID)
+						suite.Require().True(found)
+						suite.Require().Equal(path.EndpointA.ClientID, clientID.Value)
+					}
+				}
+
+				if tc.expEvents[i] == "" {
+					suite.Require().Empty(eventTypes, "check %d", i)
+				} else {
+					suite.Require().Equal([]string{tc.expEvents[i]}, eventTypes, "check %d", i)
+				}
+
+				typedEvents, err := ibctesting.ParseTypedEvents(ctx.EventManager().ABCIEvents())
+				suite.Require().NoError(err)
+				suite.Require().Len(typedEvents, len(eventTypes))
+			}
+		})
+	}
+}
@@ -240,15 +240,14 @@ func (k Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	clientState, found := k.GetClientState(ctx, req.ClientId)
-	if !found {
+	if _, found := k.GetClientState(ctx, req.ClientId); !found {
 		return nil, status.Error(
 			codes.NotFound,
 			errorsmod.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
 		)
 	}
 
-	clientStatus := k.GetClientStatus(ctx, clientState, req.ClientId)
+	clientStatus := k.GetClientStatus(ctx, req.ClientId)
 
 	return &types.QueryClientStatusResponse{
 		Status: clientStatus.String(),
@@ -370,7 +369,7 @@ func (k Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMember
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	// cache the context to ensure clientState.VerifyMembership does not change state
+	// cache the context to ensure the light client module VerifyMembership does not change state
 	cachedCtx, _ := ctx.CacheContext()
 
 	// make sure we charge the higher level context even on panic
@@ -378,12 +377,16 @@ func (k Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMember
 		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify membership query")
 	}()
 
-	clientState, found := k.GetClientState(cachedCtx, req.ClientId)
-	if !found {
+	if _, found := k.GetClientState(cachedCtx, req.ClientId); !found {
 		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrClientNotFound, req.ClientId).Error())
 	}
 
-	if clientStatus := k.GetClientStatus(ctx, clientState, req.ClientId); clientStatus != exported.Active {
+	lightClientModule, found := k.Route(req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrRouteNotFound, req.ClientId).Error())
+	}
+
+	if clientStatus := k.GetClientStatus(ctx, req.ClientId); clientStatus != exported.Active {
 		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrapf(types.ErrClientNotActive, "cannot verify membership using client (%s) with status %s", req.ClientId, clientStatus).Error())
 	}
 
@@ -394,7 +397,7 @@ func (k Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMember
 		"verify membership query",
 	)
 
-	if err := clientState.VerifyMembership(cachedCtx, k.ClientStore(cachedCtx, req.ClientId), k.cdc, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath, req.Value); err != nil {
+	if err := lightClientModule.VerifyMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath, req.Value); err != nil {
 		k.Logger(ctx).Debug("proof verification failed", "key", req.MerklePath, "error", err)
 		return &types.QueryVerifyMembershipResponse{
 			Success: false,
@@ -405,3 +408,96 @@ func (k Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMember
 		Success: true,
 	}, nil
 }
+
+// ClientStorage implements the Query/ClientStorage gRPC method
+func (k Keeper) ClientStorage(c context.Context, req *types.QueryClientStorageRequest) (*types.QueryClientStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	// consensus states are stored under consensusStates/{height}, their metadata under further sub keys
+	consensusStatePrefix := []byte(host.KeyConsensusStatePrefix + "/")
+
+	iterator := k.ClientStore(ctx, req.ClientId).Iterator(nil, nil)
+	defer iterator.Close()
+
+	res := &types.QueryClientStorageResponse{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		res.Keys++
+		res.Bytes += uint64(len(key) + len(iterator.Value()))
+
+		if bytes.HasPrefix(key, consensusStatePrefix) && !bytes.Contains(key[len(consensusStatePrefix):], []byte("/")) {
+			res.ConsensusStates++
+		}
+	}
+
+	return res, nil
+}
+
+// ExpiringClients implements the Query/ExpiringClients gRPC method. Only the clients whose light client module implements
+// types.TrustingPeriodProvider are returned. The connections and channels which depend on the clients are set by the core
+// IBC keeper.
+func (k Keeper) ExpiringClients(c context.Context, req *types.QueryExpiringClientsRequest) (*types.QueryExpiringClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Within < 0 {
+		return nil, status.Error(codes.InvalidArgument, "within cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var clients []types.IdentifiedClientExpiry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.KeyClientStorePrefix)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// filter any metadata stored under client state key
+		keySplit := strings.Split(string(key), "/")
+		if keySplit[len(keySplit)-1] != "clientState" {
+			return false, nil
+		}
+
+		clientState, err := k.UnmarshalClientState(value)
+		if err != nil {
+			return false, err
+		}
+
+		clientID := keySplit[1]
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return false, err
+		}
+
+		expiry, ok := k.GetClientExpiry(ctx, clientID, clientState)
+		if !ok || (req.Within != 0 && expiry.TimeRemaining > req.Within) {
+			return false, nil
+		}
+
+		if accumulate {
+			clients = append(clients, expiry)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExpiringClientsResponse{
+		Clients:    clients,
+		Pagination: pageRes,
+	}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -836,3 +837,186 @@ func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryClientStorage() {
+	var (
+		req  *types.QueryClientStorageRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg                string
+		malleate           func()
+		expConsensusStates uint64
+		expPass            bool
+	}{
+		{
+			"success",
+			func() {},
+			1,
+			true,
+		},
+		{
+			"success: client updated",
+			func() {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			},
+			3,
+			true,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			0,
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			0,
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			req = &types.QueryClientStorageRequest{
+				ClientId: path.EndpointA.ClientID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ClientStorage(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.expConsensusStates, res.ConsensusStates)
+
+				// the client state, the consensus states and their processed time, processed height and iteration keys
+				suite.Require().Equal(1+4*tc.expConsensusStates, res.Keys)
+				suite.Require().NotZero(res.Bytes)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExpiringClients() {
+	var (
+		req        *types.QueryExpiringClientsRequest
+		expClients []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: all clients",
+			func() {},
+			true,
+		},
+		{
+			"success: no client expires within the duration",
+			func() {
+				req.Within = time.Hour
+				expClients = nil
+			},
+			true,
+		},
+		{
+			"success: clients expiring within the duration",
+			func() {
+				req.Within = ibctesting.TrustingPeriod
+			},
+			true,
+		},
+		{
+			"success: pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expClients = expClients[:1]
+			},
+			true,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"negative duration",
+			func() {
+				req.Within = -time.Hour
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expClients = nil
+			for i := 0; i < 2; i++ {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				expClients = append(expClients, path.EndpointA.ClientID)
+			}
+
+			// clients which do not expire are not returned
+			suite.solomachine.CreateClient(suite.chainA)
+
+			req = &types.QueryExpiringClientsRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ExpiringClients(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				var clientIDs []string
+				for _, client := range res.Clients {
+					suite.Require().Equal(exported.Active.String(), client.Status)
+					suite.Require().Equal(ibctesting.TrustingPeriod, client.TrustingPeriod)
+					suite.Require().Positive(client.TimeRemaining)
+					clientIDs = append(clientIDs, client.ClientId)
+				}
+				suite.Require().Equal(expClients, clientIDs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -17,25 +17,44 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
 )
 
 // Keeper represents a type that grants read and write permissions to any client
 // state information
 type Keeper struct {
-	storeKey       storetypes.StoreKey
-	cdc            codec.BinaryCodec
-	consensusHost  types.ConsensusHost
-	legacySubspace types.ParamSubspace
-	stakingKeeper  types.StakingKeeper
-	upgradeKeeper  types.UpgradeKeeper
-}
-
-// NewKeeper creates a new NewKeeper instance
+	storeKey         storetypes.StoreKey
+	cdc              codec.BinaryCodec
+	router           *types.Router
+	consensusHost    types.ConsensusHost
+	connectionKeeper types.ConnectionKeeper
+	legacySubspace   types.ParamSubspace
+	stakingKeeper    types.StakingKeeper
+	upgradeKeeper    types.UpgradeKeeper
+}
+
+// NewKeeper creates a new NewKeeper instance. The light client modules of the 06-solomachine, 07-tendermint
+// and 09-localhost client types are registered on the client router. The light client modules of any other
+// client types, such as 08-wasm, must be registered with AddRoute.
 func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, legacySubspace types.ParamSubspace, sk types.StakingKeeper, uk types.UpgradeKeeper) Keeper {
+	router := types.NewRouter()
+	storeProvider := types.NewStoreProvider(key)
+
+	smLightClientModule := solomachine.NewLightClientModule(cdc, storeProvider)
+	router.AddRoute(exported.Solomachine, &smLightClientModule)
+
+	tmLightClientModule := ibctm.NewLightClientModule(cdc, storeProvider)
+	router.AddRoute(exported.Tendermint, &tmLightClientModule)
+
+	localhostModule := localhost.NewLightClientModule(cdc, key)
+	router.AddRoute(exported.Localhost, &localhostModule)
+
 	return Keeper{
 		storeKey:       key,
 		cdc:            cdc,
+		router:         router,
 		legacySubspace: legacySubspace,
 		stakingKeeper:  sk,
 		upgradeKeeper:  uk,
@@ -47,15 +66,57 @@ func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
 }
 
+// AddRoute adds the light client module for the given client type to the client router.
+// It panics if a module is already registered for the client type.
+func (k Keeper) AddRoute(clientType string, module exported.LightClientModule) {
+	k.router.AddRoute(clientType, module)
+}
+
+// Route returns the light client module for the client type of the given client identifier.
+func (k Keeper) Route(clientID string) (exported.LightClientModule, bool) {
+	return k.router.GetRoute(clientID)
+}
+
+// GetStoreProvider returns the light client store provider, which light client modules use
+// to access the client prefixed stores.
+func (k Keeper) GetStoreProvider() exported.ClientStoreProvider {
+	return types.NewStoreProvider(k.storeKey)
+}
+
 // CreateLocalhostClient initialises the 09-localhost client state and sets it in state.
 func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
-	var clientState localhost.ClientState
-	return clientState.Initialize(ctx, k.cdc, k.ClientStore(ctx, exported.LocalhostClientID), nil)
+	lightClientModule, found := k.Route(exported.LocalhostClientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, exported.Localhost)
+	}
+
+	return lightClientModule.Initialize(ctx, exported.LocalhostClientID, &localhost.ClientState{}, nil)
 }
 
 // UpdateLocalhostClient updates the 09-localhost client to the latest block height and chain ID.
-func (k Keeper) UpdateLocalhostClient(ctx sdk.Context, clientState exported.ClientState) []exported.Height {
-	return clientState.UpdateState(ctx, k.cdc, k.ClientStore(ctx, exported.LocalhostClientID), nil)
+func (k Keeper) UpdateLocalhostClient(ctx sdk.Context) []exported.Height {
+	lightClientModule, found := k.Route(exported.LocalhostClientID)
+	if !found {
+		panic(errorsmod.Wrap(types.ErrRouteNotFound, exported.Localhost))
+	}
+
+	return lightClientModule.UpdateState(ctx, exported.LocalhostClientID, nil)
+}
+
+// SetConnectionKeeper sets the connection keeper used to retrieve the delay periods of the connections of a client,
+// within which the consensus states of the client are not pruned by the max consensus states retention rule.
+func (k *Keeper) SetConnectionKeeper(connectionKeeper types.ConnectionKeeper) {
+	k.connectionKeeper = connectionKeeper
+}
+
+// getMaxDelayPeriod returns the largest delay period of the connections of the client, or zero if no connection
+// keeper is set.
+func (k Keeper) getMaxDelayPeriod(ctx sdk.Context, clientID string) uint64 {
+	if k.connectionKeeper == nil {
+		return 0
+	}
+
+	return k.connectionKeeper.GetMaxDelayPeriod(ctx, clientID)
 }
 
 // SetConsensusHost sets a custom ConsensusHost for self client state and consensus state validation.
@@ -330,13 +391,25 @@ func (k Keeper) ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	return prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
 }
 
-// GetClientStatus returns the status for a given clientState. If the client type is not in the allowed
-// clients param field, Unauthorized is returned, otherwise the client state status is returned.
-func (k Keeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
-	if !k.GetParams(ctx).IsAllowedClient(clientState.ClientType()) {
+// GetClientStatus returns the status for the client with the given identifier. If the client type is not in the allowed
+// clients param field or has no light client module registered, Unauthorized is returned, otherwise the status returned
+// by the light client module is returned.
+func (k Keeper) GetClientStatus(ctx sdk.Context, clientID string) exported.Status {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
 		return exported.Unauthorized
 	}
-	return clientState.Status(ctx, k.ClientStore(ctx, clientID), k.cdc)
+
+	if !k.GetParams(ctx).IsAllowedClient(clientType) {
+		return exported.Unauthorized
+	}
+
+	lightClientModule, found := k.Route(clientID)
+	if !found {
+		return exported.Unauthorized
+	}
+
+	return lightClientModule.Status(ctx, clientID)
 }
 
 // GetParams returns the total set of ibc-client parameters.
@@ -379,7 +452,7 @@ func (k Keeper) ScheduleIBCSoftwareUpgrade(ctx sdk.Context, plan upgradetypes.Pl
 	}
 
 	// emitting an event for scheduling an upgrade plan
-	emitScheduleIBCSoftwareUpgradeEvent(ctx, plan.Name, plan.Height)
+	k.emitScheduleIBCSoftwareUpgradeEvent(ctx, plan.Name, plan.Height)
 
 	return nil
 }
@@ -373,6 +373,96 @@ func (suite *KeeperTestSuite) TestUnsetParams() {
 	})
 }
 
+// TestDefaultLightClientRoutes tests that the light client modules of the client types supported
+// by core IBC are registered on the client router by default.
+func (suite *KeeperTestSuite) TestDefaultLightClientRoutes() {
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	for _, clientType := range []string{exported.Solomachine, exported.Tendermint} {
+		_, found := clientKeeper.Route(types.FormatClientIdentifier(clientType, 0))
+		suite.Require().True(found, clientType)
+	}
+
+	_, found := clientKeeper.Route(exported.LocalhostClientID)
+	suite.Require().True(found)
+
+	_, found = clientKeeper.Route(types.FormatClientIdentifier("08-wasm", 0))
+	suite.Require().False(found)
+
+	// registering a light client module again for a client type panics
+	tmLightClientModule := ibctm.NewLightClientModule(suite.chainA.App.AppCodec(), clientKeeper.GetStoreProvider())
+	suite.Require().Panics(func() {
+		clientKeeper.AddRoute(exported.Tendermint, &tmLightClientModule)
+	})
+}
+
+// TestGetClientStatus tests that the client status is retrieved through the light client module
+// registered for the client type.
+func (suite *KeeperTestSuite) TestGetClientStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"success: active client",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			exported.Unauthorized,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = types.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			exported.Unknown,
+		},
+		{
+			"client type not allowed",
+			func() {
+				params := types.NewParams(exported.Localhost)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			exported.Unauthorized,
+		},
+		{
+			"no light client module registered for client type",
+			func() {
+				params := types.NewParams(exported.Tendermint, exported.Wasm)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				clientID = types.FormatClientIdentifier(exported.Wasm, 0)
+			},
+			exported.Unauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), clientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
 // TestIBCSoftwareUpgrade tests that an IBC client upgrade has been properly scheduled
 func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
 	var (
@@ -5,6 +5,7 @@ import (
 
 	v7 "github.com/cosmos/ibc-go/v8/modules/core/02-client/migrations/v7"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -47,3 +48,14 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated client to self-manage params")
 	return nil
 }
+
+// MigrateClientExpiryIndex migrates from consensus version 6 to 7.
+// This migration queues the existing clients by their expiry time.
+func (m Migrator) MigrateClientExpiryIndex(ctx sdk.Context) error {
+	m.keeper.IterateClientStates(ctx, nil, func(clientID string, _ exported.ClientState) bool {
+		m.keeper.IndexClientExpiry(ctx, clientID)
+		return false
+	})
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 // TestMigrateParams tests the migration for the client params
@@ -41,3 +44,25 @@ func (suite *KeeperTestSuite) TestMigrateParams() {
 		})
 	}
 }
+
+// TestMigrateClientExpiryIndex tests the migration queueing the existing clients by their expiry time
+func (suite *KeeperTestSuite) TestMigrateClientExpiryIndex() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(ibcexported.StoreKey))
+
+	// remove the client from the client expiry queue, as for clients created before the queue existed
+	bz := store.Get(types.ClientExpiryTimeKey(path.EndpointA.ClientID))
+	suite.Require().NotEmpty(bz)
+	store.Delete(types.ClientExpiryQueueKey(sdk.BigEndianToUint64(bz), path.EndpointA.ClientID))
+	store.Delete(types.ClientExpiryTimeKey(path.EndpointA.ClientID))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCKeeper.ClientKeeper)
+	err := migrator.MigrateClientExpiryIndex(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(bz, store.Get(types.ClientExpiryTimeKey(path.EndpointA.ClientID)))
+	suite.Require().True(store.Has(types.ClientExpiryQueueKey(sdk.BigEndianToUint64(bz), path.EndpointA.ClientID)))
+}
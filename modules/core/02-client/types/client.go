@@ -5,6 +5,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 
@@ -28,6 +29,21 @@ type ConsensusHost interface {
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 }
 
+// ConsensusStatePruner defines an optional interface which light client modules may implement to prune the consensus states
+// of their clients according to a ConsensusStateRetention policy. The expired consensus states and the ones not retained by
+// the policy are pruned oldest first, until limit consensus states are pruned. A limit of zero prunes all of them.
+// Consensus states processed within the delay time period, the largest delay period of the connections of the client,
+// must not be pruned by the max consensus states rule. The number of consensus states pruned is returned.
+type ConsensusStatePruner interface {
+	PruneConsensusStates(ctx sdk.Context, clientID string, retention ConsensusStateRetention, delayTimePeriod, limit uint64) (uint64, error)
+}
+
+// TrustingPeriodProvider defines an optional interface which light client modules may implement if their clients expire once
+// the trusting period has elapsed since the timestamp of their latest consensus state. It is used to monitor client expiry.
+type TrustingPeriodProvider interface {
+	TrustingPeriod(ctx sdk.Context, clientID string) (time.Duration, error)
+}
+
 // NewIdentifiedClientState creates a new IdentifiedClientState instance
 func NewIdentifiedClientState(clientID string, clientState exported.ClientState) IdentifiedClientState {
 	msg, ok := clientState.(proto.Message)
@@ -118,3 +134,34 @@ func ValidateClientType(clientType string) error {
 
 	return nil
 }
+
+// FlattenClientMessages returns the ordered list of client messages to be applied to a client. Client messages
+// implementing exported.ClientMessageBatch are replaced, recursively, by the client messages they contain.
+func FlattenClientMessages(clientMsgs []exported.ClientMessage) ([]exported.ClientMessage, error) {
+	var flattened []exported.ClientMessage
+	for _, clientMsg := range clientMsgs {
+		batch, ok := clientMsg.(exported.ClientMessageBatch)
+		if !ok {
+			flattened = append(flattened, clientMsg)
+			continue
+		}
+
+		batchMsgs, err := batch.GetClientMessages()
+		if err != nil {
+			return nil, err
+		}
+
+		batchMsgs, err = FlattenClientMessages(batchMsgs)
+		if err != nil {
+			return nil, err
+		}
+
+		flattened = append(flattened, batchMsgs...)
+	}
+
+	if len(flattened) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidClientMessageBatch, "client messages cannot be empty")
+	}
+
+	return flattened, nil
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -249,6 +253,14 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// whether typed protobuf events are emitted alongside the legacy events for client state transitions.
+	EmitTypedEvents bool `protobuf:"varint,2,opt,name=emit_typed_events,json=emitTypedEvents,proto3" json:"emit_typed_events,omitempty"`
+	// consensus_state_retention defines the retention policy applied to the consensus states of the
+	// clients whose light client module supports pruning.
+	ConsensusStateRetention ConsensusStateRetention `protobuf:"bytes,3,opt,name=consensus_state_retention,json=consensusStateRetention,proto3" json:"consensus_state_retention"`
+	// expiry_warning_threshold defines the time remaining before the expiry of a client at which an expiry
+	// warning event is emitted. A zero value disables the client expiry events.
+	ExpiryWarningThreshold time.Duration `protobuf:"bytes,4,opt,name=expiry_warning_threshold,json=expiryWarningThreshold,proto3,stdduration" json:"expiry_warning_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -291,6 +303,96 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetEmitTypedEvents() bool {
+	if m != nil {
+		return m.EmitTypedEvents
+	}
+	return false
+}
+
+func (m *Params) GetConsensusStateRetention() ConsensusStateRetention {
+	if m != nil {
+		return m.ConsensusStateRetention
+	}
+	return ConsensusStateRetention{}
+}
+
+func (m *Params) GetExpiryWarningThreshold() time.Duration {
+	if m != nil {
+		return m.ExpiryWarningThreshold
+	}
+	return 0
+}
+
+// ConsensusStateRetention defines which consensus states of a client are retained in addition to the
+// ones required by the light client itself. Expired consensus states are always pruned. A zero value
+// disables the corresponding rule, the latest consensus state of a client is never pruned.
+type ConsensusStateRetention struct {
+	// the maximum number of consensus states retained per client, excluding the checkpoint consensus states.
+	// It must be at least 2 when set.
+	MaxConsensusStates uint64 `protobuf:"varint,1,opt,name=max_consensus_states,json=maxConsensusStates,proto3" json:"max_consensus_states,omitempty"`
+	// the maximum age of a retained consensus state, relative to the block time. It cannot be less than the
+	// delay period of any connection.
+	MaxAge time.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age"`
+	// consensus states at revision heights which are a multiple of this value are checkpoints, which are
+	// retained regardless of max_consensus_states and max_age until they expire.
+	KeepEveryNthHeight uint64 `protobuf:"varint,3,opt,name=keep_every_nth_height,json=keepEveryNthHeight,proto3" json:"keep_every_nth_height,omitempty"`
+}
+
+func (m *ConsensusStateRetention) Reset()         { *m = ConsensusStateRetention{} }
+func (m *ConsensusStateRetention) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStateRetention) ProtoMessage()    {}
+func (*ConsensusStateRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{5}
+}
+func (m *ConsensusStateRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusStateRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusStateRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusStateRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStateRetention.Merge(m, src)
+}
+func (m *ConsensusStateRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusStateRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStateRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStateRetention proto.InternalMessageInfo
+
+func (m *ConsensusStateRetention) GetMaxConsensusStates() uint64 {
+	if m != nil {
+		return m.MaxConsensusStates
+	}
+	return 0
+}
+
+func (m *ConsensusStateRetention) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *ConsensusStateRetention) GetKeepEveryNthHeight() uint64 {
+	if m != nil {
+		return m.KeepEveryNthHeight
+	}
+	return 0
+}
+
 // ClientUpdateProposal is a legacy governance proposal. If it passes, the substitute
 // client's latest consensus state is copied over to the subject client. The proposal
 // handler may fail if the subject and the substitute do not match in client and
@@ -315,7 +417,7 @@ func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
 func (m *ClientUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*ClientUpdateProposal) ProtoMessage()    {}
 func (*ClientUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{6}
 }
 func (m *ClientUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +468,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{7}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,6 +503,7 @@ func init() {
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*ConsensusStateRetention)(nil), "ibc.core.client.v1.ConsensusStateRetention")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
 }
@@ -408,50 +511,61 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xa6, 0x31, 0x34, 0x13, 0x69, 0x74, 0x4d, 0x61, 0x4d, 0x4b, 0x36, 0x2c, 0x05, 0x73,
-	0x68, 0x77, 0x4d, 0x04, 0x2d, 0x01, 0x0f, 0xa6, 0x97, 0xf6, 0x22, 0x75, 0xa5, 0x08, 0x82, 0x84,
-	0xfd, 0x98, 0x6e, 0xa6, 0xec, 0xce, 0x2c, 0x3b, 0xb3, 0x91, 0xfc, 0x03, 0x8f, 0x8a, 0x17, 0xc1,
-	0x4b, 0x7f, 0x84, 0x3f, 0xa2, 0x78, 0xea, 0xd1, 0x53, 0x90, 0xf6, 0xe2, 0xb9, 0xbf, 0x40, 0x76,
-	0x3e, 0x6c, 0x63, 0x5a, 0x15, 0xbc, 0xcd, 0xfb, 0xcc, 0x33, 0xef, 0xfb, 0xcc, 0x33, 0xf3, 0xbe,
-	0xc0, 0x44, 0x7e, 0xe0, 0x04, 0x24, 0x83, 0x4e, 0x10, 0x23, 0x88, 0x99, 0x33, 0xe9, 0xc9, 0x95,
-	0x9d, 0x66, 0x84, 0x11, 0x5d, 0x47, 0x7e, 0x60, 0x17, 0x04, 0x5b, 0xc2, 0x93, 0x5e, 0x6b, 0x23,
-	0x20, 0x34, 0x21, 0xd4, 0xc9, 0xd3, 0x28, 0xf3, 0x42, 0xe8, 0x4c, 0x7a, 0x3e, 0x64, 0x5e, 0x4f,
-	0xc5, 0xe2, 0x64, 0xeb, 0xbe, 0x60, 0x8d, 0x78, 0xe4, 0x88, 0x40, 0x6e, 0x35, 0x23, 0x12, 0x11,
-	0x81, 0x17, 0x2b, 0x75, 0x20, 0x22, 0x24, 0x8a, 0xa1, 0xc3, 0x23, 0x3f, 0x3f, 0x74, 0x3c, 0x3c,
-	0x15, 0x5b, 0x56, 0x02, 0x56, 0xf7, 0x42, 0x88, 0x19, 0x3a, 0x44, 0x30, 0xdc, 0xe1, 0x42, 0x5e,
-	0x32, 0x8f, 0x41, 0x7d, 0x0d, 0xd4, 0x84, 0xae, 0x11, 0x0a, 0x0d, 0xad, 0xa3, 0x75, 0x6b, 0xee,
-	0xb2, 0x00, 0xf6, 0x42, 0xfd, 0x09, 0xb8, 0x2d, 0x37, 0x69, 0x41, 0x36, 0xca, 0x1d, 0xad, 0x5b,
-	0xef, 0x37, 0x6d, 0x51, 0xc7, 0x56, 0x75, 0xec, 0x67, 0x78, 0xea, 0xd6, 0x83, 0xcb, 0xac, 0xd6,
-	0x47, 0x0d, 0x18, 0x3b, 0x04, 0x53, 0x88, 0x69, 0x4e, 0x39, 0xf4, 0x0a, 0xb1, 0xf1, 0x2e, 0x44,
-	0xd1, 0x98, 0xe9, 0xdb, 0xa0, 0x3a, 0xe6, 0x2b, 0x5e, 0xaf, 0xde, 0x6f, 0xd9, 0x8b, 0x16, 0xd9,
-	0x82, 0x3b, 0xac, 0x9c, 0xcc, 0xcc, 0x92, 0x2b, 0xf9, 0xfa, 0x53, 0xd0, 0x08, 0x54, 0xd6, 0x7f,
-	0x90, 0xb4, 0x12, 0xcc, 0x49, 0x28, 0x54, 0xad, 0x8a, 0xbb, 0xcf, 0x6b, 0xa3, 0x7f, 0x76, 0xe1,
-	0x0d, 0xb8, 0xf3, 0x5b, 0x55, 0x6a, 0x94, 0x3b, 0x4b, 0xdd, 0x7a, 0x7f, 0xf3, 0x3a, 0xe5, 0x37,
-	0xdd, 0x5b, 0xde, 0xa5, 0x31, 0x2f, 0x8a, 0x5a, 0x21, 0xa8, 0x4a, 0x63, 0x1e, 0x80, 0x46, 0x06,
-	0x27, 0x88, 0x22, 0x82, 0x47, 0x38, 0x4f, 0x7c, 0x98, 0x71, 0x2d, 0x15, 0x77, 0x45, 0xc1, 0xcf,
-	0x39, 0x3a, 0x47, 0x94, 0x56, 0x96, 0xe7, 0x89, 0x22, 0xe3, 0x60, 0xf9, 0xdd, 0xb1, 0x59, 0xfa,
-	0x74, 0x6c, 0x96, 0xac, 0x1e, 0xa8, 0xee, 0x7b, 0x99, 0x97, 0xd0, 0xe2, 0xb0, 0x17, 0xc7, 0xe4,
-	0x2d, 0x0c, 0x47, 0x42, 0x34, 0x35, 0xb4, 0xce, 0x52, 0xb7, 0xe6, 0xae, 0x48, 0x58, 0x58, 0x44,
-	0xad, 0x0f, 0x65, 0xd0, 0x14, 0xeb, 0x83, 0x34, 0xf4, 0x18, 0xdc, 0xcf, 0x48, 0x4a, 0xa8, 0x17,
-	0xeb, 0x4d, 0x70, 0x8b, 0x21, 0x16, 0x43, 0xe9, 0x94, 0x08, 0xf4, 0x0e, 0xa8, 0x87, 0x90, 0x06,
-	0x19, 0x4a, 0x19, 0x22, 0x98, 0x0b, 0xaa, 0xb9, 0x57, 0x21, 0x7d, 0x17, 0xdc, 0xa5, 0xb9, 0x7f,
-	0x04, 0x03, 0x36, 0xba, 0x74, 0x7b, 0xa9, 0xe0, 0x0d, 0xd7, 0x2f, 0x66, 0xa6, 0x31, 0xf5, 0x92,
-	0x78, 0x60, 0x2d, 0x50, 0x2c, 0xb7, 0x21, 0xb1, 0x1d, 0xf5, 0x24, 0x2f, 0x40, 0x93, 0xe6, 0x3e,
-	0x65, 0x88, 0xe5, 0x0c, 0x5e, 0x49, 0x56, 0xe1, 0xc9, 0xcc, 0x8b, 0x99, 0xb9, 0xf6, 0x2b, 0xd9,
-	0x02, 0xcb, 0x72, 0xf5, 0x4b, 0x58, 0xa5, 0x1c, 0x6c, 0x14, 0x56, 0x7d, 0xfd, 0xb2, 0xd5, 0x92,
-	0x8d, 0x16, 0x91, 0x89, 0x2d, 0xfb, 0xb2, 0x78, 0x52, 0x06, 0x31, 0x33, 0x34, 0xeb, 0x73, 0x19,
-	0x34, 0x0e, 0x44, 0x97, 0xfe, 0xb7, 0x1d, 0x8f, 0x41, 0x25, 0x8d, 0x3d, 0xcc, 0x1d, 0xa8, 0xf7,
-	0xd7, 0x6d, 0x59, 0x58, 0x0d, 0x01, 0x55, 0x7c, 0x3f, 0xf6, 0xb0, 0xfc, 0x3b, 0x9c, 0xaf, 0x1f,
-	0x81, 0x55, 0xc9, 0x51, 0x2f, 0x28, 0x7b, 0xa1, 0x72, 0x73, 0x2f, 0x0c, 0x3b, 0x17, 0x33, 0x73,
-	0x5d, 0x78, 0x72, 0xed, 0x61, 0xcb, 0xbd, 0xa7, 0xf0, 0x2b, 0xe3, 0x61, 0xb0, 0xa9, 0x3e, 0xd0,
-	0x8f, 0x63, 0x53, 0xfb, 0x9b, 0x3b, 0x43, 0xf7, 0xe4, 0xac, 0xad, 0x9d, 0x9e, 0xb5, 0xb5, 0xef,
-	0x67, 0x6d, 0xed, 0xfd, 0x79, 0xbb, 0x74, 0x7a, 0xde, 0x2e, 0x7d, 0x3b, 0x6f, 0x97, 0x5e, 0x6f,
-	0x47, 0x88, 0x8d, 0x73, 0xdf, 0x0e, 0x48, 0x22, 0x27, 0x99, 0x83, 0xfc, 0x60, 0x2b, 0x22, 0xce,
-	0x64, 0xdb, 0x49, 0x48, 0x98, 0xc7, 0x90, 0x8a, 0x31, 0xfa, 0xb0, 0xbf, 0x25, 0x27, 0x29, 0x9b,
-	0xa6, 0x90, 0xfa, 0x55, 0x7e, 0x8d, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x80, 0x51,
-	0x2a, 0x69, 0x05, 0x00, 0x00,
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x6f, 0x96, 0x25, 0x99, 0x45, 0x59, 0x3a, 0x6c, 0xa8, 0x93, 0x46, 0xeb, 0x95, 0x55,
+	0x89, 0x08, 0x1a, 0xbb, 0x1b, 0x24, 0x88, 0x22, 0x38, 0x74, 0x43, 0xa5, 0xf6, 0x52, 0x05, 0xd3,
+	0xaa, 0x12, 0x52, 0x65, 0x8d, 0xed, 0xa9, 0x77, 0x8a, 0xed, 0xb1, 0x3c, 0xe3, 0x6d, 0xf6, 0x3f,
+	0xe0, 0x08, 0xe2, 0x52, 0x89, 0x4b, 0xee, 0x5c, 0x39, 0xf2, 0x07, 0x54, 0x9c, 0x7a, 0xe4, 0x14,
+	0x50, 0x72, 0xe1, 0x9c, 0xbf, 0x00, 0xcd, 0x2f, 0x92, 0xdd, 0x4d, 0x68, 0x24, 0x6e, 0x9e, 0xef,
+	0x7d, 0xf3, 0xde, 0x37, 0xdf, 0xbc, 0x79, 0x06, 0x0e, 0x89, 0x62, 0x3f, 0xa6, 0x15, 0xf6, 0xe3,
+	0x8c, 0xe0, 0x82, 0xfb, 0x93, 0xa1, 0xfe, 0xf2, 0xca, 0x8a, 0x72, 0x0a, 0x21, 0x89, 0x62, 0x4f,
+	0x10, 0x3c, 0x0d, 0x4f, 0x86, 0x1b, 0xb7, 0x63, 0xca, 0x72, 0xca, 0xfc, 0xba, 0x4c, 0x2b, 0x94,
+	0x60, 0x7f, 0x32, 0x8c, 0x30, 0x47, 0x43, 0xb3, 0x56, 0x3b, 0x37, 0xd6, 0x15, 0x2b, 0x94, 0x2b,
+	0x5f, 0x2d, 0x74, 0xa8, 0x97, 0xd2, 0x94, 0x2a, 0x5c, 0x7c, 0x99, 0x0d, 0x29, 0xa5, 0x69, 0x86,
+	0x7d, 0xb9, 0x8a, 0xea, 0xe7, 0x3e, 0x2a, 0xa6, 0x3a, 0xd4, 0x9f, 0x0f, 0x25, 0x75, 0x85, 0x38,
+	0xa1, 0x85, 0x8a, 0xbb, 0x39, 0x58, 0x7b, 0x98, 0xe0, 0x82, 0x93, 0xe7, 0x04, 0x27, 0xfb, 0x52,
+	0xe8, 0x37, 0x1c, 0x71, 0x0c, 0x6f, 0x81, 0x15, 0xa5, 0x3b, 0x24, 0x89, 0x6d, 0x0d, 0xac, 0xad,
+	0x95, 0x60, 0x59, 0x01, 0x0f, 0x13, 0xf8, 0x39, 0x78, 0x4f, 0x07, 0x99, 0x20, 0xdb, 0xcd, 0x81,
+	0xb5, 0xd5, 0xd9, 0xe9, 0x79, 0xaa, 0x98, 0x67, 0x8a, 0x79, 0xf7, 0x8a, 0x69, 0xd0, 0x89, 0xcf,
+	0xb3, 0xba, 0x3f, 0x59, 0xc0, 0xde, 0xa7, 0x05, 0xc3, 0x05, 0xab, 0x99, 0x84, 0x9e, 0x12, 0x3e,
+	0x7e, 0x80, 0x49, 0x3a, 0xe6, 0x70, 0x17, 0xb4, 0xc7, 0xf2, 0x4b, 0xd6, 0xeb, 0xec, 0x6c, 0x78,
+	0x8b, 0x16, 0x7a, 0x8a, 0x3b, 0x6a, 0xbd, 0x3e, 0x76, 0x1a, 0x81, 0xe6, 0xc3, 0x2f, 0x41, 0x37,
+	0x36, 0x59, 0xaf, 0x21, 0x69, 0x35, 0x9e, 0x91, 0x20, 0x54, 0xad, 0xa9, 0xb3, 0xcf, 0x6a, 0x63,
+	0xff, 0xed, 0xc2, 0x33, 0xf0, 0xfe, 0x5c, 0x55, 0x66, 0x37, 0x07, 0x4b, 0x5b, 0x9d, 0x9d, 0x3b,
+	0x97, 0x29, 0xbf, 0xea, 0xdc, 0xfa, 0x2c, 0xdd, 0x59, 0x51, 0xcc, 0x4d, 0x40, 0x5b, 0x1b, 0xf3,
+	0x11, 0xe8, 0x56, 0x78, 0x42, 0x18, 0xa1, 0x45, 0x58, 0xd4, 0x79, 0x84, 0x2b, 0xa9, 0xa5, 0x15,
+	0xac, 0x1a, 0xf8, 0x91, 0x44, 0x67, 0x88, 0xda, 0xca, 0xe6, 0x2c, 0x51, 0x65, 0xdc, 0x5b, 0xfe,
+	0xfe, 0xc8, 0x69, 0xbc, 0x3a, 0x72, 0x1a, 0xee, 0x2f, 0x4d, 0xd0, 0x3e, 0x40, 0x15, 0xca, 0x99,
+	0xd8, 0x8d, 0xb2, 0x8c, 0xbe, 0xc4, 0x49, 0xa8, 0x54, 0x33, 0xdb, 0x1a, 0x2c, 0x6d, 0xad, 0x04,
+	0xab, 0x1a, 0x56, 0x1e, 0x31, 0xf8, 0x31, 0xb8, 0x81, 0x73, 0xc2, 0x43, 0x3e, 0x2d, 0x71, 0x12,
+	0xe2, 0x89, 0xa4, 0x8a, 0x42, 0xcb, 0x41, 0x57, 0x04, 0x1e, 0x0b, 0xfc, 0xbe, 0x84, 0x61, 0x0e,
+	0xd6, 0xe7, 0x4c, 0x0a, 0x2b, 0xcc, 0x45, 0xc7, 0xd1, 0xc2, 0x5e, 0x92, 0x97, 0xf4, 0xc9, 0xdb,
+	0xdd, 0x0a, 0xcc, 0x16, 0x6d, 0xd6, 0xcd, 0xf8, 0xf2, 0x30, 0x7c, 0x06, 0x6c, 0x7c, 0x58, 0x92,
+	0x6a, 0x1a, 0xbe, 0x44, 0x55, 0x41, 0x8a, 0x34, 0xe4, 0xe3, 0x0a, 0xb3, 0x31, 0xcd, 0x12, 0xbb,
+	0x25, 0xab, 0xad, 0x2f, 0xb4, 0xc4, 0x57, 0xfa, 0x49, 0x8c, 0x96, 0x45, 0xee, 0x57, 0x7f, 0x3a,
+	0x56, 0xf0, 0xa1, 0x4a, 0xf2, 0x54, 0xe5, 0x78, 0x6c, 0x52, 0xb8, 0xbf, 0x59, 0xe0, 0xe6, 0x15,
+	0xca, 0xe0, 0x5d, 0xd0, 0xcb, 0xd1, 0x61, 0xb8, 0xd0, 0x12, 0xea, 0xaa, 0x60, 0x8e, 0x0e, 0xe7,
+	0xbb, 0xeb, 0x0b, 0xf0, 0xae, 0xd8, 0x81, 0x52, 0xd3, 0xae, 0xd7, 0xd2, 0xd6, 0xce, 0xd1, 0xe1,
+	0xbd, 0x14, 0xc3, 0x21, 0x58, 0xfb, 0x0e, 0xe3, 0x52, 0xf8, 0x5f, 0x4d, 0xc3, 0x82, 0x8f, 0xcd,
+	0x95, 0x2f, 0xa9, 0x82, 0x22, 0x78, 0x5f, 0xc4, 0x1e, 0x99, 0x4e, 0x73, 0x7f, 0x6c, 0x82, 0x9e,
+	0xba, 0xc4, 0x27, 0x65, 0x82, 0x38, 0x3e, 0xa8, 0x68, 0x49, 0x19, 0xca, 0x60, 0x0f, 0xbc, 0xc3,
+	0x09, 0xcf, 0xb0, 0xee, 0x71, 0xb5, 0x80, 0x03, 0xd0, 0x49, 0x30, 0x8b, 0x2b, 0x52, 0xca, 0xdb,
+	0x6a, 0xca, 0xd8, 0x45, 0x08, 0x3e, 0x00, 0x37, 0x58, 0x1d, 0xbd, 0xc0, 0x31, 0x0f, 0xcf, 0xdf,
+	0x89, 0xa8, 0xbf, 0x32, 0xda, 0x3c, 0x3b, 0x76, 0xec, 0x29, 0xca, 0xb3, 0x3d, 0x77, 0x81, 0xe2,
+	0x06, 0x5d, 0x8d, 0xed, 0x9b, 0xc7, 0xf4, 0x35, 0xe8, 0xb1, 0x3a, 0x62, 0x9c, 0xf0, 0x9a, 0xe3,
+	0x0b, 0xc9, 0x5a, 0x32, 0x99, 0x73, 0x76, 0xec, 0xdc, 0xfa, 0x37, 0xd9, 0x02, 0xcb, 0x0d, 0xe0,
+	0x39, 0x6c, 0x52, 0xee, 0xdd, 0x16, 0x4d, 0xfe, 0xfb, 0xaf, 0xdb, 0x1b, 0x7a, 0x84, 0xa6, 0x74,
+	0xe2, 0xe9, 0x89, 0x2b, 0xda, 0x4b, 0xdc, 0x9b, 0x6d, 0xb9, 0x3f, 0x37, 0x41, 0xf7, 0x89, 0x9a,
+	0xbf, 0xff, 0xdb, 0x8e, 0xcf, 0x40, 0xab, 0xcc, 0x90, 0xe9, 0xeb, 0x4d, 0x4f, 0x17, 0x36, 0xe3,
+	0xdd, 0x14, 0x3f, 0xc8, 0x90, 0x69, 0x64, 0xc9, 0x87, 0x2f, 0xc0, 0x9a, 0xe6, 0x98, 0xa7, 0xa7,
+	0xa7, 0x58, 0xeb, 0xea, 0x29, 0x36, 0x1a, 0x9c, 0x1d, 0x3b, 0x9b, 0xca, 0x93, 0x4b, 0x37, 0xbb,
+	0xc1, 0x07, 0x06, 0xbf, 0x30, 0xd8, 0xf7, 0xee, 0x98, 0xa7, 0xff, 0xf7, 0x91, 0x63, 0xbd, 0xcd,
+	0x9d, 0x51, 0xf0, 0xfa, 0xa4, 0x6f, 0xbd, 0x39, 0xe9, 0x5b, 0x7f, 0x9d, 0xf4, 0xad, 0x1f, 0x4e,
+	0xfb, 0x8d, 0x37, 0xa7, 0xfd, 0xc6, 0x1f, 0xa7, 0xfd, 0xc6, 0xb7, 0xbb, 0x29, 0xe1, 0xe3, 0x3a,
+	0xf2, 0x62, 0x9a, 0xeb, 0x7f, 0x94, 0x4f, 0xa2, 0x78, 0x3b, 0xa5, 0xfe, 0x64, 0xd7, 0xcf, 0x69,
+	0x52, 0x67, 0x98, 0xa9, 0x1f, 0xe4, 0xdd, 0x9d, 0x6d, 0xfd, 0x8f, 0x14, 0x03, 0x83, 0x45, 0x6d,
+	0x79, 0x8c, 0x4f, 0xff, 0x19, 0x00, 0x80, 0xbf, 0x2f, 0x5a, 0x43, 0x07, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -671,6 +785,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiryWarningThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiryWarningThreshold):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClient(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ConsensusStateRetention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EmitTypedEvents {
+		i--
+		if m.EmitTypedEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -683,6 +825,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsensusStateRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusStateRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusStateRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeepEveryNthHeight != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.KeepEveryNthHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintClient(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxConsensusStates != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxConsensusStates))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -882,6 +1065,30 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if m.EmitTypedEvents {
+		n += 2
+	}
+	l = m.ConsensusStateRetention.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiryWarningThreshold)
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *ConsensusStateRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxConsensusStates != 0 {
+		n += 1 + sovClient(uint64(m.MaxConsensusStates))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovClient(uint64(l))
+	if m.KeepEveryNthHeight != 0 {
+		n += 1 + sovClient(uint64(m.KeepEveryNthHeight))
+	}
 	return n
 }
 
@@ -1441,6 +1648,213 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitTypedEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitTypedEvents = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusStateRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryWarningThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiryWarningThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusStateRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsensusStates", wireType)
+			}
+			m.MaxConsensusStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsensusStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepEveryNthHeight", wireType)
+			}
+			m.KeepEveryNthHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepEveryNthHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
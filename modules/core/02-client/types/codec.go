@@ -41,11 +41,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateClient{},
 		&MsgUpdateClient{},
+		&MsgUpdateClientBatch{},
 		&MsgUpgradeClient{},
 		&MsgSubmitMisbehaviour{},
 		&MsgRecoverClient{},
 		&MsgIBCSoftwareUpgrade{},
 		&MsgUpdateParams{},
+		&MsgPruneConsensusStates{},
 	)
 	registry.RegisterImplementations(
 		(*govtypesv1beta1.Content)(nil),
@@ -181,6 +181,11 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgUpdateClient{}),
 			true,
 		},
+		{
+			"success: MsgUpdateClientBatch",
+			sdk.MsgTypeURL(&types.MsgUpdateClientBatch{}),
+			true,
+		},
 		{
 			"success: MsgUpgradeClient",
 			sdk.MsgTypeURL(&types.MsgUpgradeClient{}),
@@ -206,6 +211,11 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			true,
 		},
+		{
+			"success: MsgPruneConsensusStates",
+			sdk.MsgTypeURL(&types.MsgPruneConsensusStates{}),
+			true,
+		},
 		{
 			"success: ClientUpdateProposal",
 			sdk.MsgTypeURL(&types.ClientUpdateProposal{}),
@@ -37,4 +37,6 @@ var (
 	ErrFailedMembershipVerification           = errorsmod.Register(SubModuleName, 30, "membership verification failed")
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
 	ErrClientTypeNotSupported                 = errorsmod.Register(SubModuleName, 32, "client type not supported")
+	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 33, "light client module route not found")
+	ErrInvalidClientMessageBatch              = errorsmod.Register(SubModuleName, 34, "invalid client message batch")
 )
@@ -17,6 +17,7 @@ const (
 	AttributeKeyUpgradeStore      = "upgrade_store"
 	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyExpiryTime        = "expiry_time"
 )
 
 // IBC client events vars
@@ -28,6 +29,8 @@ var (
 	EventTypeRecoverClient              = "recover_client"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypeClientExpiryWarning        = "client_expiry_warning"
+	EventTypeClientExpired              = "client_expired"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
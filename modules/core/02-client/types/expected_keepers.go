@@ -28,6 +28,11 @@ type UpgradeKeeper interface {
 	ScheduleUpgrade(ctx context.Context, plan upgradetypes.Plan) error
 }
 
+// ConnectionKeeper expected connection keeper
+type ConnectionKeeper interface {
+	GetMaxDelayPeriod(ctx sdk.Context, clientID string) uint64
+}
+
 // ParamSubspace defines the expected Subspace interface for module parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
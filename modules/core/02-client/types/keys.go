@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -29,9 +30,25 @@ const (
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
 
+	// KeyClientExpiryCheckTime is the key used to store the block time at which the client
+	// expiry events were last checked for.
+	KeyClientExpiryCheckTime = "clientExpiryCheckTime"
+
+	// KeyClientExpiryQueuePrefix is the prefix of the keys under which clients are
+	// queued by their expiry time.
+	KeyClientExpiryQueuePrefix = "clientExpiryQueue"
+
+	// KeyClientExpiryTimePrefix is the prefix of the keys under which the expiry time
+	// at which each client is queued is stored.
+	KeyClientExpiryTimePrefix = "clientExpiryTime"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
+
+	// MaxConsensusStatesPrunedPerUpdate is the maximum number of consensus states pruned according to the
+	// consensus state retention policy on each client update
+	MaxConsensusStatesPrunedPerUpdate uint64 = 2
 )
 
 // FormatClientIdentifier returns the client identifier with the sequence appended.
@@ -80,3 +97,21 @@ func ParseClientIdentifier(clientID string) (string, uint64, error) {
 
 	return clientType, sequence, nil
 }
+
+// ClientExpiryQueueTimePrefix returns the prefix of the keys under which the clients expiring at the given
+// time (in nanoseconds) are queued. Queue keys are ordered by expiry time as the time is big endian encoded.
+func ClientExpiryQueueTimePrefix(expiryTime uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(KeyClientExpiryQueuePrefix+"/"), expiryTime)
+}
+
+// ClientExpiryQueueKey returns the key under which the client with the given identifier expiring at the
+// given time is queued.
+func ClientExpiryQueueKey(expiryTime uint64, clientID string) []byte {
+	return append(ClientExpiryQueueTimePrefix(expiryTime), []byte("/"+clientID)...)
+}
+
+// ClientExpiryTimeKey returns the key under which the expiry time at which the client with the given
+// identifier is queued is stored.
+func ClientExpiryTimeKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientExpiryTimePrefix, clientID))
+}
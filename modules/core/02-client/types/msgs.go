@@ -15,22 +15,27 @@ import (
 var (
 	_ sdk.Msg = (*MsgCreateClient)(nil)
 	_ sdk.Msg = (*MsgUpdateClient)(nil)
+	_ sdk.Msg = (*MsgUpdateClientBatch)(nil)
 	_ sdk.Msg = (*MsgSubmitMisbehaviour)(nil)
 	_ sdk.Msg = (*MsgUpgradeClient)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
+	_ sdk.Msg = (*MsgPruneConsensusStates)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateClientBatch)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitMisbehaviour)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpgradeClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneConsensusStates)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClientBatch)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgSubmitMisbehaviour)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpgradeClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgIBCSoftwareUpgrade)(nil)
@@ -149,6 +154,66 @@ func (msg MsgUpdateClient) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 	return unpacker.UnpackAny(msg.ClientMessage, &clientMsg)
 }
 
+// NewMsgUpdateClientBatch creates a new MsgUpdateClientBatch instance
+func NewMsgUpdateClientBatch(id string, clientMsgs []exported.ClientMessage, signer string) (*MsgUpdateClientBatch, error) {
+	anyClientMsgs := make([]*codectypes.Any, len(clientMsgs))
+	for i, clientMsg := range clientMsgs {
+		anyClientMsg, err := PackClientMessage(clientMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		anyClientMsgs[i] = anyClientMsg
+	}
+
+	return &MsgUpdateClientBatch{
+		ClientId:       id,
+		ClientMessages: anyClientMsgs,
+		Signer:         signer,
+	}, nil
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClientBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.ClientMessages) == 0 {
+		return errorsmod.Wrap(ErrInvalidClientMessageBatch, "client messages cannot be empty")
+	}
+	for i, anyClientMsg := range msg.ClientMessages {
+		clientMsg, err := UnpackClientMessage(anyClientMsg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "client message %d", i)
+		}
+		if err := clientMsg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "client message %d", i)
+		}
+	}
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateClientBatch) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateClientBatch) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, anyClientMsg := range msg.ClientMessages {
+		var clientMsg exported.ClientMessage
+		if err := unpacker.UnpackAny(anyClientMsg, &clientMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewMsgUpgradeClient creates a new MsgUpgradeClient instance
 func NewMsgUpgradeClient(clientID string, clientState exported.ClientState, consState exported.ConsensusState,
 	upgradeClientProof, upgradeConsensusStateProof []byte, signer string,
@@ -381,3 +446,34 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+// NewMsgPruneConsensusStates creates a new instance of MsgPruneConsensusStates.
+func NewMsgPruneConsensusStates(signer, clientID string) *MsgPruneConsensusStates {
+	return &MsgPruneConsensusStates{
+		ClientId: clientID,
+		Signer:   signer,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgPruneConsensusStates message.
+func (msg *MsgPruneConsensusStates) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneConsensusStates. An empty client identifier
+// selects every client.
+func (msg *MsgPruneConsensusStates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if msg.ClientId == "" {
+		return nil
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
@@ -330,6 +330,82 @@ func (suite *TypesTestSuite) TestMsgUpdateClient_ValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgUpdateClientBatch_ValidateBasic() {
+	var msg *types.MsgUpdateClientBatch
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid client-id",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty client messages",
+			func() {
+				msg.ClientMessages = nil
+			},
+			types.ErrInvalidClientMessageBatch,
+		},
+		{
+			"failure: failed to unpack client message",
+			func() {
+				msg.ClientMessages[1] = nil
+			},
+			ibcerrors.ErrUnpackAny,
+		},
+		{
+			"failure: invalid tendermint header",
+			func() {
+				anyClientMsg, err := types.PackClientMessage(&ibctm.Header{})
+				suite.Require().NoError(err)
+
+				msg.ClientMessages[1] = anyClientMsg
+			},
+			types.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientMsgs := []exported.ClientMessage{suite.chainA.CurrentTMClientHeader(), suite.chainA.CurrentTMClientHeader()}
+
+			var err error
+			msg, err = types.NewMsgUpdateClientBatch("tendermint", clientMsgs, suite.chainA.SenderAccount.GetAddress().String())
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = msg.ValidateBasic()
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMarshalMsgUpgradeClient() {
 	var (
 		msg *types.MsgUpgradeClient
@@ -964,3 +1040,55 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgPruneConsensusStatesValidateBasic() {
+	var msg *types.MsgPruneConsensusStates
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty client identifier",
+			func() {
+				msg.ClientId = ""
+			},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = "a"
+			},
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgPruneConsensusStates(ibctesting.TestAccAddress, ibctesting.FirstClientID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
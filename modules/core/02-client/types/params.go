@@ -4,8 +4,15 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// MinRetainedConsensusStates is the minimum number of consensus states which may be retained per client
+// by a consensus state retention policy.
+const MinRetainedConsensusStates = 2
+
 // DefaultAllowedClients are the default clients for the AllowedClients parameter.
 // By default it allows all client types.
 var DefaultAllowedClients = []string{AllowAllClients}
@@ -24,7 +31,19 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	if err := p.ConsensusStateRetention.Validate(); err != nil {
+		return err
+	}
+
+	if p.ExpiryWarningThreshold < 0 {
+		return fmt.Errorf("expiry warning threshold cannot be negative: %s", p.ExpiryWarningThreshold)
+	}
+
+	return nil
 }
 
 // IsAllowedClient checks if the given client type is registered on the allowlist.
@@ -63,3 +82,38 @@ func validateClients(clients []string) error {
 
 	return nil
 }
+
+// NewConsensusStateRetention creates a new ConsensusStateRetention instance.
+func NewConsensusStateRetention(maxConsensusStates uint64, maxAge time.Duration, keepEveryNthHeight uint64) ConsensusStateRetention {
+	return ConsensusStateRetention{
+		MaxConsensusStates: maxConsensusStates,
+		MaxAge:             maxAge,
+		KeepEveryNthHeight: keepEveryNthHeight,
+	}
+}
+
+// Validate performs a basic validation of the consensus state retention policy.
+func (r ConsensusStateRetention) Validate() error {
+	// a client must retain a consensus state to verify the headers following the latest one, and a previous one
+	// so that proofs at a height below the latest can still be verified while the client is updated
+	if r.MaxConsensusStates != 0 && r.MaxConsensusStates < MinRetainedConsensusStates {
+		return fmt.Errorf("consensus state retention max consensus states (%d) must be at least %d", r.MaxConsensusStates, MinRetainedConsensusStates)
+	}
+
+	if r.MaxAge < 0 {
+		return fmt.Errorf("consensus state retention max age cannot be negative: %s", r.MaxAge)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the retention policy does not retain fewer consensus states than the light clients do.
+func (r ConsensusStateRetention) IsEmpty() bool {
+	return r.MaxConsensusStates == 0 && r.MaxAge == 0
+}
+
+// IsCheckpoint returns true if the consensus state at the given height is retained regardless of
+// the max consensus states and max age rules.
+func (r ConsensusStateRetention) IsCheckpoint(height exported.Height) bool {
+	return r.KeepEveryNthHeight != 0 && height.GetRevisionHeight()%r.KeepEveryNthHeight == 0
+}
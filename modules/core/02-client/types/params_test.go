@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -40,6 +41,12 @@ func TestValidateParams(t *testing.T) {
 		{"blank client", NewParams(" "), false},
 		{"duplicate clients", NewParams(exported.Tendermint, exported.Tendermint), false},
 		{"allow all clients plus valid client", NewParams(AllowAllClients, exported.Tendermint), false},
+		{"consensus state retention", Params{AllowedClients: DefaultAllowedClients, ConsensusStateRetention: NewConsensusStateRetention(100, time.Hour, 1000)}, true},
+		{"expiry warning threshold", Params{AllowedClients: DefaultAllowedClients, ExpiryWarningThreshold: 72 * time.Hour}, true},
+		{"negative expiry warning threshold", Params{AllowedClients: DefaultAllowedClients, ExpiryWarningThreshold: -time.Hour}, false},
+		{"negative consensus state retention max age", Params{AllowedClients: DefaultAllowedClients, ConsensusStateRetention: NewConsensusStateRetention(100, -time.Hour, 1000)}, false},
+		{"consensus state retention with min max consensus states", Params{AllowedClients: DefaultAllowedClients, ConsensusStateRetention: NewConsensusStateRetention(MinRetainedConsensusStates, 0, 0)}, true},
+		{"consensus state retention max consensus states below min", Params{AllowedClients: DefaultAllowedClients, ConsensusStateRetention: NewConsensusStateRetention(MinRetainedConsensusStates-1, 0, 0)}, false},
 	}
 
 	for _, tc := range testCases {
@@ -53,3 +60,13 @@ func TestValidateParams(t *testing.T) {
 		}
 	}
 }
+
+func TestConsensusStateRetentionIsCheckpoint(t *testing.T) {
+	retention := NewConsensusStateRetention(0, 0, 10)
+	require.True(t, retention.IsCheckpoint(NewHeight(0, 10)))
+	require.True(t, retention.IsCheckpoint(NewHeight(1, 20)))
+	require.False(t, retention.IsCheckpoint(NewHeight(0, 15)))
+
+	retention.KeepEveryNthHeight = 0
+	require.False(t, retention.IsCheckpoint(NewHeight(0, 10)))
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Router is a map from a light client type to its LightClientModule.
+// It is used by core IBC to route the operations on a client to the
+// module handling its client type.
+type Router struct {
+	routes map[string]exported.LightClientModule
+}
+
+// NewRouter returns an instance of the Router.
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[string]exported.LightClientModule),
+	}
+}
+
+// AddRoute adds the LightClientModule for the given client type. It returns the Router
+// so AddRoute calls can be linked. It will panic if a route is already registered for the
+// client type.
+func (rtr *Router) AddRoute(clientType string, module exported.LightClientModule) *Router {
+	if rtr.HasRoute(clientType) {
+		panic(fmt.Errorf("route %s has already been registered", clientType))
+	}
+
+	rtr.routes[clientType] = module
+	return rtr
+}
+
+// HasRoute returns true if the Router has a module registered for the given client type.
+func (rtr *Router) HasRoute(clientType string) bool {
+	_, ok := rtr.routes[clientType]
+	return ok
+}
+
+// GetRoute returns the LightClientModule registered for the client type of the given client identifier.
+func (rtr *Router) GetRoute(clientID string) (exported.LightClientModule, bool) {
+	clientType, _, err := ParseClientIdentifier(clientID)
+	if err != nil {
+		return nil, false
+	}
+
+	module, ok := rtr.routes[clientType]
+	return module, ok
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+func TestRouter(t *testing.T) {
+	router := types.NewRouter()
+	require.False(t, router.HasRoute(exported.Tendermint))
+
+	tmLightClientModule := ibctm.NewLightClientModule(nil, nil)
+	router.AddRoute(exported.Tendermint, &tmLightClientModule)
+	require.True(t, router.HasRoute(exported.Tendermint))
+
+	require.Panics(t, func() {
+		router.AddRoute(exported.Tendermint, &tmLightClientModule)
+	})
+
+	testCases := []struct {
+		name     string
+		clientID string
+		expFound bool
+	}{
+		{"success", "07-tendermint-0", true},
+		{"client type not registered", "06-solomachine-0", false},
+		{"invalid client identifier", "07-tendermint", false},
+		{"empty client identifier", "", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		module, found := router.GetRoute(tc.clientID)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expFound {
+			require.Equal(t, &tmLightClientModule, module, tc.name)
+		} else {
+			require.Nil(t, module, tc.name)
+		}
+	}
+}
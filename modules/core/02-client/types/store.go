@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientStoreProvider = (*storeProvider)(nil)
+
+// storeProvider implements the exported.ClientStoreProvider interface and gives light client
+// modules access to the client prefixed stores of the IBC store.
+type storeProvider struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewStoreProvider creates a new ClientStoreProvider for the clients stored under the given store key.
+func NewStoreProvider(storeKey storetypes.StoreKey) exported.ClientStoreProvider {
+	return storeProvider{
+		storeKey: storeKey,
+	}
+}
+
+// ClientStore returns isolated prefix store for each client so they can read/write in separate
+// namespace without being able to read/write other client's data
+func (s storeProvider) ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore {
+	clientPrefix := []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID))
+	return prefix.NewStore(ctx.KVStore(s.storeKey), clientPrefix)
+}
@@ -193,6 +193,88 @@ func (m *MsgUpdateClientResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateClientResponse proto.InternalMessageInfo
 
+// MsgUpdateClientBatch defines an sdk.Msg to update a IBC client state using
+// an ordered list of client messages. Each client message is verified against
+// the client state produced by the previous one. The batch is applied atomically.
+type MsgUpdateClientBatch struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// ordered list of client messages to update the light client
+	ClientMessages []*types.Any `protobuf:"bytes,2,rep,name=client_messages,json=clientMessages,proto3" json:"client_messages,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUpdateClientBatch) Reset()         { *m = MsgUpdateClientBatch{} }
+func (m *MsgUpdateClientBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClientBatch) ProtoMessage()    {}
+func (*MsgUpdateClientBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{4}
+}
+func (m *MsgUpdateClientBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClientBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClientBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClientBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClientBatch.Merge(m, src)
+}
+func (m *MsgUpdateClientBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClientBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClientBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateClientBatch proto.InternalMessageInfo
+
+// MsgUpdateClientBatchResponse defines the Msg/UpdateClientBatch response type.
+type MsgUpdateClientBatchResponse struct {
+}
+
+func (m *MsgUpdateClientBatchResponse) Reset()         { *m = MsgUpdateClientBatchResponse{} }
+func (m *MsgUpdateClientBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClientBatchResponse) ProtoMessage()    {}
+func (*MsgUpdateClientBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{5}
+}
+func (m *MsgUpdateClientBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClientBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClientBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClientBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClientBatchResponse.Merge(m, src)
+}
+func (m *MsgUpdateClientBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClientBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClientBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateClientBatchResponse proto.InternalMessageInfo
+
 // MsgUpgradeClient defines an sdk.Msg to upgrade an IBC client to a new client
 // state
 type MsgUpgradeClient struct {
@@ -215,7 +297,7 @@ func (m *MsgUpgradeClient) Reset()         { *m = MsgUpgradeClient{} }
 func (m *MsgUpgradeClient) String() string { return proto.CompactTextString(m) }
 func (*MsgUpgradeClient) ProtoMessage()    {}
 func (*MsgUpgradeClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{4}
+	return fileDescriptor_cb5dc4651eb49a04, []int{6}
 }
 func (m *MsgUpgradeClient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +334,7 @@ func (m *MsgUpgradeClientResponse) Reset()         { *m = MsgUpgradeClientRespon
 func (m *MsgUpgradeClientResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpgradeClientResponse) ProtoMessage()    {}
 func (*MsgUpgradeClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{5}
+	return fileDescriptor_cb5dc4651eb49a04, []int{7}
 }
 func (m *MsgUpgradeClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +381,7 @@ func (m *MsgSubmitMisbehaviour) Reset()         { *m = MsgSubmitMisbehaviour{} }
 func (m *MsgSubmitMisbehaviour) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitMisbehaviour) ProtoMessage()    {}
 func (*MsgSubmitMisbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{6}
+	return fileDescriptor_cb5dc4651eb49a04, []int{8}
 }
 func (m *MsgSubmitMisbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +419,7 @@ func (m *MsgSubmitMisbehaviourResponse) Reset()         { *m = MsgSubmitMisbehav
 func (m *MsgSubmitMisbehaviourResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitMisbehaviourResponse) ProtoMessage()    {}
 func (*MsgSubmitMisbehaviourResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{7}
+	return fileDescriptor_cb5dc4651eb49a04, []int{9}
 }
 func (m *MsgSubmitMisbehaviourResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +463,7 @@ func (m *MsgRecoverClient) Reset()         { *m = MsgRecoverClient{} }
 func (m *MsgRecoverClient) String() string { return proto.CompactTextString(m) }
 func (*MsgRecoverClient) ProtoMessage()    {}
 func (*MsgRecoverClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{8}
+	return fileDescriptor_cb5dc4651eb49a04, []int{10}
 }
 func (m *MsgRecoverClient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +500,7 @@ func (m *MsgRecoverClientResponse) Reset()         { *m = MsgRecoverClientRespon
 func (m *MsgRecoverClientResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRecoverClientResponse) ProtoMessage()    {}
 func (*MsgRecoverClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{9}
+	return fileDescriptor_cb5dc4651eb49a04, []int{11}
 }
 func (m *MsgRecoverClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +549,7 @@ func (m *MsgIBCSoftwareUpgrade) Reset()         { *m = MsgIBCSoftwareUpgrade{} }
 func (m *MsgIBCSoftwareUpgrade) String() string { return proto.CompactTextString(m) }
 func (*MsgIBCSoftwareUpgrade) ProtoMessage()    {}
 func (*MsgIBCSoftwareUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{10}
+	return fileDescriptor_cb5dc4651eb49a04, []int{12}
 }
 func (m *MsgIBCSoftwareUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +607,7 @@ func (m *MsgIBCSoftwareUpgradeResponse) Reset()         { *m = MsgIBCSoftwareUpg
 func (m *MsgIBCSoftwareUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIBCSoftwareUpgradeResponse) ProtoMessage()    {}
 func (*MsgIBCSoftwareUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{11}
+	return fileDescriptor_cb5dc4651eb49a04, []int{13}
 }
 func (m *MsgIBCSoftwareUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,7 +650,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{12}
+	return fileDescriptor_cb5dc4651eb49a04, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +687,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5dc4651eb49a04, []int{13}
+	return fileDescriptor_cb5dc4651eb49a04, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,11 +716,101 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgPruneConsensusStates defines the sdk.Msg type to prune, in bulk, the expired consensus states of
+// a client and the ones which are not retained by the consensus state retention policy.
+type MsgPruneConsensusStates struct {
+	// client unique identifier, the consensus states of all clients are pruned if empty
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneConsensusStates) Reset()         { *m = MsgPruneConsensusStates{} }
+func (m *MsgPruneConsensusStates) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStates) ProtoMessage()    {}
+func (*MsgPruneConsensusStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{16}
+}
+func (m *MsgPruneConsensusStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStates.Merge(m, src)
+}
+func (m *MsgPruneConsensusStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStates proto.InternalMessageInfo
+
+// MsgPruneConsensusStatesResponse defines the Msg/PruneConsensusStates response type.
+type MsgPruneConsensusStatesResponse struct {
+	// the number of consensus states pruned
+	TotalPruned uint64 `protobuf:"varint,1,opt,name=total_pruned,json=totalPruned,proto3" json:"total_pruned,omitempty"`
+}
+
+func (m *MsgPruneConsensusStatesResponse) Reset()         { *m = MsgPruneConsensusStatesResponse{} }
+func (m *MsgPruneConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneConsensusStatesResponse) ProtoMessage()    {}
+func (*MsgPruneConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{17}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.Merge(m, src)
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneConsensusStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneConsensusStatesResponse proto.InternalMessageInfo
+
+func (m *MsgPruneConsensusStatesResponse) GetTotalPruned() uint64 {
+	if m != nil {
+		return m.TotalPruned
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
 	proto.RegisterType((*MsgUpdateClient)(nil), "ibc.core.client.v1.MsgUpdateClient")
 	proto.RegisterType((*MsgUpdateClientResponse)(nil), "ibc.core.client.v1.MsgUpdateClientResponse")
+	proto.RegisterType((*MsgUpdateClientBatch)(nil), "ibc.core.client.v1.MsgUpdateClientBatch")
+	proto.RegisterType((*MsgUpdateClientBatchResponse)(nil), "ibc.core.client.v1.MsgUpdateClientBatchResponse")
 	proto.RegisterType((*MsgUpgradeClient)(nil), "ibc.core.client.v1.MsgUpgradeClient")
 	proto.RegisterType((*MsgUpgradeClientResponse)(nil), "ibc.core.client.v1.MsgUpgradeClientResponse")
 	proto.RegisterType((*MsgSubmitMisbehaviour)(nil), "ibc.core.client.v1.MsgSubmitMisbehaviour")
@@ -649,63 +821,72 @@ func init() {
 	proto.RegisterType((*MsgIBCSoftwareUpgradeResponse)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.client.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.client.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPruneConsensusStates)(nil), "ibc.core.client.v1.MsgPruneConsensusStates")
+	proto.RegisterType((*MsgPruneConsensusStatesResponse)(nil), "ibc.core.client.v1.MsgPruneConsensusStatesResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8e, 0xd3, 0x36, 0xfa, 0xf5, 0x9a, 0x36, 0xbf, 0x1e, 0x29, 0x4d, 0x5d, 0x9a, 0x54, 0xa1,
-	0x43, 0x29, 0xd4, 0x6e, 0x8a, 0x04, 0x11, 0x88, 0xa1, 0xcd, 0x42, 0x87, 0x48, 0x95, 0x2b, 0x16,
-	0x96, 0x60, 0x3b, 0x97, 0xab, 0x51, 0xec, 0xb3, 0x7c, 0xe7, 0x40, 0x37, 0xc4, 0xc4, 0xc8, 0xc0,
-	0xc2, 0xc6, 0x9f, 0x50, 0xf1, 0x07, 0xb0, 0x21, 0x75, 0xec, 0xc8, 0x84, 0x50, 0x3b, 0x54, 0xe2,
-	0xaf, 0x40, 0xf6, 0x5d, 0x52, 0xdb, 0x89, 0x43, 0x2a, 0xb6, 0xd8, 0xef, 0x7b, 0xf7, 0xbe, 0xef,
-	0xdd, 0x7b, 0x5f, 0x0c, 0x56, 0x2d, 0xc3, 0x54, 0x4d, 0xe2, 0x21, 0xd5, 0xec, 0x5a, 0xc8, 0x61,
-	0x6a, 0xaf, 0xa6, 0xb2, 0xb7, 0x8a, 0xeb, 0x11, 0x46, 0x20, 0xb4, 0x0c, 0x53, 0x09, 0x82, 0x0a,
-	0x0f, 0x2a, 0xbd, 0x9a, 0xbc, 0x6c, 0x12, 0x6a, 0x13, 0xaa, 0xda, 0x14, 0x07, 0x58, 0x9b, 0x62,
-	0x0e, 0x96, 0x37, 0x44, 0xc0, 0x77, 0xb1, 0xa7, 0xb7, 0x91, 0xda, 0xab, 0x19, 0x88, 0xe9, 0xb5,
-	0xfe, 0xb3, 0x40, 0x15, 0x31, 0xc1, 0x24, 0xfc, 0xa9, 0x06, 0xbf, 0xc4, 0xdb, 0x15, 0x4c, 0x08,
-	0xee, 0x22, 0x35, 0x7c, 0x32, 0xfc, 0x8e, 0xaa, 0x3b, 0x27, 0x22, 0x54, 0x19, 0x41, 0x50, 0xb0,
-	0x09, 0x01, 0xd5, 0xaf, 0x12, 0x28, 0x34, 0x29, 0x6e, 0x78, 0x48, 0x67, 0xa8, 0x11, 0x46, 0xe0,
-	0x63, 0x90, 0xe7, 0x98, 0x16, 0x65, 0x3a, 0x43, 0x25, 0x69, 0x5d, 0xda, 0x9c, 0xdb, 0x2d, 0x2a,
-	0xbc, 0x8c, 0xd2, 0x2f, 0xa3, 0xec, 0x39, 0x27, 0xda, 0x1c, 0x47, 0x1e, 0x05, 0x40, 0xf8, 0x0c,
-	0x14, 0x4c, 0xe2, 0x50, 0xe4, 0x50, 0x9f, 0x8a, 0xdc, 0xec, 0x98, 0xdc, 0x85, 0x01, 0x98, 0xa7,
-	0xdf, 0x06, 0x39, 0x6a, 0x61, 0x07, 0x79, 0xa5, 0xa9, 0x75, 0x69, 0x73, 0x56, 0x13, 0x4f, 0x4f,
-	0x0a, 0x1f, 0xbe, 0x54, 0x32, 0xef, 0xaf, 0x4e, 0xb7, 0xc4, 0x8b, 0xea, 0x0a, 0x58, 0x4e, 0x70,
-	0xd6, 0x10, 0x75, 0x83, 0xc3, 0xaa, 0x9f, 0xb8, 0x9e, 0x17, 0x6e, 0xfb, 0x5a, 0xcf, 0x2a, 0x98,
-	0x15, 0x7a, 0xac, 0x76, 0x28, 0x66, 0x56, 0xfb, 0x8f, 0xbf, 0x38, 0x68, 0xc3, 0xa7, 0x60, 0x41,
-	0x04, 0x6d, 0x44, 0xa9, 0x8e, 0xc7, 0x53, 0x9e, 0xe7, 0xd8, 0x26, 0x87, 0xde, 0x94, 0x71, 0x94,
-	0xd5, 0x80, 0xf1, 0xf7, 0x2c, 0xf8, 0x3f, 0x8c, 0x85, 0x17, 0x3d, 0x09, 0xe5, 0xe4, 0xfd, 0x64,
-	0xff, 0xe1, 0x7e, 0xa6, 0x6e, 0x70, 0x3f, 0x3b, 0xa0, 0xe8, 0x7a, 0x84, 0x74, 0x5a, 0x62, 0x28,
-	0x5b, 0xfc, 0xec, 0xd2, 0xf4, 0xba, 0xb4, 0x99, 0xd7, 0x60, 0x18, 0x8b, 0xcb, 0xd8, 0x03, 0x6b,
-	0x89, 0x8c, 0x44, 0xf9, 0x99, 0x30, 0x55, 0x8e, 0xa5, 0xa6, 0x0d, 0x45, 0x6e, 0x7c, 0x8b, 0x65,
-	0x50, 0x4a, 0xb6, 0x71, 0xd0, 0xe3, 0xcf, 0x12, 0x58, 0x6a, 0x52, 0x7c, 0xe4, 0x1b, 0xb6, 0xc5,
-	0x9a, 0x16, 0x35, 0xd0, 0xb1, 0xde, 0xb3, 0x88, 0xef, 0x8d, 0x6f, 0x74, 0x1d, 0xe4, 0xed, 0x08,
-	0x78, 0x6c, 0xa3, 0x63, 0xc8, 0xd4, 0xc1, 0x58, 0x4c, 0xb0, 0x2e, 0x49, 0xd5, 0x0a, 0x58, 0x1b,
-	0x49, 0x2d, 0x4a, 0x3e, 0x18, 0x10, 0x0d, 0x99, 0xa4, 0x87, 0x3c, 0xd1, 0xd9, 0x2d, 0xb0, 0x48,
-	0x7d, 0xe3, 0x35, 0x32, 0x59, 0x2b, 0xc9, 0xbf, 0x20, 0x02, 0x8d, 0xbe, 0x8c, 0x1d, 0x50, 0xa4,
-	0xbe, 0x41, 0x99, 0xc5, 0x7c, 0x86, 0x22, 0xf0, 0x6c, 0x08, 0x87, 0xd7, 0xb1, 0x41, 0xc6, 0xc4,
-	0x73, 0xcd, 0x9b, 0x1e, 0xa3, 0x36, 0xe0, 0xfd, 0x8d, 0x37, 0xfd, 0x60, 0xbf, 0x71, 0x44, 0x3a,
-	0xec, 0x8d, 0xee, 0x21, 0x71, 0x39, 0xf0, 0x11, 0x98, 0x76, 0xbb, 0xba, 0x23, 0x8c, 0xe5, 0x8e,
-	0xc2, 0xbd, 0x4f, 0xe9, 0x7b, 0x9d, 0xf0, 0x3e, 0xe5, 0xb0, 0xab, 0x3b, 0xfb, 0xd3, 0x67, 0x3f,
-	0x2b, 0x19, 0x2d, 0xc4, 0xc3, 0xe7, 0x60, 0x49, 0x60, 0xda, 0xad, 0x89, 0x37, 0xe0, 0x56, 0x3f,
-	0xa5, 0x11, 0xd9, 0x84, 0x34, 0x81, 0x73, 0x51, 0x71, 0xfc, 0x66, 0x86, 0xf9, 0x0f, 0x14, 0xb2,
-	0x88, 0xd7, 0x1c, 0xea, 0x9e, 0x6e, 0xd3, 0xc8, 0xc1, 0x52, 0xf4, 0x60, 0x58, 0x07, 0x39, 0x37,
-	0x44, 0x08, 0xae, 0xb2, 0x32, 0xfc, 0xef, 0xa0, 0xf0, 0x33, 0x84, 0x64, 0x81, 0x1f, 0xef, 0x25,
-	0x3c, 0xa3, 0x4f, 0x68, 0xf7, 0xf7, 0x0c, 0x98, 0x6a, 0x52, 0x0c, 0x5f, 0x81, 0x7c, 0xcc, 0xd1,
-	0xef, 0x8e, 0xaa, 0x96, 0xb0, 0x50, 0xf9, 0xfe, 0x04, 0xa0, 0x7e, 0xa5, 0xa0, 0x42, 0xcc, 0x63,
-	0xd3, 0x2a, 0x44, 0x41, 0xa9, 0x15, 0x46, 0xf9, 0x22, 0x34, 0xc1, 0x7c, 0xdc, 0x4c, 0x36, 0x52,
-	0xb3, 0x23, 0x28, 0xf9, 0xc1, 0x24, 0xa8, 0x41, 0x11, 0x0f, 0xc0, 0x11, 0xa6, 0x70, 0x2f, 0xe5,
-	0x8c, 0x61, 0xa8, 0x5c, 0x9b, 0x18, 0x1a, 0x15, 0x16, 0xdf, 0xe5, 0x34, 0x61, 0x31, 0x54, 0xaa,
-	0xb0, 0x91, 0xcb, 0x17, 0x08, 0x1b, 0xb1, 0x78, 0x69, 0xc2, 0x86, 0xa1, 0xa9, 0xc2, 0xd2, 0xd7,
-	0x01, 0x76, 0x00, 0x8c, 0xde, 0xa4, 0xd8, 0x88, 0xf1, 0x93, 0xc1, 0x41, 0x7f, 0x99, 0x8c, 0xf8,
-	0x94, 0xcb, 0x33, 0xef, 0xae, 0x4e, 0xb7, 0xa4, 0x7d, 0xed, 0xec, 0xa2, 0x2c, 0x9d, 0x5f, 0x94,
-	0xa5, 0x5f, 0x17, 0x65, 0xe9, 0xe3, 0x65, 0x39, 0x73, 0x7e, 0x59, 0xce, 0xfc, 0xb8, 0x2c, 0x67,
-	0x5e, 0xd6, 0xb1, 0xc5, 0x8e, 0x7d, 0x43, 0x31, 0x89, 0xad, 0x8a, 0xef, 0x2a, 0xcb, 0x30, 0xb7,
-	0x31, 0x51, 0x7b, 0x75, 0xd5, 0x26, 0x6d, 0xbf, 0x8b, 0x28, 0xff, 0x2a, 0xda, 0xd9, 0xdd, 0x16,
-	0x1f, 0x46, 0xec, 0xc4, 0x45, 0xd4, 0xc8, 0x85, 0xd6, 0xf1, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa3, 0x68, 0xc1, 0x10, 0xd9, 0x09, 0x00, 0x00,
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0x45, 0xd9, 0x71, 0xeb, 0x67, 0x25, 0xae, 0x59, 0xa5, 0x51, 0x98, 0x44, 0x52, 0xd5,
+	0x0c, 0xaa, 0x9d, 0x90, 0x92, 0x03, 0xb4, 0x42, 0x8b, 0x0e, 0x91, 0x3a, 0x34, 0x83, 0x00, 0x83,
+	0x46, 0x97, 0x2e, 0x0a, 0x49, 0x9d, 0x68, 0x16, 0x22, 0x8f, 0xe0, 0x1d, 0xd5, 0x78, 0x2b, 0x3a,
+	0x75, 0xec, 0xd0, 0xa5, 0xe8, 0xd2, 0x8f, 0x10, 0xf4, 0x03, 0x74, 0x2b, 0x90, 0x31, 0x4b, 0x81,
+	0x4e, 0x45, 0x61, 0x0f, 0xfe, 0x1a, 0x05, 0xef, 0x4e, 0x34, 0x49, 0x91, 0x04, 0x8d, 0x6e, 0x24,
+	0xdf, 0xef, 0xdd, 0xfb, 0xbf, 0x77, 0xef, 0xde, 0x11, 0x1e, 0x38, 0xa6, 0xa5, 0x59, 0x38, 0x40,
+	0x9a, 0xb5, 0x74, 0x90, 0x47, 0xb5, 0xd5, 0x50, 0xa3, 0xaf, 0x54, 0x3f, 0xc0, 0x14, 0xcb, 0xb2,
+	0x63, 0x5a, 0x6a, 0x64, 0x54, 0xb9, 0x51, 0x5d, 0x0d, 0x95, 0x7b, 0x16, 0x26, 0x2e, 0x26, 0x9a,
+	0x4b, 0xec, 0x88, 0x75, 0x89, 0xcd, 0x61, 0xe5, 0xb1, 0x30, 0x84, 0xbe, 0x1d, 0x18, 0x73, 0xa4,
+	0xad, 0x86, 0x26, 0xa2, 0xc6, 0x70, 0xfd, 0x2e, 0xa8, 0xa6, 0x8d, 0x6d, 0xcc, 0x1e, 0xb5, 0xe8,
+	0x49, 0x7c, 0xbd, 0x6f, 0x63, 0x6c, 0x2f, 0x91, 0xc6, 0xde, 0xcc, 0x70, 0xa1, 0x19, 0xde, 0xb9,
+	0x30, 0x75, 0x72, 0x04, 0x0a, 0x35, 0x0c, 0xe8, 0xfd, 0x2e, 0xc1, 0xfe, 0x94, 0xd8, 0x93, 0x00,
+	0x19, 0x14, 0x4d, 0x98, 0x45, 0xfe, 0x14, 0x1a, 0x9c, 0x99, 0x11, 0x6a, 0x50, 0xd4, 0x92, 0xba,
+	0x52, 0x7f, 0xef, 0xb8, 0xa9, 0xf2, 0x30, 0xea, 0x3a, 0x8c, 0xfa, 0xdc, 0x3b, 0xd7, 0xf7, 0x38,
+	0x79, 0x1a, 0x81, 0xf2, 0x17, 0xb0, 0x6f, 0x61, 0x8f, 0x20, 0x8f, 0x84, 0x44, 0xf8, 0xd6, 0x4b,
+	0x7c, 0xef, 0xc4, 0x30, 0x77, 0xff, 0x00, 0x76, 0x88, 0x63, 0x7b, 0x28, 0x68, 0x6d, 0x75, 0xa5,
+	0xfe, 0xae, 0x2e, 0xde, 0x3e, 0xdb, 0xff, 0xf1, 0xb7, 0x4e, 0xed, 0x87, 0xab, 0xd7, 0x87, 0xe2,
+	0x43, 0xef, 0x3e, 0xdc, 0xcb, 0x68, 0xd6, 0x11, 0xf1, 0xa3, 0xc5, 0x7a, 0x3f, 0xf3, 0x7c, 0xbe,
+	0xf6, 0xe7, 0xd7, 0xf9, 0x3c, 0x80, 0x5d, 0x91, 0x8f, 0x33, 0x67, 0xc9, 0xec, 0xea, 0xef, 0xf2,
+	0x0f, 0x2f, 0xe6, 0xf2, 0xe7, 0x70, 0x47, 0x18, 0x5d, 0x44, 0x88, 0x61, 0x97, 0x4b, 0xbe, 0xcd,
+	0xd9, 0x29, 0x47, 0x6f, 0xaa, 0x38, 0xa9, 0x2a, 0x56, 0xfc, 0xab, 0x04, 0xcd, 0x8c, 0x6d, 0x6c,
+	0x50, 0xeb, 0xac, 0x5c, 0x76, 0x54, 0xea, 0x94, 0x6c, 0xd2, 0xaa, 0x77, 0xb7, 0x4a, 0x4a, 0x9d,
+	0xd4, 0x4d, 0xaa, 0x0b, 0x6f, 0xc3, 0xc3, 0x3c, 0x71, 0xb1, 0xfa, 0x3f, 0xeb, 0xf0, 0x1e, 0x03,
+	0x58, 0x9b, 0x56, 0x29, 0x78, 0xb6, 0xbb, 0xea, 0xff, 0xa3, 0xbb, 0xb6, 0x6e, 0xd0, 0x5d, 0x03,
+	0x68, 0xfa, 0x01, 0xc6, 0x8b, 0x99, 0x38, 0x52, 0x33, 0xbe, 0x76, 0x6b, 0xbb, 0x2b, 0xf5, 0x1b,
+	0xba, 0xcc, 0x6c, 0xe9, 0x34, 0x9e, 0xc3, 0xa3, 0x8c, 0x47, 0x26, 0xfc, 0x2d, 0xe6, 0xaa, 0xa4,
+	0x5c, 0x8b, 0x5a, 0x7a, 0xa7, 0xbc, 0xce, 0x0a, 0xb4, 0xb2, 0x65, 0x8c, 0x6b, 0xfc, 0x8b, 0x04,
+	0x77, 0xa7, 0xc4, 0x3e, 0x0d, 0x4d, 0xd7, 0xa1, 0x53, 0x87, 0x98, 0xe8, 0xcc, 0x58, 0x39, 0x38,
+	0x0c, 0xca, 0x0b, 0x3d, 0x82, 0x86, 0x9b, 0x80, 0x4b, 0x0b, 0x9d, 0x22, 0x0b, 0xbb, 0xe3, 0x20,
+	0xa3, 0xba, 0x25, 0xf5, 0x3a, 0xf0, 0x28, 0x57, 0x5a, 0x52, 0x7c, 0xd4, 0x20, 0x3a, 0xb2, 0xf0,
+	0x0a, 0x05, 0xa2, 0xb2, 0x87, 0x70, 0x40, 0x42, 0xf3, 0x5b, 0x64, 0xd1, 0x59, 0x56, 0xff, 0xbe,
+	0x30, 0x4c, 0xd6, 0x69, 0x0c, 0xa0, 0x49, 0x42, 0x93, 0x50, 0x87, 0x86, 0x14, 0x25, 0xf0, 0x3a,
+	0xc3, 0xe5, 0x6b, 0x5b, 0xec, 0x51, 0xb9, 0xb9, 0x79, 0xd1, 0x53, 0xd2, 0x62, 0xdd, 0x7f, 0xf0,
+	0xa2, 0xbf, 0x18, 0x4f, 0x4e, 0xf1, 0x82, 0x7e, 0x67, 0x04, 0x48, 0x6c, 0x8e, 0xfc, 0x09, 0x6c,
+	0xfb, 0x4b, 0xc3, 0x13, 0x63, 0xf1, 0xa1, 0xca, 0x27, 0xb7, 0xba, 0x9e, 0xd4, 0x62, 0x72, 0xab,
+	0x27, 0x4b, 0xc3, 0x1b, 0x6f, 0xbf, 0xf9, 0xa7, 0x53, 0xd3, 0x19, 0x2f, 0x7f, 0x05, 0x77, 0x05,
+	0x33, 0x9f, 0x55, 0x3e, 0x01, 0xef, 0xaf, 0x5d, 0x26, 0x89, 0x93, 0x50, 0x94, 0xe0, 0x5e, 0x32,
+	0x39, 0xbe, 0x33, 0x9b, 0xfa, 0xe3, 0x0c, 0x69, 0x62, 0x52, 0x9e, 0x18, 0x81, 0xe1, 0x26, 0xc7,
+	0x82, 0x94, 0x5c, 0x58, 0x1e, 0xc1, 0x8e, 0xcf, 0x08, 0xa1, 0x55, 0x51, 0x37, 0xef, 0x36, 0x95,
+	0xaf, 0x21, 0x52, 0x16, 0x7c, 0xf9, 0x24, 0xe4, 0x1e, 0xb1, 0xa0, 0x19, 0x33, 0x9d, 0x04, 0xa1,
+	0x97, 0x39, 0x46, 0xa4, 0xbc, 0xd1, 0xaf, 0x55, 0xd7, 0xcb, 0xf7, 0xfb, 0x4b, 0xe8, 0x14, 0x04,
+	0x58, 0x6b, 0x90, 0x3f, 0x84, 0x06, 0xc5, 0xd4, 0x58, 0xce, 0xfc, 0x88, 0xe2, 0xb1, 0xb6, 0xf5,
+	0x3d, 0xf6, 0x8d, 0x39, 0xce, 0x8f, 0xff, 0x7a, 0x07, 0xb6, 0xa6, 0xc4, 0x96, 0x5f, 0x42, 0x23,
+	0x75, 0x6d, 0x7e, 0x94, 0x57, 0x94, 0xcc, 0x3d, 0xa5, 0x1c, 0x55, 0x80, 0x62, 0x31, 0x2f, 0xa1,
+	0x91, 0xba, 0xc8, 0x8a, 0x22, 0x24, 0x21, 0xe5, 0xa8, 0x02, 0x14, 0x47, 0xc0, 0x70, 0xb0, 0x79,
+	0xf1, 0xf4, 0x2b, 0xac, 0xc0, 0x48, 0x65, 0x50, 0x95, 0x8c, 0x03, 0x5a, 0x70, 0x3b, 0x3d, 0x64,
+	0x1f, 0x17, 0x2e, 0x91, 0xa0, 0x94, 0x27, 0x55, 0xa8, 0x38, 0x48, 0x00, 0x72, 0xce, 0xb0, 0xfc,
+	0xb8, 0x60, 0x8d, 0x4d, 0x54, 0x19, 0x56, 0x46, 0x93, 0x89, 0xa5, 0x67, 0x5c, 0x51, 0x62, 0x29,
+	0x4a, 0x79, 0x52, 0x85, 0x4a, 0x26, 0x96, 0x33, 0x90, 0x8a, 0x12, 0xdb, 0x44, 0x95, 0x61, 0x65,
+	0x34, 0x8e, 0xb9, 0x00, 0x39, 0xb9, 0x9d, 0x62, 0x52, 0x94, 0xb7, 0x22, 0x87, 0x94, 0xa3, 0x0a,
+	0x50, 0x1c, 0xe7, 0x15, 0x34, 0x73, 0x8f, 0x7e, 0xd1, 0x22, 0x79, 0xb0, 0xf2, 0xec, 0x06, 0xf0,
+	0x3a, 0xb2, 0x72, 0xeb, 0xfb, 0xab, 0xd7, 0x87, 0xd2, 0x58, 0x7f, 0x73, 0xd1, 0x96, 0xde, 0x5e,
+	0xb4, 0xa5, 0x7f, 0x2f, 0xda, 0xd2, 0x4f, 0x97, 0xed, 0xda, 0xdb, 0xcb, 0x76, 0xed, 0xef, 0xcb,
+	0x76, 0xed, 0x9b, 0x91, 0xed, 0xd0, 0xb3, 0xd0, 0x54, 0x2d, 0xec, 0x6a, 0xe2, 0x3f, 0xdd, 0x31,
+	0xad, 0xa7, 0x36, 0xd6, 0x56, 0x23, 0xcd, 0xc5, 0xf3, 0x70, 0x89, 0x08, 0xff, 0xcb, 0x1e, 0x1c,
+	0x3f, 0x15, 0x3f, 0xda, 0xf4, 0xdc, 0x47, 0xc4, 0xdc, 0x61, 0xc3, 0xfc, 0xd9, 0x7f, 0x03, 0x00,
+	0x60, 0x15, 0xe5, 0xe3, 0x29, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -724,6 +905,8 @@ type MsgClient interface {
 	CreateClient(ctx context.Context, in *MsgCreateClient, opts ...grpc.CallOption) (*MsgCreateClientResponse, error)
 	// UpdateClient defines a rpc handler method for MsgUpdateClient.
 	UpdateClient(ctx context.Context, in *MsgUpdateClient, opts ...grpc.CallOption) (*MsgUpdateClientResponse, error)
+	// UpdateClientBatch defines a rpc handler method for MsgUpdateClientBatch.
+	UpdateClientBatch(ctx context.Context, in *MsgUpdateClientBatch, opts ...grpc.CallOption) (*MsgUpdateClientBatchResponse, error)
 	// UpgradeClient defines a rpc handler method for MsgUpgradeClient.
 	UpgradeClient(ctx context.Context, in *MsgUpgradeClient, opts ...grpc.CallOption) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
@@ -734,6 +917,8 @@ type MsgClient interface {
 	IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 	UpdateClientParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error)
 }
 
 type msgClient struct {
@@ -762,6 +947,15 @@ func (c *msgClient) UpdateClient(ctx context.Context, in *MsgUpdateClient, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateClientBatch(ctx context.Context, in *MsgUpdateClientBatch, opts ...grpc.CallOption) (*MsgUpdateClientBatchResponse, error) {
+	out := new(MsgUpdateClientBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/UpdateClientBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpgradeClient(ctx context.Context, in *MsgUpgradeClient, opts ...grpc.CallOption) (*MsgUpgradeClientResponse, error) {
 	out := new(MsgUpgradeClientResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/UpgradeClient", in, out, opts...)
@@ -807,12 +1001,23 @@ func (c *msgClient) UpdateClientParams(ctx context.Context, in *MsgUpdateParams,
 	return out, nil
 }
 
+func (c *msgClient) PruneConsensusStates(ctx context.Context, in *MsgPruneConsensusStates, opts ...grpc.CallOption) (*MsgPruneConsensusStatesResponse, error) {
+	out := new(MsgPruneConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/PruneConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
 	CreateClient(context.Context, *MsgCreateClient) (*MsgCreateClientResponse, error)
 	// UpdateClient defines a rpc handler method for MsgUpdateClient.
 	UpdateClient(context.Context, *MsgUpdateClient) (*MsgUpdateClientResponse, error)
+	// UpdateClientBatch defines a rpc handler method for MsgUpdateClientBatch.
+	UpdateClientBatch(context.Context, *MsgUpdateClientBatch) (*MsgUpdateClientBatchResponse, error)
 	// UpgradeClient defines a rpc handler method for MsgUpgradeClient.
 	UpgradeClient(context.Context, *MsgUpgradeClient) (*MsgUpgradeClientResponse, error)
 	// SubmitMisbehaviour defines a rpc handler method for MsgSubmitMisbehaviour.
@@ -823,6 +1028,8 @@ type MsgServer interface {
 	IBCSoftwareUpgrade(context.Context, *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 	UpdateClientParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+	PruneConsensusStates(context.Context, *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -835,6 +1042,9 @@ func (*UnimplementedMsgServer) CreateClient(ctx context.Context, req *MsgCreateC
 func (*UnimplementedMsgServer) UpdateClient(ctx context.Context, req *MsgUpdateClient) (*MsgUpdateClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClient not implemented")
 }
+func (*UnimplementedMsgServer) UpdateClientBatch(ctx context.Context, req *MsgUpdateClientBatch) (*MsgUpdateClientBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientBatch not implemented")
+}
 func (*UnimplementedMsgServer) UpgradeClient(ctx context.Context, req *MsgUpgradeClient) (*MsgUpgradeClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradeClient not implemented")
 }
@@ -850,6 +1060,9 @@ func (*UnimplementedMsgServer) IBCSoftwareUpgrade(ctx context.Context, req *MsgI
 func (*UnimplementedMsgServer) UpdateClientParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientParams not implemented")
 }
+func (*UnimplementedMsgServer) PruneConsensusStates(ctx context.Context, req *MsgPruneConsensusStates) (*MsgPruneConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneConsensusStates not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -891,6 +1104,24 @@ func _Msg_UpdateClient_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateClientBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClientBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClientBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/UpdateClientBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClientBatch(ctx, req.(*MsgUpdateClientBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpgradeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpgradeClient)
 	if err := dec(in); err != nil {
@@ -981,6 +1212,24 @@ func _Msg_UpdateClientParams_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneConsensusStates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/PruneConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneConsensusStates(ctx, req.(*MsgPruneConsensusStates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -993,6 +1242,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateClient",
 			Handler:    _Msg_UpdateClient_Handler,
 		},
+		{
+			MethodName: "UpdateClientBatch",
+			Handler:    _Msg_UpdateClientBatch_Handler,
+		},
 		{
 			MethodName: "UpgradeClient",
 			Handler:    _Msg_UpgradeClient_Handler,
@@ -1013,6 +1266,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateClientParams",
 			Handler:    _Msg_UpdateClientParams_Handler,
 		},
+		{
+			MethodName: "PruneConsensusStates",
+			Handler:    _Msg_PruneConsensusStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -1167,6 +1424,80 @@ func (m *MsgUpdateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateClientBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClientBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClientBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientMessages) > 0 {
+		for iNdEx := len(m.ClientMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClientBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClientBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClientBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpgradeClient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1542,32 +1873,97 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPruneConsensusStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPruneConsensusStates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ConsensusState != nil {
-		l = m.ConsensusState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1613,6 +2009,38 @@ func (m *MsgUpdateClientResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateClientBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ClientMessages) > 0 {
+		for _, e := range m.ClientMessages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateClientBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpgradeClient) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1753,27 +2181,260 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneConsensusStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPruned != 0 {
+		n += 1 + sovTx(uint64(m.TotalPruned))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1796,17 +2457,17 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1816,31 +2477,27 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMessage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1867,10 +2524,10 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
+			if m.ClientMessage == nil {
+				m.ClientMessage = &types.Any{}
 			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClientMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1927,7 +2584,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1950,10 +2607,10 @@ func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1977,7 +2634,7 @@ func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClientBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,10 +2657,10 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClientBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClientBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2040,7 +2697,7 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMessages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2067,10 +2724,8 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientMessage == nil {
-				m.ClientMessage = &types.Any{}
-			}
-			if err := m.ClientMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClientMessages = append(m.ClientMessages, &types.Any{})
+			if err := m.ClientMessages[len(m.ClientMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2127,7 +2782,7 @@ func (m *MsgUpdateClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateClientBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2150,10 +2805,10 @@ func (m *MsgUpdateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateClientBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateClientBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3243,6 +3898,189 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPruned", wireType)
+			}
+			m.TotalPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 )
 
 // emitConnectionOpenInitEvent emits a connection open init event
-func emitConnectionOpenInitEvent(ctx sdk.Context, connectionID string, clientID string, counterparty types.Counterparty) {
+func (k Keeper) emitConnectionOpenInitEvent(ctx sdk.Context, connectionID string, clientID string, counterparty types.Counterparty) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeConnectionOpenInit,
@@ -20,10 +24,16 @@ func emitConnectionOpenInitEvent(ctx sdk.Context, connectionID string, clientID
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionOpenInit{
+		ConnectionId:         connectionID,
+		ClientId:             clientID,
+		CounterpartyClientId: counterparty.ClientId,
+	})
 }
 
 // emitConnectionOpenTryEvent emits a connection open try event
-func emitConnectionOpenTryEvent(ctx sdk.Context, connectionID string, clientID string, counterparty types.Counterparty) {
+func (k Keeper) emitConnectionOpenTryEvent(ctx sdk.Context, connectionID string, clientID string, counterparty types.Counterparty) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeConnectionOpenTry,
@@ -37,10 +47,17 @@ func emitConnectionOpenTryEvent(ctx sdk.Context, connectionID string, clientID s
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionOpenTry{
+		ConnectionId:             connectionID,
+		ClientId:                 clientID,
+		CounterpartyClientId:     counterparty.ClientId,
+		CounterpartyConnectionId: counterparty.ConnectionId,
+	})
 }
 
 // emitConnectionOpenAckEvent emits a connection open acknowledge event
-func emitConnectionOpenAckEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+func (k Keeper) emitConnectionOpenAckEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeConnectionOpenAck,
@@ -54,10 +71,17 @@ func emitConnectionOpenAckEvent(ctx sdk.Context, connectionID string, connection
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionOpenAck{
+		ConnectionId:             connectionID,
+		ClientId:                 connectionEnd.ClientId,
+		CounterpartyClientId:     connectionEnd.Counterparty.ClientId,
+		CounterpartyConnectionId: connectionEnd.Counterparty.ConnectionId,
+	})
 }
 
 // emitConnectionOpenConfirmEvent emits a connection open confirm event
-func emitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+func (k Keeper) emitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeConnectionOpenConfirm,
@@ -71,4 +95,126 @@ func emitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connec
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionOpenConfirm{
+		ConnectionId:             connectionID,
+		ClientId:                 connectionEnd.ClientId,
+		CounterpartyClientId:     connectionEnd.Counterparty.ClientId,
+		CounterpartyConnectionId: connectionEnd.Counterparty.ConnectionId,
+	})
+}
+
+// emitConnectionCloseInitEvent emits a connection close init event
+func (k Keeper) emitConnectionCloseInitEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionCloseInit,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionCloseInit{
+		ConnectionId:             connectionID,
+		ClientId:                 connectionEnd.ClientId,
+		CounterpartyClientId:     connectionEnd.Counterparty.ClientId,
+		CounterpartyConnectionId: connectionEnd.Counterparty.ConnectionId,
+	})
+}
+
+// emitConnectionCloseConfirmEvent emits a connection close confirm event
+func (k Keeper) emitConnectionCloseConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionCloseConfirm,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionCloseConfirm{
+		ConnectionId:             connectionID,
+		ClientId:                 connectionEnd.ClientId,
+		CounterpartyClientId:     connectionEnd.Counterparty.ClientId,
+		CounterpartyConnectionId: connectionEnd.Counterparty.ConnectionId,
+	})
+}
+
+// emitConnectionUpgradeEvent emits an event for the provided connection upgrade handshake step
+func (k Keeper) emitConnectionUpgradeEvent(ctx sdk.Context, eventType, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", upgrade.DelayPeriod)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, fmt.Sprintf("%d", upgrade.TimeoutTimestamp)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionUpgrade{
+		Step:                     eventType,
+		ConnectionId:             connectionID,
+		ClientId:                 connectionEnd.ClientId,
+		CounterpartyClientId:     connectionEnd.Counterparty.ClientId,
+		CounterpartyConnectionId: connectionEnd.Counterparty.ConnectionId,
+		UpgradeSequence:          connectionEnd.UpgradeSequence,
+		Upgrade:                  &upgrade,
+	})
+}
+
+// emitConnectionUpgradeErrorEvent emits an error receipt event for a connection upgrade
+func (k Keeper) emitConnectionUpgradeErrorEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgradeErr *types.UpgradeError) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeError,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", upgradeErr.GetErrorReceipt().Sequence)),
+			// NOTE: this error is expected to be used in off-chain contexts only and not written to state
+			sdk.NewAttribute(types.AttributeKeyUpgradeErrorReceipt, upgradeErr.Error()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventConnectionUpgradeError{
+		ConnectionId:             connectionID,
+		CounterpartyConnectionId: connectionEnd.Counterparty.ConnectionId,
+		UpgradeSequence:          upgradeErr.GetErrorReceipt().Sequence,
+		ErrorReceipt:             upgradeErr.Error(),
+	})
+}
+
+// emitTypedEvent emits the given typed event if typed events are enabled in the connection params.
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if !k.GetParams(ctx).EmitTypedEvents {
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit typed event", "type", proto.MessageName(event), "error", err)
+	}
 }
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	testCases := []struct {
+		name            string
+		emitTypedEvents bool
+	}{
+		{"typed events enabled", true},
+		{"typed events disabled", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			params := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetParams(suite.chainA.GetContext())
+			params.EmitTypedEvents = tc.emitTypedEvents
+			suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			ctx := suite.chainA.GetContext()
+			counterparty := types.NewCounterparty(path.EndpointB.ClientID, "", suite.chainB.GetPrefix())
+			connectionID, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnOpenInit(ctx, path.EndpointA.ClientID, counterparty, nil, 0)
+			suite.Require().NoError(err)
+
+			typedEvents, err := ibctesting.ParseTypedEvents(ctx.EventManager().ABCIEvents())
+			suite.Require().NoError(err)
+
+			if tc.emitTypedEvents {
+				ibctesting.AssertTypedEvents(&suite.Suite, []proto.Message{
+					&types.EventConnectionOpenInit{ConnectionId: connectionID, ClientId: path.EndpointA.ClientID, CounterpartyClientId: path.EndpointB.ClientID},
+				}, ctx.EventManager().ABCIEvents())
+			} else {
+				suite.Require().Empty(typedEvents)
+			}
+		})
+	}
+}
@@ -33,15 +33,18 @@ func (k Keeper) ConnOpenInit(
 		versions = []*types.Version{version}
 	}
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
+	if _, found := k.clientKeeper.GetClientState(ctx, clientID); !found {
 		return "", errorsmod.Wrapf(clienttypes.ErrClientNotFound, "clientID (%s)", clientID)
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return "", errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
+	if err := k.validateDelayPeriod(ctx, delayPeriod); err != nil {
+		return "", err
+	}
+
 	connectionID := k.GenerateConnectionIdentifier(ctx)
 	if err := k.addConnectionToClient(ctx, clientID, connectionID); err != nil {
 		return "", err
@@ -55,7 +58,7 @@ func (k Keeper) ConnOpenInit(
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-init")
 
-	emitConnectionOpenInitEvent(ctx, connectionID, clientID, counterparty)
+	k.emitConnectionOpenInitEvent(ctx, connectionID, clientID, counterparty)
 
 	return connectionID, nil
 }
@@ -79,6 +82,10 @@ func (k Keeper) ConnOpenTry(
 	proofHeight exported.Height, // height at which relayer constructs proof of A storing connectionEnd in state
 	_ exported.Height, // latest height of chain B which chain A has stored in its chain B client
 ) (string, error) {
+	if err := k.validateDelayPeriod(ctx, delayPeriod); err != nil {
+		return "", err
+	}
+
 	// generate a new connection
 	connectionID := k.GenerateConnectionIdentifier(ctx)
 
@@ -118,7 +125,7 @@ func (k Keeper) ConnOpenTry(
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-try")
 
-	emitConnectionOpenTryEvent(ctx, connectionID, clientID, counterparty)
+	k.emitConnectionOpenTryEvent(ctx, connectionID, clientID, counterparty)
 
 	return connectionID, nil
 }
@@ -183,7 +190,7 @@ func (k Keeper) ConnOpenAck(
 	connection.Counterparty.ConnectionId = counterpartyConnectionID
 	k.SetConnection(ctx, connectionID, connection)
 
-	emitConnectionOpenAckEvent(ctx, connectionID, connection)
+	k.emitConnectionOpenAckEvent(ctx, connectionID, connection)
 
 	return nil
 }
@@ -231,7 +238,133 @@ func (k Keeper) ConnOpenConfirm(
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-confirm")
 
-	emitConnectionOpenConfirmEvent(ctx, connectionID, connection)
+	k.emitConnectionOpenConfirmEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// ConnCloseInit is called by the authority on chain A to close a connection with chain B.
+// Connections in any state other than CLOSED may be closed, which allows connections
+// built on top of deprecated clients to be retired.
+//
+// NOTE: the caller must ensure that all channels built on top of the connection are closed.
+func (k Keeper) ConnCloseInit(ctx sdk.Context, connectionID string) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State == types.CLOSED {
+		return errorsmod.Wrap(types.ErrInvalidConnectionState, "connection is already CLOSED")
+	}
+
+	previousState := connection.State
+	connection.State = types.CLOSED
+	k.SetConnection(ctx, connectionID, connection)
+
+	// an in progress upgrade can no longer complete
+	k.deleteUpgrade(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", previousState.String(), "new-state", types.CLOSED.String())
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "close-init")
+
+	k.emitConnectionCloseInitEvent(ctx, connectionID, connection)
 
 	return nil
 }
+
+// ConnCloseConfirm is called by the counterparty chain B to close its end of the
+// connection once chain A has closed its end. As for ConnCloseInit, connections in any
+// state other than CLOSED may be closed. If the connection is in the INIT state, the
+// identifier of the connection on chain A is not known yet and must be provided.
+//
+// NOTE: the caller must ensure that all channels built on top of the connection are closed.
+func (k Keeper) ConnCloseConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyConnectionID string, // identifier of the connection on ChainA, only used in the INIT state
+	initProof []byte, // proof that connection was closed on ChainA during ConnCloseInit
+	proofHeight exported.Height, // height that relayer constructed proofInit
+	counterpartyUpgradeSequence uint64,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State == types.CLOSED {
+		return errorsmod.Wrap(types.ErrInvalidConnectionState, "connection is already CLOSED")
+	}
+
+	if connection.State == types.INIT {
+		if counterpartyConnectionID == "" {
+			return errorsmod.Wrap(types.ErrInvalidCounterparty, "counterparty connection identifier must be provided for connections in the INIT state")
+		}
+
+		connection.Counterparty.ConnectionId = counterpartyConnectionID
+	}
+
+	// Check that connection on ChainA is closed. The connection on ChainA is only known to
+	// match this connection once both connection ends are OPEN, so every connection end
+	// ChainA may have closed given the state of this connection is tried in turn.
+	prefix := k.GetCommitmentPrefix()
+	var err error
+	for _, expectedConnection := range expectedClosedCounterparties(connection, connectionID, prefix) {
+		expectedConnection.UpgradeSequence = counterpartyUpgradeSequence
+
+		if err = k.VerifyConnectionState(
+			ctx, connection, proofHeight, initProof, connection.Counterparty.ConnectionId,
+			expectedConnection,
+		); err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	previousState := connection.State
+	connection.State = types.CLOSED
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.deleteUpgrade(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", previousState.String(), "new-state", types.CLOSED.String())
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "close-confirm")
+
+	k.emitConnectionCloseConfirmEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// expectedClosedCounterparties returns the connection ends the counterparty may have closed given the
+// state of the connection. The counterparty of a connection in the INIT state selected one of its versions
+// in ConnOpenTry. The counterparty of a connection in the TRYOPEN state may still be in the INIT state,
+// without a counterparty connection identifier and with the versions it proposed, which are the compatible
+// versions unless a single version was proposed, or may have moved to OPEN with the selected version.
+func expectedClosedCounterparties(connection types.ConnectionEnd, connectionID string, prefix exported.Prefix) []types.ConnectionEnd {
+	newExpectedConnection := func(counterpartyConnectionID string, versions []*types.Version) types.ConnectionEnd {
+		expectedCounterparty := types.NewCounterparty(connection.ClientId, counterpartyConnectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
+		return types.NewConnectionEnd(types.CLOSED, connection.Counterparty.ClientId, expectedCounterparty, versions, connection.DelayPeriod)
+	}
+
+	switch connection.State {
+	case types.INIT:
+		expectedConnections := make([]types.ConnectionEnd, len(connection.Versions))
+		for i, version := range connection.Versions {
+			expectedConnections[i] = newExpectedConnection(connectionID, []*types.Version{version})
+		}
+		return expectedConnections
+	case types.TRYOPEN:
+		return []types.ConnectionEnd{
+			newExpectedConnection("", connection.Versions),
+			newExpectedConnection("", types.GetCompatibleVersions()),
+			newExpectedConnection(connectionID, connection.Versions),
+		}
+	default:
+		return []types.ConnectionEnd{newExpectedConnection(connectionID, connection.Versions)}
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -38,9 +39,24 @@ func (suite *KeeperTestSuite) TestConnOpenInit() {
 			delayPeriod = uint64(time.Hour.Nanoseconds())
 		}, true},
 
+		{"success with delay period equal to the consensus state retention max age", func() {
+			delayPeriod = uint64(time.Hour.Nanoseconds())
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(0, time.Hour, 0)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+		}, true},
 		{"invalid version", func() {
 			version = &types.Version{}
 		}, false},
+		{"delay period greater than the consensus state retention max age", func() {
+			delayPeriod = uint64(time.Hour.Nanoseconds())
+			expErrorMsgSubstring = types.ErrInvalidDelayPeriod.Error()
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(0, time.Hour-1, 0)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+		}, false},
 		{"couldn't add connection to client", func() {
 			// set path.EndpointA.ClientID to invalid client identifier
 			path.EndpointA.ClientID = "clientidentifier"
@@ -131,6 +147,29 @@ func (suite *KeeperTestSuite) TestConnOpenTry() {
 			// retrieve client state of chainA to pass as counterpartyClient
 			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
 		}, true},
+		{"delay period greater than the consensus state retention max age", func() {
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			delayPeriod = uint64(time.Hour.Nanoseconds())
+
+			// set delay period on counterparty to non-zero value
+			conn := path.EndpointA.GetConnection()
+			conn.DelayPeriod = delayPeriod
+			suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, conn)
+
+			params := suite.chainB.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainB.GetContext())
+			params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(0, time.Hour-1, 0)
+			suite.chainB.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			// commit in order for proof to return correct value
+			suite.coordinator.CommitBlock(suite.chainA)
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			// retrieve client state of chainA to pass as counterpartyClient
+			counterpartyClient = suite.chainA.GetClientState(path.EndpointA.ClientID)
+		}, false},
 		{"counterparty versions is empty", func() {
 			err := path.EndpointA.ConnOpenInit()
 			suite.Require().NoError(err)
@@ -447,3 +486,192 @@ func (suite *KeeperTestSuite) TestConnOpenConfirm() {
 		})
 	}
 }
+
+// TestConnCloseInit tests the close init handshake step on chainA
+func (suite *KeeperTestSuite) TestConnCloseInit() {
+	var (
+		path         *ibctesting.Path
+		connectionID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"success: connection is in INIT", func() {
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			connectionID = path.EndpointA.ConnectionID
+		}, nil},
+		{"success: upgrade in progress is deleted", func() {
+			upgrade := types.NewUpgrade(ibctesting.ConnectionVersion, 1, suite.chainB.GetPrefix())
+			err := path.EndpointA.ConnUpgradeInit(upgrade)
+			suite.Require().NoError(err)
+		}, nil},
+		{"connection not found", func() {
+			connectionID = ibctesting.InvalidID
+		}, types.ErrConnectionNotFound},
+		{"connection is already CLOSED", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.State = types.CLOSED
+			path.EndpointA.SetConnection(connection)
+		}, types.ErrInvalidConnectionState},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			connectionID = path.EndpointA.ConnectionID
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnCloseInit(suite.chainA.GetContext(), connectionID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetConnection().State)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), connectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestConnCloseConfirm tests the close confirm handshake step on chainB
+func (suite *KeeperTestSuite) TestConnCloseConfirm() {
+	var (
+		path                        *ibctesting.Path
+		counterpartyConnectionID    string
+		counterpartyUpgradeSequence uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"success: upgrade sequences differ", func() {
+			// bump the upgrade sequence of the closed connection on chainA
+			connection := path.EndpointA.GetConnection()
+			connection.State = types.CLOSED
+			connection.UpgradeSequence = 5
+			path.EndpointA.SetConnection(connection)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			counterpartyUpgradeSequence = 5
+		}, nil},
+		{"success: connection is INIT and counterparty connection was closed in TRYOPEN", func() {
+			// the counterparty of a connection in INIT has selected a single version in ConnOpenTry
+			connection := path.EndpointA.GetConnection()
+			connection.Versions = []*types.Version{types.GetCompatibleVersions()[0]}
+			path.EndpointA.SetConnection(connection)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			connection = path.EndpointB.GetConnection()
+			connection.State = types.INIT
+			connection.Counterparty.ConnectionId = ""
+			connection.Versions = append(connection.Versions, types.NewVersion("2", []string{"ORDER_UNORDERED"}))
+			path.EndpointB.SetConnection(connection)
+
+			counterpartyConnectionID = path.EndpointA.ConnectionID
+		}, nil},
+		{"success: connection is TRYOPEN and counterparty connection was closed in INIT", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.Counterparty.ConnectionId = ""
+			connection.Versions = types.GetCompatibleVersions()
+			path.EndpointA.SetConnection(connection)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			connection = path.EndpointB.GetConnection()
+			connection.State = types.TRYOPEN
+			path.EndpointB.SetConnection(connection)
+		}, nil},
+		{"success: connection is TRYOPEN and counterparty connection was closed in OPEN", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.State = types.TRYOPEN
+			path.EndpointB.SetConnection(connection)
+		}, nil},
+		{"connection not found", func() {
+			path.EndpointB.ConnectionID = ibctesting.InvalidID
+		}, types.ErrConnectionNotFound},
+		{"connection is already CLOSED", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.State = types.CLOSED
+			path.EndpointB.SetConnection(connection)
+		}, types.ErrInvalidConnectionState},
+		{"connection is INIT and counterparty connection identifier is empty", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.State = types.INIT
+			connection.Counterparty.ConnectionId = ""
+			path.EndpointB.SetConnection(connection)
+		}, types.ErrInvalidCounterparty},
+		{"connection is INIT and counterparty connection identifier does not match", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.State = types.INIT
+			connection.Counterparty.ConnectionId = ""
+			path.EndpointB.SetConnection(connection)
+
+			counterpartyConnectionID = ibctesting.InvalidID
+		}, commitmenttypes.ErrInvalidProof},
+		{"counterparty connection is not CLOSED", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.State = types.OPEN
+			path.EndpointA.SetConnection(connection)
+			suite.coordinator.CommitBlock(suite.chainA)
+		}, commitmenttypes.ErrInvalidProof},
+		{"counterparty upgrade sequence does not match", func() {
+			counterpartyUpgradeSequence = 1
+		}, commitmenttypes.ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := path.EndpointA.ConnCloseInit()
+			suite.Require().NoError(err)
+
+			counterpartyConnectionID = ""
+			counterpartyUpgradeSequence = 0
+
+			tc.malleate()
+
+			// ensure client is up to date to receive proof
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			connectionKey := host.ConnectionKey(path.EndpointA.ConnectionID)
+			initProof, proofHeight := suite.chainA.QueryProof(connectionKey)
+
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnCloseConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyConnectionID, initProof, proofHeight, counterpartyUpgradeSequence,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.CLOSED, path.EndpointB.GetConnection().State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
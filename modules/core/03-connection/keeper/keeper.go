@@ -60,6 +60,18 @@ func (k Keeper) GetCommitmentPrefix() exported.Prefix {
 	return commitmenttypes.NewMerklePrefix([]byte(k.storeKey.Name()))
 }
 
+// validateDelayPeriod returns an error if the delay period is greater than the max age of the consensus states
+// retained by the clients, as the consensus states used to verify proofs on the connection would be pruned before
+// the delay period elapses.
+func (k Keeper) validateDelayPeriod(ctx sdk.Context, delayPeriod uint64) error {
+	maxAge := k.clientKeeper.GetParams(ctx).ConsensusStateRetention.MaxAge
+	if maxAge != 0 && delayPeriod > uint64(maxAge) {
+		return errorsmod.Wrapf(types.ErrInvalidDelayPeriod, "delay period (%d) cannot be greater than the consensus state retention max age (%s)", delayPeriod, maxAge)
+	}
+
+	return nil
+}
+
 // GenerateConnectionIdentifier returns the next connection identifier.
 func (k Keeper) GenerateConnectionIdentifier(ctx sdk.Context) string {
 	nextConnSeq := k.GetNextConnectionSequence(ctx)
@@ -98,17 +110,75 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 	store.Set(host.ConnectionKey(connectionID), bz)
 }
 
+// GetUpgrade returns the proposed upgrade for the provided connection identifier.
+func (k Keeper) GetUpgrade(ctx sdk.Context, connectionID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionUpgradeKey(connectionID))
+	if len(bz) == 0 {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetUpgrade sets the proposed upgrade using the provided connection identifier.
+func (k Keeper) SetUpgrade(ctx sdk.Context, connectionID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ConnectionUpgradeKey(connectionID), bz)
+}
+
+// hasUpgrade returns true if a proposed upgrade exists in store
+func (k Keeper) hasUpgrade(ctx sdk.Context, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ConnectionUpgradeKey(connectionID))
+}
+
+// deleteUpgrade deletes the upgrade for the provided connection identifier.
+func (k Keeper) deleteUpgrade(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ConnectionUpgradeKey(connectionID))
+}
+
+// GetUpgradeErrorReceipt returns the upgrade error receipt for the provided connection identifier.
+func (k Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, connectionID string) (types.ErrorReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionUpgradeErrorKey(connectionID))
+	if len(bz) == 0 {
+		return types.ErrorReceipt{}, false
+	}
+
+	var errorReceipt types.ErrorReceipt
+	k.cdc.MustUnmarshal(bz, &errorReceipt)
+
+	return errorReceipt, true
+}
+
+// setUpgradeErrorReceipt sets the provided error receipt in store using the connection identifier.
+func (k Keeper) setUpgradeErrorReceipt(ctx sdk.Context, connectionID string, errorReceipt types.ErrorReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&errorReceipt)
+	store.Set(host.ConnectionUpgradeErrorKey(connectionID), bz)
+}
+
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
 // given height.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (uint64, error) {
-	clientState, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID())
-	if !found {
+	if _, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID()); !found {
 		return 0, errorsmod.Wrapf(
 			clienttypes.ErrClientNotFound, "clientID (%s)", connection.GetClientID(),
 		)
 	}
 
-	timestamp, err := clientState.GetTimestampAtHeight(ctx, k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height)
+	lightClientModule, found := k.clientKeeper.Route(connection.GetClientID())
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrRouteNotFound, connection.GetClientID())
+	}
+
+	timestamp, err := lightClientModule.TimestampAtHeight(ctx, connection.GetClientID(), height)
 	if err != nil {
 		return 0, err
 	}
@@ -130,6 +200,25 @@ func (k Keeper) GetClientConnectionPaths(ctx sdk.Context, clientID string) ([]st
 	return clientPaths.Paths, true
 }
 
+// GetMaxDelayPeriod returns the largest delay period of the connections of the given client, including the delay
+// periods of their pending upgrades.
+func (k Keeper) GetMaxDelayPeriod(ctx sdk.Context, clientID string) uint64 {
+	connectionIDs, _ := k.GetClientConnectionPaths(ctx, clientID)
+
+	var maxDelayPeriod uint64
+	for _, connectionID := range connectionIDs {
+		if connection, found := k.GetConnection(ctx, connectionID); found {
+			maxDelayPeriod = max(maxDelayPeriod, connection.DelayPeriod)
+		}
+
+		if upgrade, found := k.GetUpgrade(ctx, connectionID); found {
+			maxDelayPeriod = max(maxDelayPeriod, upgrade.DelayPeriod)
+		}
+	}
+
+	return maxDelayPeriod
+}
+
 // SetClientConnectionPaths sets the connections paths for client
 func (k Keeper) SetClientConnectionPaths(ctx sdk.Context, clientID string, paths []string) {
 	store := ctx.KVStore(k.storeKey)
@@ -114,6 +114,29 @@ func (suite KeeperTestSuite) TestGetAllClientConnectionPaths() { //nolint:govet
 	suite.Require().Equal(expPaths, connPaths)
 }
 
+func (suite *KeeperTestSuite) TestGetMaxDelayPeriod() {
+	path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path1.EndpointA.ConnectionConfig.DelayPeriod = 10
+	path1.EndpointB.ConnectionConfig.DelayPeriod = 10
+	suite.coordinator.SetupConnections(path1)
+
+	path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path2.EndpointA.ClientID = path1.EndpointA.ClientID
+	path2.EndpointB.ClientID = path1.EndpointB.ClientID
+	path2.EndpointA.ConnectionConfig.DelayPeriod = 20
+	path2.EndpointB.ConnectionConfig.DelayPeriod = 20
+	suite.coordinator.CreateConnections(path2)
+
+	connectionKeeper := suite.chainA.App.GetIBCKeeper().ConnectionKeeper
+	suite.Require().Equal(uint64(20), connectionKeeper.GetMaxDelayPeriod(suite.chainA.GetContext(), path1.EndpointA.ClientID))
+
+	// the delay period of a pending upgrade is included
+	connectionKeeper.SetUpgrade(suite.chainA.GetContext(), path1.EndpointA.ConnectionID, types.NewUpgrade(types.GetCompatibleVersions()[0], 30, path1.EndpointB.Chain.GetPrefix()))
+	suite.Require().Equal(uint64(30), connectionKeeper.GetMaxDelayPeriod(suite.chainA.GetContext(), path1.EndpointA.ClientID))
+
+	suite.Require().Zero(connectionKeeper.GetMaxDelayPeriod(suite.chainA.GetContext(), ibctesting.InvalidID))
+}
+
 // TestGetTimestampAtHeight verifies if the clients on each chain return the
 // correct timestamp for the other chain.
 func (suite *KeeperTestSuite) TestGetTimestampAtHeight() {
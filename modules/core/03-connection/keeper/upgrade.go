@@ -0,0 +1,433 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ConnUpgradeInit is called by the authority on chain A to initiate an upgrade of an open
+// connection with chain B. The connection upgrade sequence is incremented and the proposed
+// upgrade is stored so that it may be proven to the counterparty. The upgrade may be timed
+// out once its timeout timestamp has elapsed on chain B, which defaults to DefaultUpgradeTimeout
+// after the current block time.
+func (k Keeper) ConnUpgradeInit(ctx sdk.Context, connectionID string, upgradeFields types.Upgrade) (types.ConnectionEnd, types.Upgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if k.hasUpgrade(ctx, connectionID) {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrUpgradeInProgress, "connection (%s)", connectionID)
+	}
+
+	if err := validateProposedUpgradeFields(connection, upgradeFields); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, err
+	}
+
+	if err := k.validateDelayPeriod(ctx, upgradeFields.DelayPeriod); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, err
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if upgradeFields.TimeoutTimestamp == 0 {
+		upgradeFields.TimeoutTimestamp = blockTime + uint64(types.DefaultUpgradeTimeout)
+	} else if upgradeFields.TimeoutTimestamp <= blockTime {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidUpgrade, "upgrade timeout timestamp (%d) must be after the block time (%d)", upgradeFields.TimeoutTimestamp, blockTime)
+	}
+
+	connection.UpgradeSequence++
+	k.SetConnection(ctx, connectionID, connection)
+	k.SetUpgrade(ctx, connectionID, upgradeFields)
+
+	k.Logger(ctx).Info("connection upgrade init succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-init")
+
+	k.emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeInit, connectionID, connection, upgradeFields)
+
+	return connection, upgradeFields, nil
+}
+
+// ConnUpgradeTry is called by a relayer on chain B after the upgrade has been initialised on chain A.
+// The proposed version and delay period are adopted from the counterparty upgrade while the counterparty
+// prefix of chain B is left unchanged. Crossing hellos are not supported. The upgrade of chain B has no
+// timeout: chain B only applies the upgrade once chain A has, so it may only cancel its upgrade with the
+// error receipt written by chain A when the upgrade is aborted or timed out there.
+//
+// An UpgradeError is returned if the counterparty upgrade cannot be accepted. In this case, the
+// connection upgrade sequence is updated and the caller is expected to write an error receipt.
+func (k Keeper) ConnUpgradeTry(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgrade types.Upgrade,
+	counterpartyUpgradeSequence uint64,
+	connectionProof,
+	upgradeProof []byte,
+	proofHeight exported.Height,
+) (types.ConnectionEnd, types.Upgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if k.hasUpgrade(ctx, connectionID) {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrUpgradeInProgress, "crossing hellos are not supported for connection (%s)", connectionID)
+	}
+
+	// the counterparty connection end must be unchanged apart from the upgrade sequence
+	expectedConnection := k.expectedCounterpartyConnection(connectionID, connection, connection.Versions, connection.DelayPeriod, counterpartyUpgradeSequence)
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	if err := k.VerifyConnectionUpgrade(
+		ctx, connection, proofHeight, upgradeProof, connection.Counterparty.ConnectionId,
+		counterpartyUpgrade,
+	); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	if counterpartyUpgradeSequence <= connection.UpgradeSequence {
+		// the error receipt is written at the current upgrade sequence so that the counterparty
+		// is able to cancel its upgrade and resume at a higher upgrade sequence
+		return types.ConnectionEnd{}, types.Upgrade{}, types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence (%d) must be greater than current upgrade sequence (%d)", counterpartyUpgradeSequence, connection.UpgradeSequence,
+		))
+	}
+
+	connection.UpgradeSequence = counterpartyUpgradeSequence
+	k.SetConnection(ctx, connectionID, connection)
+
+	if blockTime := uint64(ctx.BlockTime().UnixNano()); counterpartyUpgrade.HasTimedOut(blockTime) {
+		return types.ConnectionEnd{}, types.Upgrade{}, types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrUpgradeTimeout, "counterparty upgrade timeout timestamp (%d) has elapsed at block time (%d)", counterpartyUpgrade.TimeoutTimestamp, blockTime,
+		))
+	}
+
+	// the counterparty may only propose the commitment prefix of this chain
+	if prefix := k.GetCommitmentPrefix(); !bytes.Equal(counterpartyUpgrade.CounterpartyPrefix.Bytes(), prefix.Bytes()) {
+		return types.ConnectionEnd{}, types.Upgrade{}, types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrIncompatibleCounterpartyUpgrade, "counterparty prefix (%s) does not match commitment prefix (%s)", counterpartyUpgrade.CounterpartyPrefix.Bytes(), prefix.Bytes(),
+		))
+	}
+
+	if !types.IsSupportedVersion(types.GetCompatibleVersions(), counterpartyUpgrade.Version) {
+		return types.ConnectionEnd{}, types.Upgrade{}, types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrInvalidVersion, "version %s is not supported", counterpartyUpgrade.Version,
+		))
+	}
+
+	if err := k.validateDelayPeriod(ctx, counterpartyUpgrade.DelayPeriod); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, types.NewUpgradeError(connection.UpgradeSequence, err)
+	}
+
+	upgrade := types.NewUpgrade(counterpartyUpgrade.Version, counterpartyUpgrade.DelayPeriod, connection.Counterparty.Prefix)
+
+	k.SetUpgrade(ctx, connectionID, upgrade)
+
+	k.Logger(ctx).Info("connection upgrade try succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-try")
+
+	k.emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeTry, connectionID, connection, upgrade)
+
+	return connection, upgrade, nil
+}
+
+// ConnUpgradeAck is called by a relayer on chain A after the upgrade has been accepted on chain B.
+// The upgrade is applied to the connection end on chain A.
+//
+// An UpgradeError is returned if the counterparty upgrade is incompatible or the upgrade has timed out.
+// In this case, the caller is expected to abort the upgrade and write an error receipt.
+func (k Keeper) ConnUpgradeAck(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgrade types.Upgrade,
+	connectionProof,
+	upgradeProof []byte,
+	proofHeight exported.Height,
+) (types.ConnectionEnd, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection (%s)", connectionID)
+	}
+
+	// the counterparty must have moved to the same upgrade sequence during the try step
+	expectedConnection := k.expectedCounterpartyConnection(connectionID, connection, connection.Versions, connection.DelayPeriod, connection.UpgradeSequence)
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return types.ConnectionEnd{}, errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	if err := k.VerifyConnectionUpgrade(
+		ctx, connection, proofHeight, upgradeProof, connection.Counterparty.ConnectionId,
+		counterpartyUpgrade,
+	); err != nil {
+		return types.ConnectionEnd{}, errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	if blockTime := uint64(ctx.BlockTime().UnixNano()); upgrade.HasTimedOut(blockTime) {
+		return types.ConnectionEnd{}, types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrUpgradeTimeout, "upgrade timeout timestamp (%d) has elapsed at block time (%d)", upgrade.TimeoutTimestamp, blockTime,
+		))
+	}
+
+	if err := upgrade.IsCompatible(counterpartyUpgrade); err != nil {
+		return types.ConnectionEnd{}, types.NewUpgradeError(connection.UpgradeSequence, err)
+	}
+
+	connection = k.applyUpgrade(ctx, connectionID, connection, upgrade)
+
+	k.Logger(ctx).Info("connection upgrade ack succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-ack")
+
+	k.emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeAck, connectionID, connection, upgrade)
+
+	return connection, nil
+}
+
+// ConnUpgradeConfirm is called by a relayer on chain B after the upgrade has been applied on chain A.
+// The upgrade is applied to the connection end on chain B, completing the upgrade handshake.
+func (k Keeper) ConnUpgradeConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) (types.ConnectionEnd, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection (%s)", connectionID)
+	}
+
+	// the counterparty connection end must have been upgraded to the agreed version and delay period
+	expectedConnection := k.expectedCounterpartyConnection(connectionID, connection, []*types.Version{upgrade.Version}, upgrade.DelayPeriod, connection.UpgradeSequence)
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return types.ConnectionEnd{}, errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	connection = k.applyUpgrade(ctx, connectionID, connection, upgrade)
+
+	k.Logger(ctx).Info("connection upgrade confirm succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-confirm")
+
+	k.emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeConfirm, connectionID, connection, upgrade)
+
+	return connection, nil
+}
+
+// ConnUpgradeCancel is called by a relayer to cancel a connection upgrade using a proof of the
+// error receipt written by the counterparty. The error receipt sequence must be greater than or
+// equal to the current upgrade sequence.
+func (k Keeper) ConnUpgradeCancel(ctx sdk.Context, connectionID string, errorReceipt types.ErrorReceipt, errorReceiptProof []byte, proofHeight exported.Height) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if !k.hasUpgrade(ctx, connectionID) {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection (%s)", connectionID)
+	}
+
+	if len(errorReceiptProof) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty error receipt proof")
+	}
+
+	if errorReceipt.Sequence < connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than or equal to current upgrade sequence (%d)", errorReceipt.Sequence, connection.UpgradeSequence)
+	}
+
+	if err := k.VerifyConnectionUpgradeError(ctx, connection, proofHeight, errorReceiptProof, connection.Counterparty.ConnectionId, errorReceipt); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty error receipt")
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelConnection deletes the upgrade in progress, sets the connection upgrade sequence
+// to the provided sequence and writes an error receipt so that the counterparty may cancel its upgrade.
+func (k Keeper) WriteUpgradeCancelConnection(ctx sdk.Context, connectionID string, sequence uint64) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-cancel")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when cancelling upgrade, connectionID: %s", connectionID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing upgrade when cancelling upgrade, connectionID: %s", connectionID))
+	}
+
+	connection.UpgradeSequence = sequence
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
+
+	k.Logger(ctx).Info("connection upgrade cancelled", "connection-id", connectionID, "upgrade-sequence", sequence)
+
+	k.emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeCancel, connectionID, connection, upgrade)
+}
+
+// ConnUpgradeTimeout is called by a relayer on chain A to abort the connection upgrade it initiated
+// once the upgrade timeout has elapsed on chain B, which is proven by the timestamp of the consensus
+// state of chain B at the given proof height. Chain B cannot have applied the upgrade, as it only does
+// so once chain A has applied it in ConnUpgradeAck.
+func (k Keeper) ConnUpgradeTimeout(ctx sdk.Context, connectionID string, proofHeight exported.Height) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection (%s)", connectionID)
+	}
+
+	if upgrade.TimeoutTimestamp == 0 {
+		return errorsmod.Wrapf(types.ErrUpgradeTimeoutFailed, "upgrade of connection (%s) was initiated by the counterparty", connectionID)
+	}
+
+	proofTimestamp, err := k.GetTimestampAtHeight(ctx, connection, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if !upgrade.HasTimedOut(proofTimestamp) {
+		return errorsmod.Wrapf(types.ErrUpgradeTimeoutFailed, "upgrade timeout timestamp (%d) has not elapsed at proof timestamp (%d)", upgrade.TimeoutTimestamp, proofTimestamp)
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutConnection aborts the upgrade in progress and writes an error receipt at the current
+// upgrade sequence so that the counterparty may cancel its upgrade.
+func (k Keeper) WriteUpgradeTimeoutConnection(ctx sdk.Context, connectionID string) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-timeout")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when timing out upgrade, connectionID: %s", connectionID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing upgrade when timing out upgrade, connectionID: %s", connectionID))
+	}
+
+	k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(connection.UpgradeSequence, types.ErrUpgradeTimeout))
+
+	k.Logger(ctx).Info("connection upgrade timed out", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	k.emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeTimeout, connectionID, connection, upgrade)
+}
+
+// WriteErrorReceipt aborts any upgrade in progress and writes an error receipt for the provided
+// upgrade error. The error receipt may be proven to the counterparty in order to cancel its upgrade.
+func (k Keeper) WriteErrorReceipt(ctx sdk.Context, connectionID string, upgradeError *types.UpgradeError) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(errorsmod.Wrap(types.ErrConnectionNotFound, connectionID))
+	}
+
+	errorReceiptToWrite := upgradeError.GetErrorReceipt()
+
+	existingErrorReceipt, found := k.GetUpgradeErrorReceipt(ctx, connectionID)
+	if found && existingErrorReceipt.Sequence > errorReceiptToWrite.Sequence {
+		panic(errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than or equal to existing error receipt sequence (%d)", errorReceiptToWrite.Sequence, existingErrorReceipt.Sequence))
+	}
+
+	k.deleteUpgrade(ctx, connectionID)
+	k.setUpgradeErrorReceipt(ctx, connectionID, errorReceiptToWrite)
+
+	k.emitConnectionUpgradeErrorEvent(ctx, connectionID, connection, upgradeError)
+}
+
+// validateProposedUpgradeFields ensures that the proposed upgrade uses a supported version and
+// differs from the current connection end.
+func validateProposedUpgradeFields(connection types.ConnectionEnd, upgrade types.Upgrade) error {
+	if !types.IsSupportedVersion(types.GetCompatibleVersions(), upgrade.Version) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "version %s is not supported", upgrade.Version)
+	}
+
+	if len(connection.Versions) == 1 && connection.Versions[0].String() == upgrade.Version.String() &&
+		connection.DelayPeriod == upgrade.DelayPeriod &&
+		bytes.Equal(connection.Counterparty.Prefix.Bytes(), upgrade.CounterpartyPrefix.Bytes()) {
+		return errorsmod.Wrap(types.ErrInvalidUpgrade, "existing connection end is identical to proposed upgrade")
+	}
+
+	return nil
+}
+
+// expectedCounterpartyConnection returns the counterparty connection end which is expected to be
+// stored on the counterparty chain, using the provided versions, delay period and upgrade sequence.
+func (k Keeper) expectedCounterpartyConnection(
+	connectionID string,
+	connection types.ConnectionEnd,
+	versions []*types.Version,
+	delayPeriod uint64,
+	upgradeSequence uint64,
+) types.ConnectionEnd {
+	prefix := k.GetCommitmentPrefix()
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
+	expectedConnection := types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, versions, delayPeriod)
+	expectedConnection.UpgradeSequence = upgradeSequence
+
+	return expectedConnection
+}
+
+// applyUpgrade sets the upgraded connection end in state and deletes the upgrade in progress.
+func (k Keeper) applyUpgrade(ctx sdk.Context, connectionID string, connection types.ConnectionEnd, upgrade types.Upgrade) types.ConnectionEnd {
+	connection.Versions = []*types.Version{upgrade.Version}
+	connection.DelayPeriod = upgrade.DelayPeriod
+	connection.Counterparty.Prefix = upgrade.CounterpartyPrefix
+
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteUpgrade(ctx, connectionID)
+
+	return connection
+}
@@ -0,0 +1,773 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var upgradeDelayPeriod = uint64(time.Hour.Nanoseconds())
+
+// newTestUpgrade returns a connection upgrade for chainA which modifies the delay period.
+func (suite *KeeperTestSuite) newTestUpgrade() types.Upgrade {
+	return types.NewUpgrade(ibctesting.ConnectionVersion, upgradeDelayPeriod, suite.chainB.GetPrefix())
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeInit() {
+	var (
+		path    *ibctesting.Path
+		upgrade types.Upgrade
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection state is not OPEN",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.State = types.CLOSED
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"upgrade already in progress",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+			},
+			types.ErrUpgradeInProgress,
+		},
+		{
+			"unsupported version",
+			func() {
+				upgrade.Version = types.NewVersion("2", []string{"ORDER_UNORDERED"})
+			},
+			types.ErrInvalidVersion,
+		},
+		{
+			"upgrade is identical to existing connection end",
+			func() {
+				upgrade.DelayPeriod = path.EndpointA.GetConnection().DelayPeriod
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"success: timeout timestamp is set",
+			func() {
+				upgrade.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			},
+			nil,
+		},
+		{
+			"timeout timestamp is not after the block time",
+			func() {
+				upgrade.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"delay period greater than the consensus state retention max age",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(0, time.Duration(upgradeDelayPeriod)-1, 0)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrInvalidDelayPeriod,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			upgrade = suite.newTestUpgrade()
+
+			tc.malleate()
+
+			connection, proposedUpgrade, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+				suite.Require().Equal(connection, path.EndpointA.GetConnection())
+
+				expUpgrade := upgrade
+				if expUpgrade.TimeoutTimestamp == 0 {
+					expUpgrade.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(types.DefaultUpgradeTimeout).UnixNano())
+				}
+				suite.Require().Equal(expUpgrade, proposedUpgrade)
+
+				storedUpgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(expUpgrade, storedUpgrade)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTry() {
+	var (
+		path                        *ibctesting.Path
+		counterpartyUpgrade         types.Upgrade
+		counterpartyUpgradeSequence uint64
+	)
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		expErr         error
+		expUpgradeErr  bool
+		expSequence    uint64
+		expUpgradeKept bool
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+			false,
+			1,
+			true,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+			false,
+			0,
+			false,
+		},
+		{
+			"connection state is not OPEN",
+			func() {
+				connection := path.EndpointB.GetConnection()
+				connection.State = types.CLOSED
+				path.EndpointB.SetConnection(connection)
+			},
+			types.ErrInvalidConnectionState,
+			false,
+			0,
+			false,
+		},
+		{
+			"crossing hellos are not supported",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyUpgrade)
+			},
+			types.ErrUpgradeInProgress,
+			false,
+			0,
+			true,
+		},
+		{
+			"counterparty connection verification failed: upgrade sequence mismatch",
+			func() {
+				counterpartyUpgradeSequence = 2
+			},
+			commitmenttypes.ErrInvalidProof,
+			false,
+			0,
+			false,
+		},
+		{
+			"counterparty upgrade verification failed",
+			func() {
+				counterpartyUpgrade.DelayPeriod++
+			},
+			commitmenttypes.ErrInvalidProof,
+			false,
+			0,
+			false,
+		},
+		{
+			"upgrade sequence is not greater than the current upgrade sequence",
+			func() {
+				connection := path.EndpointB.GetConnection()
+				connection.UpgradeSequence = 5
+				path.EndpointB.SetConnection(connection)
+			},
+			types.ErrInvalidUpgradeSequence,
+			true,
+			5,
+			false,
+		},
+		{
+			"counterparty prefix does not match commitment prefix",
+			func() {
+				counterpartyUpgrade.CounterpartyPrefix = commitmenttypes.NewMerklePrefix([]byte("other"))
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			types.ErrIncompatibleCounterpartyUpgrade,
+			true,
+			1,
+			false,
+		},
+		{
+			"unsupported version",
+			func() {
+				counterpartyUpgrade.Version = types.NewVersion("2", []string{"ORDER_UNORDERED"})
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			types.ErrInvalidVersion,
+			true,
+			1,
+			false,
+		},
+		{
+			"counterparty upgrade has timed out",
+			func() {
+				counterpartyUpgrade.TimeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			types.ErrUpgradeTimeout,
+			true,
+			1,
+			false,
+		},
+		{
+			"delay period greater than the consensus state retention max age",
+			func() {
+				params := suite.chainB.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainB.GetContext())
+				params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(0, time.Duration(upgradeDelayPeriod)-1, 0)
+				suite.chainB.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrInvalidDelayPeriod,
+			true,
+			1,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit(suite.newTestUpgrade()))
+
+			var found bool
+			counterpartyUpgrade, found = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+			suite.Require().True(found)
+
+			counterpartyUpgradeSequence = path.EndpointA.GetConnection().UpgradeSequence
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			connectionProof, upgradeProof, proofHeight := path.EndpointA.QueryConnectionUpgradeProof()
+
+			connection, upgrade, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTry(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyUpgrade, counterpartyUpgradeSequence,
+				connectionProof, upgradeProof, proofHeight,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSequence, connection.UpgradeSequence)
+				suite.Require().Equal(types.NewUpgrade(counterpartyUpgrade.Version, counterpartyUpgrade.DelayPeriod, suite.chainA.GetPrefix()), upgrade)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(tc.expUpgradeErr, types.IsUpgradeError(err))
+			}
+
+			if tc.expUpgradeErr {
+				suite.Require().Equal(tc.expSequence, path.EndpointB.GetConnection().UpgradeSequence)
+			}
+
+			_, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().Equal(tc.expUpgradeKept, found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeAck() {
+	var (
+		path                *ibctesting.Path
+		counterpartyUpgrade types.Upgrade
+	)
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expErr        error
+		expUpgradeErr bool
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+			false,
+		},
+		{
+			"connection state is not OPEN",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.State = types.CLOSED
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrInvalidConnectionState,
+			false,
+		},
+		{
+			"upgrade not found",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID, types.NewUpgradeError(1, types.ErrInvalidUpgrade))
+			},
+			types.ErrUpgradeNotFound,
+			false,
+		},
+		{
+			"counterparty connection verification failed: upgrade sequence mismatch",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.UpgradeSequence = 2
+				path.EndpointA.SetConnection(connection)
+			},
+			commitmenttypes.ErrInvalidProof,
+			false,
+		},
+		{
+			"counterparty upgrade verification failed",
+			func() {
+				counterpartyUpgrade.DelayPeriod++
+			},
+			commitmenttypes.ErrInvalidProof,
+			false,
+		},
+		{
+			"counterparty upgrade is incompatible",
+			func() {
+				counterpartyUpgrade.DelayPeriod++
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyUpgrade)
+				suite.coordinator.CommitBlock(suite.chainB)
+			},
+			types.ErrIncompatibleCounterpartyUpgrade,
+			true,
+		},
+		{
+			"upgrade has timed out",
+			func() {
+				upgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+			},
+			types.ErrUpgradeTimeout,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			upgrade := suite.newTestUpgrade()
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit(upgrade))
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+			var found bool
+			counterpartyUpgrade, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			connectionProof, upgradeProof, proofHeight := path.EndpointB.QueryConnectionUpgradeProof()
+
+			connection, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeAck(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade,
+				connectionProof, upgradeProof, proofHeight,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(connection, path.EndpointA.GetConnection())
+				suite.Require().Equal([]*types.Version{upgrade.Version}, connection.Versions)
+				suite.Require().Equal(upgrade.DelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(upgrade.CounterpartyPrefix, connection.Counterparty.Prefix)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(tc.expUpgradeErr, types.IsUpgradeError(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeConfirm() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection state is not OPEN",
+			func() {
+				connection := path.EndpointB.GetConnection()
+				connection.State = types.CLOSED
+				path.EndpointB.SetConnection(connection)
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"upgrade not found",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.WriteErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID, types.NewUpgradeError(1, types.ErrInvalidUpgrade))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty connection has not been upgraded",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.DelayPeriod = 0
+				path.EndpointA.SetConnection(connection)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit(suite.newTestUpgrade()))
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+
+			upgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			connectionProof, proofHeight := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			connection, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, connectionProof, proofHeight,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(connection, path.EndpointB.GetConnection())
+				suite.Require().Equal([]*types.Version{upgrade.Version}, connection.Versions)
+				suite.Require().Equal(upgrade.DelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(path.EndpointA.GetConnection().DelayPeriod, connection.DelayPeriod)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeCancel() {
+	var (
+		path              *ibctesting.Path
+		errorReceipt      types.ErrorReceipt
+		errorReceiptProof []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade not found",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeCancelConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, 1)
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"empty error receipt proof",
+			func() {
+				errorReceiptProof = nil
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"error receipt sequence is less than the current upgrade sequence",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.UpgradeSequence = 2
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"error receipt verification failed",
+			func() {
+				errorReceipt.Message = "invalid message"
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit(suite.newTestUpgrade()))
+
+			// chainB rejects the upgrade at the sequence used by chainA
+			suite.chainB.App.GetIBCKeeper().ConnectionKeeper.WriteErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID, types.NewUpgradeError(1, types.ErrInvalidUpgrade))
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			var found bool
+			errorReceipt, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+
+			var proofHeight clienttypes.Height
+			errorReceiptProof, proofHeight = suite.chainB.QueryProof(host.ConnectionUpgradeErrorKey(path.EndpointB.ConnectionID))
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, errorReceipt, errorReceiptProof, proofHeight,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWriteUpgradeCancelConnection() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit(suite.newTestUpgrade()))
+
+	ctx := suite.chainA.GetContext()
+	connectionKeeper := suite.chainA.App.GetIBCKeeper().ConnectionKeeper
+	connectionKeeper.WriteUpgradeCancelConnection(ctx, path.EndpointA.ConnectionID, 3)
+
+	connection := path.EndpointA.GetConnection()
+	suite.Require().Equal(uint64(3), connection.UpgradeSequence)
+	suite.Require().Equal(types.OPEN, connection.State)
+	suite.Require().Equal(uint64(ibctesting.DefaultDelayPeriod), connection.DelayPeriod)
+
+	_, found := connectionKeeper.GetUpgrade(ctx, path.EndpointA.ConnectionID)
+	suite.Require().False(found)
+
+	errorReceipt, found := connectionKeeper.GetUpgradeErrorReceipt(ctx, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(3), errorReceipt.Sequence)
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTimeout() {
+	var (
+		path        *ibctesting.Path
+		proofHeight exported.Height
+	)
+
+	// setUpgradeTimeout sets the timeout timestamp of the upgrade in progress on chainA.
+	setUpgradeTimeout := func(timeoutTimestamp uint64) {
+		upgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+		suite.Require().True(found)
+
+		upgrade.TimeoutTimestamp = timeoutTimestamp
+		suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade not found",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeCancelConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, 1)
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"upgrade was initiated by the counterparty",
+			func() {
+				setUpgradeTimeout(0)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"consensus state not found",
+			func() {
+				proofHeight = proofHeight.Increment()
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"upgrade timeout has not elapsed",
+			func() {
+				setUpgradeTimeout(math.MaxUint64)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit(suite.newTestUpgrade()))
+
+			// the upgrade timeout elapses on chainB
+			suite.coordinator.IncrementTimeBy(types.DefaultUpgradeTimeout)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proofHeight = path.EndpointA.GetClientState().GetLatestHeight()
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTimeout(suite.chainA.GetContext(), path.EndpointA.ConnectionID, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWriteUpgradeTimeoutConnection() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit(suite.newTestUpgrade()))
+	suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+	ctx := suite.chainA.GetContext()
+	connectionKeeper := suite.chainA.App.GetIBCKeeper().ConnectionKeeper
+	connectionKeeper.WriteUpgradeTimeoutConnection(ctx, path.EndpointA.ConnectionID)
+
+	connection := path.EndpointA.GetConnection()
+	suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+	suite.Require().Equal(types.OPEN, connection.State)
+	suite.Require().Equal(uint64(ibctesting.DefaultDelayPeriod), connection.DelayPeriod)
+
+	_, found := connectionKeeper.GetUpgrade(ctx, path.EndpointA.ConnectionID)
+	suite.Require().False(found)
+
+	errorReceipt, found := connectionKeeper.GetUpgradeErrorReceipt(ctx, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+	suite.Require().Equal(types.NewUpgradeError(1, types.ErrUpgradeTimeout).GetErrorReceipt(), errorReceipt)
+
+	// chainB cancels the upgrade it accepted using the error receipt of chainA
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.ConnUpgradeCancel())
+
+	_, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+	suite.Require().False(found)
+}
@@ -4,7 +4,6 @@ import (
 	"math"
 
 	errorsmod "cosmossdk.io/errors"
-	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,12 +26,12 @@ func (k Keeper) VerifyClientState(
 	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
-	targetClient, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, targetClient, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -47,8 +46,8 @@ func (k Keeper) VerifyClientState(
 		return err
 	}
 
-	if err := targetClient.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -69,12 +68,12 @@ func (k Keeper) VerifyClientConsensusState(
 	consensusState exported.ConsensusState,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -89,8 +88,8 @@ func (k Keeper) VerifyClientConsensusState(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -111,12 +110,12 @@ func (k Keeper) VerifyConnectionState(
 	counterpartyConnection exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -136,8 +135,8 @@ func (k Keeper) VerifyConnectionState(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -159,12 +158,12 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -184,8 +183,8 @@ func (k Keeper) VerifyChannelState(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -208,12 +207,12 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -227,8 +226,8 @@ func (k Keeper) VerifyPacketCommitment(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, commitmentBytes,
 	); err != nil {
@@ -251,12 +250,12 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -270,8 +269,8 @@ func (k Keeper) VerifyPacketAcknowledgement(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement),
 	); err != nil {
@@ -294,12 +293,12 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -313,8 +312,8 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 		return err
 	}
 
-	if err := clientState.VerifyNonMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyNonMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath,
 	); err != nil {
@@ -324,6 +323,49 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt with
+// the given value at the specified port, specified channel, and specified sequence.
+func (k Keeper) VerifyPacketReceipt(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.GetClientID()
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, receipt,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k Keeper) VerifyNextSequenceRecv(
@@ -336,12 +378,12 @@ func (k Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -355,8 +397,8 @@ func (k Keeper) VerifyNextSequenceRecv(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
 	); err != nil {
@@ -377,12 +419,12 @@ func (k Keeper) VerifyChannelUpgradeError(
 	errorReceipt channeltypes.ErrorReceipt,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -397,8 +439,8 @@ func (k Keeper) VerifyChannelUpgradeError(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, height,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
 		0, 0, // skip delay period checks for non-packet processing verification
 		proof, merklePath, bz,
 	); err != nil {
@@ -419,12 +461,12 @@ func (k Keeper) VerifyChannelUpgrade(
 	upgrade channeltypes.Upgrade,
 ) error {
 	clientID := connection.GetClientID()
-	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -439,8 +481,8 @@ func (k Keeper) VerifyChannelUpgrade(
 		return err
 	}
 
-	if err := clientState.VerifyMembership(
-		ctx, clientStore, k.cdc, proofHeight,
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, proofHeight,
 		0, 0, // skip delay period checks for non-packet processing verification
 		upgradeProof, merklePath, bz,
 	); err != nil {
@@ -450,6 +492,280 @@ func (k Keeper) VerifyChannelUpgrade(
 	return nil
 }
 
+// VerifyConnectionUpgradeError verifies a proof of the provided connection upgrade error receipt.
+func (k Keeper) VerifyConnectionUpgradeError(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionID string,
+	errorReceipt connectiontypes.ErrorReceipt,
+) error {
+	clientID := connection.GetClientID()
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionUpgradeErrorPath(connectionID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed connection upgrade error receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyConnectionUpgrade verifies the proof that a particular proposed connection upgrade has been stored in the upgrade path.
+func (k Keeper) VerifyConnectionUpgrade(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	proofHeight exported.Height,
+	upgradeProof []byte,
+	connectionID string,
+	upgrade connectiontypes.Upgrade,
+) error {
+	clientID := connection.GetClientID()
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionUpgradePath(connectionID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&upgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := lightClientModule.VerifyMembership(
+		ctx, clientID, proofHeight,
+		0, 0, // skip delay period checks for non-packet processing verification
+		upgradeProof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed upgrade verification for client (%s) on connection (%s)", clientID, connectionID)
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitments verifies a single proof of the outgoing packet commitments
+// at the specified port, specified channel, and the sequences given as keys of the
+// commitments.
+func (k Keeper) VerifyPacketCommitments(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	items := make(map[string][]byte, len(commitments))
+	for sequence, commitment := range commitments {
+		items[host.PacketCommitmentPath(portID, channelID, sequence)] = commitment
+	}
+
+	if err := k.batchVerifyMembership(ctx, connection, height, proof, items); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitments verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgements verifies a single proof of the incoming packet
+// acknowledgements at the specified port, specified channel, and the sequences
+// given as keys of the acknowledgements.
+func (k Keeper) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	acknowledgements map[uint64][]byte,
+) error {
+	items := make(map[string][]byte, len(acknowledgements))
+	for sequence, acknowledgement := range acknowledgements {
+		items[host.PacketAcknowledgementPath(portID, channelID, sequence)] = channeltypes.CommitAcknowledgement(acknowledgement)
+	}
+
+	if err := k.batchVerifyMembership(ctx, connection, height, proof, items); err != nil {
+		return errorsmod.Wrapf(err, "failed packet acknowledgements verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyPacketReceipts verifies a single proof of the incoming packet receipts with
+// the given value at the specified port, specified channel, and specified sequences.
+func (k Keeper) VerifyPacketReceipts(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	receipt []byte,
+) error {
+	items := make(map[string][]byte, len(sequences))
+	for _, sequence := range sequences {
+		items[host.PacketReceiptPath(portID, channelID, sequence)] = receipt
+	}
+
+	if err := k.batchVerifyMembership(ctx, connection, height, proof, items); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipts verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyPacketReceiptsAbsence verifies a single proof of the absence of the incoming
+// packet receipts at the specified port, specified channel, and specified sequences.
+func (k Keeper) VerifyPacketReceiptsAbsence(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+) error {
+	keys := make([][]byte, len(sequences))
+	for i, sequence := range sequences {
+		keys[i] = []byte(host.PacketReceiptPath(portID, channelID, sequence))
+	}
+
+	if err := k.batchVerifyNonMembership(ctx, connection, height, proof, keys); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipts absence verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitmentsAbsence verifies a single proof of the absence of the outgoing
+// packet commitments at the specified port, specified channel, and specified sequences.
+func (k Keeper) VerifyPacketCommitmentsAbsence(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+) error {
+	keys := make([][]byte, len(sequences))
+	for i, sequence := range sequences {
+		keys[i] = []byte(host.PacketCommitmentPath(portID, channelID, sequence))
+	}
+
+	if err := k.batchVerifyNonMembership(ctx, connection, height, proof, keys); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitments absence verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// batchVerifyMembership verifies a single proof of the given values stored under the given
+// paths of the counterparty IBC store.
+func (k Keeper) batchVerifyMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	items map[string][]byte,
+) error {
+	clientID := connection.GetClientID()
+	batchVerifier, err := k.getBatchVerifierModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), commitmenttypes.NewMerklePath())
+	if err != nil {
+		return err
+	}
+
+	return batchVerifier.BatchVerifyMembership(
+		ctx, clientID, height,
+		connection.GetDelayPeriod(), k.getBlockDelay(ctx, connection),
+		proof, path, items,
+	)
+}
+
+// batchVerifyNonMembership verifies a single proof of the absence of the given keys in the
+// counterparty IBC store.
+func (k Keeper) batchVerifyNonMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	keys [][]byte,
+) error {
+	clientID := connection.GetClientID()
+	batchVerifier, err := k.getBatchVerifierModule(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), commitmenttypes.NewMerklePath())
+	if err != nil {
+		return err
+	}
+
+	return batchVerifier.BatchVerifyNonMembership(
+		ctx, clientID, height,
+		connection.GetDelayPeriod(), k.getBlockDelay(ctx, connection),
+		proof, path, keys,
+	)
+}
+
+// getBatchVerifierModule returns the light client module of an active client supporting batch
+// proof verification for the provided client identifier.
+func (k Keeper) getBatchVerifierModule(ctx sdk.Context, clientID string) (exported.BatchVerifierModule, error) {
+	lightClientModule, err := k.getLightClientModule(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	batchVerifier, ok := lightClientModule.(exported.BatchVerifierModule)
+	if !ok {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "client (%s) does not support batch proof verification", clientID)
+	}
+
+	return batchVerifier, nil
+}
+
 // getBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
@@ -465,18 +781,17 @@ func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI)
 	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
 }
 
-// getClientStateAndVerificationStore returns the client state and associated KVStore for the provided client identifier.
-// If the client type is localhost then the core IBC KVStore is returned, otherwise the client prefixed store is returned.
-func (k Keeper) getClientStateAndVerificationStore(ctx sdk.Context, clientID string) (exported.ClientState, storetypes.KVStore, error) {
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return nil, nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+// getLightClientModule returns the light client module for the provided client identifier.
+// An error is returned if the client does not exist or if no light client module is registered for its client type.
+func (k Keeper) getLightClientModule(ctx sdk.Context, clientID string) (exported.LightClientModule, error) {
+	if _, found := k.clientKeeper.GetClientState(ctx, clientID); !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
-	store := k.clientKeeper.ClientStore(ctx, clientID)
-	if clientID == exported.LocalhostClientID {
-		store = ctx.KVStore(k.storeKey)
+	lightClientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
 	}
 
-	return clientState, store, nil
+	return lightClientModule, nil
 }
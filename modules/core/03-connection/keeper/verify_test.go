@@ -844,6 +844,163 @@ func (suite *KeeperTestSuite) TestVerifyUpgrade() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyConnectionUpgradeErrorReceipt() {
+	var (
+		path         *ibctesting.Path
+		upgradeError *types.UpgradeError
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+			expPass:  true,
+		},
+		{
+			name: "fails when client state is frozen",
+			malleate: func() {
+				clientState := path.EndpointB.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointB.SetClientState(clientState)
+			},
+			expPass: false,
+		},
+		{
+			name: "fails with bad client id",
+			malleate: func() {
+				connection := path.EndpointB.GetConnection()
+				connection.ClientId = ibctesting.InvalidID
+				path.EndpointB.SetConnection(connection)
+			},
+			expPass: false,
+		},
+		{
+			name: "verification fails when the key does not exist",
+			malleate: func() {
+				suite.chainA.DeleteKey(host.ConnectionUpgradeErrorKey(path.EndpointA.ConnectionID))
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			expPass: false,
+		},
+		{
+			name: "verification fails when sequence differs",
+			malleate: func() {
+				upgradeError = types.NewUpgradeError(2, types.ErrInvalidUpgrade)
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			upgradeError = types.NewUpgradeError(1, types.ErrInvalidUpgrade)
+			suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgradeError)
+
+			suite.chainA.Coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			tc.malleate()
+
+			upgradeErrorReceiptKey := host.ConnectionUpgradeErrorKey(path.EndpointA.ConnectionID)
+			proof, proofHeight := suite.chainA.QueryProof(upgradeErrorReceiptKey)
+
+			err := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.VerifyConnectionUpgradeError(suite.chainB.GetContext(), path.EndpointB.GetConnection(), proofHeight, proof, path.EndpointB.Counterparty.ConnectionID, upgradeError.GetErrorReceipt())
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyConnectionUpgrade() {
+	var (
+		path    *ibctesting.Path
+		upgrade types.Upgrade
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+			expPass:  true,
+		},
+		{
+			name: "fails when client state is frozen",
+			malleate: func() {
+				clientState := path.EndpointB.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointB.SetClientState(clientState)
+			},
+			expPass: false,
+		},
+		{
+			name: "fails with bad client id",
+			malleate: func() {
+				connection := path.EndpointB.GetConnection()
+				connection.ClientId = ibctesting.InvalidID
+				path.EndpointB.SetConnection(connection)
+			},
+			expPass: false,
+		},
+		{
+			name: "fails when the upgrade field is different",
+			malleate: func() {
+				upgrade.DelayPeriod++
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			upgrade = types.NewUpgrade(ibctesting.ConnectionVersion, uint64(time.Hour.Nanoseconds()), suite.chainB.GetPrefix())
+
+			suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+
+			suite.chainA.Coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			tc.malleate()
+
+			connectionUpgradeKey := host.ConnectionUpgradeKey(path.EndpointA.ConnectionID)
+			proof, proofHeight := suite.chainA.QueryProof(connectionUpgradeKey)
+
+			err := suite.chainB.GetSimApp().IBCKeeper.ConnectionKeeper.VerifyConnectionUpgrade(suite.chainB.GetContext(), path.EndpointB.GetConnection(), proofHeight, proof, path.EndpointB.Counterparty.ConnectionID, upgrade)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func malleateHeight(height exported.Height, diff uint64) exported.Height {
 	return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+diff)
 }
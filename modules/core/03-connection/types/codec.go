@@ -29,6 +29,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConnectionOpenAck{},
 		&MsgConnectionOpenConfirm{},
 		&MsgUpdateParams{},
+		&MsgConnectionCloseInit{},
+		&MsgConnectionCloseConfirm{},
+		&MsgConnectionUpgradeInit{},
+		&MsgConnectionUpgradeTry{},
+		&MsgConnectionUpgradeAck{},
+		&MsgConnectionUpgradeConfirm{},
+		&MsgConnectionUpgradeCancel{},
+		&MsgConnectionUpgradeTimeout{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -25,7 +25,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // State defines if a connection is in one of the following states:
-// INIT, TRYOPEN, OPEN or UNINITIALIZED.
+// INIT, TRYOPEN, OPEN, CLOSED or UNINITIALIZED.
 type State int32
 
 const (
@@ -38,6 +38,8 @@ const (
 	TRYOPEN State = 2
 	// A connection end has completed the handshake.
 	OPEN State = 3
+	// A connection end has been closed and can no longer be used by channels.
+	CLOSED State = 4
 )
 
 var State_name = map[int32]string{
@@ -45,6 +47,7 @@ var State_name = map[int32]string{
 	1: "STATE_INIT",
 	2: "STATE_TRYOPEN",
 	3: "STATE_OPEN",
+	4: "STATE_CLOSED",
 }
 
 var State_value = map[string]int32{
@@ -52,6 +55,7 @@ var State_value = map[string]int32{
 	"STATE_INIT":                      1,
 	"STATE_TRYOPEN":                   2,
 	"STATE_OPEN":                      3,
+	"STATE_CLOSED":                    4,
 }
 
 func (x State) String() string {
@@ -80,6 +84,9 @@ type ConnectionEnd struct {
 	// packet-verification NOTE: delay period logic is only implemented by some
 	// clients.
 	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// monotonically increasing sequence number incremented on each connection
+	// upgrade attempt.
+	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
 func (m *ConnectionEnd) Reset()         { *m = ConnectionEnd{} }
@@ -131,6 +138,8 @@ type IdentifiedConnection struct {
 	Counterparty Counterparty `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty"`
 	// delay period associated with this connection.
 	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// upgrade sequence associated with this connection.
+	UpgradeSequence uint64 `protobuf:"varint,7,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
 func (m *IdentifiedConnection) Reset()         { *m = IdentifiedConnection{} }
@@ -354,19 +363,127 @@ func (m *Version) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Version proto.InternalMessageInfo
 
+// Upgrade is a verifiable type which contains the connection end parameters
+// proposed by a chain for a connection upgrade.
+type Upgrade struct {
+	// version to be used by the connection after the upgrade.
+	Version *Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// delay period to be used by the connection after the upgrade.
+	DelayPeriod uint64 `protobuf:"varint,2,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// commitment merkle prefix of the counterparty chain to be used by the
+	// connection after the upgrade.
+	CounterpartyPrefix types.MerklePrefix `protobuf:"bytes,3,opt,name=counterparty_prefix,json=counterpartyPrefix,proto3" json:"counterparty_prefix"`
+	// timestamp (in nanoseconds) of the counterparty chain after which the upgrade may be timed out
+	// by the chain which initiated it. It is zero for the upgrade of the counterparty chain.
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{6}
+}
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+// ErrorReceipt defines a type which encapsulates the upgrade sequence and error
+// associated with the connection upgrade handshake failure. When a connection
+// upgrade handshake is aborted, an error receipt is written to state so that
+// the counterparty may cancel its own upgrade.
+type ErrorReceipt struct {
+	// the connection upgrade sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the error message detailing the cause of failure
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ErrorReceipt) Reset()         { *m = ErrorReceipt{} }
+func (m *ErrorReceipt) String() string { return proto.CompactTextString(m) }
+func (*ErrorReceipt) ProtoMessage()    {}
+func (*ErrorReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{7}
+}
+func (m *ErrorReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorReceipt.Merge(m, src)
+}
+func (m *ErrorReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorReceipt proto.InternalMessageInfo
+
+func (m *ErrorReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ErrorReceipt) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 // Params defines the set of Connection parameters.
 type Params struct {
 	// maximum expected time per block (in nanoseconds), used to enforce block delay. This parameter should reflect the
 	// largest amount of time that the chain might reasonably take to produce the next block under normal operating
 	// conditions. A safe choice is 3-5x the expected time per block.
 	MaxExpectedTimePerBlock uint64 `protobuf:"varint,1,opt,name=max_expected_time_per_block,json=maxExpectedTimePerBlock,proto3" json:"max_expected_time_per_block,omitempty"`
+	// whether typed protobuf events are emitted alongside the legacy events for connection state transitions.
+	EmitTypedEvents bool `protobuf:"varint,2,opt,name=emit_typed_events,json=emitTypedEvents,proto3" json:"emit_typed_events,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{6}
+	return fileDescriptor_90572467c054e43a, []int{8}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,6 +519,13 @@ func (m *Params) GetMaxExpectedTimePerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetEmitTypedEvents() bool {
+	if m != nil {
+		return m.EmitTypedEvents
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.connection.v1.State", State_name, State_value)
 	proto.RegisterType((*ConnectionEnd)(nil), "ibc.core.connection.v1.ConnectionEnd")
@@ -410,6 +534,8 @@ func init() {
 	proto.RegisterType((*ClientPaths)(nil), "ibc.core.connection.v1.ClientPaths")
 	proto.RegisterType((*ConnectionPaths)(nil), "ibc.core.connection.v1.ConnectionPaths")
 	proto.RegisterType((*Version)(nil), "ibc.core.connection.v1.Version")
+	proto.RegisterType((*Upgrade)(nil), "ibc.core.connection.v1.Upgrade")
+	proto.RegisterType((*ErrorReceipt)(nil), "ibc.core.connection.v1.ErrorReceipt")
 	proto.RegisterType((*Params)(nil), "ibc.core.connection.v1.Params")
 }
 
@@ -418,49 +544,59 @@ func init() {
 }
 
 var fileDescriptor_90572467c054e43a = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5d, 0x4b, 0xdb, 0x50,
-	0x18, 0x4e, 0xd2, 0xb4, 0xd6, 0xd3, 0xd6, 0xb9, 0x83, 0x6c, 0x21, 0xb2, 0x34, 0xd3, 0xc1, 0xca,
-	0xc0, 0x66, 0x2a, 0x8c, 0xb1, 0x79, 0x63, 0x6b, 0x84, 0xb0, 0xad, 0x0b, 0xb1, 0x0a, 0xf3, 0x26,
-	0xa4, 0xc9, 0xb1, 0x1e, 0x6c, 0x72, 0x42, 0x72, 0x5a, 0xea, 0x3f, 0x10, 0xaf, 0x76, 0x37, 0x36,
-	0x10, 0x06, 0xbb, 0xdf, 0xef, 0xf0, 0xd2, 0xcb, 0x5d, 0x8d, 0xa1, 0x7f, 0x64, 0xe4, 0xc3, 0x26,
-	0xca, 0xec, 0xc5, 0xb6, 0xbb, 0xf7, 0xe3, 0x79, 0x9e, 0xbc, 0x79, 0xde, 0x97, 0x03, 0x9e, 0xe2,
-	0x9e, 0xad, 0xd8, 0x24, 0x40, 0x8a, 0x4d, 0x3c, 0x0f, 0xd9, 0x14, 0x13, 0x4f, 0x19, 0xad, 0xe6,
-	0xb2, 0xa6, 0x1f, 0x10, 0x4a, 0xe0, 0x03, 0xdc, 0xb3, 0x9b, 0x11, 0xb0, 0x99, 0x6b, 0x8d, 0x56,
-	0xc5, 0x85, 0x3e, 0xe9, 0x93, 0x18, 0xa2, 0x44, 0x51, 0x82, 0x16, 0xf3, 0xb2, 0xae, 0x8b, 0xa9,
-	0x8b, 0x3c, 0x9a, 0xc8, 0x5e, 0x67, 0x09, 0x70, 0xe9, 0x13, 0x07, 0x6a, 0xed, 0x89, 0xa0, 0xea,
-	0x39, 0x70, 0x11, 0xcc, 0xda, 0x03, 0x8c, 0x3c, 0x6a, 0x62, 0x47, 0x60, 0x65, 0xb6, 0x31, 0x6b,
-	0x94, 0x93, 0x82, 0xe6, 0xc0, 0xd7, 0xa0, 0x3c, 0x42, 0x41, 0x88, 0x89, 0x17, 0x0a, 0x9c, 0x5c,
-	0x68, 0x54, 0xd6, 0xea, 0xcd, 0x3f, 0x0f, 0xd6, 0xdc, 0x4b, 0x70, 0xc6, 0x84, 0x00, 0xd7, 0x41,
-	0x31, 0xa4, 0x16, 0x45, 0x42, 0x41, 0x66, 0x1b, 0x73, 0x6b, 0x8f, 0xee, 0x62, 0xee, 0x44, 0x20,
-	0x23, 0xc1, 0xc2, 0x0e, 0xa8, 0xda, 0x64, 0xe8, 0x51, 0x14, 0xf8, 0x56, 0x40, 0x8f, 0x05, 0x5e,
-	0x66, 0x1b, 0x95, 0xb5, 0x27, 0x77, 0x71, 0xdb, 0x39, 0x6c, 0x8b, 0x3f, 0xff, 0x59, 0x67, 0x8c,
-	0x1b, 0x7c, 0xf8, 0x18, 0x54, 0x1d, 0x34, 0xb0, 0x8e, 0x4d, 0x1f, 0x05, 0x98, 0x38, 0x42, 0x51,
-	0x66, 0x1b, 0xbc, 0x51, 0x89, 0x6b, 0x7a, 0x5c, 0x7a, 0xc5, 0x9f, 0x7c, 0xad, 0x33, 0x4b, 0xdf,
-	0x39, 0xb0, 0xa0, 0x39, 0xc8, 0xa3, 0xf8, 0x00, 0x23, 0x27, 0xf3, 0x08, 0xce, 0x01, 0x6e, 0xe2,
-	0x0c, 0x87, 0x6f, 0x19, 0xc6, 0x4d, 0x31, 0xac, 0xf0, 0xd7, 0x86, 0xf1, 0xff, 0x60, 0x58, 0xf1,
-	0x3f, 0x1b, 0x56, 0xba, 0xcb, 0xb0, 0x2f, 0x2c, 0xa8, 0xe6, 0xd5, 0xa6, 0x5f, 0xd2, 0x32, 0xa8,
-	0x65, 0x83, 0x64, 0xce, 0x55, 0xb3, 0xa2, 0xe6, 0xc0, 0x16, 0x28, 0xf9, 0x01, 0x3a, 0xc0, 0xe3,
-	0xf8, 0x64, 0x6e, 0xfd, 0xc5, 0xe4, 0x92, 0x47, 0xab, 0xcd, 0x77, 0x28, 0x38, 0x1a, 0x20, 0x3d,
-	0xc6, 0xa6, 0x7f, 0x91, 0x32, 0xd3, 0xe1, 0x96, 0x41, 0xa5, 0x1d, 0x7f, 0x5a, 0xb7, 0xe8, 0x61,
-	0x08, 0x17, 0x40, 0xd1, 0x8f, 0x02, 0x81, 0x95, 0x0b, 0x8d, 0x59, 0x23, 0x49, 0x96, 0xb6, 0xc0,
-	0xbd, 0x6c, 0xcf, 0x09, 0x70, 0xea, 0x3f, 0x4c, 0x54, 0xb8, 0xbc, 0xca, 0x1b, 0x30, 0x93, 0xae,
-	0x12, 0x4a, 0x00, 0xe0, 0xeb, 0x13, 0x0a, 0x52, 0x7a, 0xae, 0x02, 0x45, 0x50, 0x3e, 0x40, 0x16,
-	0x1d, 0x06, 0xe8, 0x5a, 0x63, 0x92, 0xa7, 0x73, 0x6f, 0x83, 0x92, 0x6e, 0x05, 0x96, 0x1b, 0xc2,
-	0x0d, 0xb0, 0xe8, 0x5a, 0x63, 0x13, 0x8d, 0x7d, 0x64, 0x53, 0xe4, 0x98, 0x14, 0xbb, 0x28, 0xda,
-	0x89, 0xd9, 0x1b, 0x10, 0xfb, 0x28, 0x16, 0xe7, 0x8d, 0x87, 0xae, 0x35, 0x56, 0x53, 0x44, 0x17,
-	0xbb, 0x48, 0x47, 0x41, 0x2b, 0x6a, 0x3f, 0xfb, 0xcc, 0x82, 0x62, 0x7c, 0x26, 0xf0, 0x05, 0xa8,
-	0xef, 0x74, 0x37, 0xbb, 0xaa, 0xb9, 0xdb, 0xd1, 0x3a, 0x5a, 0x57, 0xdb, 0x7c, 0xab, 0xed, 0xab,
-	0x5b, 0xe6, 0x6e, 0x67, 0x47, 0x57, 0xdb, 0xda, 0xb6, 0xa6, 0x6e, 0xcd, 0x33, 0xe2, 0xfd, 0xd3,
-	0x33, 0xb9, 0x76, 0x03, 0x00, 0x05, 0x00, 0x12, 0x5e, 0x54, 0x9c, 0x67, 0xc5, 0xf2, 0xe9, 0x99,
-	0xcc, 0x47, 0x31, 0x94, 0x40, 0x2d, 0xe9, 0x74, 0x8d, 0x0f, 0xef, 0x75, 0xb5, 0x33, 0xcf, 0x89,
-	0x95, 0xd3, 0x33, 0x79, 0x26, 0x4d, 0x33, 0x66, 0xdc, 0x2c, 0x24, 0xcc, 0x28, 0x16, 0xf9, 0x93,
-	0x6f, 0x12, 0xd3, 0xda, 0x3b, 0xbf, 0x94, 0xd8, 0x8b, 0x4b, 0x89, 0xfd, 0x75, 0x29, 0xb1, 0x1f,
-	0xaf, 0x24, 0xe6, 0xe2, 0x4a, 0x62, 0x7e, 0x5c, 0x49, 0xcc, 0xfe, 0x46, 0x1f, 0xd3, 0xc3, 0x61,
-	0x2f, 0x5a, 0xb6, 0x62, 0x93, 0xd0, 0x25, 0xa1, 0x82, 0x7b, 0xf6, 0x4a, 0x9f, 0x28, 0xa3, 0x97,
-	0x8a, 0x4b, 0x9c, 0xe1, 0x00, 0x85, 0xc9, 0x33, 0xf7, 0x7c, 0x7d, 0x25, 0xf7, 0x80, 0xd2, 0x63,
-	0x1f, 0x85, 0xbd, 0x52, 0xfc, 0xc4, 0xad, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xb8, 0x93,
-	0xa8, 0x64, 0x05, 0x00, 0x00,
+	// 831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x29, 0xea, 0xc3, 0x23, 0x39, 0x96, 0xb7, 0x46, 0x4b, 0x28, 0x2d, 0xcd, 0x3a, 0x05,
+	0xaa, 0xa6, 0x88, 0x54, 0xdb, 0x40, 0xd1, 0x8f, 0x5c, 0x62, 0x89, 0x05, 0x88, 0xa6, 0x8a, 0x40,
+	0xc9, 0x01, 0x9a, 0x1e, 0x08, 0x8a, 0x1c, 0x2b, 0x8b, 0x88, 0x5c, 0x76, 0xb9, 0x12, 0xec, 0x7f,
+	0x10, 0xf8, 0xd4, 0x73, 0x01, 0x03, 0x05, 0xfa, 0x23, 0x7a, 0xef, 0x29, 0xc7, 0x1c, 0xdb, 0x4b,
+	0x51, 0xd8, 0x3f, 0xa2, 0xd7, 0x82, 0x1f, 0x92, 0x98, 0xba, 0x11, 0x02, 0xb7, 0x27, 0x72, 0xde,
+	0xbc, 0x37, 0x3b, 0xfb, 0x76, 0x16, 0x0b, 0x1f, 0xd2, 0xb1, 0xdb, 0x71, 0x19, 0xc7, 0x8e, 0xcb,
+	0x82, 0x00, 0x5d, 0x41, 0x59, 0xd0, 0x99, 0xef, 0xe7, 0xa2, 0x76, 0xc8, 0x99, 0x60, 0xe4, 0x6d,
+	0x3a, 0x76, 0xdb, 0x31, 0xb1, 0x9d, 0x4b, 0xcd, 0xf7, 0x9b, 0x3b, 0x13, 0x36, 0x61, 0x09, 0xa5,
+	0x13, 0xff, 0xa5, 0xec, 0x66, 0xbe, 0xac, 0xef, 0x53, 0xe1, 0x63, 0x20, 0xd2, 0xb2, 0x8b, 0x28,
+	0x25, 0xee, 0xfd, 0x2a, 0xc3, 0x66, 0x77, 0x59, 0xd0, 0x08, 0x3c, 0x72, 0x1b, 0x36, 0xdc, 0x29,
+	0xc5, 0x40, 0xd8, 0xd4, 0x53, 0x25, 0x5d, 0x6a, 0x6d, 0x58, 0xd5, 0x14, 0x30, 0x3d, 0xf2, 0x25,
+	0x54, 0xe7, 0xc8, 0x23, 0xca, 0x82, 0x48, 0x95, 0xf5, 0x62, 0xab, 0x76, 0xb0, 0xdb, 0xfe, 0xf7,
+	0xc6, 0xda, 0x8f, 0x53, 0x9e, 0xb5, 0x14, 0x90, 0x43, 0x28, 0x45, 0xc2, 0x11, 0xa8, 0x16, 0x75,
+	0xa9, 0x75, 0xeb, 0xe0, 0xbd, 0xd7, 0x29, 0x87, 0x31, 0xc9, 0x4a, 0xb9, 0xa4, 0x0f, 0x75, 0x97,
+	0xcd, 0x02, 0x81, 0x3c, 0x74, 0xb8, 0x38, 0x53, 0x15, 0x5d, 0x6a, 0xd5, 0x0e, 0x3e, 0x78, 0x9d,
+	0xb6, 0x9b, 0xe3, 0x1e, 0x29, 0x2f, 0xfe, 0xd8, 0x2d, 0x58, 0xaf, 0xe8, 0xc9, 0xfb, 0x50, 0xf7,
+	0x70, 0xea, 0x9c, 0xd9, 0x21, 0x72, 0xca, 0x3c, 0xb5, 0xa4, 0x4b, 0x2d, 0xc5, 0xaa, 0x25, 0xd8,
+	0x20, 0x81, 0xc8, 0x47, 0xd0, 0x98, 0x85, 0x13, 0xee, 0x78, 0x68, 0x47, 0xf8, 0xfd, 0x0c, 0x03,
+	0x17, 0xd5, 0x72, 0x42, 0xdb, 0xca, 0xf0, 0x61, 0x06, 0x7f, 0xa1, 0x3c, 0xff, 0x69, 0xb7, 0xb0,
+	0xf7, 0xbb, 0x0c, 0x3b, 0xa6, 0x87, 0x81, 0xa0, 0x27, 0x14, 0xbd, 0x95, 0x9d, 0xe4, 0x16, 0xc8,
+	0x4b, 0x13, 0x65, 0xfa, 0x0f, 0x6f, 0xe5, 0x35, 0xde, 0x16, 0x6f, 0xec, 0xad, 0xf2, 0x1f, 0xbc,
+	0x2d, 0xfd, 0xcf, 0xde, 0x96, 0xdf, 0xcc, 0xdb, 0xca, 0x3a, 0x6f, 0x7f, 0x94, 0xa0, 0x9e, 0x5f,
+	0x78, 0xfd, 0x7c, 0xde, 0x81, 0xcd, 0x55, 0xcf, 0x2b, 0x93, 0xeb, 0x2b, 0xd0, 0xf4, 0xc8, 0x11,
+	0x94, 0x43, 0x8e, 0x27, 0xf4, 0x54, 0x2d, 0x5e, 0xdf, 0xf0, 0xf2, 0x7e, 0xcc, 0xf7, 0xdb, 0xdf,
+	0x20, 0x7f, 0x36, 0xc5, 0x41, 0xc2, 0xcd, 0x36, 0x9c, 0x29, 0xb3, 0xe6, 0xee, 0x40, 0xad, 0x9b,
+	0x2c, 0x3d, 0x70, 0xc4, 0xd3, 0x88, 0xec, 0x40, 0x29, 0x8c, 0x7f, 0x54, 0x49, 0x2f, 0xb6, 0x36,
+	0xac, 0x34, 0xd8, 0xeb, 0xc1, 0xd6, 0x6a, 0x24, 0x52, 0xe2, 0xda, 0x3d, 0x2c, 0xab, 0xc8, 0xf9,
+	0x2a, 0x5f, 0x43, 0x25, 0x3b, 0x75, 0xa2, 0x01, 0xd0, 0xc5, 0xb4, 0xf1, 0x4c, 0x9e, 0x43, 0x48,
+	0x13, 0xaa, 0x27, 0xe8, 0x88, 0x19, 0xc7, 0x45, 0x8d, 0x65, 0x9c, 0xf5, 0xfd, 0x97, 0x04, 0x95,
+	0xe3, 0xd4, 0x6e, 0xf2, 0x39, 0x54, 0xb2, 0x29, 0x4a, 0x4a, 0xbd, 0xc1, 0xd4, 0x2d, 0xf8, 0xd7,
+	0xce, 0x5b, 0xbe, 0x7e, 0xde, 0xdf, 0xc1, 0x5b, 0xf9, 0x11, 0xb1, 0x6f, 0x6c, 0x3c, 0xc9, 0x97,
+	0x49, 0x33, 0xe4, 0x63, 0xd8, 0x16, 0xd4, 0x47, 0x36, 0x13, 0x76, 0xfc, 0x8d, 0x84, 0xe3, 0x87,
+	0xc9, 0x05, 0x50, 0xac, 0x46, 0x96, 0x18, 0x2d, 0xf0, 0x6c, 0xe7, 0x3d, 0xa8, 0x1b, 0x9c, 0x33,
+	0x6e, 0xa1, 0x8b, 0x34, 0x14, 0xb1, 0x57, 0xcb, 0x39, 0x94, 0x12, 0xe5, 0x32, 0x26, 0x2a, 0x54,
+	0x7c, 0x8c, 0x22, 0x67, 0x82, 0xd9, 0x18, 0x2d, 0xc2, 0x3d, 0x0e, 0xe5, 0x81, 0xc3, 0x1d, 0x3f,
+	0x22, 0xf7, 0xe1, 0xb6, 0xef, 0x9c, 0xda, 0x78, 0x1a, 0xa2, 0x2b, 0xd0, 0x4b, 0xfa, 0x88, 0xed,
+	0xb0, 0xc7, 0x53, 0xe6, 0x3e, 0xcb, 0x4a, 0xbe, 0xe3, 0x3b, 0xa7, 0x46, 0xc6, 0x88, 0x1b, 0x1a,
+	0x20, 0x3f, 0x8a, 0xd3, 0xe4, 0x2e, 0x6c, 0xa3, 0x4f, 0x85, 0x2d, 0xce, 0x42, 0xf4, 0x6c, 0x9c,
+	0x63, 0x20, 0xa2, 0x64, 0xad, 0xaa, 0xb5, 0x15, 0x27, 0x46, 0x31, 0x6e, 0x24, 0xf0, 0xdd, 0x5f,
+	0x24, 0x28, 0x25, 0xb7, 0x97, 0x7c, 0x0a, 0xbb, 0xc3, 0xd1, 0x83, 0x91, 0x61, 0x1f, 0xf7, 0xcd,
+	0xbe, 0x39, 0x32, 0x1f, 0x3c, 0x34, 0x9f, 0x18, 0x3d, 0xfb, 0xb8, 0x3f, 0x1c, 0x18, 0x5d, 0xf3,
+	0x2b, 0xd3, 0xe8, 0x35, 0x0a, 0xcd, 0xed, 0xf3, 0x0b, 0x7d, 0xf3, 0x15, 0x02, 0x51, 0x01, 0x52,
+	0x5d, 0x0c, 0x36, 0xa4, 0x66, 0xf5, 0xfc, 0x42, 0x57, 0xe2, 0x7f, 0xa2, 0xc1, 0x66, 0x9a, 0x19,
+	0x59, 0xdf, 0x3e, 0x1a, 0x18, 0xfd, 0x86, 0xdc, 0xac, 0x9d, 0x5f, 0xe8, 0x95, 0x2c, 0x5c, 0x29,
+	0x93, 0x64, 0x31, 0x55, 0x26, 0x99, 0x77, 0xa1, 0x9e, 0x66, 0xba, 0x0f, 0x1f, 0x0d, 0x8d, 0x5e,
+	0x43, 0x69, 0xc2, 0xf9, 0x85, 0x5e, 0x4e, 0xa3, 0xa6, 0xf2, 0xfc, 0x67, 0xad, 0x70, 0xf4, 0xf8,
+	0xc5, 0xa5, 0x26, 0xbd, 0xbc, 0xd4, 0xa4, 0x3f, 0x2f, 0x35, 0xe9, 0x87, 0x2b, 0xad, 0xf0, 0xf2,
+	0x4a, 0x2b, 0xfc, 0x76, 0xa5, 0x15, 0x9e, 0xdc, 0x9f, 0x50, 0xf1, 0x74, 0x36, 0x8e, 0x4f, 0xbf,
+	0xe3, 0xb2, 0xc8, 0x67, 0x51, 0x87, 0x8e, 0xdd, 0x7b, 0x13, 0xd6, 0x99, 0x7f, 0xd6, 0xf1, 0x99,
+	0x37, 0x9b, 0x62, 0x94, 0x3e, 0x63, 0x9f, 0x1c, 0xde, 0xcb, 0x3d, 0x90, 0xb1, 0x5f, 0xd1, 0xb8,
+	0x9c, 0x3c, 0x61, 0x87, 0x7f, 0x0f, 0x00, 0xe7, 0x29, 0x5f, 0x92, 0x44, 0x07, 0x00, 0x00,
 }
 
 func (m *ConnectionEnd) Marshal() (dAtA []byte, err error) {
@@ -483,6 +619,11 @@ func (m *ConnectionEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -547,6 +688,11 @@ func (m *IdentifiedConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -755,6 +901,96 @@ func (m *Version) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.CounterpartyPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConnection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DelayPeriod != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -775,6 +1011,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmitTypedEvents {
+		i--
+		if m.EmitTypedEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxExpectedTimePerBlock != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.MaxExpectedTimePerBlock))
 		i--
@@ -818,6 +1064,9 @@ func (m *ConnectionEnd) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -849,6 +1098,9 @@ func (m *IdentifiedConnection) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -924,6 +1176,43 @@ func (m *Version) Size() (n int) {
 	return n
 }
 
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovConnection(uint64(m.DelayPeriod))
+	}
+	l = m.CounterpartyPrefix.Size()
+	n += 1 + l + sovConnection(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovConnection(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *ErrorReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovConnection(uint64(m.Sequence))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -933,6 +1222,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxExpectedTimePerBlock != 0 {
 		n += 1 + sovConnection(uint64(m.MaxExpectedTimePerBlock))
 	}
+	if m.EmitTypedEvents {
+		n += 2
+	}
 	return n
 }
 
@@ -1108,6 +1400,25 @@ func (m *ConnectionEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1327,6 +1638,25 @@ func (m *IdentifiedConnection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1805,6 +2135,264 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConnection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConnection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1853,6 +2441,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitTypedEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitTypedEvents = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
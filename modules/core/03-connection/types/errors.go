@@ -6,14 +6,24 @@ import (
 
 // IBC connection sentinel errors
 var (
-	ErrConnectionExists              = errorsmod.Register(SubModuleName, 2, "connection already exists")
-	ErrConnectionNotFound            = errorsmod.Register(SubModuleName, 3, "connection not found")
-	ErrClientConnectionPathsNotFound = errorsmod.Register(SubModuleName, 4, "light client connection paths not found")
-	ErrConnectionPath                = errorsmod.Register(SubModuleName, 5, "connection path is not associated to the given light client")
-	ErrInvalidConnectionState        = errorsmod.Register(SubModuleName, 6, "invalid connection state")
-	ErrInvalidCounterparty           = errorsmod.Register(SubModuleName, 7, "invalid counterparty connection")
-	ErrInvalidConnection             = errorsmod.Register(SubModuleName, 8, "invalid connection")
-	ErrInvalidVersion                = errorsmod.Register(SubModuleName, 9, "invalid connection version")
-	ErrVersionNegotiationFailed      = errorsmod.Register(SubModuleName, 10, "connection version negotiation failed")
-	ErrInvalidConnectionIdentifier   = errorsmod.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrConnectionExists                = errorsmod.Register(SubModuleName, 2, "connection already exists")
+	ErrConnectionNotFound              = errorsmod.Register(SubModuleName, 3, "connection not found")
+	ErrClientConnectionPathsNotFound   = errorsmod.Register(SubModuleName, 4, "light client connection paths not found")
+	ErrConnectionPath                  = errorsmod.Register(SubModuleName, 5, "connection path is not associated to the given light client")
+	ErrInvalidConnectionState          = errorsmod.Register(SubModuleName, 6, "invalid connection state")
+	ErrInvalidCounterparty             = errorsmod.Register(SubModuleName, 7, "invalid counterparty connection")
+	ErrInvalidConnection               = errorsmod.Register(SubModuleName, 8, "invalid connection")
+	ErrInvalidVersion                  = errorsmod.Register(SubModuleName, 9, "invalid connection version")
+	ErrVersionNegotiationFailed        = errorsmod.Register(SubModuleName, 10, "connection version negotiation failed")
+	ErrInvalidConnectionIdentifier     = errorsmod.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrChannelsNotClosed               = errorsmod.Register(SubModuleName, 12, "connection has channels which are not closed")
+	ErrInvalidUpgrade                  = errorsmod.Register(SubModuleName, 13, "invalid connection upgrade")
+	ErrUpgradeNotFound                 = errorsmod.Register(SubModuleName, 14, "connection upgrade not found")
+	ErrUpgradeInProgress               = errorsmod.Register(SubModuleName, 15, "connection upgrade is in progress")
+	ErrIncompatibleCounterpartyUpgrade = errorsmod.Register(SubModuleName, 16, "incompatible counterparty connection upgrade")
+	ErrInvalidUpgradeSequence          = errorsmod.Register(SubModuleName, 17, "invalid connection upgrade sequence")
+	ErrInvalidUpgradeError             = errorsmod.Register(SubModuleName, 18, "invalid connection upgrade error")
+	ErrInvalidDelayPeriod              = errorsmod.Register(SubModuleName, 19, "invalid connection delay period")
+	ErrUpgradeTimeout                  = errorsmod.Register(SubModuleName, 20, "connection upgrade timed out")
+	ErrUpgradeTimeoutFailed            = errorsmod.Register(SubModuleName, 21, "connection upgrade timeout failed")
 )
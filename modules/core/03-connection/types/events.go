@@ -12,14 +12,29 @@ const (
 	AttributeKeyClientID                 = "client_id"
 	AttributeKeyCounterpartyClientID     = "counterparty_client_id"
 	AttributeKeyCounterpartyConnectionID = "counterparty_connection_id"
+	AttributeKeyUpgradeSequence          = "upgrade_sequence"
+	AttributeKeyUpgradeVersion           = "upgrade_version"
+	AttributeKeyUpgradeDelayPeriod       = "upgrade_delay_period"
+	AttributeKeyUpgradeTimeoutTimestamp  = "upgrade_timeout_timestamp"
+	AttributeKeyUpgradeErrorReceipt      = "upgrade_error_receipt"
 )
 
 // IBC connection events vars
 var (
-	EventTypeConnectionOpenInit    = "connection_open_init"
-	EventTypeConnectionOpenTry     = "connection_open_try"
-	EventTypeConnectionOpenAck     = "connection_open_ack"
-	EventTypeConnectionOpenConfirm = "connection_open_confirm"
+	EventTypeConnectionOpenInit     = "connection_open_init"
+	EventTypeConnectionOpenTry      = "connection_open_try"
+	EventTypeConnectionOpenAck      = "connection_open_ack"
+	EventTypeConnectionOpenConfirm  = "connection_open_confirm"
+	EventTypeConnectionCloseInit    = "connection_close_init"
+	EventTypeConnectionCloseConfirm = "connection_close_confirm"
+
+	EventTypeConnectionUpgradeInit    = "connection_upgrade_init"
+	EventTypeConnectionUpgradeTry     = "connection_upgrade_try"
+	EventTypeConnectionUpgradeAck     = "connection_upgrade_ack"
+	EventTypeConnectionUpgradeConfirm = "connection_upgrade_confirm"
+	EventTypeConnectionUpgradeCancel  = "connection_upgrade_cancelled"
+	EventTypeConnectionUpgradeTimeout = "connection_upgrade_timeout"
+	EventTypeConnectionUpgradeError   = "connection_upgrade_error"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -19,12 +19,28 @@ var (
 	_ sdk.Msg = (*MsgConnectionOpenAck)(nil)
 	_ sdk.Msg = (*MsgConnectionOpenTry)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgConnectionCloseInit)(nil)
+	_ sdk.Msg = (*MsgConnectionCloseConfirm)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeInit)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeTry)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeAck)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeConfirm)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeCancel)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeTimeout)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenConfirm)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenAck)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenTry)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionCloseInit)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionCloseConfirm)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeInit)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeTry)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeAck)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeConfirm)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeCancel)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeTimeout)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionOpenTry)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionOpenAck)(nil)
@@ -276,3 +292,245 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+// NewMsgConnectionCloseInit creates a new MsgConnectionCloseInit instance
+func NewMsgConnectionCloseInit(connectionID, signer string) *MsgConnectionCloseInit {
+	return &MsgConnectionCloseInit{
+		ConnectionId: connectionID,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionCloseInit) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionCloseConfirm creates a new MsgConnectionCloseConfirm instance
+func NewMsgConnectionCloseConfirm(
+	connectionID string, initProof []byte, proofHeight clienttypes.Height,
+	signer string, counterpartyUpgradeSequence uint64, counterpartyConnectionID string,
+) *MsgConnectionCloseConfirm {
+	return &MsgConnectionCloseConfirm{
+		ConnectionId:                connectionID,
+		ProofInit:                   initProof,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		CounterpartyConnectionId:    counterpartyConnectionID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionCloseConfirm) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if msg.CounterpartyConnectionId != "" && !IsValidConnectionID(msg.CounterpartyConnectionId) {
+		return errorsmod.Wrap(ErrInvalidConnectionIdentifier, "invalid counterparty connection ID")
+	}
+	if len(msg.ProofInit) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof init")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeInit creates a new MsgConnectionUpgradeInit instance
+func NewMsgConnectionUpgradeInit(connectionID string, upgradeFields Upgrade, signer string) *MsgConnectionUpgradeInit {
+	return &MsgConnectionUpgradeInit{
+		ConnectionId: connectionID,
+		Fields:       upgradeFields,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeInit) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if err := msg.Fields.ValidateBasic(); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeTry creates a new MsgConnectionUpgradeTry instance
+func NewMsgConnectionUpgradeTry(
+	connectionID string,
+	counterpartyUpgrade Upgrade,
+	counterpartyUpgradeSequence uint64,
+	connectionProof, upgradeProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeTry {
+	return &MsgConnectionUpgradeTry{
+		ConnectionId:                connectionID,
+		CounterpartyUpgrade:         counterpartyUpgrade,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofConnection:             connectionProof,
+		ProofUpgrade:                upgradeProof,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTry) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "error validating counterparty upgrade")
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgradeSequence, "counterparty sequence cannot be 0")
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeAck creates a new MsgConnectionUpgradeAck instance
+func NewMsgConnectionUpgradeAck(
+	connectionID string,
+	counterpartyUpgrade Upgrade,
+	connectionProof, upgradeProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeAck {
+	return &MsgConnectionUpgradeAck{
+		ConnectionId:        connectionID,
+		CounterpartyUpgrade: counterpartyUpgrade,
+		ProofConnection:     connectionProof,
+		ProofUpgrade:        upgradeProof,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeAck) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "error validating counterparty upgrade")
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeConfirm creates a new MsgConnectionUpgradeConfirm instance
+func NewMsgConnectionUpgradeConfirm(
+	connectionID string, connectionProof []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeConfirm {
+	return &MsgConnectionUpgradeConfirm{
+		ConnectionId:    connectionID,
+		ProofConnection: connectionProof,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeConfirm) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeCancel creates a new MsgConnectionUpgradeCancel instance
+func NewMsgConnectionUpgradeCancel(
+	connectionID string,
+	errorReceipt ErrorReceipt,
+	errorReceiptProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeCancel {
+	return &MsgConnectionUpgradeCancel{
+		ConnectionId:      connectionID,
+		ErrorReceipt:      errorReceipt,
+		ProofErrorReceipt: errorReceiptProof,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. The error receipt proof is not validated as the
+// authority may cancel an upgrade without providing one.
+func (msg MsgConnectionUpgradeCancel) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeTimeout creates a new MsgConnectionUpgradeTimeout instance
+func NewMsgConnectionUpgradeTimeout(connectionID string, proofHeight clienttypes.Height, signer string) *MsgConnectionUpgradeTimeout {
+	return &MsgConnectionUpgradeTimeout{
+		ConnectionId: connectionID,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTimeout) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if msg.ProofHeight.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
@@ -211,6 +211,214 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 	}
 }
 
+func (suite *MsgTestSuite) TestNewMsgConnectionCloseInit() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionCloseInit
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionCloseInit("test/conn1", signer), false},
+		{"localhost connection ID", types.NewMsgConnectionCloseInit(exported.LocalhostConnectionID, signer), false},
+		{"empty signer", types.NewMsgConnectionCloseInit(connectionID, ""), false},
+		{"success", types.NewMsgConnectionCloseInit(connectionID, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionCloseConfirm() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionCloseConfirm
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionCloseConfirm("test/conn1", suite.proof, clientHeight, signer, 0, ""), false},
+		{"invalid counterparty connection ID", types.NewMsgConnectionCloseConfirm(connectionID, suite.proof, clientHeight, signer, 0, "test/conn1"), false},
+		{"empty proofInit", types.NewMsgConnectionCloseConfirm(connectionID, emptyProof, clientHeight, signer, 0, ""), false},
+		{"empty signer", types.NewMsgConnectionCloseConfirm(connectionID, suite.proof, clientHeight, "", 0, ""), false},
+		{"success", types.NewMsgConnectionCloseConfirm(connectionID, suite.proof, clientHeight, signer, 0, ""), true},
+		{"success: counterparty connection ID", types.NewMsgConnectionCloseConfirm(connectionID, suite.proof, clientHeight, signer, 0, connectionID), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeInit() {
+	upgrade := types.NewUpgrade(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey")))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeInit
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeInit("test/conn1", upgrade, signer), false},
+		{"invalid version", types.NewMsgConnectionUpgradeInit(connectionID, types.NewUpgrade(nil, 500, upgrade.CounterpartyPrefix), signer), false},
+		{"empty counterparty prefix", types.NewMsgConnectionUpgradeInit(connectionID, types.NewUpgrade(ibctesting.ConnectionVersion, 500, emptyPrefix), signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeInit(connectionID, upgrade, ""), false},
+		{"success", types.NewMsgConnectionUpgradeInit(connectionID, upgrade, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTry() {
+	upgrade := types.NewUpgrade(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey")))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTry
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTry("test/conn1", upgrade, 1, suite.proof, suite.proof, clientHeight, signer), false},
+		{"invalid counterparty upgrade", types.NewMsgConnectionUpgradeTry(connectionID, types.NewUpgrade(ibctesting.ConnectionVersion, 500, emptyPrefix), 1, suite.proof, suite.proof, clientHeight, signer), false},
+		{"zero counterparty upgrade sequence", types.NewMsgConnectionUpgradeTry(connectionID, upgrade, 0, suite.proof, suite.proof, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeTry(connectionID, upgrade, 1, emptyProof, suite.proof, clientHeight, signer), false},
+		{"empty upgrade proof", types.NewMsgConnectionUpgradeTry(connectionID, upgrade, 1, suite.proof, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTry(connectionID, upgrade, 1, suite.proof, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTry(connectionID, upgrade, 1, suite.proof, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeAck() {
+	upgrade := types.NewUpgrade(ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey")))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeAck
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeAck("test/conn1", upgrade, suite.proof, suite.proof, clientHeight, signer), false},
+		{"invalid counterparty upgrade", types.NewMsgConnectionUpgradeAck(connectionID, types.NewUpgrade(nil, 500, upgrade.CounterpartyPrefix), suite.proof, suite.proof, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, emptyProof, suite.proof, clientHeight, signer), false},
+		{"empty upgrade proof", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, suite.proof, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, suite.proof, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, suite.proof, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeConfirm() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeConfirm
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeConfirm("test/conn1", suite.proof, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeConfirm(connectionID, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeCancel() {
+	errorReceipt := types.NewErrorReceipt(1, "error")
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeCancel
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeCancel("test/conn1", errorReceipt, suite.proof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, suite.proof, clientHeight, ""), false},
+		{"success: empty error receipt proof", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, emptyProof, clientHeight, signer), true},
+		{"success", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTimeout() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTimeout
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTimeout("test/conn1", clientHeight, signer), false},
+		{"zero proof height", types.NewMsgConnectionUpgradeTimeout(connectionID, clienttypes.ZeroHeight(), signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTimeout(connectionID, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTimeout(connectionID, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func (suite *MsgTestSuite) TestMsgUpdateParamsValidateBasic() {
 	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
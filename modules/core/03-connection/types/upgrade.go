@@ -0,0 +1,130 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+)
+
+// DefaultUpgradeTimeout is the duration after which a connection upgrade may be timed out, if the
+// proposed upgrade does not set a timeout timestamp.
+const DefaultUpgradeTimeout = 10 * time.Minute
+
+// NewUpgrade creates a new Upgrade instance.
+func NewUpgrade(version *Version, delayPeriod uint64, counterpartyPrefix commitmenttypes.MerklePrefix) Upgrade {
+	return Upgrade{
+		Version:            version,
+		DelayPeriod:        delayPeriod,
+		CounterpartyPrefix: counterpartyPrefix,
+	}
+}
+
+// ValidateBasic performs a basic validation of the proposed upgrade fields
+func (u Upgrade) ValidateBasic() error {
+	if err := ValidateVersion(u.Version); err != nil {
+		return errorsmod.Wrap(err, "proposed upgrade version is invalid")
+	}
+
+	if u.CounterpartyPrefix.Empty() {
+		return errorsmod.Wrap(ErrInvalidUpgrade, "counterparty prefix cannot be empty")
+	}
+
+	return nil
+}
+
+// HasTimedOut returns true if the upgrade has a timeout timestamp which is not after the given timestamp.
+func (u Upgrade) HasTimedOut(timestamp uint64) bool {
+	return u.TimeoutTimestamp != 0 && timestamp >= u.TimeoutTimestamp
+}
+
+// IsCompatible returns an error if the counterparty upgrade is not compatible with the upgrade.
+// Both connection ends must agree on the version and delay period. The counterparty prefix is
+// chosen independently by each chain.
+func (u Upgrade) IsCompatible(counterpartyUpgrade Upgrade) error {
+	if u.Version.String() != counterpartyUpgrade.Version.String() {
+		return errorsmod.Wrapf(ErrIncompatibleCounterpartyUpgrade, "expected upgrade version (%s), got (%s)", u.Version, counterpartyUpgrade.Version)
+	}
+
+	if u.DelayPeriod != counterpartyUpgrade.DelayPeriod {
+		return errorsmod.Wrapf(ErrIncompatibleCounterpartyUpgrade, "expected upgrade delay period (%d), got (%d)", u.DelayPeriod, counterpartyUpgrade.DelayPeriod)
+	}
+
+	return nil
+}
+
+// NewErrorReceipt creates a new ErrorReceipt instance.
+func NewErrorReceipt(sequence uint64, message string) ErrorReceipt {
+	return ErrorReceipt{
+		Sequence: sequence,
+		Message:  message,
+	}
+}
+
+// UpgradeError defines an error that occurs during a connection upgrade.
+type UpgradeError struct {
+	// err is the underlying error that caused the upgrade to fail.
+	// this error should not be written to state.
+	err error
+	// sequence is the upgrade sequence number of the upgrade that failed.
+	sequence uint64
+}
+
+var _ error = &UpgradeError{}
+
+// NewUpgradeError returns a new UpgradeError instance.
+func NewUpgradeError(upgradeSequence uint64, err error) *UpgradeError {
+	return &UpgradeError{
+		err:      err,
+		sequence: upgradeSequence,
+	}
+}
+
+// Error implements the error interface, returning the underlying error which caused the upgrade to fail.
+func (u *UpgradeError) Error() string {
+	return u.err.Error()
+}
+
+// Is returns true if the of the provided error is an upgrade error.
+func (*UpgradeError) Is(err error) bool {
+	_, ok := err.(*UpgradeError)
+	return ok
+}
+
+// Unwrap returns the next error in the error chain.
+// If there is no next error, Unwrap returns nil.
+func (u *UpgradeError) Unwrap() error {
+	return u.err
+}
+
+// Cause implements the sdk error interface which uses this function to unwrap the error in various functions such as `wrappedError.Is()`.
+// Cause returns the underlying error which caused the upgrade to fail.
+func (u *UpgradeError) Cause() error {
+	baseError := u.err
+	for {
+		if err := errors.Unwrap(baseError); err != nil {
+			baseError = err
+		} else {
+			return baseError
+		}
+	}
+}
+
+// GetErrorReceipt returns an error receipt with the code from the underlying error type stripped.
+func (u *UpgradeError) GetErrorReceipt() ErrorReceipt {
+	// restoreErrorString defines a string constant included in error receipts.
+	// NOTE: Changing this const is state machine breaking as it is written into state.
+	const restoreErrorString = "restored connection to pre-upgrade state"
+
+	_, code, _ := errorsmod.ABCIInfo(u, false) // discard non-determinstic codespace and log values
+	return NewErrorReceipt(u.sequence, fmt.Sprintf("ABCI code: %d: %s", code, restoreErrorString))
+}
+
+// IsUpgradeError returns true if err is of type UpgradeError or contained
+// in the error chain of err and false otherwise.
+func IsUpgradeError(err error) bool {
+	return errors.Is(err, &UpgradeError{})
+}
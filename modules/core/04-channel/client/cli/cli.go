@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPacketCommitments(),
 		GetCmdQueryPacketReceipt(),
 		GetCmdQueryPacketAcknowledgement(),
+		GetCmdQueryPendingAcknowledgements(),
+		GetCmdQueryPacketLifecycle(),
+		GetCmdQuerySenderPacketLifecycles(),
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
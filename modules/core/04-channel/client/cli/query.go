@@ -17,6 +17,7 @@ import (
 
 const (
 	flagSequences = "sequences"
+	flagReceived  = "received"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -204,6 +205,136 @@ func GetCmdQueryPacketCommitments() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPendingAcknowledgements defines the command to query all the packets of a channel
+// whose acknowledgements have not yet been written.
+func GetCmdQueryPendingAcknowledgements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-acks [port-id] [channel-id]",
+		Short:   "Query all pending acknowledgements associated with a channel",
+		Long:    "Query all the received packets associated with a channel whose acknowledgements have not yet been written",
+		Example: fmt.Sprintf("%s query %s %s pending-acks [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingAcknowledgementsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingAcknowledgements(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending acknowledgements associated with a channel")
+
+	return cmd
+}
+
+// GetCmdQueryPacketLifecycle defines the command to query the lifecycle of a packet
+func GetCmdQueryPacketLifecycle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-lifecycle [port-id] [channel-id] [sequence]",
+		Short: "Query the lifecycle of a packet",
+		Long:  "Query the status and the send, receive, acknowledgement and timeout heights of a packet sent on a channel, or received on it with the --received flag, recorded by the packet lifecycle index",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-lifecycle [port-id] [channel-id] [sequence] --%s", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagReceived,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			received, err := cmd.Flags().GetBool(flagReceived)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketLifecycleRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+				Received:  received,
+			}
+
+			res, err := queryClient.PacketLifecycle(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagReceived, false, "query the lifecycle of the packet received on the channel instead of sent on it")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySenderPacketLifecycles defines the command to query the lifecycles of all the packets
+// sent by an address
+func GetCmdQuerySenderPacketLifecycles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sender-packets [sender]",
+		Short:   "Query the lifecycles of all packets sent by an address",
+		Long:    "Query the lifecycles of all packets sent by an address, as recorded by the packet lifecycle index",
+		Example: fmt.Sprintf("%s query %s %s sender-packets [sender]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySenderPacketLifecyclesRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SenderPacketLifecycles(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet lifecycles of a sender")
+
+	return cmd
+}
+
 // GetCmdQueryPacketCommitment defines the command to query a packet commitment
 func GetCmdQueryPacketCommitment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -38,20 +38,36 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, pc := range gs.PausedChannels {
+		k.SetChannelPaused(ctx, pc.PortId, pc.ChannelId)
+	}
+	for _, rt := range gs.ReceiptTimeouts {
+		k.SetPacketReceiptTimeout(ctx, rt.PortId, rt.ChannelId, rt.Sequence, rt.Timeout)
+	}
+	for _, pa := range gs.PendingAcknowledgements {
+		k.SetPendingAcknowledgement(ctx, pa)
+	}
+	for _, pl := range gs.PacketLifecycles {
+		k.SetPacketLifecycle(ctx, pl)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
-		Params:              k.GetParams(ctx),
+		Channels:                k.GetAllChannels(ctx),
+		Acknowledgements:        k.GetAllPacketAcks(ctx),
+		Commitments:             k.GetAllPacketCommitments(ctx),
+		Receipts:                k.GetAllPacketReceipts(ctx),
+		SendSequences:           k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:           k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:            k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:     k.GetNextChannelSequence(ctx),
+		Params:                  k.GetParams(ctx),
+		PausedChannels:          k.GetAllPausedChannels(ctx),
+		ReceiptTimeouts:         k.GetAllPacketReceiptTimeouts(ctx),
+		PendingAcknowledgements: k.GetAllPendingAcknowledgements(ctx),
+		PacketLifecycles:        k.GetAllPacketLifecycles(ctx),
 	}
 }
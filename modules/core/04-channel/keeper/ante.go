@@ -16,6 +16,11 @@ func (k *Keeper) RecvPacketReCheckTx(ctx sdk.Context, packet types.Packet) error
 		return errorsmod.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
+	// packets sent on paused channels are rejected until the channel is resumed
+	if k.IsChannelPaused(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
 	if err := k.applyReplayProtection(ctx, packet, channel); err != nil {
 		return err
 	}
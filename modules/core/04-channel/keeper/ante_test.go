@@ -28,6 +28,13 @@ func (suite *KeeperTestSuite) TestRecvPacketReCheckTx() {
 			},
 			types.ErrChannelNotFound,
 		},
+		{
+			"channel paused",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannelPaused(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+			},
+			types.ErrChannelPaused,
+		},
 		{
 			"redundant relay",
 			func() {
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// RecvPackets is called by a module in order to receive & process IBC packets sent
+// on the same channel end on the counterparty chain, whose commitments are verified
+// with a single proof at the given proof height. The packets are received in order
+// and the returned results indicate for each packet whether it has been received
+// (SUCCESS) or had already been received before (NOOP).
+func (k Keeper) RecvPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]types.ResponseResultType, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	portID, channelID := packets[0].GetDestPort(), packets[0].GetDestChannel()
+	channel, connectionEnd, err := k.getChannelAndConnection(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments := make(map[uint64][]byte, len(packets))
+	for _, packet := range packets {
+		if packet.GetDestPort() != portID || packet.GetDestChannel() != channelID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packets must be sent to the same channel (%s, %s)", portID, channelID)
+		}
+
+		if _, found := commitments[packet.GetSequence()]; found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+
+		commitments[packet.GetSequence()] = types.CommitPacket(k.cdc, packet)
+	}
+
+	// verify that the counterparty did commit to sending all the packets
+	if err := k.connectionKeeper.VerifyPacketCommitments(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, commitments,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "couldn't verify counterparty packet commitments")
+	}
+
+	return processBatch(ctx, packets, func(ctx sdk.Context, packet types.Packet) error {
+		return k.recvPacket(ctx, chanCap, packet, batchVerified)
+	})
+}
+
+// AcknowledgePackets is called by a module to process the acknowledgements of packets
+// previously sent by the calling module on the same channel, whose acknowledgements are
+// verified with a single proof at the given proof height. The acknowledgements must be
+// given in the order of the packets. The returned results indicate for each packet whether
+// it has been acknowledged (SUCCESS) or had already been acknowledged before (NOOP).
+func (k Keeper) AcknowledgePackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	acknowledgements [][]byte,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]types.ResponseResultType, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	if len(packets) != len(acknowledgements) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements must match the number of packets (%d ≠ %d)", len(acknowledgements), len(packets))
+	}
+
+	portID, channelID := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, connectionEnd, err := k.getChannelAndConnection(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	acks := make(map[uint64][]byte, len(packets))
+	for i, packet := range packets {
+		if packet.GetSourcePort() != portID || packet.GetSourceChannel() != channelID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packets must be sent from the same channel (%s, %s)", portID, channelID)
+		}
+
+		if _, found := acks[packet.GetSequence()]; found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+
+		acks[packet.GetSequence()] = acknowledgements[i]
+	}
+
+	if err := k.connectionKeeper.VerifyPacketAcknowledgements(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, acks,
+	); err != nil {
+		return nil, err
+	}
+
+	return processBatch(ctx, packets, func(ctx sdk.Context, packet types.Packet) error {
+		return k.acknowledgePacket(ctx, chanCap, packet, acks[packet.GetSequence()], batchVerified)
+	})
+}
+
+// TimeoutPackets is called by a module to process the timeouts of packets previously
+// sent by the calling module on the same UNORDERED or ORDERED_ALLOW_TIMEOUT channel.
+// The absence of the packet receipts is verified with a single proof at the given proof height.
+// As for TimeoutPacket, the packets of ORDERED_ALLOW_TIMEOUT channels which have been received
+// by the counterparty are proven with their timeout receipts, and the packets which have not been
+// received yet with the next sequence receive of the counterparty channel, so all the packets of
+// a batch must either have been received or not. As timeouts are processed in order on
+// ORDERED_ALLOW_TIMEOUT channels, TimeoutPackets also executes the timeout of each packet, so
+// TimeoutExecuted must not be called for the returned packets. The returned results indicate for
+// each packet whether it has been timed out (SUCCESS) or had already been timed out before (NOOP).
+func (k Keeper) TimeoutPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	proof []byte,
+	proofHeight exported.Height,
+	nextSequenceRecv uint64,
+) ([]types.ResponseResultType, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	portID, channelID := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, connectionEnd, err := k.getChannelAndConnection(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var received int
+	sequences := make([]uint64, len(packets))
+	for i, packet := range packets {
+		if packet.GetSourcePort() != portID || packet.GetSourceChannel() != channelID {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packets must be sent from the same channel (%s, %s)", portID, channelID)
+		}
+
+		sequences[i] = packet.GetSequence()
+		if nextSequenceRecv > packet.GetSequence() {
+			received++
+		}
+	}
+
+	switch channel.Ordering {
+	case types.UNORDERED:
+		err = k.connectionKeeper.VerifyPacketReceiptsAbsence(
+			ctx, connectionEnd, proofHeight, proof,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		switch received {
+		case len(packets):
+			// the packets were received by the counterparty after their timeout elapsed
+			err = k.connectionKeeper.VerifyPacketReceipts(
+				ctx, connectionEnd, proofHeight, proof,
+				channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+				types.TimeoutReceipt,
+			)
+		case 0:
+			// the packets have not been received yet and will be rejected by the counterparty as their timeout elapsed
+			err = k.connectionKeeper.VerifyNextSequenceRecv(
+				ctx, connectionEnd, proofHeight, proof,
+				channel.Counterparty.PortId, channel.Counterparty.ChannelId, nextSequenceRecv,
+			)
+		default:
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"packets must either all be received or all not be received by the counterparty, next sequence receive %d", nextSequenceRecv,
+			)
+		}
+	default:
+		// the first timeout closes an ORDERED channel
+		return nil, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "packets cannot be timed out in batches on %s channels", channel.Ordering)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return processBatch(ctx, packets, func(ctx sdk.Context, packet types.Packet) error {
+		if err := k.timeoutPacket(ctx, packet, proofHeight, func(exported.ConnectionI, types.Channel) error { return nil }); err != nil {
+			return err
+		}
+
+		return k.TimeoutExecuted(ctx, chanCap, packet)
+	})
+}
+
+// batchVerified is used as the proof verification function of packets whose proofs
+// have already been verified with a single proof for the whole batch.
+func batchVerified(exported.ConnectionI) error {
+	return nil
+}
+
+// processBatch processes the packets in order using the given function. The state changes
+// of packets for which the function returns ErrNoOpMsg are discarded, any other error aborts
+// the processing of the whole batch.
+func processBatch(ctx sdk.Context, packets []types.Packet, process func(sdk.Context, types.Packet) error) ([]types.ResponseResultType, error) {
+	results := make([]types.ResponseResultType, len(packets))
+	for i, packet := range packets {
+		cacheCtx, writeFn := ctx.CacheContext()
+		switch err := process(cacheCtx, packet); err {
+		case nil:
+			writeFn()
+			results[i] = types.SUCCESS
+		case types.ErrNoOpMsg:
+			results[i] = types.NOOP
+		default:
+			return nil, errorsmod.Wrapf(err, "packet with sequence %d", packet.GetSequence())
+		}
+	}
+
+	return results, nil
+}
+
+// getChannelAndConnection returns the channel with the given identifiers and its connection end.
+func (k Keeper) getChannelAndConnection(ctx sdk.Context, portID, channelID string) (types.Channel, connectiontypes.ConnectionEnd, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	return channel, connectionEnd, nil
+}
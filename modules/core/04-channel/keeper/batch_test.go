@@ -0,0 +1,321 @@
+package keeper_test
+
+import (
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+// sendPackets sends the given number of mock packets from endpoint A of the path.
+func (suite *KeeperTestSuite) sendPackets(path *ibctesting.Path, n int, timeoutHeight clienttypes.Height) []types.Packet {
+	packets := make([]types.Packet, n)
+	for i := range packets {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets[i] = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	}
+
+	return packets
+}
+
+// TestRecvPackets tests receiving a batch of packets whose commitments are verified with a single proof.
+func (suite *KeeperTestSuite) TestRecvPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		proofKeys  [][]byte
+		channelCap *capabilitytypes.Capability
+		expResults []types.ResponseResultType
+	)
+
+	testCases := []struct {
+		msg      string
+		order    types.Order
+		malleate func()
+		expErr   error
+	}{
+		{"success: UNORDERED channel", types.UNORDERED, func() {}, nil},
+		{"success: ORDERED channel", types.ORDERED, func() {}, nil},
+		{"success: ORDERED_ALLOW_TIMEOUT channel", types.ORDERED_ALLOW_TIMEOUT, func() {}, nil},
+		{"success: packet already received is a no-op", types.UNORDERED, func() {
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			expResults = []types.ResponseResultType{types.SUCCESS, types.NOOP, types.SUCCESS}
+		}, nil},
+		{"failure: empty batch", types.UNORDERED, func() {
+			packets = nil
+		}, types.ErrInvalidPacket},
+		{"failure: packets sent to different channels", types.UNORDERED, func() {
+			packets[1].DestinationChannel = ibctesting.InvalidID
+		}, types.ErrInvalidPacket},
+		{"failure: duplicate packet sequence", types.UNORDERED, func() {
+			packets[2] = packets[0]
+		}, types.ErrInvalidPacket},
+		{"failure: channel not found", types.UNORDERED, func() {
+			for i := range packets {
+				packets[i].DestinationChannel = ibctesting.InvalidID
+			}
+		}, types.ErrChannelNotFound},
+		{"failure: proof does not cover all packets", types.UNORDERED, func() {
+			proofKeys = proofKeys[:2]
+		}, commitmenttypes.ErrInvalidProof},
+		{"failure: packet data does not match commitment", types.UNORDERED, func() {
+			packets[2].Data = []byte("invalid data")
+		}, commitmenttypes.ErrInvalidProof},
+		{"failure: ORDERED channel packets out of order", types.ORDERED, func() {
+			packets[0], packets[1] = packets[1], packets[0]
+		}, types.ErrPacketSequenceOutOfOrder},
+		{"failure: invalid channel capability", types.UNORDERED, func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, types.ErrInvalidChannelCapability},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			suite.coordinator.Setup(path)
+
+			packets = suite.sendPackets(path, 3, defaultTimeoutHeight)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proofKeys = nil
+			for _, packet := range packets {
+				proofKeys = append(proofKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS, types.SUCCESS}
+
+			tc.malleate()
+
+			proof, proofHeight := path.EndpointA.QueryBatchProof(proofKeys)
+
+			results, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPackets(suite.chainB.GetContext(), channelCap, packets, proof, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+
+				if tc.order == types.UNORDERED {
+					for _, packet := range packets {
+						_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+						suite.Require().True(found)
+					}
+				} else {
+					nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+					suite.Require().True(found)
+					suite.Require().Equal(packets[len(packets)-1].GetSequence()+1, nextSeqRecv)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(results)
+			}
+		})
+	}
+}
+
+// TestAcknowledgePackets tests acknowledging a batch of packets whose acknowledgements are verified with a single proof.
+func (suite *KeeperTestSuite) TestAcknowledgePackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		acks       [][]byte
+		proofKeys  [][]byte
+		channelCap *capabilitytypes.Capability
+		expResults []types.ResponseResultType
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"success: packet already acknowledged is a no-op", func() {
+			suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[0], acks[0]))
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			expResults = []types.ResponseResultType{types.NOOP, types.SUCCESS, types.SUCCESS}
+		}, nil},
+		{"failure: number of acknowledgements does not match", func() {
+			acks = acks[:2]
+		}, types.ErrInvalidAcknowledgement},
+		{"failure: packets sent from different channels", func() {
+			packets[1].SourceChannel = ibctesting.InvalidID
+		}, types.ErrInvalidPacket},
+		{"failure: duplicate packet sequence", func() {
+			packets[2] = packets[0]
+		}, types.ErrInvalidPacket},
+		{"failure: acknowledgement does not match", func() {
+			acks[1] = ibcmock.MockFailAcknowledgement.Acknowledgement()
+		}, commitmenttypes.ErrInvalidProof},
+		{"failure: proof does not cover all packets", func() {
+			proofKeys = proofKeys[1:]
+		}, commitmenttypes.ErrInvalidProof},
+		{"failure: invalid channel capability", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, types.ErrInvalidChannelCapability},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets = suite.sendPackets(path, 3, defaultTimeoutHeight)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			suite.Require().NoError(path.EndpointB.RecvPackets(packets))
+
+			acks, proofKeys = nil, nil
+			for _, packet := range packets {
+				acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+				proofKeys = append(proofKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS, types.SUCCESS}
+
+			tc.malleate()
+
+			proof, proofHeight := path.EndpointB.QueryBatchProof(proofKeys)
+
+			results, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePackets(suite.chainA.GetContext(), channelCap, packets, acks, proof, proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+
+				for _, packet := range packets {
+					suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(results)
+			}
+		})
+	}
+}
+
+// TestTimeoutPackets tests timing out a batch of packets whose non-receipt is verified with a single proof.
+func (suite *KeeperTestSuite) TestTimeoutPackets() {
+	var (
+		path             *ibctesting.Path
+		packets          []types.Packet
+		proofKeys        [][]byte
+		proof            []byte
+		proofHeight      clienttypes.Height
+		nextSequenceRecv uint64
+		channelCap       *capabilitytypes.Capability
+		expResults       []types.ResponseResultType
+	)
+
+	// proveNextSequenceRecv proves the packets with the next sequence receive of the counterparty channel
+	proveNextSequenceRecv := func() {
+		proof, proofHeight = path.EndpointB.QueryProof(host.NextSequenceRecvKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+		nextSequenceRecv = 1
+	}
+
+	testCases := []struct {
+		msg      string
+		order    types.Order
+		malleate func()
+		expErr   error
+	}{
+		{"success: UNORDERED channel", types.UNORDERED, func() {}, nil},
+		{"success: ORDERED_ALLOW_TIMEOUT channel", types.ORDERED_ALLOW_TIMEOUT, func() {
+			// the packets are received as timed out on chainB, writing a timeout receipt for each
+			suite.Require().NoError(path.EndpointB.RecvPackets(packets))
+			nextSequenceRecv = 4
+		}, nil},
+		{"success: ORDERED_ALLOW_TIMEOUT packets not yet received", types.ORDERED_ALLOW_TIMEOUT, proveNextSequenceRecv, nil},
+		{"success: packet already timed out is a no-op", types.UNORDERED, func() {
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packets[2]))
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS, types.NOOP}
+		}, nil},
+		{"failure: ORDERED channel", types.ORDERED, func() {}, types.ErrInvalidChannelOrdering},
+		{"failure: ORDERED_ALLOW_TIMEOUT packets not yet received proven with timeout receipts", types.ORDERED_ALLOW_TIMEOUT, func() {
+			nextSequenceRecv = 4
+		}, commitmenttypes.ErrInvalidProof},
+		{"failure: ORDERED_ALLOW_TIMEOUT next sequence receive does not match the proof", types.ORDERED_ALLOW_TIMEOUT, func() {
+			proveNextSequenceRecv()
+			nextSequenceRecv = 0
+		}, commitmenttypes.ErrInvalidProof},
+		{"failure: ORDERED_ALLOW_TIMEOUT packets partially received", types.ORDERED_ALLOW_TIMEOUT, func() {
+			suite.Require().NoError(path.EndpointB.RecvPackets(packets[:1]))
+			nextSequenceRecv = 2
+		}, types.ErrInvalidPacket},
+		{"failure: packets sent from different channels", types.UNORDERED, func() {
+			packets[1].SourceChannel = ibctesting.InvalidID
+		}, types.ErrInvalidPacket},
+		{"failure: packet not timed out", types.UNORDERED, func() {
+			packets[1].TimeoutHeight = defaultTimeoutHeight
+		}, types.ErrTimeoutNotReached},
+		{"failure: invalid channel capability", types.UNORDERED, func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, types.ErrChannelCapabilityNotFound},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			packets = suite.sendPackets(path, 3, timeoutHeight)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proofKeys = nil
+			for _, packet := range packets {
+				proofKeys = append(proofKeys, host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			proof = nil
+			nextSequenceRecv = 1
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS, types.SUCCESS}
+
+			tc.malleate()
+
+			if proof == nil {
+				proof, proofHeight = path.EndpointB.QueryBatchProof(proofKeys)
+			}
+
+			results, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPackets(suite.chainA.GetContext(), channelCap, packets, proof, proofHeight, nextSequenceRecv)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+
+				for _, packet := range packets {
+					suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				}
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(results)
+			}
+		})
+	}
+}
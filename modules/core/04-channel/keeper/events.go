@@ -4,14 +4,17 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // emitChannelOpenInitEvent emits a channel open init event
-func emitChannelOpenInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func (k Keeper) emitChannelOpenInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelOpenInit,
@@ -26,10 +29,18 @@ func emitChannelOpenInitEvent(ctx sdk.Context, portID string, channelID string,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelOpenInit{
+		PortId:             portID,
+		ChannelId:          channelID,
+		CounterpartyPortId: channel.Counterparty.PortId,
+		ConnectionId:       channel.ConnectionHops[0],
+		Version:            channel.Version,
+	})
 }
 
 // emitChannelOpenTryEvent emits a channel open try event
-func emitChannelOpenTryEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func (k Keeper) emitChannelOpenTryEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelOpenTry,
@@ -45,10 +56,19 @@ func emitChannelOpenTryEvent(ctx sdk.Context, portID string, channelID string, c
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelOpenTry{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		Version:               channel.Version,
+	})
 }
 
 // emitChannelOpenAckEvent emits a channel open acknowledge event
-func emitChannelOpenAckEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func (k Keeper) emitChannelOpenAckEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelOpenAck,
@@ -63,10 +83,18 @@ func emitChannelOpenAckEvent(ctx sdk.Context, portID string, channelID string, c
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelOpenAck{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+	})
 }
 
 // emitChannelOpenConfirmEvent emits a channel open confirm event
-func emitChannelOpenConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func (k Keeper) emitChannelOpenConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelOpenConfirm,
@@ -81,10 +109,18 @@ func emitChannelOpenConfirmEvent(ctx sdk.Context, portID string, channelID strin
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelOpenConfirm{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+	})
 }
 
 // emitChannelCloseInitEvent emits a channel close init event
-func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func (k Keeper) emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelCloseInit,
@@ -99,10 +135,18 @@ func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelCloseInit{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+	})
 }
 
 // emitChannelCloseConfirmEvent emits a channel close confirm event
-func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func (k Keeper) emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelCloseConfirm,
@@ -117,11 +161,19 @@ func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelCloseConfirm{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+	})
 }
 
 // emitSendPacketEvent emits an event with packet data along with other packet information for relayer
 // to pick up and relay to other chain
-func emitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, timeoutHeight exported.Height) {
+func (k Keeper) emitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, timeoutHeight exported.Height) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSendPacket,
@@ -145,11 +197,17 @@ func emitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventSendPacket{
+		Packet:          newPacketFromPacketI(packet),
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitRecvPacketEvent emits a receive packet event. It will be emitted both the first time a packet
 // is received for a certain sequence and for all duplicate receives.
-func emitRecvPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+func (k Keeper) emitRecvPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRecvPacket,
@@ -173,10 +231,16 @@ func emitRecvPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventRecvPacket{
+		Packet:          newPacketFromPacketI(packet),
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitWriteAcknowledgementEvent emits an event that the relayer can query for
-func emitWriteAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, acknowledgement []byte) {
+func (k Keeper) emitWriteAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, acknowledgement []byte) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeWriteAck,
@@ -202,11 +266,18 @@ func emitWriteAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, cha
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventWriteAcknowledgement{
+		Packet:          newPacketFromPacketI(packet),
+		Acknowledgement: acknowledgement,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitAcknowledgePacketEvent emits an acknowledge packet event. It will be emitted both the first time
 // a packet is acknowledged for a certain sequence and for all duplicate acknowledgements.
-func emitAcknowledgePacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+func (k Keeper) emitAcknowledgePacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeAcknowledgePacket,
@@ -228,11 +299,17 @@ func emitAcknowledgePacketEvent(ctx sdk.Context, packet exported.PacketI, channe
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventAcknowledgePacket{
+		Packet:          newPacketFromPacketI(packet),
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitTimeoutPacketEvent emits a timeout packet event. It will be emitted both the first time a packet
 // is timed out for a certain sequence and for all duplicate timeouts.
-func emitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+func (k Keeper) emitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTimeoutPacket,
@@ -251,10 +328,45 @@ func emitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventTimeoutPacket{
+		Packet:          newPacketFromPacketI(packet),
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
+}
+
+// emitRecvPacketTimeoutEvent emits an event that a timed out packet has been received on an
+// ORDERED_ALLOW_TIMEOUT channel and a timeout receipt has been written.
+func (k Keeper) emitRecvPacketTimeoutEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecvPacketTimeout,
+			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, packet.GetTimeoutHeight().String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventRecvPacketTimeout{
+		Packet:          newPacketFromPacketI(packet),
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	})
 }
 
 // emitChannelClosedEvent emits a channel closed event.
-func emitChannelClosedEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+func (k Keeper) emitChannelClosedEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelClosed,
@@ -270,10 +382,19 @@ func emitChannelClosedEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelClosed{
+		PortId:                packet.GetSourcePort(),
+		ChannelId:             packet.GetSourceChannel(),
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          channel.ConnectionHops[0],
+		ChannelOrdering:       channel.Ordering,
+	})
 }
 
 // EmitChannelUpgradeInitEvent emits a channel upgrade init event
-func EmitChannelUpgradeInitEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
+func (k Keeper) EmitChannelUpgradeInitEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeInit,
@@ -291,10 +412,19 @@ func EmitChannelUpgradeInitEvent(ctx sdk.Context, portID string, channelID strin
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeInit{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeFields:         upgrade.Fields,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // EmitChannelUpgradeTryEvent emits a channel upgrade try event
-func EmitChannelUpgradeTryEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
+func (k Keeper) EmitChannelUpgradeTryEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeTry,
@@ -312,10 +442,19 @@ func EmitChannelUpgradeTryEvent(ctx sdk.Context, portID string, channelID string
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeTry{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeFields:         upgrade.Fields,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // EmitChannelUpgradeAckEvent emits a channel upgrade ack event
-func EmitChannelUpgradeAckEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
+func (k Keeper) EmitChannelUpgradeAckEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeAck,
@@ -333,10 +472,19 @@ func EmitChannelUpgradeAckEvent(ctx sdk.Context, portID string, channelID string
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeAck{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeFields:         upgrade.Fields,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // EmitChannelUpgradeConfirmEvent emits a channel upgrade confirm event
-func EmitChannelUpgradeConfirmEvent(ctx sdk.Context, portID, channelID string, currentChannel types.Channel) {
+func (k Keeper) EmitChannelUpgradeConfirmEvent(ctx sdk.Context, portID, channelID string, currentChannel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeConfirm,
@@ -352,10 +500,19 @@ func EmitChannelUpgradeConfirmEvent(ctx sdk.Context, portID, channelID string, c
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeConfirm{
+		PortId:                portID,
+		ChannelId:             channelID,
+		ChannelState:          currentChannel.State,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // EmitChannelUpgradeOpenEvent emits a channel upgrade open event
-func EmitChannelUpgradeOpenEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel) {
+func (k Keeper) EmitChannelUpgradeOpenEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeOpen,
@@ -374,10 +531,22 @@ func EmitChannelUpgradeOpenEvent(ctx sdk.Context, portID string, channelID strin
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeOpen{
+		PortId:                portID,
+		ChannelId:             channelID,
+		ChannelState:          currentChannel.State,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		ConnectionHops:        currentChannel.ConnectionHops,
+		Version:               currentChannel.Version,
+		Ordering:              currentChannel.Ordering,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // EmitChannelUpgradeTimeoutEvent emits an upgrade timeout event.
-func EmitChannelUpgradeTimeoutEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
+func (k Keeper) EmitChannelUpgradeTimeoutEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeTimeout,
@@ -397,10 +566,20 @@ func EmitChannelUpgradeTimeoutEvent(ctx sdk.Context, portID string, channelID st
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeTimeout{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeFields:         upgrade.Fields,
+		UpgradeTimeout:        upgrade.Timeout,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // EmitErrorReceiptEvent emits an error receipt event
-func EmitErrorReceiptEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, err error) {
+func (k Keeper) EmitErrorReceiptEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, err error) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeError,
@@ -416,10 +595,19 @@ func EmitErrorReceiptEvent(ctx sdk.Context, portID string, channelID string, cur
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeError{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+		ErrorReceipt:          err.Error(),
+	})
 }
 
 // EmitChannelUpgradeCancelEvent emits an upgraded cancelled event.
-func EmitChannelUpgradeCancelEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
+func (k Keeper) EmitChannelUpgradeCancelEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel, upgrade types.Upgrade) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelUpgradeCancel,
@@ -437,10 +625,19 @@ func EmitChannelUpgradeCancelEvent(ctx sdk.Context, portID string, channelID str
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelUpgradeCancel{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		UpgradeFields:         upgrade.Fields,
+		UpgradeSequence:       currentChannel.UpgradeSequence,
+	})
 }
 
 // emitChannelFlushCompleteEvents emits an flushing event.
-func emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel) {
+func (k Keeper) emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID string, currentChannel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelFlushComplete,
@@ -455,4 +652,83 @@ func emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID str
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelFlushComplete{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    currentChannel.Counterparty.PortId,
+		CounterpartyChannelId: currentChannel.Counterparty.ChannelId,
+		ChannelState:          currentChannel.State,
+	})
+}
+
+// emitChannelPausedEvent emits a channel paused event.
+func (k Keeper) emitChannelPausedEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelPaused,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyChannelState, channel.State.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelPaused{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ChannelState:          channel.State,
+	})
+}
+
+// emitChannelResumedEvent emits a channel resumed event.
+func (k Keeper) emitChannelResumedEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelResumed,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyChannelState, channel.State.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	k.emitTypedEvent(ctx, &types.EventChannelResumed{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ChannelState:          channel.State,
+	})
+}
+
+// emitTypedEvent emits the given typed event if typed events are enabled in the channel params.
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if !k.GetParams(ctx).EmitTypedEvents {
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit typed event", "type", proto.MessageName(event), "error", err)
+	}
+}
+
+// newPacketFromPacketI returns the packet with the fields of the given packet.
+func newPacketFromPacketI(packet exported.PacketI) types.Packet {
+	return types.NewPacket(
+		packet.GetData(), packet.GetSequence(), packet.GetSourcePort(), packet.GetSourceChannel(),
+		packet.GetDestPort(), packet.GetDestChannel(), clienttypes.NewHeight(packet.GetTimeoutHeight().GetRevisionNumber(), packet.GetTimeoutHeight().GetRevisionHeight()), packet.GetTimeoutTimestamp(),
+	)
 }
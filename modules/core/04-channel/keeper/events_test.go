@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestTypedEvents() {
+	testCases := []struct {
+		name               string
+		emitTypedEvents    bool
+		expTypedEventCount int
+	}{
+		{"typed events enabled", true, 2},
+		{"typed events disabled", false, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+				params := chain.App.GetIBCKeeper().ChannelKeeper.GetParams(chain.GetContext())
+				params.EmitTypedEvents = tc.emitTypedEvents
+				chain.App.GetIBCKeeper().ChannelKeeper.SetParams(chain.GetContext(), params)
+			}
+
+			// send the packet as a module so that the events can be inspected
+			ctx := suite.chainA.GetContext()
+			sequence, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(
+				ctx, suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData,
+			)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+			typedEvents, err := ibctesting.ParseTypedEvents(ctx.EventManager().ABCIEvents())
+			suite.Require().NoError(err)
+
+			if tc.emitTypedEvents {
+				ibctesting.AssertTypedEvents(&suite.Suite, []proto.Message{
+					&types.EventSendPacket{Packet: packet, ChannelOrdering: types.UNORDERED, ConnectionId: path.EndpointA.ConnectionID},
+				}, ctx.EventManager().ABCIEvents())
+			} else {
+				suite.Require().Empty(typedEvents)
+			}
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			typedEvents, err = ibctesting.ParseTypedEvents(res.Events)
+			suite.Require().NoError(err)
+			suite.Require().Len(typedEvents, tc.expTypedEventCount)
+
+			if tc.emitTypedEvents {
+				ibctesting.AssertTypedEvents(&suite.Suite, []proto.Message{
+					&types.EventRecvPacket{Packet: packet, ChannelOrdering: types.UNORDERED, ConnectionId: path.EndpointB.ConnectionID},
+					&types.EventWriteAcknowledgement{Packet: packet, Acknowledgement: ibcmock.MockAcknowledgement.Acknowledgement(), ChannelOrdering: types.UNORDERED, ConnectionId: path.EndpointB.ConnectionID},
+				}, res.Events)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// GetExpiredPendingAcknowledgements returns, oldest first, at most limit pending acknowledgements of the packets
+// received on the port of the given acknowledgement expiry which have been pending for at least its maximum pending
+// duration. Only the expired entries of the expiry queue are read.
+func (k Keeper) GetExpiredPendingAcknowledgements(ctx sdk.Context, expiry types.AcknowledgementExpiry, limit int) []types.PendingAcknowledgement {
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if limit <= 0 || blockTime < expiry.MaxPendingDuration {
+		return nil
+	}
+
+	// the packets received at or before the cutoff time have expired
+	cutoff := blockTime - expiry.MaxPendingDuration
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PendingAcknowledgementQueuePrefix(expiry.PortId), types.PendingAcknowledgementQueueTimePrefix(expiry.PortId, cutoff+1))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingAcks []types.PendingAcknowledgement
+	for ; iterator.Valid() && len(pendingAcks) < limit; iterator.Next() {
+		bz := store.Get(iterator.Value())
+		if len(bz) == 0 {
+			continue
+		}
+
+		var pendingAck types.PendingAcknowledgement
+		k.cdc.MustUnmarshal(bz, &pendingAck)
+		pendingAcks = append(pendingAcks, pendingAck)
+	}
+
+	return pendingAcks
+}
+
+// WriteExpiredAcknowledgement writes the acknowledgement returned by the application for a packet whose
+// acknowledgement expired and removes the packet from the pending acknowledgements. The acknowledgement
+// must be an error acknowledgement, as the application has reverted the receipt of the packet.
+func (k Keeper) WriteExpiredAcknowledgement(ctx sdk.Context, packet types.Packet, acknowledgement exported.Acknowledgement) error {
+	if acknowledgement == nil || acknowledgement.Success() {
+		return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "expired acknowledgement must be an error acknowledgement")
+	}
+
+	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	return k.writeAcknowledgement(ctx, channel, packet, acknowledgement)
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+// TestGetExpiredPendingAcknowledgements tests that the expired pending acknowledgements of a port are
+// returned ordered by the time at which their packets were received, up to the given limit.
+func (suite *KeeperTestSuite) TestGetExpiredPendingAcknowledgements() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	maxPendingDuration := uint64(time.Hour.Nanoseconds())
+
+	params := channelKeeper.GetParams(suite.chainB.GetContext())
+	params.AcknowledgementExpiries = []types.AcknowledgementExpiry{types.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, maxPendingDuration)}
+	channelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	// packets with higher sequences are received earlier, the last packet has not expired yet
+	cutoff := uint64(suite.chainB.GetContext().BlockTime().UnixNano()) - maxPendingDuration
+	var pendingAcks []types.PendingAcknowledgement
+	for i := uint64(0); i < 4; i++ {
+		packet := types.NewPacket(ibcmock.MockAsyncPacketData, 4-i, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+		pendingAck := types.NewPendingAcknowledgement(packet, cutoff-2+i)
+		channelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), pendingAck)
+		pendingAcks = append(pendingAcks, pendingAck)
+	}
+
+	expired := channelKeeper.GetExpiredPendingAcknowledgements(suite.chainB.GetContext(), params.AcknowledgementExpiries[0], 10)
+	suite.Require().Equal(pendingAcks[:3], expired)
+
+	expired = channelKeeper.GetExpiredPendingAcknowledgements(suite.chainB.GetContext(), params.AcknowledgementExpiries[0], 2)
+	suite.Require().Equal(pendingAcks[:2], expired)
+
+	// acknowledged packets are removed from the expiry queue
+	channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	err := channelKeeper.WriteAcknowledgement(suite.chainB.GetContext(), channelCap, pendingAcks[0].Packet, ibcmock.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	expired = channelKeeper.GetExpiredPendingAcknowledgements(suite.chainB.GetContext(), params.AcknowledgementExpiries[0], 10)
+	suite.Require().Equal(pendingAcks[1:3], expired)
+}
+
+func (suite *KeeperTestSuite) TestWriteExpiredAcknowledgement() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+		ack    exported.Acknowledgement
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: acknowledgement is nil",
+			func() {
+				ack = nil
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: acknowledgement is successful",
+			func() {
+				ack = ibcmock.MockAcknowledgement
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				packet.DestinationChannel = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: acknowledgement already written",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), types.CommitAcknowledgement(ack.Acknowledgement()))
+			},
+			types.ErrAcknowledgementExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibcmock.MockAsyncPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet, 1))
+
+			ack = types.NewErrorAcknowledgement(types.ErrAcknowledgementExpired)
+
+			tc.malleate()
+
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.WriteExpiredAcknowledgement(suite.chainB.GetContext(), packet, ack)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().False(found)
+
+				ackCommitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().Equal(types.CommitAcknowledgement(ack.Acknowledgement()), ackCommitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
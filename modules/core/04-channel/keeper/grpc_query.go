@@ -42,7 +42,10 @@ func (k Keeper) Channel(c context.Context, req *types.QueryChannelRequest) (*typ
 	}
 
 	selfHeight := clienttypes.GetSelfHeight(ctx)
-	return types.NewQueryChannelResponse(channel, nil, selfHeight), nil
+	res := types.NewQueryChannelResponse(channel, nil, selfHeight)
+	res.Paused = k.IsChannelPaused(ctx, req.PortId, req.ChannelId)
+
+	return res, nil
 }
 
 // Channels implements the Query/Channels gRPC method
@@ -373,6 +376,151 @@ func (k Keeper) PacketAcknowledgements(c context.Context, req *types.QueryPacket
 	}, nil
 }
 
+// PendingAcknowledgement implements the Query/PendingAcknowledgement gRPC method
+func (k Keeper) PendingAcknowledgement(c context.Context, req *types.QueryPendingAcknowledgementRequest) (*types.QueryPendingAcknowledgementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingAck, found := k.GetPendingAcknowledgement(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPendingAcknowledgementNotFound, "port-id: %s, channel-id: %s, sequence: %d", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPendingAcknowledgementResponse{
+		PendingAcknowledgement: pendingAck,
+		Height:                 selfHeight,
+	}, nil
+}
+
+// PendingAcknowledgements implements the Query/PendingAcknowledgements gRPC method
+func (k Keeper) PendingAcknowledgements(c context.Context, req *types.QueryPendingAcknowledgementsRequest) (*types.QueryPendingAcknowledgementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pendingAcks []types.PendingAcknowledgement
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.PendingAcknowledgementPrefixPath(req.PortId, req.ChannelId)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pendingAck types.PendingAcknowledgement
+		if err := k.cdc.Unmarshal(value, &pendingAck); err != nil {
+			return err
+		}
+
+		pendingAcks = append(pendingAcks, pendingAck)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPendingAcknowledgementsResponse{
+		PendingAcknowledgements: pendingAcks,
+		Pagination:              pageRes,
+		Height:                  selfHeight,
+	}, nil
+}
+
+// PacketLifecycle implements the Query/PacketLifecycle gRPC method
+func (k Keeper) PacketLifecycle(c context.Context, req *types.QueryPacketLifecycleRequest) (*types.QueryPacketLifecycleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lifecycle, found := k.GetPacketLifecycle(ctx, req.PortId, req.ChannelId, req.Sequence, req.Received)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPacketLifecycleNotFound, "port-id: %s, channel-id: %s, sequence: %d", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketLifecycleResponse{
+		PacketLifecycle: lifecycle,
+		Height:          selfHeight,
+	}, nil
+}
+
+// SenderPacketLifecycles implements the Query/SenderPacketLifecycles gRPC method
+func (k Keeper) SenderPacketLifecycles(c context.Context, req *types.QuerySenderPacketLifecyclesRequest) (*types.QuerySenderPacketLifecyclesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Sender) == "" {
+		return nil, status.Error(codes.InvalidArgument, "sender cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var lifecycles []types.PacketLifecycle
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.PacketSenderPrefixPath(req.Sender)+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		// the key has the format ports/{port-id}/channels/{channel-id}/sequences/{sequence}
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 6 {
+			return errorsmod.Wrapf(host.ErrInvalidPath, "cannot parse packet sender key %s", key)
+		}
+
+		sequence, err := strconv.ParseUint(keySplit[5], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		lifecycle, found := k.GetPacketLifecycle(ctx, keySplit[1], keySplit[3], sequence, false)
+		if !found {
+			return errorsmod.Wrapf(types.ErrPacketLifecycleNotFound, "port-id: %s, channel-id: %s, sequence: %d", keySplit[1], keySplit[3], sequence)
+		}
+
+		lifecycles = append(lifecycles, lifecycle)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QuerySenderPacketLifecyclesResponse{
+		PacketLifecycles: lifecycles,
+		Pagination:       pageRes,
+		Height:           selfHeight,
+	}, nil
+}
+
 // UnreceivedPackets implements the Query/UnreceivedPackets gRPC method. Given
 // a list of counterparty packet commitments, the querier checks if the packet
 // has already been received by checking if a receipt exists on this
@@ -422,7 +570,7 @@ func (k Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
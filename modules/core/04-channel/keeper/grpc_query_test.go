@@ -1130,6 +1130,453 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPendingAcknowledgement() {
+	var (
+		req           *types.QueryPendingAcknowledgementRequest
+		expPendingAck types.PendingAcknowledgement
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPendingAcknowledgementRequest{
+					PortId:    "",
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPendingAcknowledgementRequest{
+					PortId:    ibctesting.MockPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"pending acknowledgement not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				req = &types.QueryPendingAcknowledgementRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				expPendingAck = types.NewPendingAcknowledgement(packet, uint64(suite.chainA.GetContext().BlockTime().UnixNano()))
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainA.GetContext(), expPendingAck)
+
+				req = &types.QueryPendingAcknowledgementRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.PendingAcknowledgement(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPendingAck, res.PendingAcknowledgement)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingAcknowledgements() {
+	var (
+		req            *types.QueryPendingAcknowledgementsRequest
+		expPendingAcks []types.PendingAcknowledgement
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryPendingAcknowledgementsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success, empty res",
+			func() {
+				expPendingAcks = nil
+
+				req = &types.QueryPendingAcknowledgementsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expPendingAcks = make([]types.PendingAcknowledgement, 9)
+
+				for i := uint64(1); i < 10; i++ {
+					packet := types.NewPacket(ibctesting.MockPacketData, i, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+					pendingAck := types.NewPendingAcknowledgement(packet, uint64(suite.chainA.GetContext().BlockTime().UnixNano()))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainA.GetContext(), pendingAck)
+					expPendingAcks[i-1] = pendingAck
+				}
+
+				req = &types.QueryPendingAcknowledgementsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      11,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.PendingAcknowledgements(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPendingAcks, res.PendingAcknowledgements)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketLifecycle() {
+	var (
+		req          *types.QueryPacketLifecycleRequest
+		expLifecycle types.PacketLifecycle
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPacketLifecycleRequest{
+					PortId:    "",
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPacketLifecycleRequest{
+					PortId:    ibctesting.MockPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"packet lifecycle not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				req = &types.QueryPacketLifecycleRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				enablePacketLifecycleIndex(suite.chainA)
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				var found bool
+				expLifecycle, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, false)
+				suite.Require().True(found)
+
+				req = &types.QueryPacketLifecycleRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+				}
+			},
+			true,
+		},
+		{
+			"received packet lifecycle not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				enablePacketLifecycleIndex(suite.chainA)
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				req = &types.QueryPacketLifecycleRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+					Received:  true,
+				}
+			},
+			false,
+		},
+		{
+			"success: received packet",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				enablePacketLifecycleIndex(suite.chainA)
+
+				sequence, err := path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				var found bool
+				expLifecycle, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, true)
+				suite.Require().True(found)
+
+				req = &types.QueryPacketLifecycleRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  sequence,
+					Received:  true,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.PacketLifecycle(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expLifecycle, res.PacketLifecycle)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySenderPacketLifecycles() {
+	var (
+		req           *types.QuerySenderPacketLifecyclesRequest
+		expLifecycles []types.PacketLifecycle
+	)
+
+	sender := "cosmos1sender"
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty sender",
+			func() {
+				req = &types.QuerySenderPacketLifecyclesRequest{
+					Sender: "",
+				}
+			},
+			false,
+		},
+		{
+			"success, empty res",
+			func() {
+				expLifecycles = nil
+
+				req = &types.QuerySenderPacketLifecyclesRequest{
+					Sender: sender,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expLifecycles = make([]types.PacketLifecycle, 9)
+
+				for i := uint64(1); i < 10; i++ {
+					lifecycle := types.PacketLifecycle{
+						PortId:     path.EndpointA.ChannelConfig.PortID,
+						ChannelId:  path.EndpointA.ChannelID,
+						Sequence:   i,
+						Sender:     sender,
+						Status:     types.SENT,
+						SendHeight: clienttypes.GetSelfHeight(suite.chainA.GetContext()),
+					}
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketLifecycle(suite.chainA.GetContext(), lifecycle)
+					expLifecycles[i-1] = lifecycle
+				}
+
+				// packets sent by a sender whose address starts with the queried sender are not returned
+				lifecycle := expLifecycles[0]
+				lifecycle.Sender = sender + "2"
+				lifecycle.Sequence = 10
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketLifecycle(suite.chainA.GetContext(), lifecycle)
+
+				req = &types.QuerySenderPacketLifecyclesRequest{
+					Sender: sender,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      11,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.SenderPacketLifecycles(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expLifecycles, res.PacketLifecycles)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	var (
 		req    *types.QueryUnreceivedPacketsRequest
@@ -35,6 +35,10 @@ func (k Keeper) ChanOpenInit(
 		return "", nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
 	}
 
+	if connectionEnd.State == connectiontypes.CLOSED {
+		return "", nil, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection (%s) is CLOSED", connectionHops[0])
+	}
+
 	getVersions := connectionEnd.GetVersions()
 	if len(getVersions) != 1 {
 		return "", nil, errorsmod.Wrapf(
@@ -52,12 +56,11 @@ func (k Keeper) ChanOpenInit(
 		)
 	}
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.ClientId)
-	if !found {
+	if _, found := k.clientKeeper.GetClientState(ctx, connectionEnd.ClientId); !found {
 		return "", nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "clientID (%s)", connectionEnd.ClientId)
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, connectionEnd.ClientId); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, connectionEnd.ClientId); status != exported.Active {
 		return "", nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", connectionEnd.ClientId, status)
 	}
 
@@ -98,7 +101,7 @@ func (k Keeper) WriteOpenInitChannel(
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-init")
 
-	emitChannelOpenInitEvent(ctx, portID, channelID, channel)
+	k.emitChannelOpenInitEvent(ctx, portID, channelID, channel)
 }
 
 // ChanOpenTry is called by a module to accept the first step of a channel opening
@@ -209,7 +212,7 @@ func (k Keeper) WriteOpenTryChannel(
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-try")
 
-	emitChannelOpenTryEvent(ctx, portID, channelID, channel)
+	k.emitChannelOpenTryEvent(ctx, portID, channelID, channel)
 }
 
 // ChanOpenAck is called by the handshake-originating module to acknowledge the
@@ -287,7 +290,7 @@ func (k Keeper) WriteOpenAckChannel(
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-ack")
 
-	emitChannelOpenAckEvent(ctx, portID, channelID, channel)
+	k.emitChannelOpenAckEvent(ctx, portID, channelID, channel)
 }
 
 // ChanOpenConfirm is called by the handshake-accepting module to confirm the acknowledgement
@@ -362,7 +365,7 @@ func (k Keeper) WriteOpenConfirmChannel(
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-confirm")
 
-	emitChannelOpenConfirmEvent(ctx, portID, channelID, channel)
+	k.emitChannelOpenConfirmEvent(ctx, portID, channelID, channel)
 }
 
 // Closing Handshake
@@ -396,12 +399,11 @@ func (k Keeper) ChanCloseInit(
 		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.ClientId)
-	if !found {
+	if _, found := k.clientKeeper.GetClientState(ctx, connectionEnd.ClientId); !found {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotFound, "clientID (%s)", connectionEnd.ClientId)
 	}
 
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, connectionEnd.ClientId); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, connectionEnd.ClientId); status != exported.Active {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", connectionEnd.ClientId, status)
 	}
 
@@ -419,7 +421,7 @@ func (k Keeper) ChanCloseInit(
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
-	emitChannelCloseInitEvent(ctx, portID, channelID, channel)
+	k.emitChannelCloseInitEvent(ctx, portID, channelID, channel)
 
 	return nil
 }
@@ -504,7 +506,7 @@ func (k Keeper) ChanCloseConfirmWithCounterpartyUpgradeSequence(
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
-	emitChannelCloseConfirmEvent(ctx, portID, channelID, channel)
+	k.emitChannelCloseConfirmEvent(ctx, portID, channelID, channel)
 
 	return nil
 }
@@ -51,6 +51,14 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
 			portCap = capabilitytypes.NewCapability(3)
 		}, false},
+		{"connection is closed", func() {
+			suite.coordinator.SetupConnections(path)
+			suite.Require().NoError(path.EndpointA.ConnCloseInit())
+
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+		}, false},
 		{"connection version not negotiated", func() {
 			suite.coordinator.SetupConnections(path)
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"strconv"
 	"strings"
@@ -194,12 +195,52 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// SetPacketTimeoutReceipt sets the timeout receipt of a packet which was received after its
+// timeout elapsed on an ORDERED_ALLOW_TIMEOUT channel
+func (k Keeper) SetPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
+// HasPacketTimeoutReceipt returns true if a timeout receipt is stored for the given packet
+func (k Keeper) HasPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return bytes.Equal(store.Get(host.PacketReceiptKey(portID, channelID, sequence)), types.TimeoutReceipt)
+}
+
 // deletePacketReceipt deletes a packet receipt from the store
 func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
 }
 
+// GetPacketReceiptTimeout gets the timeout of a packet received on an UNORDERED channel from the store
+func (k Keeper) GetPacketReceiptTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) (types.Timeout, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketReceiptTimeoutKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.Timeout{}, false
+	}
+
+	var timeout types.Timeout
+	k.cdc.MustUnmarshal(bz, &timeout)
+	return timeout, true
+}
+
+// SetPacketReceiptTimeout sets the timeout of a packet received on an UNORDERED channel to the store.
+// The timeout allows the packet receipt to be pruned once the timeout has elapsed.
+func (k Keeper) SetPacketReceiptTimeout(ctx sdk.Context, portID, channelID string, sequence uint64, timeout types.Timeout) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&timeout)
+	store.Set(host.PacketReceiptTimeoutKey(portID, channelID, sequence), bz)
+}
+
+// deletePacketReceiptTimeout deletes the timeout of a received packet from the store
+func (k Keeper) deletePacketReceiptTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptTimeoutKey(portID, channelID, sequence))
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -366,6 +407,19 @@ func (k Keeper) GetAllPacketReceipts(ctx sdk.Context) (receipts []types.PacketSt
 	return receipts
 }
 
+// GetAllPacketReceiptTimeouts returns the timeouts of all received packets stored alongside their packet receipts.
+func (k Keeper) GetAllPacketReceiptTimeouts(ctx sdk.Context) (timeouts []types.PacketReceiptTimeout) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyReceiptTimeoutPrefix+"/"))
+	k.iterateHashes(ctx, iterator, func(portID, channelID string, sequence uint64, bz []byte) bool {
+		var timeout types.Timeout
+		k.cdc.MustUnmarshal(bz, &timeout)
+		timeouts = append(timeouts, types.NewPacketReceiptTimeout(portID, channelID, sequence, timeout))
+		return false
+	})
+	return timeouts
+}
+
 // IteratePacketAcknowledgement provides an iterator over all PacketAcknowledgement objects. For each
 // aknowledgement, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -436,6 +490,18 @@ func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChan
 	return channels
 }
 
+// HasActiveChannels returns true if any channel built on top of the provided connection is not CLOSED.
+func (k Keeper) HasActiveChannels(ctx sdk.Context, connectionID string) bool {
+	var found bool
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		if channel.ConnectionHops[0] == connectionID && channel.State != types.CLOSED {
+			found = true
+		}
+		return found
+	})
+	return found
+}
+
 // GetChannelClientState returns the associated client state with its ID, from a port and channel identifier.
 func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
@@ -586,6 +652,108 @@ func (k Keeper) deleteUpgradeInfo(ctx sdk.Context, portID, channelID string) {
 	k.deleteCounterpartyUpgrade(ctx, portID, channelID)
 }
 
+// IsChannelPaused returns true if the channel with the given identifiers is paused.
+func (k Keeper) IsChannelPaused(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelPausedKey(portID, channelID))
+}
+
+// SetChannelPaused marks the channel with the given identifiers as paused.
+func (k Keeper) SetChannelPaused(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ChannelPausedKey(portID, channelID), []byte{byte(1)})
+}
+
+// deleteChannelPaused removes the paused flag of the channel with the given identifiers.
+func (k Keeper) deleteChannelPaused(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelPausedKey(portID, channelID))
+}
+
+// GetAllPausedChannels returns the identifiers of all paused channels.
+func (k Keeper) GetAllPausedChannels(ctx sdk.Context) (pausedChannels []types.PausedChannel) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyChannelPausedPrefix+"/"))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		pausedChannels = append(pausedChannels, types.NewPausedChannel(portID, channelID))
+	}
+
+	return pausedChannels
+}
+
+// GetPendingAcknowledgement gets the pending acknowledgement of the packet received on the
+// given channel with the given sequence.
+func (k Keeper) GetPendingAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingAcknowledgement, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PendingAcknowledgementKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PendingAcknowledgement{}, false
+	}
+
+	var pendingAck types.PendingAcknowledgement
+	k.cdc.MustUnmarshal(bz, &pendingAck)
+	return pendingAck, true
+}
+
+// SetPendingAcknowledgement stores the packet of the pending acknowledgement under its destination
+// port, channel and sequence, and queues it for expiry by the time at which the packet was received.
+func (k Keeper) SetPendingAcknowledgement(ctx sdk.Context, pendingAck types.PendingAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	packet := pendingAck.Packet
+	key := host.PendingAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+
+	store.Set(key, k.cdc.MustMarshal(&pendingAck))
+	store.Set(types.PendingAcknowledgementQueueKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence, pendingAck.ReceivedAt), key)
+}
+
+// DeletePendingAcknowledgement deletes the pending acknowledgement and removes it from the expiry queue.
+func (k Keeper) DeletePendingAcknowledgement(ctx sdk.Context, pendingAck types.PendingAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	packet := pendingAck.Packet
+
+	store.Delete(host.PendingAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+	k.DequeuePendingAcknowledgement(ctx, pendingAck)
+}
+
+// DequeuePendingAcknowledgement removes the pending acknowledgement from the expiry queue. The packet
+// remains pending and may still be acknowledged, but its acknowledgement no longer expires.
+func (k Keeper) DequeuePendingAcknowledgement(ctx sdk.Context, pendingAck types.PendingAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	packet := pendingAck.Packet
+
+	store.Delete(types.PendingAcknowledgementQueueKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence, pendingAck.ReceivedAt))
+}
+
+// IteratePendingAcknowledgements provides an iterator over all the pending acknowledgements whose
+// store keys start with the given prefix. For each pending acknowledgement, cb will be called.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePendingAcknowledgements(ctx sdk.Context, prefix []byte, cb func(pendingAck types.PendingAcknowledgement) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingAck types.PendingAcknowledgement
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingAck)
+
+		if cb(pendingAck) {
+			break
+		}
+	}
+}
+
+// GetAllPendingAcknowledgements returns all the pending acknowledgements.
+func (k Keeper) GetAllPendingAcknowledgements(ctx sdk.Context) (pendingAcks []types.PendingAcknowledgement) {
+	k.IteratePendingAcknowledgements(ctx, []byte(host.KeyPendingAckPrefix+"/"), func(pendingAck types.PendingAcknowledgement) bool {
+		pendingAcks = append(pendingAcks, pendingAck)
+		return false
+	})
+	return pendingAcks
+}
+
 // SetParams sets the channel parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -708,6 +876,7 @@ func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string,
 
 		// NOTE: packet receipts are only relevant for unordered channels.
 		k.deletePacketReceipt(ctx, portID, channelID, start)
+		k.deletePacketReceiptTimeout(ctx, portID, channelID, start)
 	}
 
 	// set pruning sequence start to the updated value
@@ -718,3 +887,68 @@ func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string,
 
 	return totalPruned, totalRemaining, nil
 }
+
+// PruneReceipts prunes the packet receipts of an UNORDERED channel for the given sequences. A packet receipt
+// may only be pruned once the packet timeout has elapsed on this chain, such that the packet can no longer be
+// received, and once the counterparty has deleted the packet commitment, such that the packet can no longer be
+// timed out on the counterparty using a proof of absence of the packet receipt. The absence of the packet
+// commitments is verified with a single proof at the given proof height. Packet receipts written before packet
+// timeouts were stored alongside them can never be pruned, as their packet timeout is unknown.
+func (k Keeper) PruneReceipts(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	sequences []uint64,
+	proof []byte,
+	proofHeight exported.Height,
+) (uint64, error) {
+	if len(sequences) == 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidPacket, "sequences cannot be empty")
+	}
+
+	channel, connectionEnd, err := k.getChannelAndConnection(ctx, portID, channelID)
+	if err != nil {
+		return 0, err
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "packet receipts can only be pruned on %s channels, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+	seen := make(map[uint64]struct{}, len(sequences))
+	for _, sequence := range sequences {
+		if _, found := seen[sequence]; found {
+			return 0, errorsmod.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", sequence)
+		}
+		seen[sequence] = struct{}{}
+
+		if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); !found {
+			return 0, errorsmod.Wrapf(types.ErrPacketReceiptNotFound, "port ID (%s) channel ID (%s) sequence (%d)", portID, channelID, sequence)
+		}
+
+		timeout, found := k.GetPacketReceiptTimeout(ctx, portID, channelID, sequence)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrReceiptTimeoutNotFound, "port ID (%s) channel ID (%s) sequence (%d): receipts written before packet timeouts were stored cannot be pruned", portID, channelID, sequence)
+		}
+
+		if !timeout.Elapsed(selfHeight, selfTimestamp) {
+			return 0, errorsmod.Wrapf(timeout.ErrTimeoutNotReached(selfHeight, selfTimestamp), "packet sequence (%d)", sequence)
+		}
+	}
+
+	// verify that the counterparty has deleted the commitments of all the packets
+	if err := k.connectionKeeper.VerifyPacketCommitmentsAbsence(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+	); err != nil {
+		return 0, errorsmod.Wrap(err, "couldn't verify absence of counterparty packet commitments")
+	}
+
+	for _, sequence := range sequences {
+		k.deletePacketReceipt(ctx, portID, channelID, sequence)
+		k.deletePacketReceiptTimeout(ctx, portID, channelID, sequence)
+	}
+
+	return uint64(len(sequences)), nil
+}
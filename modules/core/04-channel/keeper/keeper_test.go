@@ -10,6 +10,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -895,6 +896,146 @@ func (suite *KeeperTestSuite) UpgradeChannel(path *ibctesting.Path, upgradeField
 // sendMockPacket sends a packet from source to dest and acknowledges it on the source (completing the packet lifecycle)
 // if acknowledge is true. If acknowledge is false, then the packet will be sent, but timed out.
 // Question(jim): find a nicer home for this?
+func (suite *KeeperTestSuite) TestPruneReceipts() {
+	var (
+		path      *ibctesting.Path
+		sequences []uint64
+	)
+
+	testCases := []struct {
+		name     string
+		order    types.Order
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			types.UNORDERED,
+			func() {},
+			nil,
+		},
+		{
+			"failure: channel not found",
+			types.UNORDERED,
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: ORDERED channel",
+			types.ORDERED,
+			func() {},
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"failure: packet receipt not found",
+			types.UNORDERED,
+			func() {
+				sequences = append(sequences, 100)
+			},
+			types.ErrPacketReceiptNotFound,
+		},
+		{
+			"failure: packet receipt timeout not found",
+			types.UNORDERED,
+			func() {
+				// receipts written before packet timeouts were stored cannot be pruned
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 100)
+				sequences = append(sequences, 100)
+			},
+			types.ErrReceiptTimeoutNotFound,
+		},
+		{
+			"failure: packet timeout not reached",
+			types.UNORDERED,
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceiptTimeout(
+					suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences[0],
+					types.NewTimeout(clienttypes.NewHeight(1, 1000), disabledTimeoutTimestamp),
+				)
+			},
+			types.ErrTimeoutNotReached,
+		},
+		{
+			"failure: packet commitment not deleted on counterparty",
+			types.UNORDERED,
+			func() {
+				sequence, err := path.EndpointB.SendPacket(clienttypes.NewHeight(1, 1000), disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				// packet is received but its acknowledgement is not relayed to the counterparty
+				timeout := types.NewTimeout(clienttypes.GetSelfHeight(suite.chainA.GetContext()), disabledTimeoutTimestamp)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceiptTimeout(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, timeout)
+
+				sequences = append(sequences, sequence)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			suite.coordinator.Setup(path)
+
+			// send packets from B -> A which are received and acknowledged before they time out on A
+			selfHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+			timeoutHeight := clienttypes.NewHeight(selfHeight.RevisionNumber, selfHeight.RevisionHeight+20)
+
+			sequences = nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointB.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				sequences = append(sequences, sequence)
+			}
+
+			suite.coordinator.CommitNBlocks(suite.chainA, 20)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			tc.malleate()
+
+			proofKeys := make([][]byte, len(sequences))
+			for i, sequence := range sequences {
+				proofKeys[i] = host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+			}
+			proof, proofHeight := path.EndpointB.QueryBatchProof(proofKeys)
+
+			pruned, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneReceipts(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences, proof, proofHeight)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(len(sequences)), pruned)
+
+				for _, sequence := range sequences {
+					_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+					suite.Require().False(found)
+
+					_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceiptTimeout(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+					suite.Require().False(found)
+				}
+
+				// acknowledgements are not pruned
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), len(sequences))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Zero(pruned)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) sendMockPackets(path *ibctesting.Path, numPackets int, acknowledge bool) {
 	for i := 0; i < numPackets; i++ {
 
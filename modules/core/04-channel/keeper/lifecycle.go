@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// GetPacketLifecycle gets the lifecycle recorded for the packet with the given sequence sent on the given
+// channel, or received on it if received is true.
+func (k Keeper) GetPacketLifecycle(ctx sdk.Context, portID, channelID string, sequence uint64, received bool) (types.PacketLifecycle, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(packetLifecycleKey(portID, channelID, sequence, received))
+	if len(bz) == 0 {
+		return types.PacketLifecycle{}, false
+	}
+
+	var lifecycle types.PacketLifecycle
+	k.cdc.MustUnmarshal(bz, &lifecycle)
+	return lifecycle, true
+}
+
+// SetPacketLifecycle stores the lifecycle of a packet. If the lifecycle has a sender, the packet
+// is also indexed under its sender.
+func (k Keeper) SetPacketLifecycle(ctx sdk.Context, lifecycle types.PacketLifecycle) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&lifecycle)
+	store.Set(packetLifecycleKey(lifecycle.PortId, lifecycle.ChannelId, lifecycle.Sequence, lifecycle.Received), bz)
+
+	if lifecycle.Sender != "" {
+		store.Set(host.PacketSenderKey(lifecycle.Sender, lifecycle.PortId, lifecycle.ChannelId, lifecycle.Sequence), []byte{byte(1)})
+	}
+}
+
+// IteratePacketLifecycles provides an iterator over all the recorded packet lifecycles, those of the packets
+// sent first. For each lifecycle, cb will be called. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePacketLifecycles(ctx sdk.Context, cb func(lifecycle types.PacketLifecycle) bool) {
+	for _, keyPrefix := range []string{host.KeySendLifecyclePrefix, host.KeyRecvLifecyclePrefix} {
+		if k.iteratePacketLifecycles(ctx, []byte(keyPrefix+"/"), cb) {
+			return
+		}
+	}
+}
+
+// iteratePacketLifecycles iterates over the packet lifecycles stored under the given prefix and returns
+// true if the iteration was stopped by cb.
+func (k Keeper) iteratePacketLifecycles(ctx sdk.Context, prefix []byte, cb func(lifecycle types.PacketLifecycle) bool) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var lifecycle types.PacketLifecycle
+		k.cdc.MustUnmarshal(iterator.Value(), &lifecycle)
+
+		if cb(lifecycle) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAllPacketLifecycles returns all the recorded packet lifecycles.
+func (k Keeper) GetAllPacketLifecycles(ctx sdk.Context) (lifecycles []types.PacketLifecycle) {
+	k.IteratePacketLifecycles(ctx, func(lifecycle types.PacketLifecycle) bool {
+		lifecycles = append(lifecycles, lifecycle)
+		return false
+	})
+	return lifecycles
+}
+
+// indexPacketSent records a packet sent on its source channel, along with its sender if the
+// sending application is able to provide it.
+func (k Keeper) indexPacketSent(ctx sdk.Context, packet exported.PacketI) {
+	if !k.GetParams(ctx).PacketLifecycleIndexEnabled {
+		return
+	}
+
+	k.SetPacketLifecycle(ctx, types.PacketLifecycle{
+		PortId:     packet.GetSourcePort(),
+		ChannelId:  packet.GetSourceChannel(),
+		Sequence:   packet.GetSequence(),
+		Sender:     k.getPacketSender(ctx, packet),
+		Status:     types.SENT,
+		SendHeight: clienttypes.GetSelfHeight(ctx),
+	})
+}
+
+// indexPacketReceived records a packet received on its destination channel.
+func (k Keeper) indexPacketReceived(ctx sdk.Context, packet exported.PacketI) {
+	k.updatePacketLifecycle(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), true, func(lifecycle *types.PacketLifecycle) {
+		lifecycle.Status = types.RECEIVED
+		lifecycle.RecvHeight = clienttypes.GetSelfHeight(ctx)
+	})
+}
+
+// indexPacketAcknowledged records the acknowledgement of a packet, either written on its
+// destination channel if received is true or processed on its source channel.
+func (k Keeper) indexPacketAcknowledged(ctx sdk.Context, portID, channelID string, sequence uint64, received bool, acknowledgement []byte) {
+	k.updatePacketLifecycle(ctx, portID, channelID, sequence, received, func(lifecycle *types.PacketLifecycle) {
+		lifecycle.Status = types.ACKNOWLEDGED
+		lifecycle.AckHeight = clienttypes.GetSelfHeight(ctx)
+		lifecycle.Acknowledgement = acknowledgement
+	})
+}
+
+// indexPacketTimedOut records the timeout of a packet, either received after its timeout on its
+// destination channel if received is true or processed on its source channel.
+func (k Keeper) indexPacketTimedOut(ctx sdk.Context, portID, channelID string, sequence uint64, received bool) {
+	k.updatePacketLifecycle(ctx, portID, channelID, sequence, received, func(lifecycle *types.PacketLifecycle) {
+		lifecycle.Status = types.TIMEDOUT
+		lifecycle.TimeoutHeight = clienttypes.GetSelfHeight(ctx)
+	})
+}
+
+// updatePacketLifecycle applies the given update to the lifecycle of a packet sent on the given channel, or received
+// on it if received is true, creating it if the packet has not been indexed yet. It is a no-op if the packet lifecycle
+// index is disabled.
+func (k Keeper) updatePacketLifecycle(ctx sdk.Context, portID, channelID string, sequence uint64, received bool, update func(lifecycle *types.PacketLifecycle)) {
+	if !k.GetParams(ctx).PacketLifecycleIndexEnabled {
+		return
+	}
+
+	lifecycle, found := k.GetPacketLifecycle(ctx, portID, channelID, sequence, received)
+	if !found {
+		lifecycle = types.PacketLifecycle{PortId: portID, ChannelId: channelID, Sequence: sequence, Received: received}
+	}
+
+	update(&lifecycle)
+	k.SetPacketLifecycle(ctx, lifecycle)
+}
+
+// getPacketSender returns the sender of a packet as provided by the application bound to the packet
+// source port. An empty string is returned if the application does not provide the packet sender.
+func (k Keeper) getPacketSender(ctx sdk.Context, packet exported.PacketI) string {
+	data, err := k.portKeeper.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetData())
+	if err != nil {
+		return ""
+	}
+
+	packetData, ok := data.(exported.PacketData)
+	if !ok {
+		return ""
+	}
+
+	return packetData.GetPacketSender(packet.GetSourcePort())
+}
+
+// packetLifecycleKey returns the store key under which the lifecycle of a packet sent on the given channel,
+// or received on it if received is true, is recorded.
+func packetLifecycleKey(portID, channelID string, sequence uint64, received bool) []byte {
+	if received {
+		return host.PacketRecvLifecycleKey(portID, channelID, sequence)
+	}
+	return host.PacketSendLifecycleKey(portID, channelID, sequence)
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+// enablePacketLifecycleIndex enables the packet lifecycle index on the given chain.
+func enablePacketLifecycleIndex(chain *ibctesting.TestChain) {
+	params := chain.App.GetIBCKeeper().ChannelKeeper.GetParams(chain.GetContext())
+	params.PacketLifecycleIndexEnabled = true
+	chain.App.GetIBCKeeper().ChannelKeeper.SetParams(chain.GetContext(), params)
+}
+
+// TestPacketLifecycleIndexAcknowledgement tests that the lifecycle of an acknowledged packet
+// is recorded on both the sending and the receiving chain.
+func (suite *KeeperTestSuite) TestPacketLifecycleIndexAcknowledgement() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	enablePacketLifecycleIndex(suite.chainA)
+	enablePacketLifecycleIndex(suite.chainB)
+
+	sendHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	lifecycle, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, false)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SENT, lifecycle.Status)
+	suite.Require().Equal(sendHeight, lifecycle.SendHeight)
+	suite.Require().Empty(lifecycle.Sender, "the mock application does not provide the packet sender")
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	ack := ibcmock.MockAcknowledgement.Acknowledgement()
+
+	// the acknowledgement is written synchronously on the receiving chain
+	lifecycle, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence, true)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ACKNOWLEDGED, lifecycle.Status)
+	suite.Require().False(lifecycle.RecvHeight.IsZero())
+	suite.Require().Equal(lifecycle.RecvHeight, lifecycle.AckHeight)
+	suite.Require().True(lifecycle.SendHeight.IsZero())
+	suite.Require().Equal(ack, lifecycle.Acknowledgement)
+
+	lifecycle, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, false)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ACKNOWLEDGED, lifecycle.Status)
+	suite.Require().Equal(sendHeight, lifecycle.SendHeight)
+	suite.Require().True(lifecycle.AckHeight.GT(sendHeight))
+	suite.Require().True(lifecycle.TimeoutHeight.IsZero())
+	suite.Require().Equal(ack, lifecycle.Acknowledgement)
+}
+
+// TestPacketLifecycleIndexAsyncAcknowledgement tests that a packet acknowledged asynchronously
+// is recorded as received until its acknowledgement is written.
+func (suite *KeeperTestSuite) TestPacketLifecycleIndexAsyncAcknowledgement() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	enablePacketLifecycleIndex(suite.chainB)
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibcmock.MockAsyncPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibcmock.MockAsyncPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().NoError(err)
+
+	lifecycle, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence, true)
+	suite.Require().True(found)
+	suite.Require().Equal(types.RECEIVED, lifecycle.Status)
+	suite.Require().False(lifecycle.RecvHeight.IsZero())
+	suite.Require().True(lifecycle.AckHeight.IsZero())
+	suite.Require().Nil(lifecycle.Acknowledgement)
+
+	err = path.EndpointB.WriteAcknowledgement(ibcmock.MockAcknowledgement, packet)
+	suite.Require().NoError(err)
+
+	lifecycle, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence, true)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ACKNOWLEDGED, lifecycle.Status)
+	suite.Require().False(lifecycle.AckHeight.IsZero())
+	suite.Require().Equal(ibcmock.MockAcknowledgement.Acknowledgement(), lifecycle.Acknowledgement)
+
+	// the packet lifecycle index is disabled on the sending chain
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, false)
+	suite.Require().False(found)
+}
+
+// TestPacketLifecycleIndexBidirectional tests that the lifecycles of packets with the same sequence
+// sent and received on the same channel end are recorded separately.
+func (suite *KeeperTestSuite) TestPacketLifecycleIndexBidirectional() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	enablePacketLifecycleIndex(suite.chainA)
+
+	sentSequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	recvSequence, err := path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sentSequence, recvSequence)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, recvSequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.RecvPacket(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement written for the received packet does not alter the lifecycle of the sent packet
+	lifecycle, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sentSequence, false)
+	suite.Require().True(found)
+	suite.Require().False(lifecycle.Received)
+	suite.Require().Equal(types.SENT, lifecycle.Status)
+	suite.Require().False(lifecycle.SendHeight.IsZero())
+	suite.Require().True(lifecycle.RecvHeight.IsZero())
+	suite.Require().Nil(lifecycle.Acknowledgement)
+
+	lifecycle, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, recvSequence, true)
+	suite.Require().True(found)
+	suite.Require().True(lifecycle.Received)
+	suite.Require().Equal(types.ACKNOWLEDGED, lifecycle.Status)
+	suite.Require().True(lifecycle.SendHeight.IsZero())
+	suite.Require().False(lifecycle.RecvHeight.IsZero())
+	suite.Require().Equal(ibcmock.MockAcknowledgement.Acknowledgement(), lifecycle.Acknowledgement)
+
+	suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketLifecycles(suite.chainA.GetContext()), 2)
+}
+
+// TestPacketLifecycleIndexTimeout tests that the lifecycle of a timed out packet is recorded on the sending chain.
+func (suite *KeeperTestSuite) TestPacketLifecycleIndexTimeout() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	enablePacketLifecycleIndex(suite.chainA)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	lifecycle, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, false)
+	suite.Require().True(found)
+	suite.Require().Equal(types.TIMEDOUT, lifecycle.Status)
+	suite.Require().True(lifecycle.TimeoutHeight.GT(lifecycle.SendHeight))
+	suite.Require().True(lifecycle.AckHeight.IsZero())
+}
+
+// TestPacketLifecycleIndexDisabled tests that no packet lifecycle is recorded while the index is disabled.
+func (suite *KeeperTestSuite) TestPacketLifecycleIndexDisabled() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketLifecycles(suite.chainA.GetContext()))
+	suite.Require().Empty(suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetAllPacketLifecycles(suite.chainB.GetContext()))
+}
+
+// TestPacketLifecycleIndexSender tests that the sender of a packet provided by the sending application is recorded.
+func (suite *KeeperTestSuite) TestPacketLifecycleIndexSender() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	enablePacketLifecycleIndex(suite.chainA)
+
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", sender, suite.chainB.SenderAccount.GetAddress().String(), "").GetBytes()
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, data)
+	suite.Require().NoError(err)
+
+	lifecycle, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketLifecycle(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, false)
+	suite.Require().True(found)
+	suite.Require().Equal(sender, lifecycle.Sender)
+	suite.Require().Equal(types.SENT, lifecycle.Status)
+}
@@ -38,6 +38,10 @@ func (k Keeper) SendPacket(
 		return 0, errorsmod.Wrapf(types.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
 	}
 
+	if k.IsChannelPaused(ctx, sourcePort, sourceChannel) {
+		return 0, errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(sourcePort, sourceChannel)) {
 		return 0, errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
@@ -69,7 +73,7 @@ func (k Keeper) SendPacket(
 	}
 
 	// prevent accidental sends with clients that cannot be updated
-	if status := k.clientKeeper.GetClientStatus(ctx, clientState, connectionEnd.GetClientID()); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, connectionEnd.GetClientID()); status != exported.Active {
 		return 0, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
 	}
 
@@ -85,12 +89,17 @@ func (k Keeper) SendPacket(
 		return 0, errorsmod.Wrap(timeout.ErrTimeoutElapsed(latestHeight.(clienttypes.Height), latestTimestamp), "invalid packet timeout")
 	}
 
+	if err := k.validateSendPolicy(ctx, packet, latestTimestamp); err != nil {
+		return 0, err
+	}
+
 	commitment := types.CommitPacket(k.cdc, packet)
 
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
+	k.indexPacketSent(ctx, packet)
 
-	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
+	k.emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
 	k.Logger(ctx).Info(
 		"packet sent",
@@ -104,6 +113,40 @@ func (k Keeper) SendPacket(
 	return packet.GetSequence(), nil
 }
 
+// validateSendPolicy checks the packet against the maximum packet data size and the timeout bounds
+// set in the channel params. The timeout bounds are relative to the latest timestamp of the counterparty chain.
+func (k Keeper) validateSendPolicy(ctx sdk.Context, packet types.Packet, latestTimestamp uint64) error {
+	params := k.GetParams(ctx)
+
+	if maxSize := params.MaxPacketDataSizeForPort(packet.GetSourcePort()); maxSize != 0 && uint64(len(packet.GetData())) > maxSize {
+		return errorsmod.Wrapf(types.ErrPacketDataTooLarge, "packet data size (%d) exceeds the maximum (%d) for port %s", len(packet.GetData()), maxSize, packet.GetSourcePort())
+	}
+
+	// the timeout durations bound the timeout timestamp, packets timing out on height only
+	// are rejected as the time at which the timeout height is reached cannot be bounded
+	timeoutTimestamp := packet.GetTimeoutTimestamp()
+	if timeoutTimestamp == 0 {
+		if params.MaxTimeoutDuration != 0 {
+			return errorsmod.Wrapf(types.ErrInvalidTimeout, "packet timeout timestamp must be set when the max timeout duration (%d) is set", params.MaxTimeoutDuration)
+		}
+		if params.MinTimeoutDuration != 0 {
+			return errorsmod.Wrapf(types.ErrInvalidTimeout, "packet timeout timestamp must be set when the min timeout duration (%d) is set", params.MinTimeoutDuration)
+		}
+		return nil
+	}
+
+	// the timeout timestamp is greater than the latest timestamp, as the packet timeout has not elapsed
+	timeoutDuration := timeoutTimestamp - latestTimestamp
+	if params.MaxTimeoutDuration != 0 && timeoutDuration > params.MaxTimeoutDuration {
+		return errorsmod.Wrapf(types.ErrInvalidTimeout, "packet timeout duration (%d) exceeds the max timeout duration (%d)", timeoutDuration, params.MaxTimeoutDuration)
+	}
+	if timeoutDuration < params.MinTimeoutDuration {
+		return errorsmod.Wrapf(types.ErrInvalidTimeout, "packet timeout duration (%d) is less than the min timeout duration (%d)", timeoutDuration, params.MinTimeoutDuration)
+	}
+
+	return nil
+}
+
 // RecvPacket is called by a module in order to receive & process an IBC packet
 // sent on the corresponding channel end on the counterparty chain.
 func (k Keeper) RecvPacket(
@@ -112,6 +155,30 @@ func (k Keeper) RecvPacket(
 	packet exported.PacketI,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.recvPacket(ctx, chanCap, packet, func(connectionEnd exported.ConnectionI) error {
+		commitment := types.CommitPacket(k.cdc, packet)
+
+		// verify that the counterparty did commit to sending this packet
+		if err := k.connectionKeeper.VerifyPacketCommitment(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			commitment,
+		); err != nil {
+			return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
+		}
+
+		return nil
+	})
+}
+
+// recvPacket receives an IBC packet, using the given function to verify the
+// packet commitment stored on the counterparty chain.
+func (k Keeper) recvPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	verifyCommitment func(connectionEnd exported.ConnectionI) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
@@ -122,6 +189,10 @@ func (k Keeper) RecvPacket(
 		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected channel state to be one of [%s, %s, %s], but got %s", types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
 	}
 
+	if k.IsChannelPaused(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
 	// If counterpartyUpgrade is stored we need to ensure that the
 	// packet sequence is < counterparty next sequence send. If the
 	// counterparty is implemented correctly, this may only occur
@@ -174,28 +245,54 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// check if packet timed out by comparing it with the latest height of the chain
+	// check if packet timed out by comparing it with the latest height of the chain.
+	// Packets which timed out are still received on ORDERED_ALLOW_TIMEOUT channels, in
+	// order to write a timeout receipt and to advance the next sequence receive.
 	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(selfHeight, selfTimestamp) {
+	timedOut := timeout.Elapsed(selfHeight, selfTimestamp)
+	if timedOut && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
 		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
 	}
 
-	commitment := types.CommitPacket(k.cdc, packet)
-
-	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
-		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
+	if err := verifyCommitment(connectionEnd); err != nil {
+		return err
 	}
 
 	if err := k.applyReplayProtection(ctx, packet, channel); err != nil {
 		return err
 	}
 
+	if timedOut {
+		// the packet is not executed, the timeout receipt allows the sending chain to prove
+		// that the packet timed out, even though the next sequence receive has been incremented
+		k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		k.indexPacketTimedOut(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), true)
+
+		k.Logger(ctx).Info(
+			"timed out packet received",
+			"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+			"src_port", packet.GetSourcePort(),
+			"src_channel", packet.GetSourceChannel(),
+			"dst_port", packet.GetDestPort(),
+			"dst_channel", packet.GetDestChannel(),
+		)
+
+		k.emitRecvPacketTimeoutEvent(ctx, packet, channel)
+
+		return nil
+	}
+
+	// the acknowledgement of the packet is pending until it is written by the application
+	k.SetPendingAcknowledgement(ctx, types.NewPendingAcknowledgement(
+		types.NewPacket(
+			packet.GetData(), packet.GetSequence(), packet.GetSourcePort(), packet.GetSourceChannel(),
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp(),
+		),
+		selfTimestamp,
+	))
+	k.indexPacketReceived(ctx, packet)
+
 	// log that a packet has been received & executed
 	k.Logger(ctx).Info(
 		"packet received",
@@ -207,7 +304,7 @@ func (k Keeper) RecvPacket(
 	)
 
 	// emit an event that the relayer can query for
-	emitRecvPacketEvent(ctx, packet, channel)
+	k.emitRecvPacketEvent(ctx, packet, channel)
 
 	return nil
 }
@@ -226,12 +323,20 @@ func (k *Keeper) applyReplayProtection(ctx sdk.Context, packet exported.PacketI,
 
 	switch channel.Ordering {
 	case types.UNORDERED:
+		// REPLAY PROTECTION: Packet receipts may be pruned once the packet timeout has elapsed, thus a
+		// packet whose timeout has elapsed must never be received, regardless of a packet receipt.
+		selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+		timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
+		if timeout.Elapsed(selfHeight, selfTimestamp) {
+			return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
+		}
+
 		// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
 		// on unordered channels. Packet receipts must not be pruned, unless it has been marked stale
-		// by the increase of the recvStartSequence.
+		// by the increase of the recvStartSequence or the packet timeout has elapsed.
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		if found {
-			emitRecvPacketEvent(ctx, packet, channel)
+			k.emitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
 			// from failing and consuming unnecessary fees.
@@ -244,7 +349,11 @@ func (k *Keeper) applyReplayProtection(ctx sdk.Context, packet exported.PacketI,
 		// it's just a single store key set to a single byte to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+		// The packet timeout is stored alongside the receipt, such that the receipt can be pruned once
+		// the timeout has elapsed and the counterparty has deleted the packet commitment.
+		k.SetPacketReceiptTimeout(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), timeout)
+
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -255,7 +364,7 @@ func (k *Keeper) applyReplayProtection(ctx sdk.Context, packet exported.PacketI,
 		}
 
 		if packet.GetSequence() < nextSequenceRecv {
-			emitRecvPacketEvent(ctx, packet, channel)
+			k.emitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
 			// from failing and consuming unnecessary fees.
@@ -318,6 +427,33 @@ func (k Keeper) WriteAcknowledgement(
 		)
 	}
 
+	return k.writeAcknowledgement(ctx, channel, packet, acknowledgement)
+}
+
+// hasReceivedPacket returns true if the packet has been received and executed on the given channel,
+// according to its packet receipt on UNORDERED channels or to the next sequence receive otherwise.
+func (k Keeper) hasReceivedPacket(ctx sdk.Context, channel types.Channel, packet exported.PacketI) bool {
+	if k.HasPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
+		return false
+	}
+
+	if channel.Ordering == types.UNORDERED {
+		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		return found
+	}
+
+	nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	return found && packet.GetSequence() < nextSequenceRecv
+}
+
+// writeAcknowledgement writes the acknowledgement of a packet whose acknowledgement is pending
+// and removes the packet from the pending acknowledgements.
+func (k Keeper) writeAcknowledgement(
+	ctx sdk.Context,
+	channel types.Channel,
+	packet exported.PacketI,
+	acknowledgement exported.Acknowledgement,
+) error {
 	// NOTE: IBC app modules might have written the acknowledgement synchronously on
 	// the OnRecvPacket callback so we need to check if the acknowledgement is already
 	// set on the store and return an error if so.
@@ -325,6 +461,18 @@ func (k Keeper) WriteAcknowledgement(
 		return types.ErrAcknowledgementExists
 	}
 
+	// only packets which have been received can be acknowledged. Packets received before pending
+	// acknowledgements were recorded have no pending acknowledgement, their receipt is checked instead.
+	pendingAck, found := k.GetPendingAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	switch {
+	case found:
+		if !bytes.Equal(types.CommitPacket(k.cdc, pendingAck.Packet), types.CommitPacket(k.cdc, packet)) {
+			return errorsmod.Wrap(types.ErrInvalidPacket, "packet does not match the received packet")
+		}
+	case !k.hasReceivedPacket(ctx, channel, packet):
+		return errorsmod.Wrapf(types.ErrPendingAcknowledgementNotFound, "port ID (%s) channel ID (%s) sequence (%d)", packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+
 	if acknowledgement == nil {
 		return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement cannot be nil")
 	}
@@ -339,6 +487,10 @@ func (k Keeper) WriteAcknowledgement(
 		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		types.CommitAcknowledgement(bz),
 	)
+	if found {
+		k.DeletePendingAcknowledgement(ctx, pendingAck)
+	}
+	k.indexPacketAcknowledged(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), true, bz)
 
 	// log that a packet acknowledgement has been written
 	k.Logger(ctx).Info(
@@ -350,7 +502,7 @@ func (k Keeper) WriteAcknowledgement(
 		"dst_channel", packet.GetDestChannel(),
 	)
 
-	emitWriteAcknowledgementEvent(ctx, packet, channel, bz)
+	k.emitWriteAcknowledgementEvent(ctx, packet, channel, bz)
 
 	return nil
 }
@@ -360,7 +512,7 @@ func (k Keeper) WriteAcknowledgement(
 // module on the counterparty chain. Its intended usage is within the ante
 // handler. AcknowledgePacket will clean up the packet commitment,
 // which is no longer necessary since the packet has been received and acted upon.
-// It will also increment NextSequenceAck in case of ORDERED channels.
+// It will also increment NextSequenceAck in case of ORDERED and ORDERED_ALLOW_TIMEOUT channels.
 func (k Keeper) AcknowledgePacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -368,6 +520,23 @@ func (k Keeper) AcknowledgePacket(
 	acknowledgement []byte,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.acknowledgePacket(ctx, chanCap, packet, acknowledgement, func(connectionEnd exported.ConnectionI) error {
+		return k.connectionKeeper.VerifyPacketAcknowledgement(
+			ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+			packet.GetSequence(), acknowledgement,
+		)
+	})
+}
+
+// acknowledgePacket processes the acknowledgement of a packet, using the given function
+// to verify the acknowledgement stored on the counterparty chain.
+func (k Keeper) acknowledgePacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	acknowledgement []byte,
+	verifyAcknowledgement func(connectionEnd exported.ConnectionI) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -420,7 +589,7 @@ func (k Keeper) AcknowledgePacket(
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if len(commitment) == 0 {
-		emitAcknowledgePacketEvent(ctx, packet, channel)
+		k.emitAcknowledgePacketEvent(ctx, packet, channel)
 		// This error indicates that the acknowledgement has already been relayed
 		// or there is a misconfigured relayer attempting to prove an acknowledgement
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -435,15 +604,12 @@ func (k Keeper) AcknowledgePacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
-		packet.GetSequence(), acknowledgement,
-	); err != nil {
+	if err := verifyAcknowledgement(connectionEnd); err != nil {
 		return err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering != types.UNORDERED {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return errorsmod.Wrapf(
@@ -459,7 +625,7 @@ func (k Keeper) AcknowledgePacket(
 			)
 		}
 
-		// All verification complete, in the case of ORDERED channels we must increment nextSequenceAck
+		// All verification complete, in the case of ordered channels we must increment nextSequenceAck
 		nextSequenceAck++
 
 		// incrementing NextSequenceAck and storing under this chain's channelEnd identifiers
@@ -470,6 +636,7 @@ func (k Keeper) AcknowledgePacket(
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.indexPacketAcknowledged(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), false, acknowledgement)
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
@@ -482,7 +649,7 @@ func (k Keeper) AcknowledgePacket(
 	)
 
 	// emit an event marking that we have processed the acknowledgement
-	emitAcknowledgePacketEvent(ctx, packet, channel)
+	k.emitAcknowledgePacketEvent(ctx, packet, channel)
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
 	if channel.State == types.FLUSHING {
@@ -503,7 +670,7 @@ func (k Keeper) AcknowledgePacket(
 			if !k.HasInflightPackets(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
 				channel.State = types.FLUSHCOMPLETE
 				k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
-				emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+				k.emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
 			}
 		}
 	}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -281,6 +282,139 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 	}
 }
 
+// TestSendPacketSendPolicy tests that SendPacket enforces the packet data size and timeout bounds
+// set in the channel params.
+func (suite *KeeperTestSuite) TestSendPacketSendPolicy() {
+	var (
+		path             *ibctesting.Path
+		params           types.Params
+		packetData       []byte
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no send policy",
+			func() {
+				packetData = make([]byte, 4096)
+			},
+			nil,
+		},
+		{
+			"success: packet data size equal to the max packet data size",
+			func() {
+				params.MaxPacketDataSize = uint64(len(packetData))
+			},
+			nil,
+		},
+		{
+			"success: port send policy overrides the global max packet data size",
+			func() {
+				params.MaxPacketDataSize = 1
+				params.PortSendPolicies = []types.PortSendPolicy{types.NewPortSendPolicy(path.EndpointA.ChannelConfig.PortID, uint64(len(packetData)))}
+			},
+			nil,
+		},
+		{
+			"success: timeout duration within bounds",
+			func() {
+				timeoutHeight = disabledTimeoutHeight
+				timeoutTimestamp = suite.counterpartyTimestamp(path) + uint64(time.Hour.Nanoseconds())
+				params.MaxTimeoutDuration = uint64(time.Hour.Nanoseconds())
+				params.MinTimeoutDuration = uint64(time.Minute.Nanoseconds())
+			},
+			nil,
+		},
+		{
+			"packet data size exceeds the max packet data size",
+			func() {
+				params.MaxPacketDataSize = uint64(len(packetData)) - 1
+			},
+			types.ErrPacketDataTooLarge,
+		},
+		{
+			"packet data size exceeds the max packet data size of the port",
+			func() {
+				params.PortSendPolicies = []types.PortSendPolicy{types.NewPortSendPolicy(path.EndpointA.ChannelConfig.PortID, uint64(len(packetData))-1)}
+			},
+			types.ErrPacketDataTooLarge,
+		},
+		{
+			"timeout duration exceeds the max timeout duration",
+			func() {
+				timeoutTimestamp = suite.counterpartyTimestamp(path) + uint64(time.Hour.Nanoseconds()) + 1
+				params.MaxTimeoutDuration = uint64(time.Hour.Nanoseconds())
+			},
+			types.ErrInvalidTimeout,
+		},
+		{
+			"timeout timestamp not set with max timeout duration",
+			func() {
+				params.MaxTimeoutDuration = uint64(time.Hour.Nanoseconds())
+			},
+			types.ErrInvalidTimeout,
+		},
+		{
+			"timeout timestamp not set with min timeout duration",
+			func() {
+				params.MinTimeoutDuration = uint64(time.Minute.Nanoseconds())
+			},
+			types.ErrInvalidTimeout,
+		},
+		{
+			"timeout duration less than the min timeout duration",
+			func() {
+				timeoutTimestamp = suite.counterpartyTimestamp(path) + uint64(time.Minute.Nanoseconds()) - 1
+				params.MinTimeoutDuration = uint64(time.Minute.Nanoseconds())
+			},
+			types.ErrInvalidTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			params = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			packetData = ibctesting.MockPacketData
+			timeoutHeight = defaultTimeoutHeight
+			timeoutTimestamp = disabledTimeoutTimestamp
+
+			tc.malleate()
+
+			suite.Require().NoError(params.Validate())
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(suite.chainA.GetContext(), channelCap,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, timeoutTimestamp, packetData)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// counterpartyTimestamp returns the latest timestamp of the counterparty chain known by the client of endpoint A.
+func (suite *KeeperTestSuite) counterpartyTimestamp(path *ibctesting.Path) uint64 {
+	timestamp, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetTimestampAtHeight(suite.chainA.GetContext(), path.EndpointA.GetConnection(), path.EndpointA.GetClientState().GetLatestHeight())
+	suite.Require().NoError(err)
+	return timestamp
+}
+
 // TestRecvPacket test RecvPacket on chainB. Since packet commitment verification will always
 // occur last (resource instensive), only tests expected to succeed and packet commitment
 // verification tests need to simulate sending a packet from chainA to chainB.
@@ -677,6 +811,11 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			if expPass {
 				suite.Require().NoError(err)
 
+				pendingAck, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found, "pending acknowledgement not stored after RecvPacket")
+				suite.Require().Equal(packet, pendingAck.Packet)
+				suite.Require().Equal(uint64(suite.chainB.GetContext().BlockTime().UnixNano()), pendingAck.ReceivedAt)
+
 				channelB, _ := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
 				nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
 				suite.Require().True(found)
@@ -689,6 +828,10 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 					suite.Require().Equal(uint64(1), nextSeqRecv, "sequence incremented for UNORDERED channel")
 					suite.Require().True(receiptStored, "packet receipt not stored after RecvPacket in UNORDERED channel")
 					suite.Require().Equal(string([]byte{byte(1)}), receipt, "packet receipt is not empty string")
+
+					timeout, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceiptTimeout(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found, "packet receipt timeout not stored after RecvPacket in UNORDERED channel")
+					suite.Require().Equal(types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp()), timeout)
 				}
 			} else {
 				suite.Require().Error(err)
@@ -712,6 +855,7 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 			func() {
 				suite.coordinator.Setup(path)
 				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet.(types.Packet), 1))
 				ack = ibcmock.MockAcknowledgement
 				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			},
@@ -722,6 +866,7 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 			func() {
 				suite.coordinator.Setup(path)
 				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet.(types.Packet), 1))
 				ack = ibcmock.MockAcknowledgement
 
 				err := path.EndpointB.SetChannelState(types.FLUSHING)
@@ -735,6 +880,7 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 			func() {
 				suite.coordinator.Setup(path)
 				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet.(types.Packet), 1))
 				ack = ibcmock.MockAcknowledgement
 
 				err := path.EndpointB.SetChannelState(types.FLUSHCOMPLETE)
@@ -774,6 +920,7 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 			func() {
 				suite.coordinator.Setup(path)
 				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet.(types.Packet), 1))
 				ack = ibcmock.MockAcknowledgement
 				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), ack.Acknowledgement())
 				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
@@ -785,6 +932,7 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 			func() {
 				suite.coordinator.Setup(path)
 				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet.(types.Packet), 1))
 				ack = ibcmock.NewEmptyAcknowledgement()
 				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			},
@@ -795,11 +943,82 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 			func() {
 				suite.coordinator.Setup(path)
 				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(packet.(types.Packet), 1))
 				ack = nil
 				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			},
 			false,
 		},
+		{
+			"pending acknowledgement not found",
+			func() {
+				suite.coordinator.Setup(path)
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				ack = ibcmock.MockAcknowledgement
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			false,
+		},
+		{
+			"success: packet received without a pending acknowledgement on an unordered channel",
+			func() {
+				suite.coordinator.Setup(path)
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				ack = ibcmock.MockAcknowledgement
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			true,
+		},
+		{
+			"success: packet received without a pending acknowledgement on an ordered channel",
+			func() {
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+1)
+				ack = ibcmock.MockAcknowledgement
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			true,
+		},
+		{
+			"packet not received on an ordered channel",
+			func() {
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				ack = ibcmock.MockAcknowledgement
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			false,
+		},
+		{
+			"packet skipped with a timeout receipt",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				suite.coordinator.Setup(path)
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+1)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketTimeoutReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				ack = ibcmock.MockAcknowledgement
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			false,
+		},
+		{
+			"packet does not match the received packet",
+			func() {
+				suite.coordinator.Setup(path)
+				receivedPacket := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), types.NewPendingAcknowledgement(receivedPacket, 1))
+
+				packet = types.NewPacket([]byte("other data"), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				ack = ibcmock.MockAcknowledgement
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			false,
+		},
 	}
 	for i, tc := range testCases {
 		tc := tc
@@ -813,6 +1032,9 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().False(found)
 			} else {
 				suite.Require().Error(err)
 			}
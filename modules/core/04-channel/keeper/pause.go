@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// PauseChannel pauses the channel with the given identifiers. While a channel is paused,
+// packets can neither be sent nor received on it. Acknowledgements and timeouts of packets
+// sent before the channel was paused are still processed, so that in-flight packets can
+// be completed or refunded.
+func (k Keeper) PauseChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.SetChannelPaused(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel paused", "port-id", portID, "channel-id", channelID)
+
+	k.emitChannelPausedEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// ResumeChannel resumes the paused channel with the given identifiers, allowing packets
+// to be sent and received on it again.
+func (k Keeper) ResumeChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelNotPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.deleteChannelPaused(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel resumed", "port-id", portID, "channel-id", channelID)
+
+	k.emitChannelResumedEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestPauseChannel() {
+	var (
+		path      *ibctesting.Path
+		portID    string
+		channelID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: channel is closed",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = types.CLOSED
+				path.EndpointA.SetChannel(channel)
+			},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				channelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel already paused",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelPaused(suite.chainA.GetContext(), portID, channelID)
+			},
+			types.ErrChannelPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			portID, channelID = path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(ctx, portID, channelID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(ctx, portID, channelID))
+
+				events := ctx.EventManager().Events().ToABCIEvents()
+				expEvent := ibctesting.EventsMap{
+					types.EventTypeChannelPaused: {
+						types.AttributeKeyPortID:             portID,
+						types.AttributeKeyChannelID:          channelID,
+						types.AttributeCounterpartyPortID:    path.EndpointB.ChannelConfig.PortID,
+						types.AttributeCounterpartyChannelID: path.EndpointB.ChannelID,
+						types.AttributeKeyChannelState:       path.EndpointA.GetChannel().State.String(),
+					},
+				}
+				ibctesting.AssertEventsLegacy(&suite.Suite, expEvent, events)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResumeChannel() {
+	var (
+		path      *ibctesting.Path
+		portID    string
+		channelID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"channel not found",
+			func() {
+				channelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"channel not paused",
+			func() {
+				suite.Require().NoError(suite.chainA.App.GetIBCKeeper().ChannelKeeper.ResumeChannel(suite.chainA.GetContext(), portID, channelID))
+			},
+			types.ErrChannelNotPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			portID, channelID = path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelPaused(suite.chainA.GetContext(), portID, channelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ResumeChannel(ctx, portID, channelID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(ctx, portID, channelID))
+
+				events := ctx.EventManager().Events().ToABCIEvents()
+				expEvent := ibctesting.EventsMap{
+					types.EventTypeChannelResumed: {
+						types.AttributeKeyPortID:             portID,
+						types.AttributeKeyChannelID:          channelID,
+						types.AttributeCounterpartyPortID:    path.EndpointB.ChannelConfig.PortID,
+						types.AttributeCounterpartyChannelID: path.EndpointB.ChannelID,
+						types.AttributeKeyChannelState:       types.OPEN.String(),
+					},
+				}
+				ibctesting.AssertEventsLegacy(&suite.Suite, expEvent, events)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestPausedChannelPacketFlow tests that packets can neither be sent nor received on paused
+// channels, while in-flight packets can still be acknowledged and timed out.
+func (suite *KeeperTestSuite) TestPausedChannelPacketFlow() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	channelKeeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	// packet which is received on chainB before chainA pauses the channel
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	ackedPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointB.RecvPacket(ackedPacket))
+
+	// packet which times out on chainB
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err = path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	// packet sent from chainB which is in flight when chainA pauses the channel
+	sequence, err = path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	incomingPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	suite.Require().NoError(channelKeeperA.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	res, err := channelKeeperA.Channel(suite.chainA.GetContext(), &types.QueryChannelRequest{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Paused)
+
+	// packets cannot be sent
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrChannelPaused)
+
+	// packets cannot be received
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	err = path.EndpointA.RecvPacket(incomingPacket)
+	suite.Require().ErrorContains(err, types.ErrChannelPaused.Error())
+
+	err = channelKeeperA.RecvPacketReCheckTx(suite.chainA.GetContext(), incomingPacket)
+	suite.Require().ErrorIs(err, types.ErrChannelPaused)
+
+	// in-flight packets can be acknowledged and timed out
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(ackedPacket, ibcmock.MockAcknowledgement.Acknowledgement()))
+	suite.Require().False(channelKeeperA.HasPacketCommitment(suite.chainA.GetContext(), ackedPacket.GetSourcePort(), ackedPacket.GetSourceChannel(), ackedPacket.GetSequence()))
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(timedOutPacket))
+	suite.Require().False(channelKeeperA.HasPacketCommitment(suite.chainA.GetContext(), timedOutPacket.GetSourcePort(), timedOutPacket.GetSourceChannel(), timedOutPacket.GetSequence()))
+
+	// the counterparty channel is not affected
+	suite.Require().False(channelKeeperB.IsChannelPaused(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	// packets are received once the channel is resumed
+	suite.Require().NoError(channelKeeperA.ResumeChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.RecvPacket(incomingPacket))
+
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+}
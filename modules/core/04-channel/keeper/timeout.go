@@ -28,6 +28,56 @@ func (k Keeper) TimeoutPacket(
 	proof []byte,
 	proofHeight exported.Height,
 	nextSequenceRecv uint64,
+) error {
+	return k.timeoutPacket(ctx, packet, proofHeight, func(connectionEnd exported.ConnectionI, channel types.Channel) error {
+		switch channel.Ordering {
+		case types.ORDERED:
+			// check that packet has not been received
+			if nextSequenceRecv > packet.GetSequence() {
+				return errorsmod.Wrapf(
+					types.ErrPacketReceived,
+					"packet already received, next sequence receive > packet sequence (%d > %d)", nextSequenceRecv, packet.GetSequence(),
+				)
+			}
+
+			// check that the recv sequence is as claimed
+			return k.connectionKeeper.VerifyNextSequenceRecv(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		case types.UNORDERED:
+			return k.connectionKeeper.VerifyPacketReceiptAbsence(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			)
+		case types.ORDERED_ALLOW_TIMEOUT:
+			// the packet was either received by the counterparty after its timeout elapsed, or it has
+			// not been received yet and will be rejected by the counterparty as its timeout elapsed
+			if nextSequenceRecv > packet.GetSequence() {
+				return k.connectionKeeper.VerifyPacketReceipt(
+					ctx, connectionEnd, proofHeight, proof,
+					packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+					types.TimeoutReceipt,
+				)
+			}
+
+			return k.connectionKeeper.VerifyNextSequenceRecv(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		default:
+			panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
+		}
+	})
+}
+
+// timeoutPacket verifies the timeout of a packet, using the given function to verify
+// that the packet has not been received by the counterparty chain.
+func (k Keeper) timeoutPacket(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	proofHeight exported.Height,
+	verifyUnreceived func(connectionEnd exported.ConnectionI, channel types.Channel) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -76,7 +126,7 @@ func (k Keeper) TimeoutPacket(
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if len(commitment) == 0 {
-		emitTimeoutPacketEvent(ctx, packet, channel)
+		k.emitTimeoutPacketEvent(ctx, packet, channel)
 		// This error indicates that the timeout has already been relayed
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -91,31 +141,14 @@ func (k Keeper) TimeoutPacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	switch channel.Ordering {
-	case types.ORDERED:
-		// check that packet has not been received
-		if nextSequenceRecv > packet.GetSequence() {
-			return errorsmod.Wrapf(
-				types.ErrPacketReceived,
-				"packet already received, next sequence receive > packet sequence (%d > %d)", nextSequenceRecv, packet.GetSequence(),
-			)
+	// timeouts are processed in order on ORDERED_ALLOW_TIMEOUT channels
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		if err := k.checkTimeoutOrder(ctx, packet); err != nil {
+			return err
 		}
-
-		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
-			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
-		)
-	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
-			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
-		)
-	default:
-		panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
 	}
 
-	if err != nil {
+	if err := verifyUnreceived(connectionEnd, channel); err != nil {
 		return err
 	}
 
@@ -124,7 +157,8 @@ func (k Keeper) TimeoutPacket(
 }
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
-// If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED channel then this channel will be closed,
+// while the next sequence acknowledgement is incremented for ORDERED_ALLOW_TIMEOUT channels.
 // If the channel is in the FLUSHING state and there is a counterparty upgrade, then the
 // upgrade will be aborted if the upgrade has timed out. Otherwise, if there are no more inflight packets,
 // then the channel will be set to the FLUSHCOMPLETE state.
@@ -149,9 +183,20 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.indexPacketTimedOut(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), false)
+
+	// timeouts are processed in order on ORDERED_ALLOW_TIMEOUT channels, as acknowledgements are
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		if !found {
+			return errorsmod.Wrapf(types.ErrSequenceAckNotFound, "source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel())
+		}
+
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceAck+1)
+	}
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
-	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
+	if channel.State == types.FLUSHING && channel.Ordering != types.ORDERED {
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		// once we have received the counterparty timeout in the channel UpgradeAck or UpgradeConfirm handshake steps
 		// then we can move to flushing complete if the timeout has not passed and there are no in-flight packets
@@ -167,7 +212,7 @@ func (k Keeper) TimeoutExecuted(
 				// set the channel state to flush complete if all packets have been flushed.
 				channel.State = types.FLUSHCOMPLETE
 				k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
-				emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+				k.emitChannelFlushCompleteEvent(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
 			}
 		}
 	}
@@ -182,7 +227,7 @@ func (k Keeper) TimeoutExecuted(
 
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
-		emitChannelClosedEvent(ctx, packet, channel)
+		k.emitChannelClosedEvent(ctx, packet, channel)
 	}
 
 	k.Logger(ctx).Info(
@@ -195,7 +240,7 @@ func (k Keeper) TimeoutExecuted(
 	)
 
 	// emit an event marking that we have processed the timeout
-	emitTimeoutPacketEvent(ctx, packet, channel)
+	k.emitTimeoutPacketEvent(ctx, packet, channel)
 
 	return nil
 }
@@ -268,7 +313,7 @@ func (k Keeper) TimeoutOnCloseWithCounterpartyUpgradeSequence(
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if len(commitment) == 0 {
-		emitTimeoutPacketEvent(ctx, packet, channel)
+		k.emitTimeoutPacketEvent(ctx, packet, channel)
 		// This error indicates that the timeout has already been relayed
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
@@ -322,6 +367,25 @@ func (k Keeper) TimeoutOnCloseWithCounterpartyUpgradeSequence(
 			ctx, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.checkTimeoutOrder(ctx, packet); err != nil {
+			return err
+		}
+
+		// the packet was either received by the counterparty after its timeout elapsed, or it will
+		// never be received as the counterparty channel is closed
+		if nextSequenceRecv > packet.GetSequence() {
+			err = k.connectionKeeper.VerifyPacketReceipt(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+				types.TimeoutReceipt,
+			)
+		} else {
+			err = k.connectionKeeper.VerifyNextSequenceRecv(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		}
 	default:
 		panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
 	}
@@ -333,3 +397,24 @@ func (k Keeper) TimeoutOnCloseWithCounterpartyUpgradeSequence(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
+
+// checkTimeoutOrder ensures that packets sent on ORDERED_ALLOW_TIMEOUT channels are timed out
+// in the same order as they are acknowledged.
+func (k Keeper) checkTimeoutOrder(ctx sdk.Context, packet exported.PacketI) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
@@ -786,3 +786,118 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 		})
 	}
 }
+
+// TestTimeoutPacketOrderedAllowTimeout tests that a packet which timed out on an ORDERED_ALLOW_TIMEOUT
+// channel is skipped by the receiving chain and timed out on the sending chain without closing the channel.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	suite.coordinator.Setup(path)
+
+	channelKeeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	channelKeeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	// the first packet times out, the second packet is relayed successfully afterwards
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	sequence, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	// packets must still be received in order
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().ErrorContains(err, types.ErrPacketSequenceOutOfOrder.Error())
+
+	// the timed out packet is not executed, a timeout receipt is written instead of an acknowledgement
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	res, err := path.EndpointB.RecvPacketWithResult(timedOutPacket)
+	suite.Require().NoError(err)
+
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+	suite.Require().True(channelKeeperB.HasPacketTimeoutReceipt(suite.chainB.GetContext(), timedOutPacket.GetDestPort(), timedOutPacket.GetDestChannel(), timedOutPacket.GetSequence()))
+
+	nextSeqRecv, found := channelKeeperB.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence(), nextSeqRecv)
+	suite.Require().Equal(
+		host.PacketReceiptKey(timedOutPacket.GetDestPort(), timedOutPacket.GetDestChannel(), timedOutPacket.GetSequence()),
+		host.PacketTimeoutProofKey(timedOutPacket.GetDestPort(), timedOutPacket.GetDestChannel(), timedOutPacket.GetSequence(), nextSeqRecv),
+	)
+
+	// the timeout is proven with the timeout receipt, the channel remains open
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(timedOutPacket))
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().False(channelKeeperA.HasPacketCommitment(suite.chainA.GetContext(), timedOutPacket.GetSourcePort(), timedOutPacket.GetSourceChannel(), timedOutPacket.GetSequence()))
+
+	nextSeqAck, found := channelKeeperA.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence(), nextSeqAck)
+
+	// the next packet is received and acknowledged
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	nextSeqAck, found = channelKeeperA.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+}
+
+// TestTimeoutPacketOrderedAllowTimeoutBeforeReceive tests that a packet which timed out on an ORDERED_ALLOW_TIMEOUT
+// channel can be timed out with a proof of the next sequence receive before it is received by the receiving chain.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeoutBeforeReceive() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	suite.coordinator.Setup(path)
+
+	channelKeeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	channelKeeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	sequence, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	// the packet commitment is proven by the relayer at a height at which it still exists on chainA
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(timedOutPacket.GetSourcePort(), timedOutPacket.GetSourceChannel(), timedOutPacket.GetSequence()))
+
+	// the timeout is proven with the next sequence receive of chainB, the channel remains open
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(timedOutPacket))
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().False(channelKeeperA.HasPacketCommitment(suite.chainA.GetContext(), timedOutPacket.GetSourcePort(), timedOutPacket.GetSourceChannel(), timedOutPacket.GetSequence()))
+
+	nextSeqAck, found := channelKeeperA.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence(), nextSeqAck)
+
+	// chainB skips the timed out packet when it is received afterwards
+	res, err := suite.chainB.SendMsgs(types.NewMsgRecvPacket(timedOutPacket, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+	suite.Require().True(channelKeeperB.HasPacketTimeoutReceipt(suite.chainB.GetContext(), timedOutPacket.GetDestPort(), timedOutPacket.GetDestChannel(), timedOutPacket.GetSequence()))
+
+	// the next packet is received and acknowledged
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	nextSeqAck, found = channelKeeperA.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+}
@@ -609,15 +609,15 @@ func (k Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID strin
 
 	// next seq recv and ack is used for ordered channels to verify the packet has been received/acked in the correct order
 	// this is no longer necessary if the channel is UNORDERED and should be reset to 1
-	if channel.Ordering == types.ORDERED && upgrade.Fields.Ordering == types.UNORDERED {
+	if channel.Ordering != types.UNORDERED && upgrade.Fields.Ordering == types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
 	}
 
-	// next seq recv and ack should updated when moving from UNORDERED to ORDERED using the counterparty NextSequenceSend as set just after blocking new packet sends.
+	// next seq recv and ack should updated when moving from UNORDERED to ORDERED or ORDERED_ALLOW_TIMEOUT using the counterparty NextSequenceSend as set just after blocking new packet sends.
 	// we can be sure that the next packet we are set to receive will be the first packet the counterparty sends after reopening.
 	// we can be sure that our next acknowledgement will be our first packet sent after upgrade, as the counterparty processed all sent packets after flushing completes.
-	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering == types.ORDERED {
+	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering != types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
 		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
 	}
@@ -1060,5 +1060,5 @@ func (k Keeper) WriteErrorReceipt(ctx sdk.Context, portID, channelID string, upg
 	}
 
 	k.setUpgradeErrorReceipt(ctx, portID, channelID, errorReceiptToWrite)
-	EmitErrorReceiptEvent(ctx, portID, channelID, channel, upgradeError)
+	k.EmitErrorReceiptEvent(ctx, portID, channelID, channel, upgradeError)
 }
@@ -1646,6 +1646,33 @@ func (suite *KeeperTestSuite) TestWriteUpgradeOpenChannel_Ordering() {
 				suite.Require().Equal(uint64(2), counterpartySequenceSend)
 			},
 		},
+		{
+			name: "success: UNORDERED -> ORDERED_ALLOW_TIMEOUT",
+			malleate: func() {
+				path.EndpointA.ChannelConfig.Order = types.UNORDERED
+				path.EndpointB.ChannelConfig.Order = types.UNORDERED
+
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+			},
+			preUpgrade: func() {},
+			postUpgrade: func() {
+				channel := path.EndpointA.GetChannel()
+				ctx := suite.chainA.GetContext()
+
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(types.ORDERED_ALLOW_TIMEOUT, channel.Ordering)
+
+				// NextSeqRecv and NextSeqAck are set as for ORDERED channels
+				seq, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+
+				seq, found = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceAck(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
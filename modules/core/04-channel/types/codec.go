@@ -39,6 +39,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgRecvPackets{},
+		&MsgAcknowledgements{},
+		&MsgTimeouts{},
 		&MsgChannelUpgradeInit{},
 		&MsgChannelUpgradeTry{},
 		&MsgChannelUpgradeAck{},
@@ -47,7 +50,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeTimeout{},
 		&MsgChannelUpgradeCancel{},
 		&MsgPruneAcknowledgements{},
+		&MsgPruneReceipts{},
 		&MsgUpdateParams{},
+		&MsgPauseChannel{},
+		&MsgResumeChannel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
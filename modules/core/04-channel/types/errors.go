@@ -56,4 +56,14 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+	ErrChannelPaused                   = errorsmod.Register(SubModuleName, 43, "channel is paused")
+	ErrChannelNotPaused                = errorsmod.Register(SubModuleName, 44, "channel is not paused")
+	ErrPacketReceiptNotFound           = errorsmod.Register(SubModuleName, 45, "packet receipt not found")
+	ErrReceiptTimeoutNotFound          = errorsmod.Register(SubModuleName, 46, "packet receipt timeout not found")
+	ErrPendingAcknowledgementNotFound  = errorsmod.Register(SubModuleName, 47, "pending acknowledgement not found")
+	ErrAcknowledgementExpired          = errorsmod.Register(SubModuleName, 48, "acknowledgement expired")
+	ErrInvalidAcknowledgementExpiry    = errorsmod.Register(SubModuleName, 49, "invalid acknowledgement expiry")
+	ErrPacketLifecycleNotFound         = errorsmod.Register(SubModuleName, 50, "packet lifecycle not found")
+	ErrInvalidSendPolicy               = errorsmod.Register(SubModuleName, 51, "invalid packet send policy")
+	ErrPacketDataTooLarge              = errorsmod.Register(SubModuleName, 52, "packet data too large")
 )
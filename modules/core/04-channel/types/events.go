@@ -30,6 +30,7 @@ const (
 	EventTypeWriteAck          = "write_acknowledgement"
 	EventTypeAcknowledgePacket = "acknowledge_packet"
 	EventTypeTimeoutPacket     = "timeout_packet"
+	EventTypeRecvPacketTimeout = "recv_packet_timeout"
 
 	// Deprecated: in favor of AttributeKeyDataHex
 	AttributeKeyData = "packet_data"
@@ -67,6 +68,8 @@ var (
 	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
 	EventTypeChannelUpgradeError   = "channel_upgrade_error"
 	EventTypeChannelFlushComplete  = "channel_flush_complete"
+	EventTypeChannelPaused         = "channel_paused"
+	EventTypeChannelResumed        = "channel_resumed"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
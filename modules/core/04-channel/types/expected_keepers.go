@@ -12,7 +12,7 @@ import (
 
 // ClientKeeper expected account IBC client keeper
 type ClientKeeper interface {
-	GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status
+	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
@@ -64,6 +64,62 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		commitments map[uint64][]byte,
+	) error
+	VerifyPacketAcknowledgements(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		acknowledgements map[uint64][]byte,
+	) error
+	VerifyPacketReceipts(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+		receipt []byte,
+	) error
+	VerifyPacketReceiptsAbsence(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+	) error
+	VerifyPacketCommitmentsAbsence(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -96,4 +152,5 @@ type ConnectionKeeper interface {
 // PortKeeper expected account IBC port keeper
 type PortKeeper interface {
 	Authenticate(ctx sdk.Context, key *capabilitytypes.Capability, portID string) bool
+	UnmarshalPacketData(ctx sdk.Context, portID string, bz []byte) (interface{}, error)
 }
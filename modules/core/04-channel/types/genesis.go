@@ -41,6 +41,63 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewPausedChannel creates a new PausedChannel instance.
+func NewPausedChannel(portID, channelID string) PausedChannel {
+	return PausedChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (pc PausedChannel) Validate() error {
+	if err := host.PortIdentifierValidator(pc.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(pc.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	return nil
+}
+
+// NewPacketReceiptTimeout creates a new PacketReceiptTimeout instance.
+func NewPacketReceiptTimeout(portID, channelID string, seq uint64, timeout Timeout) PacketReceiptTimeout {
+	return PacketReceiptTimeout{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+		Timeout:   timeout,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (rt PacketReceiptTimeout) Validate() error {
+	if err := validateGenFields(rt.PortId, rt.ChannelId, rt.Sequence); err != nil {
+		return err
+	}
+	if !rt.Timeout.IsValid() {
+		return ErrInvalidTimeout
+	}
+	return nil
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (pl PacketLifecycle) Validate() error {
+	if err := validateGenFields(pl.PortId, pl.ChannelId, pl.Sequence); err != nil {
+		return err
+	}
+	if _, ok := PacketStatus_name[int32(pl.Status)]; !ok || pl.Status == UNKNOWN {
+		return fmt.Errorf("invalid packet status %s", pl.Status)
+	}
+	if pl.Received && pl.Sender != "" {
+		return errors.New("the sender of a received packet cannot be recorded")
+	}
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance. It uses the default params.
 // Breakage in v9.0.0 will allow the params to be provided. Please use
 // NewGenesisStateWithParams in this version if you want to provide custom params.
@@ -81,15 +138,19 @@ func NewGenesisStateWithParams(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
-		Params:              DefaultParams(),
+		Channels:                []IdentifiedChannel{},
+		Acknowledgements:        []PacketState{},
+		Receipts:                []PacketState{},
+		Commitments:             []PacketState{},
+		SendSequences:           []PacketSequence{},
+		RecvSequences:           []PacketSequence{},
+		AckSequences:            []PacketSequence{},
+		NextChannelSequence:     0,
+		Params:                  DefaultParams(),
+		PausedChannels:          []PausedChannel{},
+		ReceiptTimeouts:         []PacketReceiptTimeout{},
+		PendingAcknowledgements: []PendingAcknowledgement{},
+		PacketLifecycles:        []PacketLifecycle{},
 	}
 }
 
@@ -161,6 +222,30 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, pc := range gs.PausedChannels {
+		if err := pc.Validate(); err != nil {
+			return fmt.Errorf("invalid paused channel %v index %d: %w", pc, i, err)
+		}
+	}
+
+	for i, rt := range gs.ReceiptTimeouts {
+		if err := rt.Validate(); err != nil {
+			return fmt.Errorf("invalid packet receipt timeout %v index %d: %w", rt, i, err)
+		}
+	}
+
+	for i, pa := range gs.PendingAcknowledgements {
+		if err := pa.Validate(); err != nil {
+			return fmt.Errorf("invalid pending acknowledgement %v index %d: %w", pa, i, err)
+		}
+	}
+
+	for i, pl := range gs.PacketLifecycles {
+		if err := pl.Validate(); err != nil {
+			return fmt.Errorf("invalid packet lifecycle %v index %d: %w", pl, i, err)
+		}
+	}
+
 	return nil
 }
 
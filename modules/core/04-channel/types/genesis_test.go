@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
@@ -137,6 +138,124 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid paused channel",
+			genState: types.GenesisState{
+				PausedChannels: []types.PausedChannel{
+					types.NewPausedChannel(testPort1, testChannel1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid paused channel",
+			genState: types.GenesisState{
+				PausedChannels: []types.PausedChannel{
+					types.NewPausedChannel(testPort1, "(testChannel1)"),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid packet receipt timeout",
+			genState: types.GenesisState{
+				ReceiptTimeouts: []types.PacketReceiptTimeout{
+					types.NewPacketReceiptTimeout(testPort1, testChannel1, 1, types.NewTimeout(clienttypes.NewHeight(0, 10), 0)),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid packet receipt timeout sequence",
+			genState: types.GenesisState{
+				ReceiptTimeouts: []types.PacketReceiptTimeout{
+					types.NewPacketReceiptTimeout(testPort1, testChannel1, 0, types.NewTimeout(clienttypes.NewHeight(0, 10), 0)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid packet receipt timeout",
+			genState: types.GenesisState{
+				ReceiptTimeouts: []types.PacketReceiptTimeout{
+					types.NewPacketReceiptTimeout(testPort1, testChannel1, 1, types.NewTimeout(clienttypes.ZeroHeight(), 0)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid pending acknowledgement",
+			genState: types.GenesisState{
+				PendingAcknowledgements: []types.PendingAcknowledgement{
+					types.NewPendingAcknowledgement(types.NewPacket([]byte("data"), 1, testPort2, testChannel2, testPort1, testChannel1, clienttypes.NewHeight(0, 10), 0), 1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid pending acknowledgement packet",
+			genState: types.GenesisState{
+				PendingAcknowledgements: []types.PendingAcknowledgement{
+					types.NewPendingAcknowledgement(types.NewPacket([]byte("data"), 0, testPort2, testChannel2, testPort1, testChannel1, clienttypes.NewHeight(0, 10), 0), 1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pending acknowledgement receive timestamp",
+			genState: types.GenesisState{
+				PendingAcknowledgements: []types.PendingAcknowledgement{
+					types.NewPendingAcknowledgement(types.NewPacket([]byte("data"), 1, testPort2, testChannel2, testPort1, testChannel1, clienttypes.NewHeight(0, 10), 0), 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid packet lifecycle",
+			genState: types.GenesisState{
+				PacketLifecycles: []types.PacketLifecycle{
+					{PortId: testPort1, ChannelId: testChannel1, Sequence: 1, Sender: "sender", Status: types.SENT, SendHeight: clienttypes.NewHeight(0, 10)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "valid received packet lifecycle",
+			genState: types.GenesisState{
+				PacketLifecycles: []types.PacketLifecycle{
+					{PortId: testPort1, ChannelId: testChannel1, Sequence: 1, Sender: "sender", Status: types.SENT, SendHeight: clienttypes.NewHeight(0, 10)},
+					{PortId: testPort1, ChannelId: testChannel1, Sequence: 1, Status: types.RECEIVED, RecvHeight: clienttypes.NewHeight(0, 10), Received: true},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid received packet lifecycle with sender",
+			genState: types.GenesisState{
+				PacketLifecycles: []types.PacketLifecycle{
+					{PortId: testPort1, ChannelId: testChannel1, Sequence: 1, Sender: "sender", Status: types.RECEIVED, Received: true},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid packet lifecycle sequence",
+			genState: types.GenesisState{
+				PacketLifecycles: []types.PacketLifecycle{
+					{PortId: testPort1, ChannelId: testChannel1, Sequence: 0, Status: types.SENT},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid packet lifecycle status",
+			genState: types.GenesisState{
+				PacketLifecycles: []types.PacketLifecycle{
+					{PortId: testPort1, ChannelId: testChannel1, Sequence: 1, Status: types.UNKNOWN},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 	"regexp"
 
@@ -31,6 +32,15 @@ const (
 
 	// ParamsKey defines the key to store the params in the keeper.
 	ParamsKey = "channelParams"
+
+	// KeyPendingAckQueuePrefix is the prefix of the keys under which pending acknowledgements
+	// are queued by the time at which their packets were received.
+	KeyPendingAckQueuePrefix = "pendingAckQueue"
+
+	// MaxAcknowledgementExpiriesPerBlock is the maximum number of pending acknowledgements
+	// expired in a single block. The remaining expired acknowledgements are expired in the
+	// following blocks, oldest first.
+	MaxAcknowledgementExpiriesPerBlock = 100
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -68,3 +78,23 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 func FilteredPortPrefix(portPrefix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix))
 }
+
+// PendingAcknowledgementQueuePrefix returns the prefix of the keys under which the pending acknowledgements
+// of the packets received on the given port are queued.
+func PendingAcknowledgementQueuePrefix(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPendingAckQueuePrefix, portID))
+}
+
+// PendingAcknowledgementQueueTimePrefix returns the prefix of the keys under which the pending acknowledgements
+// of the packets received on the given port at the given time (in nanoseconds) are queued. Queue keys
+// are ordered by receive time as the time is big endian encoded.
+func PendingAcknowledgementQueueTimePrefix(portID string, receivedAt uint64) []byte {
+	return binary.BigEndian.AppendUint64(PendingAcknowledgementQueuePrefix(portID), receivedAt)
+}
+
+// PendingAcknowledgementQueueKey returns the key under which the pending acknowledgement of a packet
+// received on the given port and channel with the given sequence at the given time is queued.
+func PendingAcknowledgementQueueKey(portID, channelID string, sequence, receivedAt uint64) []byte {
+	key := append(PendingAcknowledgementQueueTimePrefix(portID, receivedAt), []byte("/"+channelID+"/")...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
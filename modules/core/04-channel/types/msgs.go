@@ -25,6 +25,9 @@ var (
 	_ sdk.Msg = (*MsgAcknowledgement)(nil)
 	_ sdk.Msg = (*MsgTimeout)(nil)
 	_ sdk.Msg = (*MsgTimeoutOnClose)(nil)
+	_ sdk.Msg = (*MsgRecvPackets)(nil)
+	_ sdk.Msg = (*MsgAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgTimeouts)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeInit)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeTry)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeAck)(nil)
@@ -32,6 +35,9 @@ var (
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgPruneReceipts)(nil)
+	_ sdk.Msg = (*MsgPauseChannel)(nil)
+	_ sdk.Msg = (*MsgResumeChannel)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -43,6 +49,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgTimeoutOnClose)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgTimeouts)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTry)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeAck)(nil)
@@ -50,6 +59,9 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneReceipts)(nil)
+	_ sdk.HasValidateBasic = (*MsgPauseChannel)(nil)
+	_ sdk.HasValidateBasic = (*MsgResumeChannel)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -524,6 +536,131 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// NewMsgRecvPackets constructs a new MsgRecvPackets
+func NewMsgRecvPackets(
+	packets []Packet, commitmentsProof []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: commitmentsProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.ProofCommitments) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty commitments proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validatePacketBatch(msg.Packets, func(packet Packet) (string, string) {
+		return packet.GetDestPort(), packet.GetDestChannel()
+	})
+}
+
+// NewMsgTimeouts constructs a new MsgTimeouts
+func NewMsgTimeouts(
+	packets []Packet, nextSequenceRecv uint64, unreceivedProof []byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgTimeouts {
+	return &MsgTimeouts{
+		Packets:          packets,
+		NextSequenceRecv: nextSequenceRecv,
+		ProofUnreceived:  unreceivedProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTimeouts) ValidateBasic() error {
+	if len(msg.ProofUnreceived) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty unreceived proof")
+	}
+	if msg.NextSequenceRecv == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "next sequence receive cannot be 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validatePacketBatch(msg.Packets, func(packet Packet) (string, string) {
+		return packet.GetSourcePort(), packet.GetSourceChannel()
+	})
+}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks [][]byte,
+	ackedProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofAcked:       ackedProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.ProofAcked) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty acknowledgements proof")
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements must match the number of packets (%d ≠ %d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+	for i, ack := range msg.Acknowledgements {
+		if len(ack) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgement, "ack bytes at index %d cannot be empty", i)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validatePacketBatch(msg.Packets, func(packet Packet) (string, string) {
+		return packet.GetSourcePort(), packet.GetSourceChannel()
+	})
+}
+
+// validatePacketBatch validates the packets of a batch message. The batch must not be empty
+// and all packets must have unique sequences and the same channel, as returned by the given
+// function.
+func validatePacketBatch(packets []Packet, channel func(Packet) (string, string)) error {
+	if len(packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	portID, channelID := channel(packets[0])
+	sequences := make(map[uint64]struct{}, len(packets))
+	for _, packet := range packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if packetPortID, packetChannelID := channel(packet); packetPortID != portID || packetChannelID != channelID {
+			return errorsmod.Wrapf(ErrInvalidPacket, "all packets must use the same channel, expected (%s, %s), got (%s, %s)", portID, channelID, packetPortID, packetChannelID)
+		}
+
+		if _, found := sequences[packet.GetSequence()]; found {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+		sequences[packet.GetSequence()] = struct{}{}
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgChannelUpgradeInit{}
 
 // NewMsgChannelUpgradeInit constructs a new MsgChannelUpgradeInit
@@ -905,3 +1042,105 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgPruneReceipts creates a new instance of MsgPruneReceipts.
+func NewMsgPruneReceipts(
+	portID, channelID string,
+	sequences []uint64,
+	commitmentsProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgPruneReceipts {
+	return &MsgPruneReceipts{
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequences:        sequences,
+		ProofCommitments: commitmentsProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneReceipts.
+func (msg *MsgPruneReceipts) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if len(msg.Sequences) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "sequences cannot be empty")
+	}
+
+	sequences := make(map[uint64]struct{}, len(msg.Sequences))
+	for _, sequence := range msg.Sequences {
+		if sequence == 0 {
+			return errorsmod.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+		}
+
+		if _, found := sequences[sequence]; found {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", sequence)
+		}
+		sequences[sequence] = struct{}{}
+	}
+
+	if len(msg.ProofCommitments) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty commitments proof")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgPauseChannel creates a new instance of MsgPauseChannel.
+func NewMsgPauseChannel(portID, channelID, authority string) *MsgPauseChannel {
+	return &MsgPauseChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPauseChannel.
+func (msg *MsgPauseChannel) ValidateBasic() error {
+	return validateChannelAuthorityMsg(msg.PortId, msg.ChannelId, msg.Authority)
+}
+
+// NewMsgResumeChannel creates a new instance of MsgResumeChannel.
+func NewMsgResumeChannel(portID, channelID, authority string) *MsgResumeChannel {
+	return &MsgResumeChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgResumeChannel.
+func (msg *MsgResumeChannel) ValidateBasic() error {
+	return validateChannelAuthorityMsg(msg.PortId, msg.ChannelId, msg.Authority)
+}
+
+// validateChannelAuthorityMsg validates the channel identifiers and the authority of
+// messages which are executed by the authority on a single channel.
+func validateChannelAuthorityMsg(portID, channelID, authority string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(channelID) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
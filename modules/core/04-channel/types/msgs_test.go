@@ -175,9 +175,9 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		},
 		{
 			"invalid channel order",
-			types.NewMsgChannelOpenInit(portid, version, types.Order(3),
+			types.NewMsgChannelOpenInit(portid, version, types.Order(4),
 				connHops, cpportid, addr),
-			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(3).String()),
+			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(4).String()),
 		},
 		{
 			"connection hops more than 1 ",
@@ -1079,6 +1079,104 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, "channel-100", timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRecvPackets
+		expErr error
+	}{
+		{"success", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, addr), nil},
+		{"missing signer address", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, emptyAddr), ibcerrors.ErrInvalidAddress},
+		{"empty proof", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, emptyProof, height, addr), commitmenttypes.ErrInvalidProof},
+		{"empty packets", types.NewMsgRecvPackets(nil, suite.proof, height, addr), types.ErrInvalidPacket},
+		{"invalid packet", types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, suite.proof, height, addr), types.ErrInvalidPacket},
+		{"packets sent to different channels", types.NewMsgRecvPackets([]types.Packet{packet, otherChannelPacket}, suite.proof, height, addr), types.ErrInvalidPacket},
+		{"duplicate packet sequence", types.NewMsgRecvPackets([]types.Packet{packet, packet}, suite.proof, height, addr), types.ErrInvalidPacket},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgTimeoutsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, "channel-100", cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTimeouts
+		expErr error
+	}{
+		{"success", types.NewMsgTimeouts([]types.Packet{packet, packet2}, 1, suite.proof, height, addr), nil},
+		{"next sequence receive is zero", types.NewMsgTimeouts([]types.Packet{packet, packet2}, 0, suite.proof, height, addr), ibcerrors.ErrInvalidSequence},
+		{"missing signer address", types.NewMsgTimeouts([]types.Packet{packet, packet2}, 1, suite.proof, height, emptyAddr), ibcerrors.ErrInvalidAddress},
+		{"empty proof", types.NewMsgTimeouts([]types.Packet{packet, packet2}, 1, emptyProof, height, addr), commitmenttypes.ErrInvalidProof},
+		{"empty packets", types.NewMsgTimeouts(nil, 1, suite.proof, height, addr), types.ErrInvalidPacket},
+		{"packets sent from different channels", types.NewMsgTimeouts([]types.Packet{packet, otherChannelPacket}, 1, suite.proof, height, addr), types.ErrInvalidPacket},
+		{"duplicate packet sequence", types.NewMsgTimeouts([]types.Packet{packet2, packet2}, 1, suite.proof, height, addr), types.ErrInvalidPacket},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	acks := [][]byte{packet.GetData(), packet2.GetData()}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAcknowledgements
+		expErr error
+	}{
+		{"success", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, height, addr), nil},
+		{"missing signer address", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, height, emptyAddr), ibcerrors.ErrInvalidAddress},
+		{"empty proof", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, emptyProof, height, addr), commitmenttypes.ErrInvalidProof},
+		{"number of acknowledgements does not match", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks[:1], suite.proof, height, addr), types.ErrInvalidAcknowledgement},
+		{"empty acknowledgement", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, [][]byte{packet.GetData(), {}}, suite.proof, height, addr), types.ErrInvalidAcknowledgement},
+		{"duplicate packet sequence", types.NewMsgAcknowledgements([]types.Packet{packet, packet}, acks, suite.proof, height, addr), types.ErrInvalidPacket},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgAcknowledgementGetSigners() {
 	expSigner, err := sdk.AccAddressFromBech32(addr)
 	suite.Require().NoError(err)
@@ -1860,6 +1958,88 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgPruneReceiptsValidateBasic() {
+	var msg *types.MsgPruneReceipts
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty sequences",
+			func() {
+				msg.Sequences = nil
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"zero sequence",
+			func() {
+				msg.Sequences = []uint64{1, 0}
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"duplicate sequence",
+			func() {
+				msg.Sequences = []uint64{1, 2, 1}
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"empty commitments proof",
+			func() {
+				msg.ProofCommitments = emptyProof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgPruneReceipts(ibctesting.MockPort, ibctesting.FirstChannelID, []uint64{1, 2}, suite.proof, height, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	var msg *types.MsgUpdateParams
 
@@ -1895,6 +2075,85 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
+		{
+			"success: acknowledgement expiries",
+			func() {
+				msg.Params.AcknowledgementExpiries = []types.AcknowledgementExpiry{
+					types.NewAcknowledgementExpiry(ibctesting.MockPort, uint64(100000)),
+					types.NewAcknowledgementExpiry(ibctesting.TransferPort, uint64(100000)),
+				}
+			},
+			nil,
+		},
+		{
+			"invalid params: invalid acknowledgement expiry port ID",
+			func() {
+				msg.Params.AcknowledgementExpiries = []types.AcknowledgementExpiry{types.NewAcknowledgementExpiry("", uint64(100000))}
+			},
+			types.ErrInvalidAcknowledgementExpiry,
+		},
+		{
+			"invalid params: zero max pending duration",
+			func() {
+				msg.Params.AcknowledgementExpiries = []types.AcknowledgementExpiry{types.NewAcknowledgementExpiry(ibctesting.MockPort, 0)}
+			},
+			types.ErrInvalidAcknowledgementExpiry,
+		},
+		{
+			"invalid params: duplicate acknowledgement expiry port ID",
+			func() {
+				msg.Params.AcknowledgementExpiries = []types.AcknowledgementExpiry{
+					types.NewAcknowledgementExpiry(ibctesting.MockPort, uint64(100000)),
+					types.NewAcknowledgementExpiry(ibctesting.MockPort, uint64(200000)),
+				}
+			},
+			types.ErrInvalidAcknowledgementExpiry,
+		},
+		{
+			"success: send policies",
+			func() {
+				msg.Params.MaxPacketDataSize = 1024
+				msg.Params.PortSendPolicies = []types.PortSendPolicy{
+					types.NewPortSendPolicy(ibctesting.MockPort, 2048),
+					types.NewPortSendPolicy(ibctesting.TransferPort, 512),
+				}
+				msg.Params.MaxTimeoutDuration = uint64(100000)
+				msg.Params.MinTimeoutDuration = uint64(100000)
+			},
+			nil,
+		},
+		{
+			"invalid params: invalid send policy port ID",
+			func() {
+				msg.Params.PortSendPolicies = []types.PortSendPolicy{types.NewPortSendPolicy("", 1024)}
+			},
+			types.ErrInvalidSendPolicy,
+		},
+		{
+			"invalid params: zero send policy max packet data size",
+			func() {
+				msg.Params.PortSendPolicies = []types.PortSendPolicy{types.NewPortSendPolicy(ibctesting.MockPort, 0)}
+			},
+			types.ErrInvalidSendPolicy,
+		},
+		{
+			"invalid params: duplicate send policy port ID",
+			func() {
+				msg.Params.PortSendPolicies = []types.PortSendPolicy{
+					types.NewPortSendPolicy(ibctesting.MockPort, 1024),
+					types.NewPortSendPolicy(ibctesting.MockPort, 2048),
+				}
+			},
+			types.ErrInvalidSendPolicy,
+		},
+		{
+			"invalid params: min timeout duration greater than max timeout duration",
+			func() {
+				msg.Params.MaxTimeoutDuration = uint64(100000)
+				msg.Params.MinTimeoutDuration = uint64(100001)
+			},
+			types.ErrInvalidSendPolicy,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1926,3 +2185,111 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsGetSigners() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
+
+func (suite *TypesTestSuite) TestMsgPauseChannelValidateBasic() {
+	var msg *types.MsgPauseChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty authority address",
+			func() {
+				msg.Authority = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgPauseChannel(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgResumeChannelValidateBasic() {
+	var msg *types.MsgResumeChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty authority address",
+			func() {
+				msg.Authority = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgResumeChannel(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
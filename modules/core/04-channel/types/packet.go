@@ -13,6 +13,11 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// TimeoutReceipt is the packet receipt written by the receiving chain when a packet is
+// received after its timeout elapsed on an ORDERED_ALLOW_TIMEOUT channel. It differs from
+// the receipt written for packets received on UNORDERED channels.
+var TimeoutReceipt = []byte{byte(2)}
+
 // CommitPacket returns the packet commitment bytes. The commitment consists of:
 // sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data))
 // from a given packet. This results in a fixed length preimage.
@@ -134,3 +139,21 @@ func (p PacketId) Validate() error {
 func NewPacketID(portID, channelID string, seq uint64) PacketId {
 	return PacketId{PortId: portID, ChannelId: channelID, Sequence: seq}
 }
+
+// NewPendingAcknowledgement returns a new instance of PendingAcknowledgement
+func NewPendingAcknowledgement(packet Packet, receivedAt uint64) PendingAcknowledgement {
+	return PendingAcknowledgement{Packet: packet, ReceivedAt: receivedAt}
+}
+
+// Validate performs a basic validation of the pending acknowledgement fields.
+func (pa PendingAcknowledgement) Validate() error {
+	if err := pa.Packet.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid pending acknowledgement packet")
+	}
+
+	if pa.ReceivedAt == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "pending acknowledgement receive timestamp cannot be 0")
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultTimeout defines a default parameter for the channel upgrade protocol.
@@ -14,9 +15,26 @@ import (
 var DefaultTimeout = NewTimeout(clienttypes.ZeroHeight(), uint64(10*time.Minute.Nanoseconds()))
 
 // NewParams creates a new parameter configuration for the channel submodule
-func NewParams(upgradeTimeout Timeout) Params {
+func NewParams(upgradeTimeout Timeout, acknowledgementExpiries ...AcknowledgementExpiry) Params {
 	return Params{
-		UpgradeTimeout: upgradeTimeout,
+		UpgradeTimeout:          upgradeTimeout,
+		AcknowledgementExpiries: acknowledgementExpiries,
+	}
+}
+
+// NewAcknowledgementExpiry creates a new AcknowledgementExpiry instance.
+func NewAcknowledgementExpiry(portID string, maxPendingDuration uint64) AcknowledgementExpiry {
+	return AcknowledgementExpiry{
+		PortId:             portID,
+		MaxPendingDuration: maxPendingDuration,
+	}
+}
+
+// NewPortSendPolicy creates a new PortSendPolicy instance.
+func NewPortSendPolicy(portID string, maxPacketDataSize uint64) PortSendPolicy {
+	return PortSendPolicy{
+		PortId:            portID,
+		MaxPacketDataSize: maxPacketDataSize,
 	}
 }
 
@@ -33,5 +51,50 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+
+	seenPorts := make(map[string]struct{}, len(p.AcknowledgementExpiries))
+	for _, expiry := range p.AcknowledgementExpiries {
+		if err := host.PortIdentifierValidator(expiry.PortId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgementExpiry, "invalid port ID: %s", err)
+		}
+		if expiry.MaxPendingDuration == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgementExpiry, "max pending duration of port %s cannot be 0", expiry.PortId)
+		}
+		if _, found := seenPorts[expiry.PortId]; found {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgementExpiry, "duplicate acknowledgement expiry for port %s", expiry.PortId)
+		}
+		seenPorts[expiry.PortId] = struct{}{}
+	}
+
+	seenPolicyPorts := make(map[string]struct{}, len(p.PortSendPolicies))
+	for _, policy := range p.PortSendPolicies {
+		if err := host.PortIdentifierValidator(policy.PortId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSendPolicy, "invalid port ID: %s", err)
+		}
+		if policy.MaxPacketDataSize == 0 {
+			return errorsmod.Wrapf(ErrInvalidSendPolicy, "max packet data size of port %s cannot be 0", policy.PortId)
+		}
+		if _, found := seenPolicyPorts[policy.PortId]; found {
+			return errorsmod.Wrapf(ErrInvalidSendPolicy, "duplicate send policy for port %s", policy.PortId)
+		}
+		seenPolicyPorts[policy.PortId] = struct{}{}
+	}
+
+	if p.MaxTimeoutDuration != 0 && p.MinTimeoutDuration > p.MaxTimeoutDuration {
+		return errorsmod.Wrapf(ErrInvalidSendPolicy, "min timeout duration (%d) cannot be greater than max timeout duration (%d)", p.MinTimeoutDuration, p.MaxTimeoutDuration)
+	}
+
 	return nil
 }
+
+// MaxPacketDataSizeForPort returns the maximum size of the data of packets sent on the given port.
+// The send policy of the port takes precedence over the global limit. Zero means no limit.
+func (p Params) MaxPacketDataSizeForPort(portID string) uint64 {
+	for _, policy := range p.PortSendPolicies {
+		if policy.PortId == portID {
+			return policy.MaxPacketDataSize
+		}
+	}
+
+	return p.MaxPacketDataSize
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -81,3 +82,28 @@ func (k Keeper) LookupModuleByPort(ctx sdk.Context, portID string) (string, *cap
 
 	return types.GetModuleOwner(modules), capability, nil
 }
+
+// UnmarshalPacketData unmarshals the packet data using the application bound to the given port ID.
+// An error is returned if the application does not implement the PacketDataUnmarshaler interface.
+func (k Keeper) UnmarshalPacketData(ctx sdk.Context, portID string, bz []byte) (interface{}, error) {
+	if k.Router == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRoute, "router not set")
+	}
+
+	module, _, err := k.LookupModuleByPort(ctx, portID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPort, "could not retrieve module from port-id (%s): %s", portID, err)
+	}
+
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	unmarshaler, ok := cbs.(types.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRoute, "module %s does not implement PacketDataUnmarshaler", module)
+	}
+
+	return unmarshaler.UnmarshalPacketData(bz)
+}
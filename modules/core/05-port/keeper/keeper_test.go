@@ -8,7 +8,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/05-port/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
 )
 
@@ -74,3 +77,20 @@ func (suite *KeeperTestSuite) TestAuthenticate() {
 	auth = suite.keeper.Authenticate(suite.ctx, capKey2, validPort)
 	require.False(suite.T(), auth, "invalid authentication for different capKey failed")
 }
+
+func (suite *KeeperTestSuite) TestUnmarshalPacketData() {
+	sender := "cosmos1sender"
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", sender, "cosmos1receiver", "").GetBytes()
+
+	packetData, err := suite.keeper.UnmarshalPacketData(suite.ctx, transfertypes.PortID, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sender, packetData.(exported.PacketData).GetPacketSender(transfertypes.PortID))
+
+	// the packet data cannot be unmarshaled by the application
+	_, err = suite.keeper.UnmarshalPacketData(suite.ctx, transfertypes.PortID, []byte("invalid"))
+	suite.Require().Error(err)
+
+	// the port is not bound
+	_, err = suite.keeper.UnmarshalPacketData(suite.ctx, validPort, data)
+	suite.Require().ErrorIs(err, types.ErrInvalidPort)
+}
@@ -157,6 +157,22 @@ type UpgradableModule interface {
 	)
 }
 
+// AcknowledgementExpiryModule defines an optional interface for applications which revert the receipt of a packet
+// when its asynchronous acknowledgement expires. If the application bound to a port does not implement it, core IBC
+// writes an error acknowledgement without reverting any state change of the application. Middleware must implement
+// it and forward the callback to the underlying application, returning the error acknowledgement written by core IBC
+// if the underlying application does not implement it.
+type AcknowledgementExpiryModule interface {
+	// OnAcknowledgementExpired is called once the acknowledgement of a packet has been pending for the maximum
+	// duration configured for its port. The application must revert the state changes made on the receipt of the
+	// packet and return the error acknowledgement to be written. If an error is returned, the acknowledgement
+	// remains pending and may still be written by the application, but its expiry is not attempted again.
+	OnAcknowledgementExpired(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+	) (exported.Acknowledgement, error)
+}
+
 // ICS4Wrapper implements the ICS4 interfaces that IBC applications use to send packets and acknowledgements.
 type ICS4Wrapper interface {
 	SendPacket(
@@ -173,16 +173,83 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return nil
 }
 
-// BatchVerifyMembership verifies a group of key value pairs against the given root
-// NOTE: Currently left unimplemented as it is unused
-func (MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
-	return errorsmod.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+// BatchVerifyMembership verifies a group of key value pairs against the given root with a single proof.
+// The path is the merkle path of the lowest subtree which contains all the items, and the keys of the items
+// are the keys within this subtree. The lowest proof must be a (compressed) batch proof of the existence of
+// all the items, the remaining proofs chain the subroot up to the given root.
+func (proof MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	mpath, err := validateBatchVerificationPath(specs, path, len(items))
+	if err != nil {
+		return err
+	}
+
+	for key, value := range items {
+		if len(value) == 0 {
+			return errorsmod.Wrapf(ErrInvalidProof, "empty value in membership proof for key %s", key)
+		}
+	}
+
+	subroot, err := proof.Proofs[0].Calculate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0: %v", err)
+	}
+
+	if ok := ics23.BatchVerifyMembership(specs[0], subroot, proof.Proofs[0], items); !ok {
+		return errorsmod.Wrap(ErrInvalidProof, "could not verify membership of all items in the batch proof. Please ensure that the keys and values are correct.")
+	}
+
+	// the lowest key of the path is the key of the subroot in the next subtree
+	return verifyChainedMembershipProof(root.GetHash(), specs[1:], proof.Proofs[1:], mpath, subroot, 0)
+}
+
+// BatchVerifyNonMembership verifies absence of a group of keys against the given root with a single proof.
+// The path is the merkle path of the lowest subtree, and the keys are the keys within this subtree. The lowest
+// proof must be a (compressed) batch proof of the absence of all the keys, the remaining proofs chain the subroot
+// up to the given root.
+func (proof MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items [][]byte) error {
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	mpath, err := validateBatchVerificationPath(specs, path, len(items))
+	if err != nil {
+		return err
+	}
+
+	subroot, err := proof.Proofs[0].Calculate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0, merkle tree is likely empty. %v", err)
+	}
+
+	if ok := ics23.BatchVerifyNonMembership(specs[0], subroot, proof.Proofs[0], items); !ok {
+		return errorsmod.Wrap(ErrInvalidProof, "could not verify absence of all keys in the batch proof. Please ensure that the keys are correct.")
+	}
+
+	return verifyChainedMembershipProof(root.GetHash(), specs[1:], proof.Proofs[1:], mpath, subroot, 0)
 }
 
-// BatchVerifyNonMembership verifies absence of a group of keys against the given root
-// NOTE: Currently left unimplemented as it is unused
-func (MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items [][]byte) error {
-	return errorsmod.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+// validateBatchVerificationPath validates the path and number of items given to batch verification. The path
+// must contain one key less than the number of specs, as the keys of the lowest subtree are provided by the items.
+func validateBatchVerificationPath(specs []*ics23.ProofSpec, path exported.Path, numItems int) (MerklePath, error) {
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return MerklePath{}, errorsmod.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+
+	if len(mpath.KeyPath)+1 != len(specs) {
+		return MerklePath{}, errorsmod.Wrapf(ErrInvalidProof, "path length %d not one less than proof %d",
+			len(mpath.KeyPath), len(specs))
+	}
+
+	if numItems == 0 {
+		return MerklePath{}, errorsmod.Wrap(ErrInvalidProof, "batch verification requires at least one item")
+	}
+
+	return mpath, nil
 }
 
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
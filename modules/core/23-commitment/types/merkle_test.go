@@ -140,6 +140,172 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) queryProof(key string) types.MerkleProof {
+	res, err := suite.store.Query(&storetypes.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+		Data:  []byte(key),
+		Prove: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ProofOps)
+
+	proof, err := types.ConvertProofs(res.ProofOps)
+	suite.Require().NoError(err)
+
+	return proof
+}
+
+func (suite *MerkleTestSuite) TestBatchVerifyMembership() {
+	suite.iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	suite.iavlStore.Set([]byte("MYKEY2"), []byte("MYVALUE2"))
+	suite.iavlStore.Set([]byte("MYKEY3"), []byte("MYVALUE3"))
+	cid := suite.store.Commit()
+
+	var (
+		proof types.MerkleProof
+		root  []byte
+		path  types.MerklePath
+		items map[string][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: subset of proven items", func() {
+			delete(items, "MYKEY2")
+		}, true},
+		{"success: single existence proof", func() {
+			proof = suite.queryProof("MYKEY1")
+			items = map[string][]byte{"MYKEY1": []byte("MYVALUE1")}
+		}, true},
+		{"wrong value", func() {
+			items["MYKEY2"] = []byte("WRONGVALUE")
+		}, false},
+		{"empty value", func() {
+			items["MYKEY2"] = nil
+		}, false},
+		{"key not part of the proof", func() {
+			items["MYKEY4"] = []byte("MYVALUE4")
+		}, false},
+		{"no items", func() {
+			items = map[string][]byte{}
+		}, false},
+		{"wrong path: full key path", func() {
+			path = types.NewMerklePath(suite.storeKey.Name(), "MYKEY1")
+		}, false},
+		{"wrong storekey", func() {
+			path = types.NewMerklePath("otherStoreKey")
+		}, false},
+		{"wrong root", func() {
+			root = []byte("WRONGROOT")
+		}, false},
+		{"proof is wrong length", func() {
+			proof = types.MerkleProof{Proofs: proof.Proofs[1:]}
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			var err error
+			proof, err = types.CombineProofs([]types.MerkleProof{
+				suite.queryProof("MYKEY1"), suite.queryProof("MYKEY2"), suite.queryProof("MYKEY3"),
+			})
+			suite.Require().NoError(err)
+
+			root = cid.Hash
+			path = types.NewMerklePath(suite.storeKey.Name())
+			items = map[string][]byte{
+				"MYKEY1": []byte("MYVALUE1"),
+				"MYKEY2": []byte("MYVALUE2"),
+				"MYKEY3": []byte("MYVALUE3"),
+			}
+
+			tc.malleate()
+
+			merkleRoot := types.NewMerkleRoot(root)
+			err = proof.BatchVerifyMembership(types.GetSDKSpecs(), &merkleRoot, path, items)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MerkleTestSuite) TestBatchVerifyNonMembership() {
+	suite.iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+	suite.iavlStore.Set([]byte("MYKEY3"), []byte("MYVALUE3"))
+	suite.iavlStore.Set([]byte("MYKEY5"), []byte("MYVALUE5"))
+	cid := suite.store.Commit()
+
+	var (
+		proof types.MerkleProof
+		path  types.MerklePath
+		keys  [][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"existent key", func() {
+			keys = append(keys, []byte("MYKEY1"))
+		}, false},
+		{"key not part of the proof", func() {
+			keys = append(keys, []byte("MYKEY4"))
+		}, false},
+		{"no keys", func() {
+			keys = nil
+		}, false},
+		{"wrong storekey", func() {
+			path = types.NewMerklePath("otherStoreKey")
+		}, false},
+		{"existence proofs", func() {
+			var err error
+			proof, err = types.CombineProofs([]types.MerkleProof{suite.queryProof("MYKEY1"), suite.queryProof("MYKEY3")})
+			suite.Require().NoError(err)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			var err error
+			proof, err = types.CombineProofs([]types.MerkleProof{suite.queryProof("MYKEY0"), suite.queryProof("MYKEY2")})
+			suite.Require().NoError(err)
+
+			path = types.NewMerklePath(suite.storeKey.Name())
+			keys = [][]byte{[]byte("MYKEY0"), []byte("MYKEY2")}
+
+			tc.malleate()
+
+			root := types.NewMerkleRoot(cid.Hash)
+			err = proof.BatchVerifyNonMembership(types.GetSDKSpecs(), &root, path, keys)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func TestCombineProofs(t *testing.T) {
+	_, err := types.CombineProofs(nil)
+	require.Error(t, err)
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/cosmos/gogoproto/proto"
 	ics23 "github.com/cosmos/ics23/go"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,3 +28,37 @@ func ConvertProofs(tmProof *crypto.ProofOps) (MerkleProof, error) {
 		Proofs: proofs,
 	}, nil
 }
+
+// CombineProofs combines merkle proofs of several keys of the same subtree, queried at the same height,
+// into a single proof which may be verified with BatchVerifyMembership or BatchVerifyNonMembership.
+// The lowest proofs are combined into a compressed batch proof, the proofs of the higher subtrees must
+// be equal for all the given proofs.
+func CombineProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, errorsmod.Wrap(ErrInvalidMerkleProof, "cannot combine empty list of proofs")
+	}
+
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if len(proof.Proofs) == 0 || len(proof.Proofs) != len(proofs[0].Proofs) {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "proof at index %d has invalid length %d", i, len(proof.Proofs))
+		}
+
+		for j := 1; j < len(proof.Proofs); j++ {
+			if !proto.Equal(proof.Proofs[j], proofs[0].Proofs[j]) {
+				return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "proof at index %d does not share the subtree proof at index %d", i, j)
+			}
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	batch, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batch}, proofs[0].Proofs[1:]...),
+	}, nil
+}
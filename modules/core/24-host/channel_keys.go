@@ -10,6 +10,7 @@ const (
 	KeyUpgradeErrorPrefix      = "upgradeError"
 	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
 	KeyChannelCapabilityPrefix = "capabilities"
+	KeyChannelPausedPrefix     = "channelPaused"
 )
 
 // ICS04
@@ -61,6 +62,16 @@ func ChannelCounterpartyUpgradePath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID))
 }
 
+// ChannelPausedPath defines the path under which the paused flag of a channel is stored
+func ChannelPausedPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelPausedPrefix, channelPath(portID, channelID))
+}
+
+// ChannelPausedKey returns the store key for the paused flag of a particular channel
+func ChannelPausedKey(portID, channelID string) []byte {
+	return []byte(ChannelPausedPath(portID, channelID))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
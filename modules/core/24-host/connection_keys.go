@@ -2,7 +2,10 @@ package host
 
 import "fmt"
 
-const KeyConnectionPrefix = "connections"
+const (
+	KeyConnectionPrefix        = "connections"
+	KeyConnectionUpgradePrefix = "connectionUpgrades"
+)
 
 // ICS03
 // The following paths are the keys to the store as defined in https://github.com/cosmos/ibc/blob/master/spec/core/ics-003-connection-semantics#store-paths
@@ -26,3 +29,23 @@ func ConnectionPath(connectionID string) string {
 func ConnectionKey(connectionID string) []byte {
 	return []byte(ConnectionPath(connectionID))
 }
+
+// ConnectionUpgradeErrorPath defines the path under which the ErrorReceipt is stored in the case that a chain does not accept the proposed connection upgrade
+func ConnectionUpgradeErrorPath(connectionID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionUpgradePrefix, KeyUpgradeErrorPrefix, ConnectionPath(connectionID))
+}
+
+// ConnectionUpgradeErrorKey returns the store key for a particular connection used to store the ErrorReceipt in the case that a chain does not accept the proposed upgrade
+func ConnectionUpgradeErrorKey(connectionID string) []byte {
+	return []byte(ConnectionUpgradeErrorPath(connectionID))
+}
+
+// ConnectionUpgradePath defines the path which stores the information related to a connection upgrade attempt
+func ConnectionUpgradePath(connectionID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyConnectionUpgradePrefix, KeyUpgradePrefix, ConnectionPath(connectionID))
+}
+
+// ConnectionUpgradeKey returns the store key for a particular connection upgrade attempt
+func ConnectionUpgradeKey(connectionID string) []byte {
+	return []byte(ConnectionUpgradePath(connectionID))
+}
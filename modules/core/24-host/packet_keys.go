@@ -10,6 +10,11 @@ const (
 	KeyPacketCommitmentPrefix = "commitments"
 	KeyPacketAckPrefix        = "acks"
 	KeyPacketReceiptPrefix    = "receipts"
+	KeyReceiptTimeoutPrefix   = "receiptTimeouts"
+	KeyPendingAckPrefix       = "pendingAcks"
+	KeySendLifecyclePrefix    = "packetSendLifecycles"
+	KeyRecvLifecyclePrefix    = "packetRecvLifecycles"
+	KeyPacketSenderPrefix     = "packetSenders"
 	KeyPruningSequenceStart   = "pruningSequenceStart"
 	KeyRecvStartSequence      = "recvStartSequence"
 )
@@ -82,17 +87,104 @@ func PacketAcknowledgementPrefixPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyPacketAckPrefix, channelPath(portID, channelID), KeySequencePrefix)
 }
 
+// PendingAcknowledgementPath defines the path under which a packet whose acknowledgement
+// has not yet been written is stored
+func PendingAcknowledgementPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", PendingAcknowledgementPrefixPath(portID, channelID), sequence)
+}
+
+// PendingAcknowledgementKey returns the store key under which a packet whose
+// acknowledgement has not yet been written is stored
+func PendingAcknowledgementKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PendingAcknowledgementPath(portID, channelID, sequence))
+}
+
+// PendingAcknowledgementPrefixPath defines the prefix for the packets of a channel whose
+// acknowledgements have not yet been written.
+func PendingAcknowledgementPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPendingAckPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
+// PacketSendLifecyclePath defines the path under which the lifecycle of a packet sent on
+// a channel is recorded by the packet lifecycle index
+func PacketSendLifecyclePath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeySendLifecyclePrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketSendLifecycleKey returns the store key under which the lifecycle of a packet sent on a channel is recorded
+func PacketSendLifecycleKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketSendLifecyclePath(portID, channelID, sequence))
+}
+
+// PacketRecvLifecyclePath defines the path under which the lifecycle of a packet received on
+// a channel is recorded by the packet lifecycle index
+func PacketRecvLifecyclePath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyRecvLifecyclePrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketRecvLifecycleKey returns the store key under which the lifecycle of a packet received on a channel is recorded
+func PacketRecvLifecycleKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketRecvLifecyclePath(portID, channelID, sequence))
+}
+
+// PacketSenderPath defines the path under which a packet sent by the given sender is
+// indexed by the packet lifecycle index
+func PacketSenderPath(sender, portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", PacketSenderPrefixPath(sender), channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketSenderKey returns the store key under which a packet sent by the given sender is indexed
+func PacketSenderKey(sender, portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketSenderPath(sender, portID, channelID, sequence))
+}
+
+// PacketSenderPrefixPath defines the prefix under which the packets sent by the given sender are indexed
+func PacketSenderPrefixPath(sender string) string {
+	return fmt.Sprintf("%s/%s", KeyPacketSenderPrefix, sender)
+}
+
 // PacketReceiptPath defines the packet receipt store path
 func PacketReceiptPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/%s/%s", KeyPacketReceiptPrefix, channelPath(portID, channelID), sequencePath(sequence))
 }
 
 // PacketReceiptKey returns the store key of under which a packet
-// receipt is stored
+// receipt is stored. On ORDERED_ALLOW_TIMEOUT channels, a receipt is only
+// stored for packets which were received after their timeout elapsed.
 func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketReceiptPath(portID, channelID, sequence))
 }
 
+// PacketReceiptTimeoutPath defines the path under which the timeout of a packet received on an
+// UNORDERED channel is stored alongside its packet receipt
+func PacketReceiptTimeoutPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyReceiptTimeoutPrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
+// PacketReceiptTimeoutKey returns the store key under which the timeout of a received packet is stored
+func PacketReceiptTimeoutKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketReceiptTimeoutPath(portID, channelID, sequence))
+}
+
+// PacketTimeoutProofPath returns the path which must be proven on the receiving chain to time out
+// a packet sent on an ORDERED_ALLOW_TIMEOUT channel. If the next sequence receive is greater than
+// the packet sequence, the packet was received after its timeout elapsed and the timeout receipt
+// is proven. Otherwise, the next sequence receive is proven, which is only accepted when timing
+// out a packet after the counterparty channel has been closed.
+func PacketTimeoutProofPath(portID, channelID string, sequence, nextSequenceRecv uint64) string {
+	if nextSequenceRecv > sequence {
+		return PacketReceiptPath(portID, channelID, sequence)
+	}
+
+	return NextSequenceRecvPath(portID, channelID)
+}
+
+// PacketTimeoutProofKey returns the store key which must be proven on the receiving chain to time
+// out a packet sent on an ORDERED_ALLOW_TIMEOUT channel.
+func PacketTimeoutProofKey(portID, channelID string, sequence, nextSequenceRecv uint64) []byte {
+	return []byte(PacketTimeoutProofPath(portID, channelID, sequence, nextSequenceRecv))
+}
+
 // PruningSequenceStartPath defines the path under which the pruning sequence starting value is stored
 func PruningSequenceStartPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyPruningSequenceStart, channelPath(portID, channelID))
@@ -9,8 +9,6 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
-	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
-	tendermint "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
 type RedundantRelayDecorator struct {
@@ -21,8 +19,9 @@ func NewRedundantRelayDecorator(k *keeper.Keeper) RedundantRelayDecorator {
 	return RedundantRelayDecorator{k: k}
 }
 
-// RedundantRelayDecorator returns an error if a multiMsg tx only contains packet messages (Recv, Ack, Timeout) and additional update messages
-// and all packet messages are redundant. If the transaction is just a single UpdateClient message, or the multimsg transaction
+// RedundantRelayDecorator returns an error if a multiMsg tx only contains packet messages (Recv, Ack, Timeout and their batched
+// variants) and additional update messages and all packet messages are redundant. Every packet of a batched message is
+// counted as a separate packet message. If the transaction is just a single UpdateClient message, or the multimsg transaction
 // contains some other message type, then the antedecorator returns no error and continues processing to ensure these transactions
 // are included. This will ensure that relayers do not waste fees on multiMsg transactions when another relayer has already submitted
 // all packets, by rejecting the tx at the mempool layer.
@@ -86,11 +85,51 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				packetMsgs++
 
+			case *channeltypes.MsgRecvPackets:
+				var (
+					results []channeltypes.ResponseResultType
+					err     error
+				)
+				if ctx.IsReCheckTx() {
+					results, err = rrd.recvPacketsReCheckTx(ctx, msg)
+				} else {
+					results, err = rrd.recvPacketsCheckTx(ctx, msg)
+				}
+				if err != nil {
+					return ctx, err
+				}
+
+				redundancies += countRedundancies(results)
+				packetMsgs += len(results)
+
+			case *channeltypes.MsgAcknowledgements:
+				response, err := rrd.k.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
+			case *channeltypes.MsgTimeouts:
+				response, err := rrd.k.Timeouts(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
 			case *clienttypes.MsgUpdateClient:
 				if err := rrd.updateClientCheckTx(ctx, msg); err != nil {
 					return ctx, err
 				}
 
+			case *clienttypes.MsgUpdateClientBatch:
+				if err := rrd.updateClientBatchCheckTx(ctx, msg); err != nil {
+					return ctx, err
+				}
+
 			default:
 				// if the multiMsg tx has a msg that is not a packet msg or update msg, then we will not return error
 				// regardless of if all packet messages are redundant. This ensures that non-packet messages get processed
@@ -109,41 +148,70 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // updateClientCheckTx runs a subset of ibc client update logic to be used specifically within the RedundantRelayDecorator AnteHandler.
 // The following function performs ibc client message verification for CheckTx only and state updates in both CheckTx and ReCheckTx.
-// Note that misbehaviour checks are omitted.
+// Note that misbehaviour is never applied to the client state.
 func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *clienttypes.MsgUpdateClient) error {
 	clientMsg, err := clienttypes.UnpackClientMessage(msg.ClientMessage)
 	if err != nil {
 		return err
 	}
 
-	clientState, found := rrd.k.ClientKeeper.GetClientState(ctx, msg.ClientId)
+	return rrd.applyClientMessagesCheckTx(ctx, msg.ClientId, []exported.ClientMessage{clientMsg})
+}
+
+// updateClientBatchCheckTx runs the same subset of ibc client update logic as updateClientCheckTx for every client message of a
+// MsgUpdateClientBatch, in order.
+func (rrd RedundantRelayDecorator) updateClientBatchCheckTx(ctx sdk.Context, msg *clienttypes.MsgUpdateClientBatch) error {
+	clientMsgs := make([]exported.ClientMessage, len(msg.ClientMessages))
+	for i, anyClientMsg := range msg.ClientMessages {
+		clientMsg, err := clienttypes.UnpackClientMessage(anyClientMsg)
+		if err != nil {
+			return err
+		}
+
+		clientMsgs[i] = clientMsg
+	}
+
+	return rrd.applyClientMessagesCheckTx(ctx, msg.ClientId, clientMsgs)
+}
+
+// applyClientMessagesCheckTx verifies (in CheckTx only) and applies the client messages to the client in order. Client messages
+// implementing exported.ClientMessageBatch are expanded into the client messages they contain. Processing stops at the first
+// client message detected as misbehaviour, which is left to be processed in DeliverTx.
+func (rrd RedundantRelayDecorator) applyClientMessagesCheckTx(ctx sdk.Context, clientID string, clientMsgs []exported.ClientMessage) error {
+	clientMsgs, err := clienttypes.FlattenClientMessages(clientMsgs)
+	if err != nil {
+		return err
+	}
+
+	if _, found := rrd.k.ClientKeeper.GetClientState(ctx, clientID); !found {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	lightClientModule, found := rrd.k.ClientKeeper.Route(clientID)
 	if !found {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotFound, msg.ClientId)
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
 	}
 
-	if status := rrd.k.ClientKeeper.GetClientStatus(ctx, clientState, msg.ClientId); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot update client (%s) with status %s", msg.ClientId, status)
+	if status := rrd.k.ClientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
-	clientStore := rrd.k.ClientKeeper.ClientStore(ctx, msg.ClientId)
+	for _, clientMsg := range clientMsgs {
+		if !ctx.IsReCheckTx() {
+			if err := lightClientModule.VerifyClientMessage(ctx, clientID, clientMsg); err != nil {
+				return err
+			}
+		}
 
-	if !ctx.IsReCheckTx() {
-		if err := clientState.VerifyClientMessage(ctx, rrd.k.Codec(), clientStore, clientMsg); err != nil {
-			return err
+		// client messages detected as misbehaviour are left to be processed in DeliverTx
+		if lightClientModule.CheckForMisbehaviour(ctx, clientID, clientMsg) {
+			return nil
 		}
-	}
 
-	// NOTE: the following avoids panics in ante handler client updates for ibc-go v8.3.x
-	// without state machine breaking changes within light client modules.
-	switch clientMsg.(type) {
-	case *solomachine.Misbehaviour:
-		// ignore solomachine misbehaviour for update state in ante
-	case *tendermint.Misbehaviour:
-		// ignore tendermint misbehaviour for update state in ante
-	default:
-		heights := clientState.UpdateState(ctx, rrd.k.Codec(), clientStore, clientMsg)
+		heights := lightClientModule.UpdateState(ctx, clientID, clientMsg)
 		ctx.Logger().With("module", "x/"+exported.ModuleName).Debug("ante ibc client update", "consensusHeights", heights)
 	}
+
 	return nil
 }
 
@@ -192,3 +260,53 @@ func (rrd RedundantRelayDecorator) recvPacketReCheckTx(ctx sdk.Context, msg *cha
 
 	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 }
+
+// recvPacketsCheckTx runs a subset of ibc recv packets logic to be used specifically within the RedundantRelayDecorator AnteHandler.
+// It only performs core IBC receiving logic and skips any application logic.
+func (rrd RedundantRelayDecorator) recvPacketsCheckTx(ctx sdk.Context, msg *channeltypes.MsgRecvPackets) ([]channeltypes.ResponseResultType, error) {
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// grab channel capability
+	_, capability, err := rrd.k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// packets which were already received are no-ops, state changes of these packets are discarded
+	results, err := rrd.k.ChannelKeeper.RecvPackets(ctx, capability, msg.Packets, msg.ProofCommitments, msg.ProofHeight)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "receive packets verification failed")
+	}
+
+	return results, nil
+}
+
+// recvPacketsReCheckTx runs a subset of ibc recv packets logic to be used specifically within the RedundantRelayDecorator AnteHandler.
+// It only performs core IBC receiving logic and skips any application logic.
+func (rrd RedundantRelayDecorator) recvPacketsReCheckTx(ctx sdk.Context, msg *channeltypes.MsgRecvPackets) ([]channeltypes.ResponseResultType, error) {
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		response, err := rrd.recvPacketReCheckTx(ctx, &channeltypes.MsgRecvPacket{Packet: packet})
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = response.Result
+	}
+
+	return results, nil
+}
+
+// countRedundancies returns the number of no-op results of a batched packet message.
+func countRedundancies(results []channeltypes.ResponseResultType) int {
+	redundancies := 0
+	for _, result := range results {
+		if result == channeltypes.NOOP {
+			redundancies++
+		}
+	}
+
+	return redundancies
+}
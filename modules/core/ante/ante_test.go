@@ -178,6 +178,63 @@ func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
 	return msg
 }
 
+// createUpdateClientBatchMessage creates a MsgUpdateClientBatch message with three sequential headers,
+// each trusted at the height of the previous one.
+func (suite *AnteTestSuite) createUpdateClientBatchMessage() sdk.Msg {
+	endpoint := suite.path.EndpointB
+	trustedHeight := endpoint.GetClientState().GetLatestHeight().(clienttypes.Height)
+
+	var clientMsgs []exported.ClientMessage
+	for i := 0; i < 3; i++ {
+		// ensure counterparty has committed state
+		endpoint.Chain.Coordinator.CommitBlock(endpoint.Counterparty.Chain)
+
+		header, err := endpoint.Chain.ConstructUpdateTMClientHeaderWithTrustedHeight(endpoint.Counterparty.Chain, endpoint.ClientID, trustedHeight)
+		suite.Require().NoError(err)
+
+		clientMsgs = append(clientMsgs, header)
+		trustedHeight = header.GetHeight().(clienttypes.Height)
+	}
+
+	msg, err := clienttypes.NewMsgUpdateClientBatch(endpoint.ClientID, clientMsgs, endpoint.Chain.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	return msg
+}
+
+// createRecvPacketsMessage creates a MsgRecvPackets message for three packets sent from chainA to chainB,
+// the given number of which have already been received.
+func (suite *AnteTestSuite) createRecvPacketsMessage(redundant int) *channeltypes.MsgRecvPackets {
+	var (
+		packets []channeltypes.Packet
+		keys    [][]byte
+	)
+	for i := 0; i < 3; i++ {
+		sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			clienttypes.NewHeight(2, 0), 0)
+
+		packets = append(packets, packet)
+		keys = append(keys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+
+	for _, packet := range packets[:redundant] {
+		err := suite.path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		err = suite.path.EndpointB.UpdateClient()
+		suite.Require().NoError(err)
+	}
+
+	proof, proofHeight := suite.chainA.QueryBatchProofAtHeight(keys, int64(suite.path.EndpointB.GetClientState().GetLatestHeight().GetRevisionHeight()))
+
+	return channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 	testCases := []struct {
 		name     string
@@ -319,6 +376,20 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			true,
 		},
+		{
+			"success on one new UpdateClientBatch message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientBatchMessage()}
+			},
+			true,
+		},
+		{
+			"success on one new UpdateClientBatch message and one new RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientBatchMessage(), suite.createRecvPacketMessage(false)}
+			},
+			true,
+		},
 		{
 			"success on three new Updateclient messages and one new RecvPacket message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -425,6 +496,20 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			false,
 		},
+		{
+			"success on RecvPackets message with some redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(2)}
+			},
+			true,
+		},
+		{
+			"no success on RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(3)}
+			},
+			false,
+		},
 		{
 			"no success on three redundant messages of each type",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -474,6 +559,30 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			false,
 		},
+		{
+			"no success on one new UpdateClientBatch message: invalid client identifier",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				clientMsg, err := codectypes.NewAnyWithValue(&ibctm.Header{})
+				suite.Require().NoError(err)
+
+				msgs := []sdk.Msg{&clienttypes.MsgUpdateClientBatch{ClientId: ibctesting.InvalidID, ClientMessages: []*codectypes.Any{clientMsg}}}
+				return msgs
+			},
+			false,
+		},
+		{
+			"no success on one new UpdateClientBatch message and three redundant RecvPacket messages",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msgs := []sdk.Msg{suite.createUpdateClientBatchMessage()}
+
+				for i := 1; i <= 3; i++ {
+					msgs = append(msgs, suite.createRecvPacketMessage(true))
+				}
+
+				return msgs
+			},
+			false,
+		},
 		{
 			"no success on one new UpdateClient message: client module not found",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -661,6 +770,16 @@ func (suite *AnteTestSuite) TestAnteDecoratorReCheckTx() {
 			},
 			channeltypes.ErrRedundantTx,
 		},
+		{
+			"no success on one new RecvPacket message on a paused channel",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createRecvPacketMessage(false)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannelPaused(suite.chainB.GetContext(), msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+
+				return []sdk.Msg{msg}
+			},
+			channeltypes.ErrChannelPaused,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -22,6 +22,9 @@ const (
 	// Tendermint is used to indicate that the client uses the Tendermint Consensus Algorithm.
 	Tendermint string = "07-tendermint"
 
+	// Wasm is used to indicate that the light client is a on-chain wasm program
+	Wasm string = "08-wasm"
+
 	// Localhost is the client type for the localhost client.
 	Localhost string = "09-localhost"
 
@@ -44,6 +47,118 @@ const (
 	Unauthorized Status = "Unauthorized"
 )
 
+// ClientStoreProvider is an interface which gives access to the client prefixed stores.
+// It is used by light client modules to read and write the state of the clients they handle.
+type ClientStoreProvider interface {
+	// ClientStore returns the prefixed store of the client with the given identifier.
+	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
+}
+
+// LightClientModule is the interface core IBC uses to interact with a light client type. Core IBC
+// routes every client operation to the module registered for the client type of the client
+// identifier, so that each light client module is responsible for its own store access and
+// dependencies.
+type LightClientModule interface {
+	// Initialize is called upon client creation, it allows the client to perform validation on the initial consensus state and set the
+	// client state, consensus state and any client-specific metadata necessary for correct light client operation in its client store.
+	Initialize(ctx sdk.Context, clientID string, clientState ClientState, consensusState ConsensusState) error
+
+	// VerifyClientMessage must verify a ClientMessage. A ClientMessage could be a Header, Misbehaviour, or batch update.
+	// It must handle each type of ClientMessage appropriately. Calls to CheckForMisbehaviour, UpdateState, and UpdateStateOnMisbehaviour
+	// will assume that the content of the ClientMessage has been verified and can be trusted. An error should be returned
+	// if the ClientMessage fails to verify.
+	VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg ClientMessage) error
+
+	// CheckForMisbehaviour checks for evidence of a misbehaviour in Header or Misbehaviour type. It assumes the ClientMessage
+	// has already been verified.
+	CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg ClientMessage) bool
+
+	// UpdateStateOnMisbehaviour should perform appropriate state changes on a client state given that misbehaviour has been detected and verified.
+	UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg ClientMessage)
+
+	// UpdateState updates and stores as necessary any associated information for an IBC client, such as the ClientState and corresponding ConsensusState.
+	// Upon successful update, a list of consensus heights is returned. It assumes the ClientMessage has already been verified.
+	UpdateState(ctx sdk.Context, clientID string, clientMsg ClientMessage) []Height
+
+	// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+	// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+	VerifyMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		value []byte,
+	) error
+
+	// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
+	// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+	VerifyNonMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+	) error
+
+	// Status must return the status of the client. Only Active clients are allowed to process packets.
+	Status(ctx sdk.Context, clientID string) Status
+
+	// TimestampAtHeight must return the timestamp for the consensus state associated with the provided height.
+	TimestampAtHeight(ctx sdk.Context, clientID string, height Height) (uint64, error)
+
+	// RecoverClient must verify that the provided substitute may be used to update the subject client.
+	// The light client module must set the updated client and consensus states within the client store of the subject client.
+	RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error
+
+	// VerifyUpgradeAndUpdateState verifies the upgraded client and consensus states committed to by the client.
+	// If the upgrade is verified, the upgraded client and consensus states must be set in the client store.
+	VerifyUpgradeAndUpdateState(
+		ctx sdk.Context,
+		clientID string,
+		newClient ClientState,
+		newConsState ConsensusState,
+		upgradeClientProof,
+		upgradeConsensusStateProof []byte,
+	) error
+}
+
+// BatchVerifierModule is an optional interface implemented by light client modules which support the
+// verification of several key/value pairs of the same store with a single proof.
+type BatchVerifierModule interface {
+	// BatchVerifyMembership verifies a single proof of the existence of the given values at the specified height.
+	// The path is the CommitmentPath of the store containing the items, and the keys of the items are the
+	// standardized paths (as defined in ICS 24) within this store.
+	BatchVerifyMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		items map[string][]byte,
+	) error
+
+	// BatchVerifyNonMembership verifies a single proof of the absence of the given keys at the specified height.
+	// The path is the CommitmentPath of the store, and the keys are the standardized paths (as defined in ICS 24)
+	// within this store.
+	BatchVerifyNonMembership(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		keys [][]byte,
+	) error
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -141,6 +256,40 @@ type ClientState interface {
 	) error
 }
 
+// BatchVerifier is an optional interface implemented by light clients which support the verification of
+// several key/value pairs of the same store with a single proof.
+type BatchVerifier interface {
+	// BatchVerifyMembership verifies a single proof of the existence of the given values at the specified height.
+	// The path is the CommitmentPath of the store containing the items, and the keys of the items are the
+	// standardized paths (as defined in ICS 24) within this store.
+	BatchVerifyMembership(
+		ctx sdk.Context,
+		clientStore storetypes.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		items map[string][]byte,
+	) error
+
+	// BatchVerifyNonMembership verifies a single proof of the absence of the given keys at the specified height.
+	// The path is the CommitmentPath of the store, and the keys are the standardized paths (as defined in ICS 24)
+	// within this store.
+	BatchVerifyNonMembership(
+		ctx sdk.Context,
+		clientStore storetypes.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		keys [][]byte,
+	) error
+}
+
 // ConsensusState is the state of the consensus process
 type ConsensusState interface {
 	proto.Message
@@ -163,6 +312,16 @@ type ClientMessage interface {
 	ValidateBasic() error
 }
 
+// ClientMessageBatch is an optional interface a ClientMessage may implement to be expanded by 02-client into
+// an ordered list of client messages. The client messages are applied sequentially, each one being verified
+// against the client state produced by the previous one.
+type ClientMessageBatch interface {
+	ClientMessage
+
+	// GetClientMessages returns the ordered list of client messages contained in the batch.
+	GetClientMessages() ([]ClientMessage, error)
+}
+
 // Height is a wrapper interface over clienttypes.Height
 // all clients must use the concrete implementation in types
 type Height interface {
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"slices"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ExpirePendingAcknowledgements expires the acknowledgements which have been pending for at least the maximum
+// duration configured for their destination port, so that packets are not stuck forever when an application fails
+// to acknowledge them asynchronously. The application reverts the receipt of each packet in its OnAcknowledgementExpired
+// callback and returns the error acknowledgement to be written. If the application does not implement the
+// AcknowledgementExpiryModule interface, an error acknowledgement is written without reverting the receipt of the packet.
+// At most MaxAcknowledgementExpiriesPerBlock acknowledgements are expired per block, oldest first for each port.
+func (k *Keeper) ExpirePendingAcknowledgements(ctx sdk.Context) {
+	limit := channeltypes.MaxAcknowledgementExpiriesPerBlock
+	for _, expiry := range k.ChannelKeeper.GetParams(ctx).AcknowledgementExpiries {
+		if limit <= 0 {
+			return
+		}
+
+		cbs, err := k.getPortApplication(ctx, expiry.PortId)
+		if err != nil {
+			ctx.Logger().Error("acknowledgement expiry skipped", "port_id", expiry.PortId, "error", err)
+			continue
+		}
+
+		for _, pendingAck := range k.ChannelKeeper.GetExpiredPendingAcknowledgements(ctx, expiry, limit) {
+			k.expirePendingAcknowledgement(ctx, cbs, pendingAck)
+			limit--
+		}
+	}
+}
+
+// getPortApplication returns the application bound to the given port.
+func (k *Keeper) getPortApplication(ctx sdk.Context, portID string) (porttypes.IBCModule, error) {
+	module, _, err := k.PortKeeper.LookupModuleByPort(ctx, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	app, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	return app, nil
+}
+
+// onAcknowledgementExpired returns the error acknowledgement to be written for the packet of an expired acknowledgement.
+// The OnAcknowledgementExpired callback is called if the application implements the AcknowledgementExpiryModule interface,
+// otherwise the state changes made on the receipt of the packet are not reverted.
+func onAcknowledgementExpired(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet) (exported.Acknowledgement, error) {
+	expiryModule, ok := cbs.(porttypes.AcknowledgementExpiryModule)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired), nil
+	}
+
+	return expiryModule.OnAcknowledgementExpired(ctx, packet)
+}
+
+// expirePendingAcknowledgement writes the error acknowledgement returned by the application for the packet of the given
+// pending acknowledgement. If the application fails to revert the receipt of the packet, the acknowledgement remains
+// pending and is removed from the expiry queue. If the channel is closed, the packet is removed from the pending acknowledgements.
+func (k *Keeper) expirePendingAcknowledgement(ctx sdk.Context, cbs porttypes.IBCModule, pendingAck channeltypes.PendingAcknowledgement) {
+	packet := pendingAck.Packet
+
+	channel, found := k.ChannelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found || !slices.Contains([]channeltypes.State{channeltypes.OPEN, channeltypes.FLUSHING, channeltypes.FLUSHCOMPLETE}, channel.State) {
+		k.ChannelKeeper.DeletePendingAcknowledgement(ctx, pendingAck)
+
+		ctx.Logger().Info(
+			"pending acknowledgement removed",
+			"sequence", strconv.FormatUint(packet.Sequence, 10),
+			"dst_port", packet.DestinationPort,
+			"dst_channel", packet.DestinationChannel,
+		)
+
+		return
+	}
+
+	// the state changes of the application are only committed if the acknowledgement is written
+	cacheCtx, writeFn := ctx.CacheContext()
+	ack, err := onAcknowledgementExpired(cacheCtx, cbs, packet)
+	if err == nil {
+		err = k.ChannelKeeper.WriteExpiredAcknowledgement(cacheCtx, packet, ack)
+	}
+
+	if err != nil {
+		ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
+
+		// the expiry is not attempted again, so that it does not occupy the expiries of every block
+		k.ChannelKeeper.DequeuePendingAcknowledgement(ctx, pendingAck)
+
+		ctx.Logger().Error("acknowledgement expiry failed", "sequence", packet.Sequence, "dst_port", packet.DestinationPort, "dst_channel", packet.DestinationChannel, "error", err)
+
+		return
+	}
+
+	writeFn()
+
+	ctx.Logger().Info(
+		"acknowledgement expired",
+		"sequence", strconv.FormatUint(packet.Sequence, 10),
+		"dst_port", packet.DestinationPort,
+		"dst_channel", packet.DestinationChannel,
+	)
+}
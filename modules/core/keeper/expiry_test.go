@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestExpirePendingAcknowledgements() {
+	var (
+		path       *ibctesting.Path
+		pendingAck channeltypes.PendingAcknowledgement
+	)
+
+	maxPendingDuration := uint64(time.Hour.Nanoseconds())
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expPending bool
+		expQueued  bool
+		expAck     bool
+	}{
+		{
+			"success: error acknowledgement written",
+			func() {},
+			false,
+			false,
+			true,
+		},
+		{
+			"acknowledgement not yet expired",
+			func() {
+				pendingAck.ReceivedAt++
+			},
+			true,
+			true,
+			false,
+		},
+		{
+			"no acknowledgement expiry configured for port",
+			func() {
+				params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+				params.AcknowledgementExpiries = nil
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+			true,
+			false,
+		},
+		{
+			"acknowledgement expiry configured for another port",
+			func() {
+				params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+				params.AcknowledgementExpiries = []channeltypes.AcknowledgementExpiry{channeltypes.NewAcknowledgementExpiry(ibctesting.TransferPort, 1)}
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+			true,
+			false,
+		},
+		{
+			"channel closed: pending acknowledgement removed",
+			func() {
+				suite.Require().NoError(path.EndpointB.SetChannelState(channeltypes.CLOSED))
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"application callback fails: acknowledgement remains pending",
+			func() {
+				suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementExpired = func(ctx sdk.Context, packet channeltypes.Packet) (exported.Acknowledgement, error) {
+					return nil, errors.New("failed to revert packet receipt")
+				}
+			},
+			true,
+			false,
+			false,
+		},
+		{
+			"application returns successful acknowledgement: acknowledgement remains pending",
+			func() {
+				suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementExpired = func(ctx sdk.Context, packet channeltypes.Packet) (exported.Acknowledgement, error) {
+					return ibcmock.MockAcknowledgement, nil
+				}
+			},
+			true,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+			params.AcknowledgementExpiries = []channeltypes.AcknowledgementExpiry{channeltypes.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, maxPendingDuration)}
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(ibcmock.MockAsyncPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+			pendingAck = channeltypes.NewPendingAcknowledgement(packet, uint64(suite.chainB.GetContext().BlockTime().UnixNano())-maxPendingDuration)
+
+			tc.malleate()
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), pendingAck)
+
+			suite.chainB.App.GetIBCKeeper().ExpirePendingAcknowledgements(suite.chainB.GetContext())
+
+			_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().Equal(tc.expPending, found)
+
+			// advance the block time so that the pending acknowledgement has expired for every test case
+			ctx := suite.chainB.GetContext().WithBlockTime(suite.chainB.GetContext().BlockTime().Add(time.Hour))
+			expiry := channeltypes.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, maxPendingDuration)
+			queued := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetExpiredPendingAcknowledgements(ctx, expiry, 1)
+			suite.Require().Equal(tc.expQueued, len(queued) == 1)
+
+			ackCommitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().Equal(tc.expAck, found)
+
+			if tc.expAck {
+				expAck := channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired)
+				suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackCommitment)
+			}
+		})
+	}
+}
+
+// TestExpirePendingAcknowledgementsUnsupportedApplication tests that core IBC writes the error acknowledgement
+// of applications which do not implement the AcknowledgementExpiryModule interface.
+func (suite *KeeperTestSuite) TestExpirePendingAcknowledgementsUnsupportedApplication() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = ibcmock.MockBlockUpgrade
+	path.EndpointB.ChannelConfig.PortID = ibcmock.MockBlockUpgrade
+	suite.coordinator.Setup(path)
+
+	maxPendingDuration := uint64(time.Hour.Nanoseconds())
+	params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+	params.AcknowledgementExpiries = []channeltypes.AcknowledgementExpiry{channeltypes.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, maxPendingDuration)}
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(ibcmock.MockAsyncPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), channeltypes.NewPendingAcknowledgement(packet, uint64(suite.chainB.GetContext().BlockTime().UnixNano())-maxPendingDuration))
+
+	suite.chainB.App.GetIBCKeeper().ExpirePendingAcknowledgements(suite.chainB.GetContext())
+
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	ackCommitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	expAck := channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackCommitment)
+}
+
+// TestExpirePendingAcknowledgementsTransfer tests that the expiry of the acknowledgement of a packet whose tokens
+// are forwarded is rejected by the transfer application, through the middleware wrapping it.
+func (suite *KeeperTestSuite) TestExpirePendingAcknowledgementsTransfer() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	maxPendingDuration := uint64(time.Hour.Nanoseconds())
+	params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+	params.AcknowledgementExpiries = []channeltypes.AcknowledgementExpiry{channeltypes.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, maxPendingDuration)}
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+	pendingAck := channeltypes.NewPendingAcknowledgement(packet, uint64(suite.chainB.GetContext().BlockTime().UnixNano())-maxPendingDuration)
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), pendingAck)
+
+	suite.chainB.App.GetIBCKeeper().ExpirePendingAcknowledgements(suite.chainB.GetContext())
+
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the expiry is not attempted again
+	ctx := suite.chainB.GetContext().WithBlockTime(suite.chainB.GetContext().BlockTime().Add(time.Hour))
+	suite.Require().Empty(suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetExpiredPendingAcknowledgements(ctx, params.AcknowledgementExpiries[0], 1))
+}
+
+// TestExpirePendingAcknowledgementsLimit tests that at most MaxAcknowledgementExpiriesPerBlock
+// acknowledgements are expired per block.
+func (suite *KeeperTestSuite) TestExpirePendingAcknowledgementsLimit() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	maxPendingDuration := uint64(time.Hour.Nanoseconds())
+
+	params := channelKeeper.GetParams(suite.chainB.GetContext())
+	params.AcknowledgementExpiries = []channeltypes.AcknowledgementExpiry{channeltypes.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, maxPendingDuration)}
+	channelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	receivedAt := uint64(suite.chainB.GetContext().BlockTime().UnixNano()) - maxPendingDuration
+	for sequence := uint64(1); sequence <= channeltypes.MaxAcknowledgementExpiriesPerBlock+1; sequence++ {
+		packet := channeltypes.NewPacket(ibcmock.MockAsyncPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+		channelKeeper.SetPendingAcknowledgement(suite.chainB.GetContext(), channeltypes.NewPendingAcknowledgement(packet, receivedAt))
+	}
+
+	suite.chainB.App.GetIBCKeeper().ExpirePendingAcknowledgements(suite.chainB.GetContext())
+	suite.Require().Len(channelKeeper.GetAllPendingAcknowledgements(suite.chainB.GetContext()), 1)
+
+	suite.chainB.App.GetIBCKeeper().ExpirePendingAcknowledgements(suite.chainB.GetContext())
+	suite.Require().Empty(channelKeeper.GetAllPendingAcknowledgements(suite.chainB.GetContext()))
+}
+
+// TestAsyncAcknowledgementExpiry tests that the acknowledgement of a packet received asynchronously
+// is written by the end blocker once its maximum pending duration elapsed.
+func (suite *KeeperTestSuite) TestAsyncAcknowledgementExpiry() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+	params.AcknowledgementExpiries = []channeltypes.AcknowledgementExpiry{channeltypes.NewAcknowledgementExpiry(path.EndpointB.ChannelConfig.PortID, uint64(time.Hour.Nanoseconds()))}
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	timeoutHeight := suite.chainA.GetTimeoutHeight()
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibcmock.MockAsyncPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibcmock.MockAsyncPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the expired acknowledgement can be relayed back to the sending chain
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.AcknowledgePacket(packet, channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired).Acknowledgement())
+	suite.Require().NoError(err)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -58,6 +60,43 @@ func (k Keeper) VerifyMembership(c context.Context, req *clienttypes.QueryVerify
 	return k.ClientKeeper.VerifyMembership(c, req)
 }
 
+// ExpiringClients implements the IBC QueryServer interface. The connections built on each client
+// and the channels built on these connections are added to the client expiry returned by the client keeper.
+func (k Keeper) ExpiringClients(c context.Context, req *clienttypes.QueryExpiringClientsRequest) (*clienttypes.QueryExpiringClientsResponse, error) {
+	res, err := k.ClientKeeper.ExpiringClients(c, req)
+	if err != nil || len(res.Clients) == 0 {
+		return res, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	connectionChannels := make(map[string][]clienttypes.DependentChannel)
+	k.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		connectionID := channel.ConnectionHops[0]
+		connectionChannels[connectionID] = append(connectionChannels[connectionID], clienttypes.DependentChannel{
+			PortId:       channel.PortId,
+			ChannelId:    channel.ChannelId,
+			ConnectionId: connectionID,
+		})
+		return false
+	})
+
+	for i, client := range res.Clients {
+		connectionIDs, _ := k.ConnectionKeeper.GetClientConnectionPaths(ctx, client.ClientId)
+		for _, connectionID := range connectionIDs {
+			res.Clients[i].Channels = append(res.Clients[i].Channels, connectionChannels[connectionID]...)
+		}
+		res.Clients[i].ConnectionIds = connectionIDs
+	}
+
+	return res, nil
+}
+
+// ClientStorage implements the IBC QueryServer interface
+func (k Keeper) ClientStorage(c context.Context, req *clienttypes.QueryClientStorageRequest) (*clienttypes.QueryClientStorageResponse, error) {
+	return k.ClientKeeper.ClientStorage(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (k Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return k.ConnectionKeeper.Connection(c, req)
@@ -138,6 +177,26 @@ func (k Keeper) PacketAcknowledgements(c context.Context, req *channeltypes.Quer
 	return k.ChannelKeeper.PacketAcknowledgements(c, req)
 }
 
+// PendingAcknowledgement implements the IBC QueryServer interface
+func (k Keeper) PendingAcknowledgement(c context.Context, req *channeltypes.QueryPendingAcknowledgementRequest) (*channeltypes.QueryPendingAcknowledgementResponse, error) {
+	return k.ChannelKeeper.PendingAcknowledgement(c, req)
+}
+
+// PendingAcknowledgements implements the IBC QueryServer interface
+func (k Keeper) PendingAcknowledgements(c context.Context, req *channeltypes.QueryPendingAcknowledgementsRequest) (*channeltypes.QueryPendingAcknowledgementsResponse, error) {
+	return k.ChannelKeeper.PendingAcknowledgements(c, req)
+}
+
+// PacketLifecycle implements the IBC QueryServer interface
+func (k Keeper) PacketLifecycle(c context.Context, req *channeltypes.QueryPacketLifecycleRequest) (*channeltypes.QueryPacketLifecycleResponse, error) {
+	return k.ChannelKeeper.PacketLifecycle(c, req)
+}
+
+// SenderPacketLifecycles implements the IBC QueryServer interface
+func (k Keeper) SenderPacketLifecycles(c context.Context, req *channeltypes.QuerySenderPacketLifecyclesRequest) (*channeltypes.QuerySenderPacketLifecyclesResponse, error) {
+	return k.ChannelKeeper.SenderPacketLifecycles(c, req)
+}
+
 // UnreceivedPackets implements the IBC QueryServer interface
 func (k Keeper) UnreceivedPackets(c context.Context, req *channeltypes.QueryUnreceivedPacketsRequest) (*channeltypes.QueryUnreceivedPacketsResponse, error) {
 	return k.ChannelKeeper.UnreceivedPackets(c, req)
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestExpiringClients() {
+	// a client with a connection and a channel
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// a client without connections
+	clientPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(clientPath)
+
+	res, err := suite.chainA.App.GetIBCKeeper().ExpiringClients(suite.chainA.GetContext(), &clienttypes.QueryExpiringClientsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Clients, 2)
+
+	for _, client := range res.Clients {
+		switch client.ClientId {
+		case path.EndpointA.ClientID:
+			suite.Require().Equal([]string{path.EndpointA.ConnectionID}, client.ConnectionIds)
+			suite.Require().Equal([]clienttypes.DependentChannel{{
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+				ChannelId:    path.EndpointA.ChannelID,
+				ConnectionId: path.EndpointA.ConnectionID,
+			}}, client.Channels)
+		case clientPath.EndpointA.ClientID:
+			suite.Require().Empty(client.ConnectionIds)
+			suite.Require().Empty(client.Channels)
+		default:
+			suite.Failf("unexpected client", "client %s", client.ClientId)
+		}
+	}
+
+	// no client expires within an hour
+	res, err = suite.chainA.App.GetIBCKeeper().ExpiringClients(suite.chainA.GetContext(), &clienttypes.QueryExpiringClientsRequest{Within: time.Hour})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Clients)
+}
@@ -10,12 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
-	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
@@ -62,6 +63,27 @@ func (k Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateCl
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
+// UpdateClientBatch defines a rpc handler method for MsgUpdateClientBatch.
+func (k Keeper) UpdateClientBatch(goCtx context.Context, msg *clienttypes.MsgUpdateClientBatch) (*clienttypes.MsgUpdateClientBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientMsgs := make([]exported.ClientMessage, len(msg.ClientMessages))
+	for i, anyClientMsg := range msg.ClientMessages {
+		clientMsg, err := clienttypes.UnpackClientMessage(anyClientMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		clientMsgs[i] = clientMsg
+	}
+
+	if err := k.ClientKeeper.UpdateClientBatch(ctx, msg.ClientId, clientMsgs); err != nil {
+		return nil, err
+	}
+
+	return &clienttypes.MsgUpdateClientBatchResponse{}, nil
+}
+
 // UpgradeClient defines a rpc handler method for MsgUpgradeClient.
 func (k Keeper) UpgradeClient(goCtx context.Context, msg *clienttypes.MsgUpgradeClient) (*clienttypes.MsgUpgradeClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -188,6 +210,169 @@ func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontype
 	return &connectiontypes.MsgConnectionOpenConfirmResponse{}, nil
 }
 
+// ConnectionCloseInit defines a rpc handler method for MsgConnectionCloseInit.
+func (k Keeper) ConnectionCloseInit(goCtx context.Context, msg *connectiontypes.MsgConnectionCloseInit) (*connectiontypes.MsgConnectionCloseInitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.ChannelKeeper.HasActiveChannels(ctx, msg.ConnectionId) {
+		return nil, errorsmod.Wrapf(connectiontypes.ErrChannelsNotClosed, "connection (%s)", msg.ConnectionId)
+	}
+
+	if err := k.ConnectionKeeper.ConnCloseInit(ctx, msg.ConnectionId); err != nil {
+		return nil, errorsmod.Wrap(err, "connection handshake close init failed")
+	}
+
+	return &connectiontypes.MsgConnectionCloseInitResponse{}, nil
+}
+
+// ConnectionCloseConfirm defines a rpc handler method for MsgConnectionCloseConfirm.
+func (k Keeper) ConnectionCloseConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionCloseConfirm) (*connectiontypes.MsgConnectionCloseConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.ChannelKeeper.HasActiveChannels(ctx, msg.ConnectionId) {
+		return nil, errorsmod.Wrapf(connectiontypes.ErrChannelsNotClosed, "connection (%s)", msg.ConnectionId)
+	}
+
+	if err := k.ConnectionKeeper.ConnCloseConfirm(
+		ctx, msg.ConnectionId, msg.CounterpartyConnectionId, msg.ProofInit, msg.ProofHeight, msg.CounterpartyUpgradeSequence,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "connection handshake close confirm failed")
+	}
+
+	return &connectiontypes.MsgConnectionCloseConfirmResponse{}, nil
+}
+
+// ConnectionUpgradeInit defines a rpc handler method for MsgConnectionUpgradeInit.
+func (k Keeper) ConnectionUpgradeInit(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeInit) (*connectiontypes.MsgConnectionUpgradeInitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connection, upgrade, err := k.ConnectionKeeper.ConnUpgradeInit(ctx, msg.ConnectionId, msg.Fields)
+	if err != nil {
+		ctx.Logger().Error("connection upgrade init failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade init failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeInitResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: connection.UpgradeSequence,
+	}, nil
+}
+
+// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+func (k Keeper) ConnectionUpgradeTry(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTry) (*connectiontypes.MsgConnectionUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connection, upgrade, err := k.ConnectionKeeper.ConnUpgradeTry(
+		ctx, msg.ConnectionId, msg.CounterpartyUpgrade, msg.CounterpartyUpgradeSequence,
+		msg.ProofConnection, msg.ProofUpgrade, msg.ProofHeight,
+	)
+	if err != nil {
+		ctx.Logger().Error("connection upgrade try failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		if connectiontypes.IsUpgradeError(err) {
+			k.ConnectionKeeper.WriteErrorReceipt(ctx, msg.ConnectionId, err.(*connectiontypes.UpgradeError))
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
+			return &connectiontypes.MsgConnectionUpgradeTryResponse{Result: connectiontypes.FAILURE}, nil
+		}
+
+		// NOTE: an error is returned to baseapp and transaction state is not committed.
+		return nil, errorsmod.Wrap(err, "connection upgrade try failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeTryResponse{
+		Result:          connectiontypes.SUCCESS,
+		Upgrade:         upgrade,
+		UpgradeSequence: connection.UpgradeSequence,
+	}, nil
+}
+
+// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+func (k Keeper) ConnectionUpgradeAck(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeAck) (*connectiontypes.MsgConnectionUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.ConnectionKeeper.ConnUpgradeAck(
+		ctx, msg.ConnectionId, msg.CounterpartyUpgrade,
+		msg.ProofConnection, msg.ProofUpgrade, msg.ProofHeight,
+	); err != nil {
+		ctx.Logger().Error("connection upgrade ack failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		if connectiontypes.IsUpgradeError(err) {
+			k.ConnectionKeeper.WriteErrorReceipt(ctx, msg.ConnectionId, err.(*connectiontypes.UpgradeError))
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
+			return &connectiontypes.MsgConnectionUpgradeAckResponse{Result: connectiontypes.FAILURE}, nil
+		}
+
+		// NOTE: an error is returned to baseapp and transaction state is not committed.
+		return nil, errorsmod.Wrap(err, "connection upgrade ack failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeAckResponse{Result: connectiontypes.SUCCESS}, nil
+}
+
+// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+func (k Keeper) ConnectionUpgradeConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeConfirm) (*connectiontypes.MsgConnectionUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.ConnectionKeeper.ConnUpgradeConfirm(ctx, msg.ConnectionId, msg.ProofConnection, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade confirm failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade confirm failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeConfirmResponse{}, nil
+}
+
+// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+func (k Keeper) ConnectionUpgradeCancel(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeCancel) (*connectiontypes.MsgConnectionUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connection, found := k.ConnectionKeeper.GetConnection(ctx, msg.ConnectionId)
+	if !found {
+		return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, msg.ConnectionId)
+	}
+
+	// if the msgSender is authorized to make and cancel upgrades then we can restore immediately without any additional checks
+	if k.GetAuthority() == msg.Signer {
+		if _, found := k.ConnectionKeeper.GetUpgrade(ctx, msg.ConnectionId); !found {
+			return nil, errorsmod.Wrapf(connectiontypes.ErrUpgradeNotFound, "connection (%s)", msg.ConnectionId)
+		}
+
+		k.ConnectionKeeper.WriteUpgradeCancelConnection(ctx, msg.ConnectionId, connection.UpgradeSequence)
+
+		return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+	}
+
+	if err := k.ConnectionKeeper.ConnUpgradeCancel(ctx, msg.ConnectionId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade cancel failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade cancel failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeCancelConnection(ctx, msg.ConnectionId, msg.ErrorReceipt.Sequence)
+
+	return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+}
+
+// ConnectionUpgradeTimeout defines a rpc handler method for MsgConnectionUpgradeTimeout.
+func (k Keeper) ConnectionUpgradeTimeout(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTimeout) (*connectiontypes.MsgConnectionUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeTimeout(ctx, msg.ConnectionId, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade timeout failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade timeout failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeTimeoutConnection(ctx, msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeTimeoutResponse{}, nil
+}
+
 // ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
 // ChannelOpenInit will perform 04-channel checks, route to the application
 // callback, and write an OpenInit channel into state upon successful execution.
@@ -476,11 +661,41 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
+	if err := k.executeRecvPacket(ctx, cbs, capability, msg.Packet, relayer); err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
+			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+		},
+	)
+
+	ctx.Logger().Info("receive packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+
+	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// executeRecvPacket performs the application logic callback of a received packet and writes
+// its acknowledgement, unless the application acknowledges the packet asynchronously.
+func (k Keeper) executeRecvPacket(ctx sdk.Context, cbs porttypes.IBCModule, capability *capabilitytypes.Capability, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	// Packets received after their timeout elapsed on ORDERED_ALLOW_TIMEOUT channels are not executed,
+	// a timeout receipt has been written instead of an acknowledgement
+	if k.ChannelKeeper.HasPacketTimeoutReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence) {
+		ctx.Logger().Info("timed out packet received", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel)
+		return nil
+	}
+
 	// Perform application logic callback
 	//
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+	cacheCtx, writeFn := ctx.CacheContext()
+	ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
 	if ack == nil || ack.Success() {
 		// write application state changes for asynchronous and successful acknowledgements
 		writeFn()
@@ -493,25 +708,12 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, msg.Packet, ack); err != nil {
-			return nil, err
+		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, packet, ack); err != nil {
+			return err
 		}
 	}
 
-	defer telemetry.IncrCounterWithLabels(
-		[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
-		1,
-		[]metrics.Label{
-			telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-			telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-			telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-			telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
-		},
-	)
-
-	ctx.Logger().Info("receive packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
-
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
@@ -727,6 +929,172 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
 }
 
+// RecvPackets defines a rpc handler method for MsgRecvPackets.
+func (k Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// Lookup module by channel capability, all packets are received on the same channel
+	portID, channelID := msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform TAO verification
+	//
+	// Packets which were already received are no-ops
+	results, err := k.ChannelKeeper.RecvPackets(ctx, capability, msg.Packets, msg.ProofCommitments, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "receive packets verification failed"))
+		return nil, errorsmod.Wrap(err, "receive packets verification failed")
+	}
+
+	for i, packet := range msg.Packets {
+		if results[i] == channeltypes.NOOP {
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", portID, "channel-id", channelID, "sequence", packet.Sequence)
+			continue
+		}
+
+		if err := k.executeRecvPacket(ctx, cbs, capability, packet, relayer); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.Logger().Info("receive packets callbacks succeeded", "port-id", portID, "channel-id", channelID, "packets", len(msg.Packets))
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// Timeouts defines a rpc handler method for MsgTimeouts.
+func (k Keeper) Timeouts(goCtx context.Context, msg *channeltypes.MsgTimeouts) (*channeltypes.MsgTimeoutsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// Lookup module by channel capability, all packets are sent on the same channel
+	portID, channelID := msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("timeouts failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform TAO verification and delete the packet commitments
+	//
+	// Packets which were already timed out are no-ops
+	results, err := k.ChannelKeeper.TimeoutPackets(ctx, capability, msg.Packets, msg.ProofUnreceived, msg.ProofHeight, msg.NextSequenceRecv)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "timeout packets verification failed"))
+		return nil, errorsmod.Wrap(err, "timeout packets verification failed")
+	}
+
+	for i, packet := range msg.Packets {
+		if results[i] == channeltypes.NOOP {
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", portID, "channel-id", channelID, "sequence", packet.Sequence)
+			continue
+		}
+
+		// Perform application logic callback
+		if err := cbs.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+			ctx.Logger().Error("timeouts failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "timeout packet callback failed"))
+			return nil, errorsmod.Wrap(err, "timeout packet callback failed")
+		}
+	}
+
+	ctx.Logger().Info("timeout packets callbacks succeeded", "port-id", portID, "channel-id", channelID, "packets", len(msg.Packets))
+
+	return &channeltypes.MsgTimeoutsResponse{Results: results}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+func (k Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "packets cannot be empty")
+	}
+
+	// Lookup module by channel capability, all packets are sent on the same channel
+	portID, channelID := msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform TAO verification
+	//
+	// Packets which were already acknowledged are no-ops
+	results, err := k.ChannelKeeper.AcknowledgePackets(ctx, capability, msg.Packets, msg.Acknowledgements, msg.ProofAcked, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "acknowledge packets verification failed"))
+		return nil, errorsmod.Wrap(err, "acknowledge packets verification failed")
+	}
+
+	for i, packet := range msg.Packets {
+		if results[i] == channeltypes.NOOP {
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", portID, "channel-id", channelID, "sequence", packet.Sequence)
+			continue
+		}
+
+		// Perform application logic callback
+		if err := cbs.OnAcknowledgementPacket(ctx, packet, msg.Acknowledgements[i], relayer); err != nil {
+			ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "acknowledge packet callback failed"))
+			return nil, errorsmod.Wrap(err, "acknowledge packet callback failed")
+		}
+	}
+
+	ctx.Logger().Info("acknowledgements succeeded", "port-id", portID, "channel-id", channelID, "packets", len(msg.Packets))
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
 // ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
 func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -771,7 +1139,7 @@ func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgC
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
 
 	ctx.Logger().Info("channel upgrade init succeeded", "channel-id", msg.ChannelId, "version", upgradeVersion)
-	keeper.EmitChannelUpgradeInitEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+	k.ChannelKeeper.EmitChannelUpgradeInitEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeInitResponse{
 		Upgrade:         upgrade,
@@ -827,7 +1195,7 @@ func (k Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgCh
 	channel, upgrade = k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
 
 	ctx.Logger().Info("channel upgrade try succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
-	keeper.EmitChannelUpgradeTryEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+	k.ChannelKeeper.EmitChannelUpgradeTryEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeTryResponse{
 		Result:          channeltypes.SUCCESS,
@@ -897,7 +1265,7 @@ func (k Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgCh
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
 
 	ctx.Logger().Info("channel upgrade ack succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
-	keeper.EmitChannelUpgradeAckEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+	k.ChannelKeeper.EmitChannelUpgradeAckEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
 }
@@ -943,7 +1311,7 @@ func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.M
 
 	channel := k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
 	ctx.Logger().Info("channel upgrade confirm succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
-	keeper.EmitChannelUpgradeConfirmEvent(ctx, msg.PortId, msg.ChannelId, channel)
+	k.ChannelKeeper.EmitChannelUpgradeConfirmEvent(ctx, msg.PortId, msg.ChannelId, channel)
 
 	// Move channel to OPEN state if both chains have finished flushing in-flight packets.
 	// Counterparty channel state has been verified in ChanUpgradeConfirm.
@@ -957,7 +1325,7 @@ func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.M
 		channel := k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
 
 		ctx.Logger().Info("channel upgrade open succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
-		keeper.EmitChannelUpgradeOpenEvent(ctx, msg.PortId, msg.ChannelId, channel)
+		k.ChannelKeeper.EmitChannelUpgradeOpenEvent(ctx, msg.PortId, msg.ChannelId, channel)
 	}
 
 	return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.SUCCESS}, nil
@@ -1001,7 +1369,7 @@ func (k Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.MsgC
 	channel := k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
 
 	ctx.Logger().Info("channel upgrade open succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
-	keeper.EmitChannelUpgradeOpenEvent(ctx, msg.PortId, msg.ChannelId, channel)
+	k.ChannelKeeper.EmitChannelUpgradeOpenEvent(ctx, msg.PortId, msg.ChannelId, channel)
 
 	return &channeltypes.MsgChannelUpgradeOpenResponse{}, nil
 }
@@ -1017,7 +1385,7 @@ func (k Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.M
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
 
 	ctx.Logger().Info("channel upgrade timeout callback succeeded: portID %s, channelID %s", msg.PortId, msg.ChannelId)
-	keeper.EmitChannelUpgradeTimeoutEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+	k.ChannelKeeper.EmitChannelUpgradeTimeoutEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
 }
@@ -1044,7 +1412,7 @@ func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.Ms
 
 		ctx.Logger().Info("channel upgrade cancel succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
 
-		keeper.EmitChannelUpgradeCancelEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+		k.ChannelKeeper.EmitChannelUpgradeCancelEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 		return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
 	}
@@ -1069,7 +1437,7 @@ func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.Ms
 	if !found {
 		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
 	}
-	keeper.EmitChannelUpgradeCancelEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
+	k.ChannelKeeper.EmitChannelUpgradeCancelEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
 }
@@ -1089,6 +1457,20 @@ func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.M
 	}, nil
 }
 
+// PruneReceipts defines a rpc handler method for MsgPruneReceipts.
+func (k Keeper) PruneReceipts(goCtx context.Context, msg *channeltypes.MsgPruneReceipts) (*channeltypes.MsgPruneReceiptsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, err := k.ChannelKeeper.PruneReceipts(ctx, msg.PortId, msg.ChannelId, msg.Sequences, msg.ProofCommitments, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgPruneReceiptsResponse{
+		TotalPrunedSequences: pruned,
+	}, nil
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -1096,11 +1478,58 @@ func (k Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUp
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateConsensusStateRetention(ctx, msg.Params.ConsensusStateRetention); err != nil {
+		return nil, err
+	}
+
 	k.ClientKeeper.SetParams(ctx, msg.Params)
 
 	return &clienttypes.MsgUpdateParamsResponse{}, nil
 }
 
+// validateConsensusStateRetention returns an error if the max age of the retention policy is less than the delay period
+// of a connection, as the consensus states used to verify proofs on the connection would be pruned before the delay elapses.
+func (k *Keeper) validateConsensusStateRetention(ctx sdk.Context, retention clienttypes.ConsensusStateRetention) error {
+	if retention.MaxAge == 0 {
+		return nil
+	}
+
+	var err error
+	k.ConnectionKeeper.IterateConnections(ctx, func(connection connectiontypes.IdentifiedConnection) bool {
+		if connection.DelayPeriod > uint64(retention.MaxAge) {
+			err = errorsmod.Wrapf(connectiontypes.ErrInvalidDelayPeriod, "consensus state retention max age (%s) cannot be less than the delay period (%d) of connection %s", retention.MaxAge, connection.DelayPeriod, connection.Id)
+			return true
+		}
+		return false
+	})
+
+	return err
+}
+
+// PruneConsensusStates defines a rpc handler method for MsgPruneConsensusStates.
+func (k Keeper) PruneConsensusStates(goCtx context.Context, msg *clienttypes.MsgPruneConsensusStates) (*clienttypes.MsgPruneConsensusStatesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		totalPruned uint64
+		err         error
+	)
+	if msg.ClientId == "" {
+		totalPruned, err = k.ClientKeeper.PruneAllConsensusStates(ctx)
+	} else {
+		totalPruned, err = k.ClientKeeper.PruneConsensusStates(ctx, msg.ClientId, 0)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "consensus state pruning failed")
+	}
+
+	return &clienttypes.MsgPruneConsensusStatesResponse{TotalPruned: totalPruned}, nil
+}
+
 // UpdateConnectionParams defines a rpc handler method for MsgUpdateParams for the 03-connection submodule.
 func (k Keeper) UpdateConnectionParams(goCtx context.Context, msg *connectiontypes.MsgUpdateParams) (*connectiontypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -1120,11 +1549,47 @@ func (k Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.Msg
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the acknowledgements of a port may only expire if an application is bound to it
+	for _, expiry := range msg.Params.AcknowledgementExpiries {
+		if _, err := k.getPortApplication(ctx, expiry.PortId); err != nil {
+			return nil, errorsmod.Wrapf(channeltypes.ErrInvalidAcknowledgementExpiry, "port %s: %s", expiry.PortId, err)
+		}
+	}
+
 	k.ChannelKeeper.SetParams(ctx, msg.Params)
 
 	return &channeltypes.MsgUpdateParamsResponse{}, nil
 }
 
+// PauseChannel defines a rpc handler method for MsgPauseChannel.
+func (k Keeper) PauseChannel(goCtx context.Context, msg *channeltypes.MsgPauseChannel) (*channeltypes.MsgPauseChannelResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ChannelKeeper.PauseChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, errorsmod.Wrap(err, "pause channel failed")
+	}
+
+	return &channeltypes.MsgPauseChannelResponse{}, nil
+}
+
+// ResumeChannel defines a rpc handler method for MsgResumeChannel.
+func (k Keeper) ResumeChannel(goCtx context.Context, msg *channeltypes.MsgResumeChannel) (*channeltypes.MsgResumeChannelResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ChannelKeeper.ResumeChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, errorsmod.Wrap(err, "resume channel failed")
+	}
+
+	return &channeltypes.MsgResumeChannelResponse{}, nil
+}
+
 // convertToErrorEvents converts all events to error events by appending the
 // error attribute prefix to each event's attribute key.
 func convertToErrorEvents(events sdk.Events) sdk.Events {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -207,13 +208,16 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 				// verify if ack was written
 				ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
+				_, pending := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
 				if tc.async {
 					suite.Require().Nil(ack)
 					suite.Require().False(found)
-
+					suite.Require().True(pending)
 				} else {
 					suite.Require().NotNil(ack)
 					suite.Require().True(found)
+					suite.Require().False(pending)
 				}
 			} else {
 				suite.Require().Error(err)
@@ -298,6 +302,83 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	var msg *clienttypes.MsgPruneConsensusStates
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned uint64
+		expErr    error
+	}{
+		{
+			"success: prune client consensus states",
+			func() {},
+			2,
+			nil,
+		},
+		{
+			"success: prune consensus states of all clients",
+			func() {
+				msg.ClientId = ""
+			},
+			4,
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			0,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var paths []*ibctesting.Path
+			for i := 0; i < 2; i++ {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				paths = append(paths, path)
+			}
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(1, 0, 0)
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			msg = clienttypes.NewMsgPruneConsensusStates(suite.chainA.App.GetIBCKeeper().GetAuthority(), paths[0].EndpointA.ClientID)
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.PruneConsensusStates(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, res.TotalPruned)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -782,6 +863,99 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 	}
 }
 
+// tests the IBC handler receiving, acknowledging and timing out batches of packets.
+// It verifies that the application callbacks are executed once for each packet which
+// is not redundant. More rigorous testing of the batched packet handling can be found
+// in the 04-channel/keeper/batch_test.go.
+func (suite *KeeperTestSuite) TestHandleBatchedPackets() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	var recvCallbacks, ackCallbacks, timeoutCallbacks int
+	suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+		recvCallbacks++
+		return ibcmock.MockAcknowledgement
+	}
+	suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+		ackCallbacks++
+		return nil
+	}
+	suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnTimeoutPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+		timeoutCallbacks++
+		return nil
+	}
+
+	sendPackets := func(timeoutHeight clienttypes.Height) []channeltypes.Packet {
+		var packets []channeltypes.Packet
+		for i := 0; i < 3; i++ {
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+		}
+		return packets
+	}
+
+	// the second packet is relayed individually before the batch
+	packets := sendPackets(timeoutHeight)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().Equal(1, recvCallbacks)
+
+	var keys [][]byte
+	for _, packet := range packets {
+		keys = append(keys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+	proof, proofHeight := path.EndpointA.QueryBatchProof(keys)
+
+	recvRes, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS}, recvRes.Results)
+	suite.Require().Equal(3, recvCallbacks)
+
+	for _, packet := range packets {
+		suite.Require().True(suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	}
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	keys = nil
+	acks := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys = append(keys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+		acks[i] = ibcmock.MockAcknowledgement.Acknowledgement()
+	}
+	proof, proofHeight = path.EndpointB.QueryBatchProof(keys)
+
+	ackRes, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}, ackRes.Results)
+	suite.Require().Equal(3, ackCallbacks)
+
+	// the packets time out at the current height of chainB
+	packets = sendPackets(clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	keys = nil
+	for _, packet := range packets {
+		keys = append(keys, host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	}
+	proof, proofHeight = path.EndpointB.QueryBatchProof(keys)
+
+	timeoutRes, err := keeper.Keeper.Timeouts(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), channeltypes.NewMsgTimeouts(packets, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}, timeoutRes.Results)
+	suite.Require().Equal(3, timeoutCallbacks)
+
+	for _, packet := range packets {
+		suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path              *ibctesting.Path
@@ -2672,6 +2846,67 @@ func (suite *KeeperTestSuite) TestUpdateClientParams() {
 	}
 }
 
+// TestUpdateClientParamsConsensusStateRetention tests that the UpdateClientParams rpc handler rejects a consensus
+// state retention max age less than the delay period of a connection.
+func (suite *KeeperTestSuite) TestUpdateClientParamsConsensusStateRetention() {
+	var maxAge time.Duration
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: max age equal to the connection delay period",
+			func() {},
+			nil,
+		},
+		{
+			"success: no max age",
+			func() {
+				maxAge = 0
+			},
+			nil,
+		},
+		{
+			"failure: max age less than the connection delay period",
+			func() {
+				maxAge--
+			},
+			connectiontypes.ErrInvalidDelayPeriod,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ConnectionConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			path.EndpointB.ConnectionConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			suite.coordinator.SetupConnections(path)
+
+			maxAge = time.Hour
+
+			tc.malleate()
+
+			params := clienttypes.DefaultParams()
+			params.ConsensusStateRetention = clienttypes.NewConsensusStateRetention(0, maxAge, 0)
+			msg := clienttypes.NewMsgUpdateParams(suite.chainA.App.GetIBCKeeper().GetAuthority(), params)
+
+			_, err := keeper.Keeper.UpdateClientParams(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext()))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 // TestUpdateConnectionParams tests the UpdateConnectionParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateConnectionParams() {
 	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -2736,6 +2971,27 @@ func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 			channeltypes.NewMsgUpdateChannelParams(authority, channeltypes.DefaultParams()),
 			nil,
 		},
+		{
+			"success: valid authority and send policy params",
+			channeltypes.NewMsgUpdateChannelParams(authority, channeltypes.Params{
+				UpgradeTimeout:     channeltypes.DefaultTimeout,
+				MaxPacketDataSize:  1024,
+				PortSendPolicies:   []channeltypes.PortSendPolicy{channeltypes.NewPortSendPolicy(ibctesting.MockPort, 2048)},
+				MaxTimeoutDuration: uint64(time.Hour.Nanoseconds()),
+				MinTimeoutDuration: uint64(time.Minute.Nanoseconds()),
+			}),
+			nil,
+		},
+		{
+			"success: valid authority and acknowledgement expiry params",
+			channeltypes.NewMsgUpdateChannelParams(authority, channeltypes.NewParams(channeltypes.DefaultTimeout, channeltypes.NewAcknowledgementExpiry(ibctesting.MockPort, uint64(time.Hour.Nanoseconds())))),
+			nil,
+		},
+		{
+			"failure: acknowledgement expiry for port without application",
+			channeltypes.NewMsgUpdateChannelParams(authority, channeltypes.NewParams(channeltypes.DefaultTimeout, channeltypes.NewAcknowledgementExpiry("unbound", uint64(time.Hour.Nanoseconds())))),
+			channeltypes.ErrInvalidAcknowledgementExpiry,
+		},
 		{
 			"failure: malformed authority address",
 			channeltypes.NewMsgUpdateChannelParams(ibctesting.InvalidID, channeltypes.DefaultParams()),
@@ -2777,6 +3033,440 @@ func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPauseChannel() {
+	var path *ibctesting.Path
+
+	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name     string
+		msg      func() *channeltypes.MsgPauseChannel
+		expError error
+	}{
+		{
+			"success",
+			func() *channeltypes.MsgPauseChannel {
+				return channeltypes.NewMsgPauseChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, authority)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() *channeltypes.MsgPauseChannel {
+				return channeltypes.NewMsgPauseChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestAccAddress)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() *channeltypes.MsgPauseChannel {
+				return channeltypes.NewMsgPauseChannel(path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, authority)
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			msg := tc.msg()
+			resp, err := keeper.Keeper.PauseChannel(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(suite.chainA.GetContext(), msg.PortId, msg.ChannelId))
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResumeChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgResumeChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Authority = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ResumeChannel(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrChannelNotPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			msg = channeltypes.NewMsgResumeChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := keeper.Keeper.ResumeChannel(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(suite.chainA.GetContext(), msg.PortId, msg.ChannelId))
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnectionCloseInit() {
+	var (
+		path *ibctesting.Path
+		msg  *connectiontypes.MsgConnectionCloseInit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: all channels on the connection are closed",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED))
+				msg.ConnectionId = path.EndpointA.ConnectionID
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: open channel exists on the connection",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				msg.ConnectionId = path.EndpointA.ConnectionID
+			},
+			connectiontypes.ErrChannelsNotClosed,
+		},
+		{
+			"failure: connection already closed",
+			func() {
+				suite.Require().NoError(path.EndpointA.ConnCloseInit())
+			},
+			connectiontypes.ErrInvalidConnectionState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = connectiontypes.NewMsgConnectionCloseInit(path.EndpointA.ConnectionID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := keeper.Keeper.ConnectionCloseInit(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), msg.ConnectionId)
+				suite.Require().True(found)
+				suite.Require().Equal(connectiontypes.CLOSED, connection.State)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnectionCloseConfirm() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: open channel exists on the connection",
+			func() {
+				suite.Require().NoError(path.EndpointB.SetChannelState(channeltypes.OPEN))
+			},
+			connectiontypes.ErrChannelsNotClosed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED))
+			suite.Require().NoError(path.EndpointB.SetChannelState(channeltypes.CLOSED))
+			suite.Require().NoError(path.EndpointA.ConnCloseInit())
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			proofInit, proofHeight := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+			msg := connectiontypes.NewMsgConnectionCloseConfirm(
+				path.EndpointB.ConnectionID, proofInit, proofHeight,
+				suite.chainB.SenderAccount.GetAddress().String(), path.EndpointA.GetConnection().UpgradeSequence, "",
+			)
+
+			resp, err := keeper.Keeper.ConnectionCloseConfirm(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Equal(connectiontypes.CLOSED, path.EndpointB.GetConnection().State)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnectionUpgradeInit() {
+	var msg *connectiontypes.MsgConnectionUpgradeInit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: upgrade is identical to existing connection end",
+			func() {
+				msg.Fields.DelayPeriod = ibctesting.DefaultDelayPeriod
+			},
+			connectiontypes.ErrInvalidUpgrade,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			upgrade := connectiontypes.NewUpgrade(ibctesting.ConnectionVersion, ibctesting.DefaultDelayPeriod+1, suite.chainB.GetPrefix())
+			msg = connectiontypes.NewMsgConnectionUpgradeInit(path.EndpointA.ConnectionID, upgrade, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := keeper.Keeper.ConnectionUpgradeInit(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Equal(uint64(1), resp.UpgradeSequence)
+
+				upgrade.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().Add(connectiontypes.DefaultUpgradeTimeout).UnixNano())
+				suite.Require().Equal(upgrade, resp.Upgrade)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestConnectionUpgradeHandshake runs the connection upgrade handshake end to end, including
+// the cancellation of a failed upgrade using the error receipt written by the counterparty.
+func (suite *KeeperTestSuite) TestConnectionUpgradeHandshake() {
+	suite.Run("success", func() {
+		suite.SetupTest()
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.Setup(path)
+
+		delayPeriod := ibctesting.DefaultDelayPeriod + 100
+		suite.Require().NoError(path.EndpointA.ConnUpgradeInit(connectiontypes.NewUpgrade(ibctesting.ConnectionVersion, delayPeriod, suite.chainB.GetPrefix())))
+		suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+		suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+		suite.Require().NoError(path.EndpointB.ConnUpgradeConfirm())
+
+		for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+			connection := endpoint.GetConnection()
+			suite.Require().Equal(connectiontypes.OPEN, connection.State)
+			suite.Require().Equal(delayPeriod, connection.DelayPeriod)
+			suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+
+			_, found := endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ConnectionID)
+			suite.Require().False(found)
+		}
+
+		// packets continue to flow over the upgraded connection
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(1), sequence)
+	})
+
+	suite.Run("failure: try writes error receipt and upgrade is cancelled", func() {
+		suite.SetupTest()
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+
+		// chainB is ahead of chainA and rejects the upgrade at its own sequence
+		connection := path.EndpointB.GetConnection()
+		connection.UpgradeSequence = 5
+		path.EndpointB.SetConnection(connection)
+		suite.coordinator.CommitBlock(suite.chainB)
+
+		suite.Require().NoError(path.EndpointA.ConnUpgradeInit(connectiontypes.NewUpgrade(ibctesting.ConnectionVersion, ibctesting.DefaultDelayPeriod+1, suite.chainB.GetPrefix())))
+
+		suite.Require().NoError(path.EndpointB.UpdateClient())
+		connectionProof, upgradeProof, proofHeight := path.EndpointA.QueryConnectionUpgradeProof()
+		upgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+		suite.Require().True(found)
+
+		msg := connectiontypes.NewMsgConnectionUpgradeTry(
+			path.EndpointB.ConnectionID, upgrade, path.EndpointA.GetConnection().UpgradeSequence,
+			connectionProof, upgradeProof, proofHeight, suite.chainB.SenderAccount.GetAddress().String(),
+		)
+		resp, err := keeper.Keeper.ConnectionUpgradeTry(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+		suite.Require().NoError(err)
+		suite.Require().Equal(connectiontypes.FAILURE, resp.Result)
+
+		errorReceipt, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+		suite.Require().True(found)
+		suite.Require().Equal(uint64(5), errorReceipt.Sequence)
+
+		suite.coordinator.CommitBlock(suite.chainB)
+		suite.Require().NoError(path.EndpointA.ConnUpgradeCancel())
+
+		connection = path.EndpointA.GetConnection()
+		suite.Require().Equal(uint64(5), connection.UpgradeSequence)
+		suite.Require().Equal(uint64(ibctesting.DefaultDelayPeriod), connection.DelayPeriod)
+
+		_, found = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+		suite.Require().False(found)
+	})
+
+	suite.Run("success: authority cancels upgrade without proof", func() {
+		suite.SetupTest()
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+
+		suite.Require().NoError(path.EndpointA.ConnUpgradeInit(connectiontypes.NewUpgrade(ibctesting.ConnectionVersion, ibctesting.DefaultDelayPeriod+1, suite.chainB.GetPrefix())))
+
+		msg := connectiontypes.NewMsgConnectionUpgradeCancel(
+			path.EndpointA.ConnectionID, connectiontypes.ErrorReceipt{}, nil, clienttypes.ZeroHeight(),
+			suite.chainA.App.GetIBCKeeper().GetAuthority(),
+		)
+		resp, err := keeper.Keeper.ConnectionUpgradeCancel(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(resp)
+
+		_, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+		suite.Require().False(found)
+
+		errorReceipt, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+		suite.Require().True(found)
+		suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+	})
+
+	suite.Run("success: upgrade times out and is cancelled on the counterparty", func() {
+		suite.SetupTest()
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+
+		suite.Require().NoError(path.EndpointA.ConnUpgradeInit(connectiontypes.NewUpgrade(ibctesting.ConnectionVersion, ibctesting.DefaultDelayPeriod+1, suite.chainB.GetPrefix())))
+		suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+		// the upgrade may not be timed out before its timeout elapses on chainB
+		suite.Require().Error(path.EndpointA.ConnUpgradeTimeout())
+
+		suite.coordinator.IncrementTimeBy(connectiontypes.DefaultUpgradeTimeout)
+		suite.coordinator.CommitBlock(suite.chainB)
+		suite.Require().NoError(path.EndpointA.ConnUpgradeTimeout())
+
+		errorReceipt, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+		suite.Require().True(found)
+		suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+
+		suite.Require().NoError(path.EndpointB.ConnUpgradeCancel())
+
+		for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+			connection := endpoint.GetConnection()
+			suite.Require().Equal(uint64(ibctesting.DefaultDelayPeriod), connection.DelayPeriod)
+
+			_, found := endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ConnectionID)
+			suite.Require().False(found)
+		}
+	})
+}
+
 func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 	var msg *channeltypes.MsgPruneAcknowledgements
 
@@ -2852,3 +3542,72 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPruneReceipts() {
+	var msg *channeltypes.MsgPruneReceipts
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: core keeper function fails, packet receipt not found",
+			func() {
+				msg.Sequences = append(msg.Sequences, 100)
+			},
+			channeltypes.ErrPacketReceiptNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			selfHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+			timeoutHeight := clienttypes.NewHeight(selfHeight.RevisionNumber, selfHeight.RevisionHeight+10)
+
+			sequence, err := path.EndpointB.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			// advance chainA past the packet timeout
+			suite.coordinator.CommitNBlocks(suite.chainA, 10)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight := path.EndpointB.QueryProof(host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+			msg = channeltypes.NewMsgPruneReceipts(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				[]uint64{sequence},
+				proof,
+				proofHeight,
+				suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().PruneReceipts(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Equal(uint64(1), resp.TotalPrunedSequences)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
@@ -42,6 +42,7 @@ var (
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the ibc module.
@@ -164,6 +165,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	if err := cfg.RegisterMigration(exported.ModuleName, 6, clientMigrator.MigrateClientExpiryIndex); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -184,7 +189,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -192,6 +197,13 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return nil
 }
 
+// EndBlock returns the end blocker for the ibc module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	ibcclient.EndBlocker(sdk.UnwrapSDKContext(ctx), am.keeper.ClientKeeper)
+	am.keeper.ExpirePendingAcknowledgements(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the ibc module.
@@ -2,6 +2,7 @@ package solomachine
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 
 	errorsmod "cosmossdk.io/errors"
@@ -259,6 +260,23 @@ func produceVerificationArgs(
 	return publicKey, sigData, timestamp, sequence, nil
 }
 
+// getClientState retrieves the client state from the store.
+// If the ClientState does not exist in state a nil value and false boolean flag is returned
+func getClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := store.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientStateI := clienttypes.MustUnmarshalClientState(cdc, bz)
+	clientState, ok := clientStateI.(*ClientState)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T into %T", clientStateI, clientState))
+	}
+
+	return clientState, true
+}
+
 // sets the client state to the store
 func setClientState(store storetypes.KVStore, cdc codec.BinaryCodec, clientState exported.ClientState) {
 	bz := clienttypes.MustMarshalClientState(cdc, clientState)
@@ -21,7 +21,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState   = (*ClientState)(nil)
+	_ exported.BatchVerifier = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -288,6 +291,91 @@ func (cs ClientState) VerifyNonMembership(
 	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath)
 }
 
+// BatchVerifyMembership verifies a single proof of the existence of the given values within the store at the given
+// CommitmentPath at the specified height. The keys of the items are the paths within this store.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) BatchVerifyMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	items map[string][]byte,
+) error {
+	merkleProof, merklePath, consensusState, err := cs.getBatchVerificationArgs(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.BatchVerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, items)
+}
+
+// BatchVerifyNonMembership verifies a single proof of the absence of the given keys within the store at the given
+// CommitmentPath at the specified height. The keys are the paths within this store.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) BatchVerifyNonMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	keys [][]byte,
+) error {
+	merkleProof, merklePath, consensusState, err := cs.getBatchVerificationArgs(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.BatchVerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, keys)
+}
+
+// getBatchVerificationArgs performs the checks common to batch proof verification and returns the decoded
+// merkle proof and path along with the consensus state at the proof height.
+func (cs ClientState) getBatchVerificationArgs(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) (commitmenttypes.MerkleProof, commitmenttypes.MerklePath, *ConsensusState, error) {
+	if cs.GetLatestHeight().LT(height) {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, nil, errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, nil, err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, nil, errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof, merklePath, consensusState, nil
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx sdk.Context, store storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
@@ -0,0 +1,80 @@
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientMessageBatch = (*HeaderChain)(nil)
+
+// ClientType defines that the HeaderChain is a Tendermint consensus algorithm
+func (HeaderChain) ClientType() string {
+	return exported.Tendermint
+}
+
+// GetClientMessages returns the headers of the chain in order. The fields omitted by a header are
+// filled in from the previous header: an empty TrustedHeight is set to the height of the previous
+// header, and an empty ValidatorSet or TrustedValidators is set to the corresponding validator set
+// of the previous header. The headers contained in the chain are not modified.
+func (hc HeaderChain) GetClientMessages() ([]exported.ClientMessage, error) {
+	if len(hc.Headers) == 0 {
+		return nil, errorsmod.Wrap(clienttypes.ErrInvalidHeader, "header chain cannot be empty")
+	}
+
+	clientMsgs := make([]exported.ClientMessage, len(hc.Headers))
+	for i, h := range hc.Headers {
+		if h == nil {
+			return nil, errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "header %d cannot be nil", i)
+		}
+
+		header := *h
+		if i > 0 {
+			prev := clientMsgs[i-1].(*Header)
+			if header.SignedHeader == nil || header.Header == nil {
+				return nil, errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "tendermint header %d cannot be nil", i)
+			}
+
+			if header.TrustedHeight.IsZero() && prev.SignedHeader != nil && prev.Header != nil {
+				header.TrustedHeight = prev.GetHeight().(clienttypes.Height)
+			}
+			if header.ValidatorSet == nil {
+				header.ValidatorSet = prev.ValidatorSet
+			}
+			if header.TrustedValidators == nil {
+				header.TrustedValidators = prev.TrustedValidators
+			}
+		}
+
+		clientMsgs[i] = &header
+	}
+
+	return clientMsgs, nil
+}
+
+// ValidateBasic checks that the header chain is not empty, that every header passes basic validation
+// once the omitted fields have been filled in from the previous header and that the header heights are
+// strictly increasing.
+func (hc HeaderChain) ValidateBasic() error {
+	clientMsgs, err := hc.GetClientMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, clientMsg := range clientMsgs {
+		header := clientMsg.(*Header)
+		if err := header.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "header %d failed basic validation", i)
+		}
+
+		if i > 0 {
+			prevHeight := clientMsgs[i-1].(*Header).GetHeight()
+			if header.GetHeight().LTE(prevHeight) {
+				return errorsmod.Wrapf(ErrInvalidHeaderHeight, "header %d height %s must be greater than previous header height %s", i, header.GetHeight(), prevHeight)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package tendermint_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// createHeaderChain returns a header chain of n sequential headers where only the first header is complete.
+func (suite *TendermintTestSuite) createHeaderChain(n int) *ibctm.HeaderChain {
+	headerChain := &ibctm.HeaderChain{}
+	for i := 0; i < n; i++ {
+		headerHeight := height.RevisionHeight + uint64(i)
+		trustedHeight := clienttypes.NewHeight(0, headerHeight-1)
+		header := suite.chainA.CreateTMClientHeader(chainID, int64(headerHeight), trustedHeight, suite.now.Add(time.Duration(i)*time.Second), suite.valSet, suite.valSet, suite.valSet, suite.signers)
+
+		if i > 0 {
+			header.TrustedHeight = clienttypes.ZeroHeight()
+			header.ValidatorSet = nil
+			header.TrustedValidators = nil
+		}
+
+		headerChain.Headers = append(headerChain.Headers, header)
+	}
+
+	return headerChain
+}
+
+func (suite *TendermintTestSuite) TestHeaderChainGetClientMessages() {
+	headerChain := suite.createHeaderChain(3)
+	suite.Require().Equal(exported.Tendermint, headerChain.ClientType())
+
+	clientMsgs, err := headerChain.GetClientMessages()
+	suite.Require().NoError(err)
+	suite.Require().Len(clientMsgs, 3)
+
+	first := headerChain.Headers[0]
+	for i, clientMsg := range clientMsgs {
+		header, ok := clientMsg.(*ibctm.Header)
+		suite.Require().True(ok)
+		suite.Require().Equal(headerChain.Headers[i].SignedHeader, header.SignedHeader)
+		suite.Require().Equal(first.ValidatorSet, header.ValidatorSet)
+		suite.Require().Equal(first.TrustedValidators, header.TrustedValidators)
+
+		if i > 0 {
+			suite.Require().Equal(clientMsgs[i-1].(*ibctm.Header).GetHeight(), header.TrustedHeight)
+		}
+	}
+
+	// the headers of the chain are not modified
+	suite.Require().Nil(headerChain.Headers[1].ValidatorSet)
+	suite.Require().True(headerChain.Headers[1].TrustedHeight.IsZero())
+
+	// explicitly set fields take precedence over the fields of the previous header
+	headerChain.Headers[2].TrustedHeight = first.TrustedHeight
+	clientMsgs, err = headerChain.GetClientMessages()
+	suite.Require().NoError(err)
+	suite.Require().Equal(first.TrustedHeight, clientMsgs[2].(*ibctm.Header).TrustedHeight)
+}
+
+func (suite *TendermintTestSuite) TestHeaderChainValidateBasic() {
+	var headerChain *ibctm.HeaderChain
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: single header",
+			func() {
+				headerChain.Headers = headerChain.Headers[:1]
+			},
+			nil,
+		},
+		{
+			"failure: empty header chain",
+			func() {
+				headerChain.Headers = nil
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: nil header",
+			func() {
+				headerChain.Headers[1] = nil
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: nil tendermint header",
+			func() {
+				headerChain.Headers[1].SignedHeader = nil
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: first header has no validator set",
+			func() {
+				headerChain.Headers[0].ValidatorSet = nil
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: header heights are not increasing",
+			func() {
+				headerChain.Headers[1], headerChain.Headers[2] = headerChain.Headers[2], headerChain.Headers[1]
+				headerChain.Headers[1].TrustedHeight = headerChain.Headers[0].TrustedHeight
+				headerChain.Headers[2].TrustedHeight = headerChain.Headers[0].TrustedHeight
+			},
+			ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"failure: trusted height is not less than the header height",
+			func() {
+				headerChain.Headers[2].TrustedHeight = headerChain.Headers[2].GetHeight().(clienttypes.Height)
+			},
+			ibctm.ErrInvalidHeaderHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			headerChain = suite.createHeaderChain(3)
+
+			tc.malleate()
+
+			err := headerChain.ValidateBasic()
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
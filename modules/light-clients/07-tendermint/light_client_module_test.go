@@ -0,0 +1,198 @@
+package tendermint_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestLightClientModuleRecoverClient() {
+	var substituteClientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid substitute client identifier",
+			func() {
+				substituteClientID = ibctesting.InvalidID
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: substitute client is not a 07-tendermint client",
+			func() {
+				substituteClientID = clienttypes.FormatClientIdentifier(exported.Solomachine, 0)
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: substitute client not found",
+			func() {
+				substituteClientID = clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(subjectPath)
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(substitutePath)
+			substituteClientID = substitutePath.EndpointA.ClientID
+
+			// expire the subject client so that it may be recovered
+			tmClientState := subjectPath.EndpointA.GetClientState().(*ibctm.ClientState)
+			tmClientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			subjectPath.EndpointA.SetClientState(tmClientState)
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(subjectPath.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			err := lightClientModule.RecoverClient(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID, substituteClientID)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				status := lightClientModule.Status(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
+				suite.Require().Equal(exported.Active, status)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestLightClientModuleStatusUnknown() {
+	lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(ibctesting.FirstClientID)
+	suite.Require().True(found)
+
+	status := lightClientModule.Status(suite.chainA.GetContext(), clienttypes.FormatClientIdentifier(exported.Tendermint, 100))
+	suite.Require().Equal(exported.Unknown, status)
+}
+
+func (suite *TendermintTestSuite) TestLightClientModulePruneConsensusStates() {
+	var (
+		clientID  string
+		retention clienttypes.ConsensusStateRetention
+		limit     uint64
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned uint64
+		expError  error
+	}{
+		{
+			"success: expired consensus states are pruned",
+			func() {},
+			2,
+			nil,
+		},
+		{
+			"success: expired consensus states and consensus states not retained are pruned",
+			func() {
+				retention.MaxConsensusStates = 1
+			},
+			3,
+			nil,
+		},
+		{
+			"success: limit",
+			func() {
+				retention.MaxConsensusStates = 1
+				limit = 1
+			},
+			1,
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+			clientID = path.EndpointA.ClientID
+
+			for i := 0; i < 3; i++ {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			}
+
+			// expire the initial consensus state and the one of the first update
+			firstHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+			firstHeight.RevisionHeight -= 2
+			consState, found := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, firstHeight)
+			suite.Require().True(found)
+			ctx := suite.chainA.GetContext().WithBlockTime(consState.(*ibctm.ConsensusState).Timestamp.Add(ibctesting.TrustingPeriod))
+
+			retention = clienttypes.ConsensusStateRetention{}
+			limit = 0
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			pruner, ok := lightClientModule.(clienttypes.ConsensusStatePruner)
+			suite.Require().True(ok)
+
+			pruned, err := pruner.PruneConsensusStates(ctx, clientID, retention, 0, limit)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestLightClientModuleTrustingPeriod() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	trustingPeriodProvider, ok := lightClientModule.(clienttypes.TrustingPeriodProvider)
+	suite.Require().True(ok)
+
+	trustingPeriod, err := trustingPeriodProvider.TrustingPeriod(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.GetClientState().(*ibctm.ClientState).TrustingPeriod, trustingPeriod)
+
+	_, err = trustingPeriodProvider.TrustingPeriod(suite.chainA.GetContext(), clienttypes.FormatClientIdentifier(exported.Tendermint, 100))
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+}
@@ -3,6 +3,7 @@ package tendermint
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"strings"
 
 	"cosmossdk.io/store/prefix"
@@ -42,8 +43,27 @@ var (
 	KeyProcessedHeight = []byte("/processedHeight")
 	// KeyIteration stores the key mapping to consensus state key for efficient iteration
 	KeyIteration = []byte("/iterationKey")
+	// KeyConsensusStateCount stores the number of consensus states which are not checkpoints of the retention policy
+	KeyConsensusStateCount = []byte("consensusStateCount")
 )
 
+// getClientState retrieves the client state from the client prefixed store.
+// If the ClientState does not exist in state a nil value and false boolean flag is returned
+func getClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := store.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientStateI := clienttypes.MustUnmarshalClientState(cdc, bz)
+	clientState, ok := clientStateI.(*ClientState)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T into %T", clientStateI, clientState))
+	}
+
+	return clientState, true
+}
+
 // setClientState stores the client state
 func setClientState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, clientState *ClientState) {
 	key := host.ClientStateKey()
@@ -188,6 +208,10 @@ func IterationKey(height exported.Height) []byte {
 // SetIterationKey stores the consensus state key under a key that is more efficient for ordered iteration
 func SetIterationKey(clientStore storetypes.KVStore, height exported.Height) {
 	key := IterationKey(height)
+	if !clientStore.Has(key) {
+		updateConsensusStateCount(clientStore, height, true)
+	}
+
 	val := host.ConsensusStateKey(height)
 	clientStore.Set(key, val)
 }
@@ -202,9 +226,69 @@ func GetIterationKey(clientStore storetypes.KVStore, height exported.Height) []b
 // deleteIterationKey deletes the iteration key for a given height
 func deleteIterationKey(clientStore storetypes.KVStore, height exported.Height) {
 	key := IterationKey(height)
+	if clientStore.Has(key) {
+		updateConsensusStateCount(clientStore, height, false)
+	}
+
 	clientStore.Delete(key)
 }
 
+// getConsensusStateCount returns the number of consensus states in the client store which are not checkpoints of the
+// retention policy. The count is stored together with the checkpoint interval it was computed for. If it is not stored,
+// as for client stores written before the count was introduced or imported from genesis, or if it was computed for a
+// different checkpoint interval, the consensus states are counted and the count is stored.
+func getConsensusStateCount(clientStore storetypes.KVStore, retention clienttypes.ConsensusStateRetention) uint64 {
+	if bz := clientStore.Get(KeyConsensusStateCount); len(bz) != 0 {
+		if binary.BigEndian.Uint64(bz[:8]) == retention.KeepEveryNthHeight {
+			return binary.BigEndian.Uint64(bz[8:])
+		}
+	}
+
+	var count uint64
+	IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+		if !retention.IsCheckpoint(height) {
+			count++
+		}
+		return false
+	})
+
+	setConsensusStateCount(clientStore, retention.KeepEveryNthHeight, count)
+	return count
+}
+
+// setConsensusStateCount stores the number of consensus states which are not checkpoints of the retention policy
+// together with the checkpoint interval it was computed for.
+func setConsensusStateCount(clientStore storetypes.KVStore, keepEveryNthHeight, count uint64) {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, keepEveryNthHeight)
+	binary.BigEndian.PutUint64(bz[8:], count)
+	clientStore.Set(KeyConsensusStateCount, bz)
+}
+
+// updateConsensusStateCount increments, or decrements if added is false, the stored number of consensus states if the
+// consensus state at the given height is not a checkpoint of the checkpoint interval the count was computed for. Nothing
+// is done if no count is stored.
+func updateConsensusStateCount(clientStore storetypes.KVStore, height exported.Height, added bool) {
+	bz := clientStore.Get(KeyConsensusStateCount)
+	if len(bz) == 0 {
+		return
+	}
+
+	keepEveryNthHeight, count := binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint64(bz[8:])
+	if (clienttypes.ConsensusStateRetention{KeepEveryNthHeight: keepEveryNthHeight}).IsCheckpoint(height) {
+		return
+	}
+
+	switch {
+	case added:
+		count++
+	case count > 0:
+		count--
+	}
+
+	setConsensusStateCount(clientStore, keepEveryNthHeight, count)
+}
+
 // GetHeightFromIterationKey takes an iteration key and returns the height that it references
 func GetHeightFromIterationKey(iterKey []byte) exported.Height {
 	bigEndianBytes := iterKey[len([]byte(KeyIterateConsensusStatePrefix)):]
@@ -305,6 +389,82 @@ func PruneAllExpiredConsensusStates(
 	return len(heights)
 }
 
+// PruneConsensusStates iterates over the consensus states of the client store in ascending height order and deletes,
+// together with their metadata, the consensus states which are expired or which are not retained by the given retention
+// policy. Checkpoint consensus states are skipped until they expire and the consensus state at the latest height of the
+// client is never pruned. Consensus states processed within the delay time period are not pruned by the max consensus
+// states rule, as they may still be needed to verify proofs on a connection with that delay period. Iteration stops at
+// the first retained consensus state which is not a checkpoint, or once limit consensus states are pruned if limit is
+// not zero. The number of consensus states pruned is returned.
+func PruneConsensusStates(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	clientState *ClientState, retention clienttypes.ConsensusStateRetention, delayTimePeriod, limit uint64,
+) uint64 {
+	var (
+		heights []exported.Height
+		// the number of consensus states which are not checkpoints at or above the current iteration height
+		remaining uint64
+	)
+
+	if retention.MaxConsensusStates != 0 {
+		remaining = getConsensusStateCount(clientStore, retention)
+	}
+
+	pruneCb := func(height exported.Height) bool {
+		if limit != 0 && uint64(len(heights)) >= limit {
+			return true
+		}
+
+		if height.GTE(clientState.LatestHeight) {
+			return true
+		}
+
+		consState, found := GetConsensusState(clientStore, cdc, height)
+		if !found { // consensus state should always be found
+			return true
+		}
+
+		checkpoint := retention.IsCheckpoint(height)
+		exceedsMax := !checkpoint && remaining > retention.MaxConsensusStates
+		if !checkpoint && remaining > 0 {
+			remaining--
+		}
+
+		switch {
+		case clientState.IsExpired(consState.Timestamp, ctx.BlockTime()):
+		case checkpoint:
+			return false
+		case retention.MaxAge != 0 && consState.Timestamp.Add(retention.MaxAge).Before(ctx.BlockTime()):
+		case retention.MaxConsensusStates != 0 && exceedsMax && delayTimePeriodPassed(ctx, clientStore, height, delayTimePeriod):
+		default:
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	IterateConsensusStateAscending(clientStore, pruneCb)
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return uint64(len(heights))
+}
+
+// delayTimePeriodPassed returns true if the consensus state at the given height was processed at least the delay time
+// period ago. Consensus states without a processed time cannot be used to verify proofs with a delay period.
+func delayTimePeriodPassed(ctx sdk.Context, clientStore storetypes.KVStore, height exported.Height, delayTimePeriod uint64) bool {
+	processedTime, found := GetProcessedTime(clientStore, height)
+	if !found {
+		return true
+	}
+
+	return uint64(ctx.BlockTime().UnixNano()) >= processedTime+delayTimePeriod
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
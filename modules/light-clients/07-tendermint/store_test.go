@@ -1,7 +1,9 @@
 package tendermint_test
 
 import (
+	"encoding/binary"
 	"math"
+	"slices"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -201,3 +203,164 @@ func (suite *TendermintTestSuite) TestGetNeighboringConsensusStates() {
 	suite.Require().Nil(nextCs49, "next consensus state exists after highest consensus state")
 	suite.Require().False(ok)
 }
+
+func (suite *TendermintTestSuite) TestPruneConsensusStates() {
+	var (
+		path            *ibctesting.Path
+		heights         []exported.Height
+		retention       clienttypes.ConsensusStateRetention
+		delayTimePeriod uint64
+		limit           uint64
+		blockTime       time.Time
+	)
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expRetainedFn func() []exported.Height
+	}{
+		{
+			"no consensus states pruned: empty retention policy",
+			func() {},
+			func() []exported.Height { return heights },
+		},
+		{
+			"success: max consensus states",
+			func() {
+				retention.MaxConsensusStates = 2
+			},
+			func() []exported.Height { return heights[len(heights)-2:] },
+		},
+		{
+			"success: max consensus states with limit",
+			func() {
+				retention.MaxConsensusStates = 2
+				limit = 1
+			},
+			func() []exported.Height { return heights[1:] },
+		},
+		{
+			"success: consensus states processed within the delay time period are retained",
+			func() {
+				retention.MaxConsensusStates = 2
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				processedTime, found := tendermint.GetProcessedTime(clientStore, heights[2])
+				suite.Require().True(found)
+
+				delayTimePeriod = uint64(blockTime.UnixNano()) - processedTime + 1
+			},
+			func() []exported.Height { return heights[2:] },
+		},
+		{
+			"success: max age",
+			func() {
+				consState, found := path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, heights[3])
+				suite.Require().True(found)
+
+				retention.MaxAge = time.Nanosecond
+				blockTime = consState.(*tendermint.ConsensusState).Timestamp.Add(time.Nanosecond)
+			},
+			func() []exported.Height { return heights[3:] },
+		},
+		{
+			"success: checkpoints are retained",
+			func() {
+				retention.MaxConsensusStates = 1
+				retention.KeepEveryNthHeight = heights[1].GetRevisionHeight()
+			},
+			func() []exported.Height {
+				var retained []exported.Height
+				for _, height := range heights[:len(heights)-1] {
+					if retention.IsCheckpoint(height) {
+						retained = append(retained, height)
+					}
+				}
+
+				return append(retained, heights[len(heights)-1])
+			},
+		},
+		{
+			"success: expired checkpoints are pruned",
+			func() {
+				retention.KeepEveryNthHeight = 1
+				blockTime = blockTime.Add(ibctesting.TrustingPeriod)
+			},
+			func() []exported.Height { return heights[len(heights)-1:] },
+		},
+		{
+			"success: latest consensus state is never pruned",
+			func() {
+				retention.MaxAge = time.Nanosecond
+				blockTime = blockTime.Add(time.Hour)
+			},
+			func() []exported.Height { return heights[len(heights)-1:] },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			for i := 0; i < 5; i++ {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			}
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			heights = nil
+			tendermint.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+				heights = append(heights, height)
+				return false
+			})
+			suite.Require().Len(heights, 6)
+
+			retention = clienttypes.ConsensusStateRetention{}
+			delayTimePeriod = 0
+			limit = 0
+			blockTime = ctx.BlockTime()
+
+			tc.malleate()
+
+			expRetained := tc.expRetainedFn()
+
+			clientState := path.EndpointA.GetClientState().(*tendermint.ClientState)
+			pruned := tendermint.PruneConsensusStates(ctx.WithBlockTime(blockTime), clientStore, suite.chainA.Codec, clientState, retention, delayTimePeriod, limit)
+			suite.Require().Equal(uint64(len(heights)-len(expRetained)), pruned)
+
+			var retained []exported.Height
+			tendermint.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+				retained = append(retained, height)
+				return false
+			})
+			suite.Require().Equal(expRetained, retained)
+
+			for _, height := range heights {
+				_, found := tendermint.GetConsensusState(clientStore, suite.chainA.Codec, height)
+				_, foundProcessedTime := tendermint.GetProcessedTime(clientStore, height)
+				suite.Require().Equal(slices.Contains(expRetained, height), found)
+				suite.Require().Equal(found, foundProcessedTime)
+			}
+
+			// the stored count of consensus states which are not checkpoints is maintained as consensus states are pruned
+			if retention.MaxConsensusStates != 0 {
+				var expCount uint64
+				for _, height := range retained {
+					if !retention.IsCheckpoint(height) {
+						expCount++
+					}
+				}
+
+				bz := clientStore.Get(tendermint.KeyConsensusStateCount)
+				suite.Require().Equal(retention.KeepEveryNthHeight, binary.BigEndian.Uint64(bz[:8]))
+				suite.Require().Equal(expCount, binary.BigEndian.Uint64(bz[8:]))
+			}
+		})
+	}
+}
@@ -256,6 +256,57 @@ func (m *Header) GetTrustedValidators() *types2.ValidatorSet {
 	return nil
 }
 
+// HeaderChain defines an ordered list of Tendermint client Headers which are
+// applied sequentially, each header being verified against the ConsensusState
+// produced by the previous one. Only the first header must be complete. For
+// every following header an empty TrustedHeight defaults to the height of the
+// previous header, and an empty ValidatorSet or TrustedValidators defaults to
+// the corresponding validator set of the previous header, so that a validator
+// set which does not change is only submitted once.
+type HeaderChain struct {
+	Headers []*Header `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (m *HeaderChain) Reset()         { *m = HeaderChain{} }
+func (m *HeaderChain) String() string { return proto.CompactTextString(m) }
+func (*HeaderChain) ProtoMessage()    {}
+func (*HeaderChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
+}
+func (m *HeaderChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderChain.Merge(m, src)
+}
+func (m *HeaderChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderChain proto.InternalMessageInfo
+
+func (m *HeaderChain) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -267,7 +318,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +366,7 @@ func init() {
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
+	proto.RegisterType((*HeaderChain)(nil), "ibc.lightclients.tendermint.v1.HeaderChain")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
 
@@ -323,66 +375,68 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xe3, 0x34, 0xdb, 0x26, 0x93, 0x64, 0xfb, 0xfb, 0x8d, 0x56, 0xc8, 0xad, 0xaa, 0x24,
-	0xf4, 0x00, 0xb9, 0xd4, 0xde, 0x64, 0x91, 0x40, 0x2c, 0x1c, 0x48, 0x77, 0xa1, 0x5d, 0xb6, 0x50,
-	0xb9, 0xc0, 0x81, 0x8b, 0x35, 0xb6, 0x27, 0xf6, 0x68, 0x6d, 0x8f, 0x35, 0x33, 0x0e, 0x29, 0x27,
-	0x8e, 0x1c, 0xf7, 0xc8, 0x91, 0x97, 0xc0, 0xcb, 0xd8, 0x63, 0x2f, 0x48, 0x9c, 0x0a, 0x4a, 0xdf,
-	0x05, 0x27, 0x34, 0x7f, 0x9c, 0x98, 0xb2, 0x62, 0x2b, 0x2e, 0xd1, 0x33, 0xf3, 0x7c, 0x9f, 0x4f,
-	0x66, 0x9e, 0x3f, 0x63, 0xe0, 0x92, 0x20, 0x74, 0x53, 0x12, 0x27, 0x22, 0x4c, 0x09, 0xce, 0x05,
-	0x77, 0x05, 0xce, 0x23, 0xcc, 0x32, 0x92, 0x0b, 0x77, 0x31, 0xa9, 0xad, 0x9c, 0x82, 0x51, 0x41,
-	0xe1, 0x80, 0x04, 0xa1, 0x53, 0x0f, 0x70, 0x6a, 0x92, 0xc5, 0x64, 0x7f, 0x54, 0x8b, 0x17, 0x97,
-	0x05, 0xe6, 0xee, 0x02, 0xa5, 0x24, 0x42, 0x82, 0x32, 0x4d, 0xd8, 0x3f, 0xf8, 0x87, 0x42, 0xfd,
-	0x56, 0xde, 0x90, 0xf2, 0x8c, 0x72, 0x97, 0x84, 0x7c, 0xfa, 0x48, 0x9e, 0xa0, 0x60, 0x94, 0xce,
-	0x2b, 0xef, 0x20, 0xa6, 0x34, 0x4e, 0xb1, 0xab, 0x56, 0x41, 0x39, 0x77, 0xa3, 0x92, 0x21, 0x41,
-	0x68, 0x6e, 0xfc, 0xc3, 0xdb, 0x7e, 0x41, 0x32, 0xcc, 0x05, 0xca, 0x8a, 0x4a, 0x20, 0xef, 0x1b,
-	0x52, 0x86, 0x5d, 0x7d, 0x7c, 0xf9, 0x0f, 0xda, 0x32, 0x82, 0x77, 0x37, 0x02, 0x9a, 0x65, 0x44,
-	0x64, 0x95, 0x68, 0xbd, 0x32, 0xc2, 0x07, 0x31, 0x8d, 0xa9, 0x32, 0x5d, 0x69, 0xe9, 0xdd, 0xc3,
-	0xd5, 0x3d, 0xd0, 0x3d, 0x56, 0xbc, 0x0b, 0x81, 0x04, 0x86, 0x7b, 0xa0, 0x1d, 0x26, 0x88, 0xe4,
-	0x3e, 0x89, 0x6c, 0x6b, 0x64, 0x8d, 0x3b, 0xde, 0x8e, 0x5a, 0x9f, 0x46, 0xf0, 0x4b, 0xd0, 0x15,
-	0xac, 0xe4, 0xc2, 0x4f, 0xf1, 0x02, 0xa7, 0x76, 0x73, 0x64, 0x8d, 0xbb, 0xd3, 0xb1, 0xf3, 0xef,
-	0xf9, 0x75, 0x3e, 0x65, 0x28, 0x94, 0x17, 0x9e, 0xb5, 0x5e, 0x5d, 0x0f, 0x1b, 0x1e, 0x50, 0x88,
-	0xe7, 0x92, 0x00, 0x9f, 0x83, 0x5d, 0xb5, 0x22, 0x79, 0xec, 0x17, 0x98, 0x11, 0x1a, 0xd9, 0x5b,
-	0x0a, 0xba, 0xe7, 0xe8, 0xb4, 0x38, 0x55, 0x5a, 0x9c, 0x27, 0x26, 0x6d, 0xb3, 0xb6, 0xa4, 0xfc,
-	0xf4, 0xfb, 0xd0, 0xf2, 0xee, 0x57, 0xb1, 0xe7, 0x2a, 0x14, 0x7e, 0x01, 0xfe, 0x57, 0xe6, 0x01,
-	0xcd, 0xa3, 0x1a, 0xae, 0x75, 0x77, 0xdc, 0xee, 0x3a, 0xd8, 0xf0, 0x3e, 0x07, 0xbb, 0x19, 0x5a,
-	0xfa, 0x61, 0x4a, 0xc3, 0x17, 0x7e, 0xc4, 0xc8, 0x5c, 0xd8, 0xf7, 0xee, 0x8e, 0xeb, 0x67, 0x68,
-	0x79, 0x2c, 0x43, 0x9f, 0xc8, 0x48, 0xf8, 0x14, 0xf4, 0xe7, 0x8c, 0x7e, 0x8f, 0x73, 0x3f, 0xc1,
-	0x32, 0x57, 0xf6, 0xb6, 0x42, 0xed, 0xab, 0xec, 0xc9, 0xea, 0x39, 0xa6, 0xa8, 0x8b, 0x89, 0x73,
-	0xa2, 0x14, 0x26, 0x5f, 0x3d, 0x1d, 0xa6, 0xf7, 0x24, 0x26, 0x45, 0x02, 0x73, 0x51, 0x61, 0x76,
-	0xee, 0x8a, 0xd1, 0x61, 0x06, 0xf3, 0x18, 0x74, 0x55, 0x97, 0xfa, 0xbc, 0xc0, 0x21, 0xb7, 0xdb,
-	0xa3, 0x2d, 0x05, 0xd1, 0x9d, 0xec, 0xa8, 0x4e, 0x96, 0x84, 0x73, 0xa9, 0xb9, 0x28, 0x70, 0xe8,
-	0x81, 0xa2, 0x32, 0x39, 0x7c, 0x1b, 0xf4, 0xca, 0x22, 0x66, 0x28, 0xc2, 0x7e, 0x81, 0x44, 0x62,
-	0x77, 0x46, 0x5b, 0xe3, 0x8e, 0xd7, 0x35, 0x7b, 0xe7, 0x48, 0x24, 0xf0, 0x63, 0xb0, 0x87, 0xd2,
-	0x94, 0x7e, 0xe7, 0x97, 0x45, 0x84, 0x04, 0xf6, 0xd1, 0x5c, 0x60, 0xe6, 0xe3, 0x65, 0x41, 0xd8,
-	0xa5, 0x0d, 0x46, 0xd6, 0xb8, 0x3d, 0x6b, 0xda, 0x96, 0xf7, 0x96, 0x12, 0x7d, 0xad, 0x34, 0x9f,
-	0x48, 0xc9, 0x53, 0xa5, 0x80, 0xa7, 0x60, 0xf8, 0x9a, 0xf0, 0x8c, 0xf0, 0x00, 0x27, 0x68, 0x41,
-	0x68, 0xc9, 0xec, 0xee, 0x1a, 0x72, 0x70, 0x1b, 0x72, 0x56, 0xd3, 0x7d, 0xd8, 0xfa, 0xf1, 0xe7,
-	0x61, 0xe3, 0xf0, 0x87, 0x26, 0xb8, 0x7f, 0x4c, 0x73, 0x8e, 0x73, 0x5e, 0x72, 0xdd, 0xe7, 0x33,
-	0xd0, 0x59, 0x8f, 0x9a, 0x6a, 0x74, 0x99, 0x80, 0xdb, 0x75, 0xfd, 0xaa, 0x52, 0xe8, 0xc2, 0xbe,
-	0x94, 0x85, 0xdd, 0x84, 0xc1, 0x8f, 0x40, 0x8b, 0x51, 0x2a, 0xcc, 0x24, 0x1c, 0xd6, 0x8a, 0xb0,
-	0x99, 0xbd, 0xc5, 0xc4, 0x39, 0xc3, 0xec, 0x45, 0x8a, 0x3d, 0x4a, 0xab, 0x62, 0xa8, 0x28, 0x38,
-	0x07, 0x0f, 0x72, 0xbc, 0x14, 0xfe, 0xfa, 0xb9, 0xe1, 0x7e, 0x82, 0x78, 0xa2, 0x46, 0xa0, 0x37,
-	0x7b, 0xef, 0xcf, 0xeb, 0xe1, 0xc3, 0x98, 0x88, 0xa4, 0x0c, 0x24, 0x4e, 0x8e, 0x33, 0x16, 0xc1,
-	0x5c, 0x6c, 0x8c, 0x94, 0x04, 0xdc, 0x0d, 0x2e, 0x05, 0xe6, 0xce, 0x09, 0x5e, 0xce, 0xa4, 0xe1,
-	0x41, 0x49, 0xfc, 0x66, 0x0d, 0x3c, 0x41, 0x3c, 0x31, 0x29, 0xf8, 0xd5, 0x02, 0xbd, 0x7a, 0x66,
-	0xe0, 0x10, 0x74, 0x74, 0xaf, 0xac, 0x27, 0x5d, 0xa5, 0xb3, 0xad, 0x37, 0x4f, 0xe5, 0x3c, 0xb5,
-	0x13, 0x8c, 0x22, 0xcc, 0xfc, 0x89, 0xb9, 0xe1, 0x3b, 0x6f, 0x9a, 0xf5, 0x13, 0xa5, 0x9f, 0x75,
-	0x57, 0xd7, 0xc3, 0x1d, 0x6d, 0x4f, 0xbc, 0x1d, 0x0d, 0x99, 0xd4, 0x78, 0x53, 0x33, 0xe6, 0xff,
-	0x81, 0x37, 0xad, 0x78, 0x53, 0x73, 0xaf, 0x5f, 0x9a, 0x60, 0x5b, 0xbb, 0xe0, 0x29, 0xe8, 0x73,
-	0x12, 0xe7, 0x38, 0xf2, 0xb5, 0xc4, 0x94, 0x75, 0x50, 0x87, 0xea, 0x97, 0xfb, 0x42, 0xc9, 0x0c,
-	0xbd, 0x75, 0x75, 0x3d, 0xb4, 0xbc, 0x1e, 0xaf, 0xed, 0xc1, 0x63, 0xd0, 0x5f, 0x97, 0xc5, 0xe7,
-	0xb8, 0x2a, 0xf1, 0x6b, 0x50, 0xeb, 0x64, 0x5f, 0x60, 0xe1, 0xf5, 0x16, 0xb5, 0x15, 0xfc, 0x0c,
-	0xe8, 0x27, 0x4a, 0x1d, 0x48, 0x4d, 0xeb, 0xd6, 0x1d, 0xa7, 0xb5, 0x6f, 0xe2, 0xcc, 0xb8, 0x9e,
-	0x01, 0x58, 0x81, 0x36, 0xcd, 0x62, 0xde, 0xb6, 0x37, 0x1d, 0xe9, 0xff, 0x26, 0x72, 0xd3, 0x14,
-	0x87, 0xcf, 0x40, 0xbb, 0x7a, 0x94, 0xe1, 0x01, 0xe8, 0xe4, 0x65, 0x86, 0x99, 0xf4, 0xa8, 0x7c,
-	0xb5, 0xbc, 0xcd, 0x06, 0x1c, 0x81, 0x6e, 0x84, 0x73, 0x9a, 0x91, 0x5c, 0xf9, 0x9b, 0xca, 0x5f,
-	0xdf, 0x9a, 0x45, 0xaf, 0x56, 0x03, 0xeb, 0x6a, 0x35, 0xb0, 0xfe, 0x58, 0x0d, 0xac, 0x97, 0x37,
-	0x83, 0xc6, 0xd5, 0xcd, 0xa0, 0xf1, 0xdb, 0xcd, 0xa0, 0xf1, 0xed, 0xb3, 0xbf, 0x35, 0xaf, 0xfe,
-	0x44, 0x06, 0xe1, 0x51, 0x4c, 0xdd, 0xc5, 0x07, 0x6e, 0x46, 0xa3, 0x32, 0xc5, 0x5c, 0x7f, 0xc8,
-	0x8f, 0xaa, 0x2f, 0xf9, 0xc3, 0xf7, 0x8f, 0x36, 0x97, 0x79, 0xbc, 0x31, 0x83, 0x6d, 0x35, 0x91,
-	0x8f, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xd6, 0xd5, 0xaa, 0xfd, 0x07, 0x00, 0x00,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xeb, 0x26, 0xbb, 0x4d, 0x26, 0xc9, 0x16, 0x46, 0x2b, 0xe4, 0x56, 0x55, 0x12, 0x72,
+	0x80, 0x5c, 0x6a, 0x6f, 0xb2, 0x48, 0x20, 0x16, 0x24, 0x48, 0x76, 0xa1, 0x5d, 0xb6, 0x6c, 0xe5,
+	0x02, 0x07, 0x2e, 0xd6, 0xd8, 0x9e, 0xd8, 0xa3, 0xb5, 0x3d, 0x96, 0x67, 0x1c, 0x52, 0x4e, 0x1c,
+	0x39, 0xee, 0x91, 0x23, 0x1f, 0x81, 0x8f, 0xb1, 0xc7, 0x5e, 0x90, 0x38, 0x15, 0x94, 0x7e, 0x0b,
+	0x4e, 0x68, 0x5e, 0xec, 0x98, 0xb2, 0x82, 0x88, 0x4b, 0xf5, 0xcc, 0x33, 0xff, 0xe7, 0x97, 0x99,
+	0xe7, 0x65, 0x6a, 0x60, 0x13, 0xcf, 0xb7, 0x63, 0x12, 0x46, 0xdc, 0x8f, 0x09, 0x4e, 0x39, 0xb3,
+	0x39, 0x4e, 0x03, 0x9c, 0x27, 0x24, 0xe5, 0xf6, 0x72, 0x52, 0x5b, 0x59, 0x59, 0x4e, 0x39, 0x85,
+	0x7d, 0xe2, 0xf9, 0x56, 0x3d, 0xc0, 0xaa, 0x49, 0x96, 0x93, 0xc3, 0x61, 0x2d, 0x9e, 0x5f, 0x66,
+	0x98, 0xd9, 0x4b, 0x14, 0x93, 0x00, 0x71, 0x9a, 0x2b, 0xc2, 0xe1, 0xd1, 0x3f, 0x14, 0xf2, 0x6f,
+	0xb9, 0xeb, 0x53, 0x96, 0x50, 0x66, 0x13, 0x9f, 0x4d, 0x1f, 0x8a, 0x13, 0x64, 0x39, 0xa5, 0x8b,
+	0x72, 0xb7, 0x1f, 0x52, 0x1a, 0xc6, 0xd8, 0x96, 0x2b, 0xaf, 0x58, 0xd8, 0x41, 0x91, 0x23, 0x4e,
+	0x68, 0xaa, 0xf7, 0x07, 0xb7, 0xf7, 0x39, 0x49, 0x30, 0xe3, 0x28, 0xc9, 0x4a, 0x81, 0xb8, 0xaf,
+	0x4f, 0x73, 0x6c, 0xab, 0xe3, 0x8b, 0x5f, 0x50, 0x96, 0x16, 0xbc, 0xbb, 0x11, 0xd0, 0x24, 0x21,
+	0x3c, 0x29, 0x45, 0xd5, 0x4a, 0x0b, 0xef, 0x87, 0x34, 0xa4, 0xd2, 0xb4, 0x85, 0xa5, 0xbc, 0xa3,
+	0xf5, 0x1d, 0xd0, 0x99, 0x4b, 0xde, 0x05, 0x47, 0x1c, 0xc3, 0x03, 0xd0, 0xf2, 0x23, 0x44, 0x52,
+	0x97, 0x04, 0xa6, 0x31, 0x34, 0xc6, 0x6d, 0x67, 0x4f, 0xae, 0x4f, 0x03, 0xf8, 0x1c, 0x74, 0x78,
+	0x5e, 0x30, 0xee, 0xc6, 0x78, 0x89, 0x63, 0x73, 0x77, 0x68, 0x8c, 0x3b, 0xd3, 0xb1, 0xf5, 0xef,
+	0xf9, 0xb5, 0x3e, 0xcb, 0x91, 0x2f, 0x2e, 0x3c, 0x6b, 0xbe, 0xba, 0x1e, 0xec, 0x38, 0x40, 0x22,
+	0x9e, 0x09, 0x02, 0x7c, 0x06, 0xf6, 0xe5, 0x8a, 0xa4, 0xa1, 0x9b, 0xe1, 0x9c, 0xd0, 0xc0, 0x6c,
+	0x48, 0xe8, 0x81, 0xa5, 0xd2, 0x62, 0x95, 0x69, 0xb1, 0x1e, 0xeb, 0xb4, 0xcd, 0x5a, 0x82, 0xf2,
+	0xd3, 0xef, 0x03, 0xc3, 0xb9, 0x57, 0xc6, 0x9e, 0xcb, 0x50, 0xf8, 0x25, 0x78, 0xa3, 0x48, 0x3d,
+	0x9a, 0x06, 0x35, 0x5c, 0x73, 0x7b, 0xdc, 0x7e, 0x15, 0xac, 0x79, 0x5f, 0x80, 0xfd, 0x04, 0xad,
+	0x5c, 0x3f, 0xa6, 0xfe, 0x0b, 0x37, 0xc8, 0xc9, 0x82, 0x9b, 0x77, 0xb6, 0xc7, 0xf5, 0x12, 0xb4,
+	0x9a, 0x8b, 0xd0, 0xc7, 0x22, 0x12, 0x3e, 0x01, 0xbd, 0x45, 0x4e, 0xbf, 0xc7, 0xa9, 0x1b, 0x61,
+	0x91, 0x2b, 0xf3, 0xae, 0x44, 0x1d, 0xca, 0xec, 0x89, 0xea, 0x59, 0xba, 0xa8, 0xcb, 0x89, 0x75,
+	0x22, 0x15, 0x3a, 0x5f, 0x5d, 0x15, 0xa6, 0x7c, 0x02, 0x13, 0x23, 0x8e, 0x19, 0x2f, 0x31, 0x7b,
+	0xdb, 0x62, 0x54, 0x98, 0xc6, 0x3c, 0x02, 0x1d, 0xd9, 0xa5, 0x2e, 0xcb, 0xb0, 0xcf, 0xcc, 0xd6,
+	0xb0, 0x21, 0x21, 0xaa, 0x93, 0x2d, 0xd9, 0xc9, 0x82, 0x70, 0x2e, 0x34, 0x17, 0x19, 0xf6, 0x1d,
+	0x90, 0x95, 0x26, 0x83, 0x6f, 0x83, 0x6e, 0x91, 0x85, 0x39, 0x0a, 0xb0, 0x9b, 0x21, 0x1e, 0x99,
+	0xed, 0x61, 0x63, 0xdc, 0x76, 0x3a, 0xda, 0x77, 0x8e, 0x78, 0x04, 0x3f, 0x06, 0x07, 0x28, 0x8e,
+	0xe9, 0x77, 0x6e, 0x91, 0x05, 0x88, 0x63, 0x17, 0x2d, 0x38, 0xce, 0x5d, 0xbc, 0xca, 0x48, 0x7e,
+	0x69, 0x82, 0xa1, 0x31, 0x6e, 0xcd, 0x76, 0x4d, 0xc3, 0x79, 0x4b, 0x8a, 0xbe, 0x96, 0x9a, 0x4f,
+	0x85, 0xe4, 0x89, 0x54, 0xc0, 0x53, 0x30, 0x78, 0x4d, 0x78, 0x42, 0x98, 0x87, 0x23, 0xb4, 0x24,
+	0xb4, 0xc8, 0xcd, 0x4e, 0x05, 0x39, 0xba, 0x0d, 0x39, 0xab, 0xe9, 0x3e, 0x6c, 0xfe, 0xf8, 0xf3,
+	0x60, 0x67, 0xf4, 0xc3, 0x2e, 0xb8, 0x37, 0xa7, 0x29, 0xc3, 0x29, 0x2b, 0x98, 0xea, 0xf3, 0x19,
+	0x68, 0x57, 0xa3, 0x26, 0x1b, 0x5d, 0x24, 0xe0, 0x76, 0x5d, 0xbf, 0x2a, 0x15, 0xaa, 0xb0, 0x2f,
+	0x45, 0x61, 0x37, 0x61, 0xf0, 0x23, 0xd0, 0xcc, 0x29, 0xe5, 0x7a, 0x12, 0x46, 0xb5, 0x22, 0x6c,
+	0x66, 0x6f, 0x39, 0xb1, 0xce, 0x70, 0xfe, 0x22, 0xc6, 0x0e, 0xa5, 0x65, 0x31, 0x64, 0x14, 0x5c,
+	0x80, 0xfb, 0x29, 0x5e, 0x71, 0xb7, 0x7a, 0x6e, 0x98, 0x1b, 0x21, 0x16, 0xc9, 0x11, 0xe8, 0xce,
+	0xde, 0xfb, 0xf3, 0x7a, 0xf0, 0x20, 0x24, 0x3c, 0x2a, 0x3c, 0x81, 0x13, 0xe3, 0x8c, 0xb9, 0xb7,
+	0xe0, 0x1b, 0x23, 0x26, 0x1e, 0xb3, 0xbd, 0x4b, 0x8e, 0x99, 0x75, 0x82, 0x57, 0x33, 0x61, 0x38,
+	0x50, 0x10, 0xbf, 0xa9, 0x80, 0x27, 0x88, 0x45, 0x3a, 0x05, 0xbf, 0x1a, 0xa0, 0x5b, 0xcf, 0x0c,
+	0x1c, 0x80, 0xb6, 0xea, 0x95, 0x6a, 0xd2, 0x65, 0x3a, 0x5b, 0xca, 0x79, 0x2a, 0xe6, 0xa9, 0x15,
+	0x61, 0x14, 0xe0, 0xdc, 0x9d, 0xe8, 0x1b, 0xbe, 0xf3, 0x5f, 0xb3, 0x7e, 0x22, 0xf5, 0xb3, 0xce,
+	0xfa, 0x7a, 0xb0, 0xa7, 0xec, 0x89, 0xb3, 0xa7, 0x20, 0x93, 0x1a, 0x6f, 0x6a, 0x36, 0xfe, 0x2f,
+	0x6f, 0x5a, 0xf2, 0xa6, 0xfa, 0x5e, 0xbf, 0xec, 0x82, 0xbb, 0x6a, 0x0b, 0x9e, 0x82, 0x1e, 0x23,
+	0x61, 0x8a, 0x03, 0x57, 0x49, 0x74, 0x59, 0xfb, 0x75, 0xa8, 0x7a, 0xb9, 0x2f, 0xa4, 0x4c, 0xd3,
+	0x9b, 0x57, 0xd7, 0x03, 0xc3, 0xe9, 0xb2, 0x9a, 0x0f, 0xce, 0x41, 0xaf, 0x2a, 0x8b, 0xcb, 0x70,
+	0x59, 0xe2, 0xd7, 0xa0, 0xaa, 0x64, 0x5f, 0x60, 0xee, 0x74, 0x97, 0xb5, 0x15, 0xfc, 0x1c, 0xa8,
+	0x27, 0x4a, 0x1e, 0x48, 0x4e, 0x6b, 0x63, 0xcb, 0x69, 0xed, 0xe9, 0x38, 0x3d, 0xae, 0x67, 0x00,
+	0x96, 0xa0, 0x4d, 0xb3, 0x98, 0xcd, 0xad, 0x8e, 0xf4, 0xa6, 0x8e, 0xac, 0x9c, 0x6c, 0xf4, 0x1c,
+	0x74, 0xd4, 0x35, 0xe7, 0xe2, 0x61, 0x87, 0x9f, 0x00, 0x9d, 0x52, 0x66, 0x1a, 0xc3, 0xc6, 0xf6,
+	0x65, 0x29, 0x2b, 0xc1, 0x46, 0x4f, 0x41, 0xab, 0x7c, 0xe5, 0xe1, 0x11, 0x68, 0xa7, 0x45, 0x82,
+	0x73, 0xf1, 0x53, 0xb2, 0x00, 0x4d, 0x67, 0xe3, 0x80, 0x43, 0xd0, 0x09, 0x70, 0x4a, 0x13, 0x92,
+	0xca, 0xfd, 0x5d, 0xb9, 0x5f, 0x77, 0xcd, 0x82, 0x57, 0xeb, 0xbe, 0x71, 0xb5, 0xee, 0x1b, 0x7f,
+	0xac, 0xfb, 0xc6, 0xcb, 0x9b, 0xfe, 0xce, 0xd5, 0x4d, 0x7f, 0xe7, 0xb7, 0x9b, 0xfe, 0xce, 0xb7,
+	0x4f, 0xff, 0x36, 0x0d, 0xea, 0x7f, 0xae, 0xe7, 0x1f, 0x87, 0xd4, 0x5e, 0x7e, 0x60, 0x27, 0x34,
+	0x28, 0x62, 0xcc, 0xd4, 0x97, 0xc1, 0x71, 0xf9, 0x69, 0xf0, 0xe0, 0xfd, 0xe3, 0xcd, 0xc9, 0x1f,
+	0x6d, 0x4c, 0xef, 0xae, 0x1c, 0xf1, 0x87, 0x7f, 0x0d, 0x00, 0xb2, 0x7e, 0x07, 0x99, 0x4e, 0x08,
+	0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -683,6 +737,43 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeaderChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -831,6 +922,21 @@ func (m *Header) Size() (n int) {
 	return n
 }
 
+func (m *HeaderChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Fraction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1733,6 +1839,90 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeaderChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// getCmdCode defines the command to query wasm code for given checksum.
+func getCmdCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code [checksum]",
+		Short:   "Query wasm code",
+		Long:    "Query wasm code for a light client wasm contract with a given checksum",
+		Example: fmt.Sprintf("%s query ibc-wasm code [checksum]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryCodeRequest{
+				Checksum: args[0],
+			}
+
+			res, err := queryClient.Code(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdChecksums defines the command to query all wasm checksums.
+func getCmdChecksums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksums",
+		Short:   "Query all wasm checksums",
+		Long:    "Query all checksums for light client wasm contracts",
+		Example: fmt.Sprintf("%s query ibc-wasm checksums", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryChecksumsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Checksums(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all wasm checksums")
+
+	return cmd
+}
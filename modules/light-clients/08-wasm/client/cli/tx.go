@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// FlagAuthority is the flag of the address of the 08-wasm module authority, defaulting to the gov module account.
+const FlagAuthority = "authority"
+
+// newSubmitStoreCodeProposalCmd defines the command to submit a proposal storing a light client wasm contract.
+func newSubmitStoreCodeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "store-code [path/to/wasm-file]",
+		Short:   "Submit a proposal to store wasm code",
+		Long:    "Submit a proposal to store a light client wasm contract. The wasm file may be gzip compressed.",
+		Example: fmt.Sprintf("%s tx ibc-wasm store-code path/to/wasm-file", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			code, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(authority string) sdk.Msg {
+				return types.NewMsgStoreCode(authority, code)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// newSubmitRemoveChecksumProposalCmd defines the command to submit a proposal removing a light client wasm contract.
+func newSubmitRemoveChecksumProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-checksum [checksum]",
+		Short:   "Submit a proposal to remove wasm code",
+		Long:    "Submit a proposal to remove the light client wasm contract with the given hex encoded checksum.",
+		Example: fmt.Sprintf("%s tx ibc-wasm remove-checksum [checksum]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum: %w", err)
+			}
+
+			return submitProposal(cmd, func(authority string) sdk.Msg {
+				return types.NewMsgRemoveChecksum(authority, checksum)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// newSubmitMigrateContractProposalCmd defines the command to submit a proposal migrating the contract of a client.
+func newSubmitMigrateContractProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-contract [client-id] [checksum] [migrate-msg]",
+		Short:   "Submit a proposal to migrate the contract of a wasm client",
+		Long:    "Submit a proposal to migrate the contract of a wasm client to the code with the given hex encoded checksum, passing the json encoded migrate message to the contract.",
+		Example: fmt.Sprintf(`%s tx ibc-wasm migrate-contract 08-wasm-0 [checksum] '{}'`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checksum, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid checksum: %w", err)
+			}
+
+			return submitProposal(cmd, func(authority string) sdk.Msg {
+				return types.NewMsgMigrateContract(authority, args[0], checksum, []byte(args[2]))
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal submits a governance proposal with the message created for the module authority.
+func submitProposal(cmd *cobra.Command, newMsg func(authority string) sdk.Msg) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	authority, _ := cmd.Flags().GetString(FlagAuthority)
+	if authority != "" {
+		if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+			return fmt.Errorf("invalid authority address: %w", err)
+		}
+	} else {
+		authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+	}
+
+	msg := newMsg(authority)
+	if validateBasic, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := validateBasic.ValidateBasic(); err != nil {
+			return fmt.Errorf("error validating %T: %w", msg, err)
+		}
+	}
+
+	if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+		return fmt.Errorf("failed to create proposal message: %w", err)
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAuthority, "", "The address of the 08-wasm module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+}
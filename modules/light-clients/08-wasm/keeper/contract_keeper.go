@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// Instantiate calls the instantiate entry point of the contract with the given checksum, which is expected
+// to initialize the client store with the client state and the initial consensus state.
+func (k Keeper) Instantiate(ctx sdk.Context, clientStore storetypes.KVStore, checksum types.Checksum, payload types.InstantiateMessage) error {
+	msg, err := json.Marshal(payload)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrVMError, "failed to marshal payload for wasm contract instantiation")
+	}
+
+	if err := k.loadCode(ctx, checksum); err != nil {
+		return err
+	}
+
+	_, gasUsed, err := k.vm.Instantiate(ctx, checksum, getEnv(ctx), msg, clientStore, ctx.GasMeter().GasRemaining())
+	consumeContractGas(ctx, gasUsed, err, "08-wasm contract instantiate")
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to instantiate wasm contract")
+	}
+
+	return k.checkContractModification(clientStore, checksum)
+}
+
+// Query calls the query entry point of the contract of the given client state. The contract
+// is not allowed to modify the client store. The gas limit of the query is capped at MaxQueryGas,
+// and an error is returned rather than an out of gas panic if the contract exceeds the cap.
+func (k Keeper) Query(ctx sdk.Context, clientStore storetypes.KVStore, clientState *types.ClientState, payload types.QueryMsg) ([]byte, error) {
+	msg, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrVMError, "failed to marshal payload for wasm query")
+	}
+
+	if err := k.loadCode(ctx, clientState.Checksum); err != nil {
+		return nil, err
+	}
+
+	gasLimit := min(ctx.GasMeter().GasRemaining(), types.MaxQueryGas)
+	res, gasUsed, err := k.vm.Query(ctx, clientState.Checksum, getEnv(ctx), msg, readOnlyStore{clientStore}, gasLimit)
+	if gasLimit == types.MaxQueryGas && errors.Is(err, types.ErrWasmOutOfGas) {
+		ctx.GasMeter().ConsumeGas(gasUsed, "08-wasm contract query")
+		return nil, errorsmod.Wrapf(err, "wasm query exceeded the maximum gas of %d", types.MaxQueryGas)
+	}
+
+	consumeContractGas(ctx, gasUsed, err, "08-wasm contract query")
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to query wasm contract")
+	}
+
+	return res, nil
+}
+
+// Sudo calls the sudo entry point of the contract of the given client state. The contract may modify
+// the client store but must neither change the client type nor the checksum of the client state.
+func (k Keeper) Sudo(ctx sdk.Context, clientStore storetypes.KVStore, clientState *types.ClientState, payload types.SudoMsg) ([]byte, error) {
+	res, err := k.sudo(ctx, clientStore, clientState.Checksum, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkContractModification(clientStore, clientState.Checksum); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Migrate calls the migrate entry point of the contract with the given checksum on the client store.
+func (k Keeper) Migrate(ctx sdk.Context, clientStore storetypes.KVStore, checksum types.Checksum, migrateMsg []byte) error {
+	if err := k.loadCode(ctx, checksum); err != nil {
+		return err
+	}
+
+	_, gasUsed, err := k.vm.Migrate(ctx, checksum, getEnv(ctx), migrateMsg, clientStore, ctx.GasMeter().GasRemaining())
+	consumeContractGas(ctx, gasUsed, err, "08-wasm contract migrate")
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to migrate wasm contract")
+	}
+
+	return nil
+}
+
+// MigrateClientStore calls the sudo entry point of the contract of the subject client state to recover
+// the subject client with the substitute client. Both client stores are exposed to the contract.
+func (k Keeper) MigrateClientStore(ctx sdk.Context, subjectClientStore, substituteClientStore storetypes.KVStore, subjectClientState *types.ClientState) error {
+	store := newMigrateClientWrappedStore(subjectClientStore, substituteClientStore)
+	payload := types.SudoMsg{MigrateClientStore: &types.MigrateClientStoreMsg{}}
+
+	if _, err := k.sudo(ctx, store, subjectClientState.Checksum, payload); err != nil {
+		return err
+	}
+
+	return k.checkContractModification(subjectClientStore, subjectClientState.Checksum)
+}
+
+func (k Keeper) sudo(ctx sdk.Context, store storetypes.KVStore, checksum types.Checksum, payload types.SudoMsg) ([]byte, error) {
+	msg, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrVMError, "failed to marshal payload for wasm execution")
+	}
+
+	if err := k.loadCode(ctx, checksum); err != nil {
+		return nil, err
+	}
+
+	res, gasUsed, err := k.vm.Sudo(ctx, checksum, getEnv(ctx), msg, store, ctx.GasMeter().GasRemaining())
+	consumeContractGas(ctx, gasUsed, err, "08-wasm contract sudo")
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to execute wasm contract")
+	}
+
+	return res, nil
+}
+
+// consumeContractGas consumes the gas used by a contract execution, which is limited to the gas remaining
+// when the contract is called. An out of gas panic is raised if the contract exceeded its gas limit.
+func consumeContractGas(ctx sdk.Context, gasUsed uint64, err error, descriptor string) {
+	ctx.GasMeter().ConsumeGas(gasUsed, descriptor)
+
+	if errors.Is(err, types.ErrWasmOutOfGas) {
+		panic(storetypes.ErrorOutOfGas{Descriptor: descriptor})
+	}
+}
+
+// loadCode returns an error if the code with the given checksum is not stored. Otherwise it ensures the
+// code is compiled by the VM, which does not persist compiled code across restarts.
+func (k Keeper) loadCode(ctx sdk.Context, checksum types.Checksum) error {
+	if !k.HasChecksum(ctx, checksum) {
+		return errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum %s", hex.EncodeToString(checksum))
+	}
+
+	if k.vm.HasCode(checksum) {
+		return nil
+	}
+
+	// the code is read from the underlying store to not charge the caller for compiling the contract
+	code := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.CodeKey(checksum))
+	if _, err := k.vm.StoreCode(code); err != nil {
+		return errorsmod.Wrap(err, "failed to compile wasm code")
+	}
+
+	return nil
+}
+
+// checkContractModification returns an error if the client state stored by the contract is not an 08-wasm
+// client state with the given checksum.
+func (k Keeper) checkContractModification(clientStore storetypes.KVStore, checksum types.Checksum) error {
+	clientState, found := k.getClientState(clientStore)
+	if !found {
+		return errorsmod.Wrap(types.ErrWasmInvalidContractModification, "08-wasm client state not found in the client store")
+	}
+
+	if !bytes.Equal(clientState.Checksum, checksum) {
+		return errorsmod.Wrapf(types.ErrWasmInvalidContractModification, "expected checksum %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(clientState.Checksum))
+	}
+
+	return nil
+}
+
+// getEnv returns the environment of the host chain passed to the contracts.
+func getEnv(ctx sdk.Context) types.Env {
+	return types.Env{
+		Block: types.BlockInfo{
+			Height:  uint64(ctx.BlockHeight()),
+			Time:    uint64(ctx.BlockTime().UnixNano()),
+			ChainID: ctx.ChainID(),
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryGasLimit() {
+	var (
+		gasMeter     storetypes.GasMeter
+		queryErr     error
+		gasRemaining uint64
+		gasConsumed  uint64
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expGasLimit func() uint64
+		expError    error
+	}{
+		{
+			"success: gas limit capped with an infinite gas meter",
+			func() {},
+			func() uint64 { return types.MaxQueryGas },
+			nil,
+		},
+		{
+			"success: gas limit capped with a gas meter above the cap",
+			func() {
+				gasMeter = storetypes.NewGasMeter(2 * types.MaxQueryGas)
+			},
+			func() uint64 { return types.MaxQueryGas },
+			nil,
+		},
+		{
+			"success: gas remaining below the cap",
+			func() {
+				gasMeter = storetypes.NewGasMeter(100_000)
+			},
+			func() uint64 { return gasRemaining },
+			nil,
+		},
+		{
+			"failure: query exceeds the cap",
+			func() {
+				suite.mockVM.GasUsed = types.MaxQueryGas
+				queryErr = types.ErrWasmOutOfGas
+			},
+			func() uint64 { return types.MaxQueryGas },
+			types.ErrWasmOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			gasMeter = storetypes.NewInfiniteGasMeter()
+			queryErr = nil
+
+			checksum := suite.storeCode(mockCode)
+			clientState := types.NewClientState(mockClientStateData, checksum, clienttypes.NewHeight(1, 1))
+
+			suite.mockVM.QueryFn = func(ctx context.Context, _ types.Checksum, _ types.Env, _ []byte, _ storetypes.KVStore) ([]byte, error) {
+				gasRemaining = sdk.UnwrapSDKContext(ctx).GasMeter().GasRemaining()
+				gasConsumed = sdk.UnwrapSDKContext(ctx).GasMeter().GasConsumed()
+				return []byte("{}"), queryErr
+			}
+
+			tc.malleate()
+
+			ctx := suite.ctx.WithGasMeter(gasMeter)
+			clientStore := suite.storeProvider.ClientStore(ctx, defaultClientID)
+
+			_, err := suite.keeper.Query(ctx, clientStore, clientState, types.QueryMsg{Status: &types.StatusMsg{}})
+			suite.Require().Equal(tc.expGasLimit(), suite.mockVM.GasLimit)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+
+			// the gas used by the contract is consumed in any case
+			suite.Require().Equal(gasConsumed+suite.mockVM.GasUsed, gasMeter.GasConsumed())
+		})
+	}
+}
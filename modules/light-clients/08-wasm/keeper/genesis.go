@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// InitGenesis initializes the 08-wasm module state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) error {
+	for _, contract := range state.Contracts {
+		if _, err := k.storeWasmCode(ctx, contract.CodeBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the 08-wasm module exported genesis, which contains all the stored codes
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	var contracts []types.Contract
+	k.IterateCode(ctx, func(_ types.Checksum, code []byte) bool {
+		contracts = append(contracts, types.Contract{CodeBytes: code})
+		return false
+	})
+
+	return types.GenesisState{
+		Contracts: contracts,
+	}
+}
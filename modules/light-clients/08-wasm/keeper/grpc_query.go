@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// Checksums implements the Query/Checksums gRPC method. It returns the hex encoded checksums of all the stored codes.
+func (k Keeper) Checksums(goCtx context.Context, req *types.QueryChecksumsRequest) (*types.QueryChecksumsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var checksums []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeKey(nil))
+	pagination, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		checksums = append(checksums, hex.EncodeToString(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryChecksumsResponse{
+		Checksums:  checksums,
+		Pagination: pagination,
+	}, nil
+}
+
+// Code implements the Query/Code gRPC method. It returns the code stored for the hex encoded checksum.
+func (k Keeper) Code(goCtx context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	if err := types.ValidateWasmChecksum(checksum); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	code, found := k.GetCode(ctx, checksum)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrWasmChecksumNotFound.Wrap(req.Checksum).Error())
+	}
+
+	return &types.QueryCodeResponse{
+		Data: code,
+	}, nil
+}
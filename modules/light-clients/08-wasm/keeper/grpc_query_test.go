@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryCode() {
+	var req *types.QueryCodeRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expCode  codes.Code
+	}{
+		{
+			"success",
+			func() {},
+			codes.OK,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"failure: checksum is not hex encoded",
+			func() {
+				req.Checksum = "invalid"
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"failure: invalid checksum length",
+			func() {
+				req.Checksum = hex.EncodeToString([]byte("checksum"))
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"failure: checksum not found",
+			func() {
+				req.Checksum = hex.EncodeToString(make([]byte, 32))
+			},
+			codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			checksum := suite.storeCode(mockCode)
+			req = &types.QueryCodeRequest{Checksum: hex.EncodeToString(checksum)}
+
+			tc.malleate()
+
+			res, err := suite.keeper.Code(suite.ctx, req)
+
+			if tc.expCode == codes.OK {
+				suite.Require().NoError(err)
+				suite.Require().Equal(mockCode, res.Data)
+			} else {
+				suite.Require().Equal(tc.expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChecksums() {
+	var (
+		req          *types.QueryChecksumsRequest
+		expChecksums []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no checksums",
+			func() {},
+			true,
+		},
+		{
+			"success: with checksums",
+			func() {
+				for _, code := range [][]byte{mockCode, []byte("other mock light client contract")} {
+					expChecksums = append(expChecksums, hex.EncodeToString(suite.storeCode(code)))
+				}
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				suite.storeCode(mockCode)
+				suite.storeCode([]byte("other mock light client contract"))
+
+				// the checksums are returned in the order they are stored
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expChecksums = []string{hex.EncodeToString(suite.keeper.GetAllChecksums(suite.ctx)[0])}
+			},
+			true,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryChecksumsRequest{}
+			expChecksums = nil
+
+			tc.malleate()
+
+			res, err := suite.keeper.Checksums(suite.ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expChecksums, res.Checksums)
+			} else {
+				suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+			}
+		})
+	}
+}
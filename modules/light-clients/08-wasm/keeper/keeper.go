@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/vm"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Keeper defines the 08-wasm keeper. It stores the light client contract byte codes
+// and executes the contracts with the configured WasmEngine.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	storeProvider ibcexported.ClientStoreProvider
+	vm            types.WasmEngine
+
+	// the address capable of executing the governance gated messages. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new 08-wasm Keeper instance executing the contracts with the default pure-Go VM.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, storeProvider ibcexported.ClientStoreProvider, authority string) Keeper {
+	return NewKeeperWithVM(cdc, key, storeProvider, authority, vm.NewVM())
+}
+
+// NewKeeperWithVM creates a new 08-wasm Keeper instance executing the contracts with the given WasmEngine.
+func NewKeeperWithVM(cdc codec.BinaryCodec, key storetypes.StoreKey, storeProvider ibcexported.ClientStoreProvider, authority string, wasmEngine types.WasmEngine) Keeper {
+	if wasmEngine == nil {
+		panic(errors.New("wasm engine must not be nil"))
+	}
+
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		storeProvider: storeProvider,
+		vm:            wasmEngine,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetAuthority returns the 08-wasm module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Codec returns the codec used to encode the client states.
+func (k Keeper) Codec() codec.BinaryCodec {
+	return k.cdc
+}
+
+// GetVM returns the WasmEngine used to execute the light client contracts.
+func (k Keeper) GetVM() types.WasmEngine {
+	return k.vm
+}
+
+// HasChecksum returns true if the light client contract byte code with the given checksum is stored.
+func (k Keeper) HasChecksum(ctx sdk.Context, checksum types.Checksum) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CodeKey(checksum))
+}
+
+// GetCode returns the light client contract byte code with the given checksum.
+func (k Keeper) GetCode(ctx sdk.Context, checksum types.Checksum) ([]byte, bool) {
+	code := ctx.KVStore(k.storeKey).Get(types.CodeKey(checksum))
+	if code == nil {
+		return nil, false
+	}
+
+	return code, true
+}
+
+// GetAllChecksums returns the checksums of all the stored light client contract byte codes.
+func (k Keeper) GetAllChecksums(ctx sdk.Context) []types.Checksum {
+	var checksums []types.Checksum
+	k.IterateCode(ctx, func(checksum types.Checksum, _ []byte) bool {
+		checksums = append(checksums, checksum)
+		return false
+	})
+
+	return checksums
+}
+
+// IterateCode iterates over the stored light client contract byte codes and performs a callback function.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateCode(ctx sdk.Context, cb func(checksum types.Checksum, code []byte) bool) {
+	prefix := types.CodeKey(nil)
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		checksum := bytes.TrimPrefix(iterator.Key(), prefix)
+		if cb(checksum, iterator.Value()) {
+			break
+		}
+	}
+}
+
+// storeWasmCode stores the light client contract byte code, which may be gzip compressed, and
+// compiles it with the VM. An error is returned if the code is invalid or is already stored.
+func (k Keeper) storeWasmCode(ctx sdk.Context, code []byte) (types.Checksum, error) {
+	code, err := types.Uncompress(code, types.MaxWasmSize)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to uncompress wasm code")
+	}
+
+	if err := types.ValidateWasmCode(code); err != nil {
+		return nil, errorsmod.Wrap(err, "wasm code failed validation")
+	}
+
+	checksum, err := types.CreateChecksum(code)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "wasm bytecode checksum failed")
+	}
+
+	if k.HasChecksum(ctx, checksum) {
+		return nil, types.ErrWasmCodeExists
+	}
+
+	vmChecksum, err := k.vm.StoreCode(code)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store contract")
+	}
+
+	if !bytes.Equal(vmChecksum, checksum) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChecksum, "expected %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(vmChecksum))
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CodeKey(checksum), code)
+
+	return checksum, nil
+}
+
+// removeChecksum removes the light client contract byte code with the given checksum. Clients
+// instantiated from the removed code can no longer be used until they are migrated to another code.
+func (k Keeper) removeChecksum(ctx sdk.Context, checksum types.Checksum) error {
+	if !k.HasChecksum(ctx, checksum) {
+		return types.ErrWasmChecksumNotFound
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.CodeKey(checksum))
+
+	if k.vm.HasCode(checksum) {
+		if err := k.vm.RemoveCode(checksum); err != nil {
+			return errorsmod.Wrap(err, "failed to remove code from the VM")
+		}
+	}
+
+	return nil
+}
+
+// migrateContractCode migrates the contract of the client to the code with the given checksum. The migrate
+// entry point of the new code is called before the checksum of the client state is updated.
+func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksum types.Checksum, migrateMsg []byte) error {
+	clientStore := k.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := k.getClientState(clientStore)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	oldChecksum := clientState.Checksum
+	if bytes.Equal(oldChecksum, newChecksum) {
+		return errorsmod.Wrapf(types.ErrWasmCodeExists, "new checksum (%s) is the same as current checksum (%s)", hex.EncodeToString(newChecksum), hex.EncodeToString(oldChecksum))
+	}
+
+	if err := k.Migrate(ctx, clientStore, newChecksum, migrateMsg); err != nil {
+		return errorsmod.Wrap(err, "contract migration failed")
+	}
+
+	// the client state may have been modified by the contract during the migration
+	clientState, found = k.getClientState(clientStore)
+	if !found {
+		return errorsmod.Wrap(types.ErrWasmInvalidContractModification, "client state not found after migration")
+	}
+
+	clientState.Checksum = newChecksum
+	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(k.cdc, clientState))
+
+	emitMigrateContractEvent(ctx, clientID, oldChecksum, newChecksum)
+
+	return nil
+}
+
+// getClientState returns the 08-wasm client state stored in the client store. False is returned if
+// the client state is not found or is not an 08-wasm client state.
+func (k Keeper) getClientState(clientStore storetypes.KVStore) (*types.ClientState, bool) {
+	bz := clientStore.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientState, err := clienttypes.UnmarshalClientState(k.cdc, bz)
+	if err != nil {
+		return nil, false
+	}
+
+	wasmClientState, ok := clientState.(*types.ClientState)
+	return wasmClientState, ok
+}
+
+// InitializePinnedCodes compiles all the stored light client contract byte codes with the VM. It may be
+// called when the application starts to avoid compiling the contracts on their first use.
+func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
+	var err error
+	k.IterateCode(ctx, func(checksum types.Checksum, code []byte) bool {
+		if k.vm.HasCode(checksum) {
+			return false
+		}
+
+		_, err = k.vm.StoreCode(code)
+		return err != nil
+	})
+
+	return err
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+const defaultClientID = "08-wasm-0"
+
+var (
+	// mockCode is a byte code accepted by the mock engine.
+	mockCode = []byte("mock light client contract")
+
+	// mockClientStateData is the opaque contract client state of the clients created by the tests.
+	mockClientStateData = []byte("mock client state")
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	ctx           sdk.Context
+	cdc           codec.Codec
+	storeProvider ibcexported.ClientStoreProvider
+	keeper        keeper.Keeper
+	mockVM        *wasmtesting.MockWasmEngine
+	authority     string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	clienttypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	suite.cdc = codec.NewProtoCodec(registry)
+
+	ibcKey := storetypes.NewKVStoreKey(ibcexported.StoreKey)
+	wasmKey := storetypes.NewKVStoreKey(types.StoreKey)
+	keys := map[string]*storetypes.KVStoreKey{ibcexported.StoreKey: ibcKey, types.StoreKey: wasmKey}
+	suite.ctx = testutil.DefaultContextWithKeys(keys, nil, nil)
+
+	suite.storeProvider = clienttypes.NewStoreProvider(ibcKey)
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.mockVM = wasmtesting.NewMockWasmEngine()
+	suite.keeper = keeper.NewKeeperWithVM(suite.cdc, wasmKey, suite.storeProvider, suite.authority, suite.mockVM)
+}
+
+// storeCode stores the given byte code and returns its checksum.
+func (suite *KeeperTestSuite) storeCode(code []byte) types.Checksum {
+	res, err := suite.keeper.StoreCode(suite.ctx, types.NewMsgStoreCode(suite.authority, code))
+	suite.Require().NoError(err)
+
+	return res.Checksum
+}
+
+// setClientState stores an 08-wasm client state with the given checksum for the default client.
+func (suite *KeeperTestSuite) setClientState(checksum types.Checksum) {
+	clientState := types.NewClientState(mockClientStateData, checksum, clienttypes.NewHeight(1, 1))
+	clientStore := suite.storeProvider.ClientStore(suite.ctx, defaultClientID)
+	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.cdc, clientState))
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name        string
+		instantiate func()
+		expPass     bool
+	}{
+		{
+			"success",
+			func() {
+				keeper.NewKeeper(suite.cdc, storetypes.NewKVStoreKey(types.StoreKey), suite.storeProvider, suite.authority)
+			},
+			true,
+		},
+		{
+			"failure: empty authority",
+			func() {
+				keeper.NewKeeper(suite.cdc, storetypes.NewKVStoreKey(types.StoreKey), suite.storeProvider, "")
+			},
+			false,
+		},
+		{
+			"failure: nil wasm engine",
+			func() {
+				keeper.NewKeeperWithVM(suite.cdc, storetypes.NewKVStoreKey(types.StoreKey), suite.storeProvider, suite.authority, nil)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			if tc.expPass {
+				suite.Require().NotPanics(tc.instantiate)
+			} else {
+				suite.Require().Panics(tc.instantiate)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInitializePinnedCodes() {
+	checksum := suite.storeCode(mockCode)
+
+	// the engine does not persist compiled code across restarts
+	suite.Require().NoError(suite.mockVM.RemoveCode(checksum))
+	suite.Require().False(suite.mockVM.HasCode(checksum))
+
+	err := suite.keeper.InitializePinnedCodes(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.mockVM.HasCode(checksum))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	checksum := suite.storeCode(mockCode)
+	otherChecksum := suite.storeCode(wasmtesting.Code)
+
+	genesisState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genesisState.Contracts, 2)
+
+	suite.SetupTest() // reset
+
+	err := suite.keeper.InitGenesis(suite.ctx, genesisState)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.keeper.HasChecksum(suite.ctx, checksum))
+	suite.Require().True(suite.keeper.HasChecksum(suite.ctx, otherChecksum))
+	suite.Require().ElementsMatch([]types.Checksum{checksum, otherChecksum}, suite.keeper.GetAllChecksums(suite.ctx))
+
+	// the gzip compressed code is stored uncompressed
+	code, found := suite.keeper.GetCode(suite.ctx, otherChecksum)
+	suite.Require().True(found)
+	suite.Require().False(types.IsGzip(code))
+}
@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgStoreCode() {
+	var msg *types.MsgStoreCode
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: gzip compressed code",
+			func() {
+				msg = types.NewMsgStoreCode(suite.authority, wasmtesting.Code)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: code already exists",
+			func() {
+				suite.storeCode(mockCode)
+			},
+			types.ErrWasmCodeExists,
+		},
+		{
+			"failure: empty code",
+			func() {
+				msg.WasmByteCode = nil
+			},
+			types.ErrWasmEmptyCode,
+		},
+		{
+			"failure: invalid gzip code",
+			func() {
+				msg.WasmByteCode = []byte("\x1F\x8B\x08invalid")
+			},
+			types.ErrWasmInvalidCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgStoreCode(suite.authority, mockCode)
+
+			tc.malleate()
+
+			res, err := suite.keeper.StoreCode(suite.ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.keeper.HasChecksum(suite.ctx, res.Checksum))
+				suite.Require().True(suite.mockVM.HasCode(res.Checksum))
+
+				events := suite.ctx.EventManager().Events().ToABCIEvents()
+				suite.Require().Equal(types.EventTypeStoreWasmCode, events[0].Type)
+				suite.Require().Equal(hex.EncodeToString(res.Checksum), events[0].Attributes[0].Value)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
+	var msg *types.MsgRemoveChecksum
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: code not compiled by the engine",
+			func() {
+				suite.Require().NoError(suite.mockVM.RemoveCode(msg.Checksum))
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: checksum not found",
+			func() {
+				msg.Checksum = make([]byte, 32)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			checksum := suite.storeCode(mockCode)
+			msg = types.NewMsgRemoveChecksum(suite.authority, checksum)
+
+			tc.malleate()
+
+			_, err := suite.keeper.RemoveChecksum(suite.ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.keeper.HasChecksum(suite.ctx, checksum))
+				suite.Require().False(suite.mockVM.HasCode(checksum))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(suite.keeper.HasChecksum(suite.ctx, checksum))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMigrateContract() {
+	var (
+		oldChecksum, newChecksum types.Checksum
+		msg                      *types.MsgMigrateContract
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: contract modifies the client state during the migration",
+			func() {
+				suite.mockVM.MigrateFn = func(_ context.Context, _ types.Checksum, _ types.Env, _ []byte, store storetypes.KVStore) ([]byte, error) {
+					clientState := types.NewClientState([]byte("migrated client state"), oldChecksum, clienttypes.NewHeight(1, 2))
+					store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.cdc, clientState))
+					return []byte("{}"), nil
+				}
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: new checksum is the same as the current checksum",
+			func() {
+				msg.Checksum = oldChecksum
+			},
+			types.ErrWasmCodeExists,
+		},
+		{
+			"failure: new checksum not found",
+			func() {
+				msg.Checksum = make([]byte, 32)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: client not found",
+			func() {
+				msg.ClientId = "08-wasm-100"
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: contract migration fails",
+			func() {
+				suite.mockVM.MigrateFn = func(_ context.Context, _ types.Checksum, _ types.Env, _ []byte, _ storetypes.KVStore) ([]byte, error) {
+					return nil, types.ErrWasmContractCallFailed.Wrap("migration failed")
+				}
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: contract deletes the client state",
+			func() {
+				suite.mockVM.MigrateFn = func(_ context.Context, _ types.Checksum, _ types.Env, _ []byte, store storetypes.KVStore) ([]byte, error) {
+					store.Delete(host.ClientStateKey())
+					return []byte("{}"), nil
+				}
+			},
+			types.ErrWasmInvalidContractModification,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			oldChecksum = suite.storeCode(mockCode)
+			newChecksum = suite.storeCode([]byte("new mock light client contract"))
+			suite.setClientState(oldChecksum)
+
+			migrateMsg, err := json.Marshal(map[string]string{"new_field": "value"})
+			suite.Require().NoError(err)
+			msg = types.NewMsgMigrateContract(suite.authority, defaultClientID, newChecksum, migrateMsg)
+
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			_, err = suite.keeper.MigrateContract(suite.ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				clientStore := suite.storeProvider.ClientStore(suite.ctx, defaultClientID)
+				clientState, err := clienttypes.UnmarshalClientState(suite.cdc, clientStore.Get(host.ClientStateKey()))
+				suite.Require().NoError(err)
+				suite.Require().Equal(newChecksum, clientState.(*types.ClientState).Checksum)
+
+				events := suite.ctx.EventManager().Events().ToABCIEvents()
+				suite.Require().Equal(types.EventTypeMigrateContract, events[0].Type)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+var (
+	subjectPrefix    = []byte("subject/")
+	substitutePrefix = []byte("substitute/")
+)
+
+// readOnlyStore wraps the client store of the contract queries and panics on any write.
+type readOnlyStore struct {
+	storetypes.KVStore
+}
+
+// Set panics, queries are not allowed to write to the client store.
+func (readOnlyStore) Set(_, _ []byte) {
+	panic(errorsmod.Wrap(types.ErrWasmInvalidContractModification, "contract queries cannot write to the client store"))
+}
+
+// Delete panics, queries are not allowed to delete from the client store.
+func (readOnlyStore) Delete(_ []byte) {
+	panic(errorsmod.Wrap(types.ErrWasmInvalidContractModification, "contract queries cannot delete from the client store"))
+}
+
+// migrateClientWrappedStore exposes the subject and substitute client stores to the contract
+// recovering the subject client. Keys are routed to the subject or substitute client store by
+// their "subject/" or "substitute/" prefix. The substitute client store is read-only.
+type migrateClientWrappedStore struct {
+	storetypes.KVStore
+
+	subjectStore    storetypes.KVStore
+	substituteStore storetypes.KVStore
+}
+
+func newMigrateClientWrappedStore(subjectStore, substituteStore storetypes.KVStore) migrateClientWrappedStore {
+	return migrateClientWrappedStore{
+		KVStore:         subjectStore,
+		subjectStore:    subjectStore,
+		substituteStore: substituteStore,
+	}
+}
+
+// Get implements storetypes.KVStore.
+func (ws migrateClientWrappedStore) Get(key []byte) []byte {
+	store, key := ws.route(key)
+	return store.Get(key)
+}
+
+// Has implements storetypes.KVStore.
+func (ws migrateClientWrappedStore) Has(key []byte) bool {
+	store, key := ws.route(key)
+	return store.Has(key)
+}
+
+// Set implements storetypes.KVStore. It panics if the key is not prefixed with "subject/".
+func (ws migrateClientWrappedStore) Set(key, value []byte) {
+	if !bytes.HasPrefix(key, subjectPrefix) {
+		panic(errorsmod.Wrapf(types.ErrWasmInvalidContractModification, "writes are only allowed to the subject client store, got key %s", key))
+	}
+
+	ws.subjectStore.Set(bytes.TrimPrefix(key, subjectPrefix), value)
+}
+
+// Delete implements storetypes.KVStore. It panics if the key is not prefixed with "subject/".
+func (ws migrateClientWrappedStore) Delete(key []byte) {
+	if !bytes.HasPrefix(key, subjectPrefix) {
+		panic(errorsmod.Wrapf(types.ErrWasmInvalidContractModification, "deletes are only allowed from the subject client store, got key %s", key))
+	}
+
+	ws.subjectStore.Delete(bytes.TrimPrefix(key, subjectPrefix))
+}
+
+// Iterator implements storetypes.KVStore. Iteration is not supported by the wrapped store.
+func (migrateClientWrappedStore) Iterator(_, _ []byte) storetypes.Iterator {
+	panic(errorsmod.Wrap(types.ErrVMError, "iteration is not supported during client recovery"))
+}
+
+// ReverseIterator implements storetypes.KVStore. Iteration is not supported by the wrapped store.
+func (migrateClientWrappedStore) ReverseIterator(_, _ []byte) storetypes.Iterator {
+	panic(errorsmod.Wrap(types.ErrVMError, "iteration is not supported during client recovery"))
+}
+
+// route returns the client store the key is prefixed for and the key without its prefix.
+func (ws migrateClientWrappedStore) route(key []byte) (storetypes.KVStore, []byte) {
+	switch {
+	case bytes.HasPrefix(key, subjectPrefix):
+		return ws.subjectStore, bytes.TrimPrefix(key, subjectPrefix)
+	case bytes.HasPrefix(key, substitutePrefix):
+		return ws.substituteStore, bytes.TrimPrefix(key, substitutePrefix)
+	default:
+		panic(errorsmod.Wrap(types.ErrVMError, fmt.Sprintf("key %s must be prefixed with %s or %s", key, subjectPrefix, substitutePrefix)))
+	}
+}
@@ -0,0 +1,404 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC exported.LightClientModule interface for the 08-wasm client type.
+// It loads the client states from the client stores and delegates each operation to the light client contract
+// of the client state, executed by the 08-wasm keeper.
+type LightClientModule struct {
+	keeper        keeper.Keeper
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewLightClientModule creates and returns a new 08-wasm LightClientModule.
+func NewLightClientModule(keeper keeper.Keeper, storeProvider exported.ClientStoreProvider) LightClientModule {
+	return LightClientModule{
+		keeper:        keeper,
+		storeProvider: storeProvider,
+	}
+}
+
+// Initialize checks that the initial client and consensus states are 08-wasm states and instantiates
+// the light client contract, which initializes the client store.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState) error {
+	wasmClientState, ok := clientState.(*types.ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &types.ClientState{}, clientState)
+	}
+
+	if err := wasmClientState.Validate(); err != nil {
+		return err
+	}
+
+	wasmConsensusState, ok := consensusState.(*types.ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "expected type %T, got %T", &types.ConsensusState{}, consensusState)
+	}
+
+	if err := wasmConsensusState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	payload := types.InstantiateMessage{
+		ClientState:    wasmClientState.Data,
+		ConsensusState: wasmConsensusState.Data,
+		Checksum:       wasmClientState.Checksum,
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	return l.keeper.Instantiate(ctx, clientStore, wasmClientState.Checksum, payload)
+}
+
+// VerifyClientMessage obtains the client state associated with the client identifier and verifies the client message with the contract.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clientMessage, ok := clientMsg.(*types.ClientMessage)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected type %T, got %T", &types.ClientMessage{}, clientMsg)
+	}
+
+	payload := types.QueryMsg{
+		VerifyClientMessage: &types.VerifyClientMessageMsg{ClientMessage: clientMessage.Data},
+	}
+
+	_, err := wasmQuery[types.EmptyResult](ctx, l.keeper, clientStore, clientState, payload)
+	return err
+}
+
+// CheckForMisbehaviour obtains the client state associated with the client identifier and checks the client message
+// for misbehaviour with the contract. False is returned if the client message is not an 08-wasm client message or the
+// contract call fails.
+func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientMessage, ok := clientMsg.(*types.ClientMessage)
+	if !ok {
+		return false
+	}
+
+	payload := types.QueryMsg{
+		CheckForMisbehaviour: &types.CheckForMisbehaviourMsg{ClientMessage: clientMessage.Data},
+	}
+
+	result, err := wasmQuery[types.CheckForMisbehaviourResult](ctx, l.keeper, clientStore, clientState, payload)
+	if err != nil {
+		return false
+	}
+
+	return result.FoundMisbehaviour
+}
+
+// UpdateStateOnMisbehaviour obtains the client state associated with the client identifier and calls into the contract
+// to freeze the client.
+func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientMessage, ok := clientMsg.(*types.ClientMessage)
+	if !ok {
+		panic(fmt.Errorf("expected type %T, got %T", &types.ClientMessage{}, clientMsg))
+	}
+
+	payload := types.SudoMsg{
+		UpdateStateOnMisbehaviour: &types.UpdateStateOnMisbehaviourMsg{ClientMessage: clientMessage.Data},
+	}
+
+	if _, err := wasmSudo[types.EmptyResult](ctx, l.keeper, clientStore, clientState, payload); err != nil {
+		panic(err)
+	}
+}
+
+// UpdateState obtains the client state associated with the client identifier and calls into the contract to update
+// the client and consensus states. The consensus heights updated by the contract are returned.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientMessage, ok := clientMsg.(*types.ClientMessage)
+	if !ok {
+		panic(fmt.Errorf("expected type %T, got %T", &types.ClientMessage{}, clientMsg))
+	}
+
+	payload := types.SudoMsg{
+		UpdateState: &types.UpdateStateMsg{ClientMessage: clientMessage.Data},
+	}
+
+	result, err := wasmSudo[types.UpdateStateResult](ctx, l.keeper, clientStore, clientState, payload)
+	if err != nil {
+		panic(err)
+	}
+
+	heights := make([]exported.Height, 0, len(result.Heights))
+	for _, height := range result.Heights {
+		heights = append(heights, height)
+	}
+
+	return heights
+}
+
+// VerifyMembership obtains the client state associated with the client identifier and verifies the membership proof with the contract.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	proofHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	payload := types.SudoMsg{
+		VerifyMembership: &types.VerifyMembershipMsg{
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			MerklePath:       merklePath,
+			Value:            value,
+		},
+	}
+
+	_, err := wasmSudo[types.EmptyResult](ctx, l.keeper, clientStore, clientState, payload)
+	return err
+}
+
+// VerifyNonMembership obtains the client state associated with the client identifier and verifies the non-membership proof with the contract.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	proofHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	payload := types.SudoMsg{
+		VerifyNonMembership: &types.VerifyNonMembershipMsg{
+			Height:           proofHeight,
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			MerklePath:       merklePath,
+		},
+	}
+
+	_, err := wasmSudo[types.EmptyResult](ctx, l.keeper, clientStore, clientState, payload)
+	return err
+}
+
+// Status obtains the client state associated with the client identifier and queries the status of the client from the contract.
+// Unknown is returned if the client state is not found or the contract call fails.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return exported.Unknown
+	}
+
+	payload := types.QueryMsg{Status: &types.StatusMsg{}}
+	result, err := wasmQuery[types.StatusResult](ctx, l.keeper, clientStore, clientState, payload)
+	if err != nil {
+		return exported.Unknown
+	}
+
+	return exported.Status(result.Status)
+}
+
+// TimestampAtHeight obtains the client state associated with the client identifier and queries the timestamp of the consensus
+// state at the given height from the contract.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	timestampHeight, ok := height.(clienttypes.Height)
+	if !ok {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	payload := types.QueryMsg{
+		TimestampAtHeight: &types.TimestampAtHeightMsg{Height: timestampHeight},
+	}
+
+	result, err := wasmQuery[types.TimestampAtHeightResult](ctx, l.keeper, clientStore, clientState, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Timestamp, nil
+}
+
+// RecoverClient obtains the subject and substitute client states and calls into the contract of the subject client
+// to recover it with the substitute client. The substitute client must be an 08-wasm client with the same checksum.
+func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
+	if err != nil {
+		return err
+	}
+
+	if substituteClientType != exported.Wasm {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Wasm, substituteClientType)
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
+	substituteClient, found := getClientState(substituteClientStore, l.keeper.Codec())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
+	}
+
+	if !bytes.Equal(clientState.Checksum, substituteClient.Checksum) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected checksums to be equal: expected %s, got %s", hex.EncodeToString(clientState.Checksum), hex.EncodeToString(substituteClient.Checksum))
+	}
+
+	return l.keeper.MigrateClientStore(ctx, clientStore, substituteClientStore, clientState)
+}
+
+// VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the contract
+// to verify the upgraded client and consensus states and upgrade the client.
+func (l LightClientModule) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context,
+	clientID string,
+	newClient exported.ClientState,
+	newConsState exported.ConsensusState,
+	upgradeClientProof,
+	upgradeConsensusStateProof []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.keeper.Codec())
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	newClientState, ok := newClient.(*types.ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected upgraded client state type %T, got %T", &types.ClientState{}, newClient)
+	}
+
+	newConsensusState, ok := newConsState.(*types.ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "expected upgraded consensus state type %T, got %T", &types.ConsensusState{}, newConsState)
+	}
+
+	// the upgraded client state must be at a greater height than the current client state
+	if !newClientState.LatestHeight.GT(clientState.LatestHeight) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "upgraded client height %s must be greater than current client height %s", newClientState.LatestHeight, clientState.LatestHeight)
+	}
+
+	payload := types.SudoMsg{
+		VerifyUpgradeAndUpdateState: &types.VerifyUpgradeAndUpdateStateMsg{
+			UpgradeClientState:         newClientState.Data,
+			UpgradeConsensusState:      newConsensusState.Data,
+			ProofUpgradeClient:         upgradeClientProof,
+			ProofUpgradeConsensusState: upgradeConsensusStateProof,
+		},
+	}
+
+	_, err := wasmSudo[types.EmptyResult](ctx, l.keeper, clientStore, clientState, payload)
+	return err
+}
+
+// wasmQuery queries the contract of the client state and unmarshals the result of the contract.
+func wasmQuery[T types.ContractResult](ctx sdk.Context, k keeper.Keeper, clientStore storetypes.KVStore, clientState *types.ClientState, payload types.QueryMsg) (T, error) {
+	var result T
+
+	bz, err := k.Query(ctx, clientStore, clientState, payload)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(bz, &result); err != nil {
+		return result, errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	return result, nil
+}
+
+// wasmSudo executes the contract of the client state and unmarshals the result of the contract.
+func wasmSudo[T types.ContractResult](ctx sdk.Context, k keeper.Keeper, clientStore storetypes.KVStore, clientState *types.ClientState, payload types.SudoMsg) (T, error) {
+	var result T
+
+	bz, err := k.Sudo(ctx, clientStore, clientState, payload)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(bz, &result); err != nil {
+		return result, errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	return result, nil
+}
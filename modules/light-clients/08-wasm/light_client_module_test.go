@@ -0,0 +1,462 @@
+package wasm_test
+
+import (
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *WasmTestSuite) TestInitialize() {
+	var (
+		clientState    exported.ClientState
+		consensusState exported.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client state is not an 08-wasm client state",
+			func() {
+				clientState = &ibctm.ClientState{}
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"failure: consensus state is not an 08-wasm consensus state",
+			func() {
+				consensusState = &ibctm.ConsensusState{}
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"failure: checksum not found",
+			func() {
+				clientState.(*types.ClientState).Checksum = make([]byte, 32)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: contract rejects the client state",
+			func() {
+				clientState.(*types.ClientState).Data = []byte("invalid client state")
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			height := suite.chainB.LastHeader.GetHeight().(clienttypes.Height)
+			tmClientState := ibctm.NewClientState(
+				suite.chainB.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+				height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+			)
+			clientState = types.NewClientState(clienttypes.MustMarshalClientState(suite.cdc, tmClientState), suite.checksum, height)
+			consensusState = types.NewConsensusState(clienttypes.MustMarshalConsensusState(suite.cdc, suite.chainB.LastHeader.ConsensusState()))
+
+			tc.malleate()
+
+			clientID := clienttypes.FormatClientIdentifier(exported.Wasm, 0)
+			err := suite.lightClientModule.Initialize(suite.hostContext(), clientID, clientState, consensusState)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				wasmClientState := suite.getClientState(clientID)
+				suite.Require().Equal(suite.checksum, wasmClientState.Checksum)
+				suite.Require().Equal(height, wasmClientState.LatestHeight)
+				suite.Require().Equal(exported.Active, suite.lightClientModule.Status(suite.hostContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"client is active",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client is expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			exported.Expired,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = clienttypes.FormatClientIdentifier(exported.Wasm, 100)
+			},
+			exported.Unknown,
+		},
+		{
+			"checksum of the client removed",
+			func() {
+				_, err := suite.keeper.RemoveChecksum(suite.ctx, types.NewMsgRemoveChecksum(suite.keeper.GetAuthority(), suite.checksum))
+				suite.Require().NoError(err)
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID = suite.createClient()
+
+			tc.malleate()
+
+			status := suite.lightClientModule.Status(suite.hostContext(), clientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestTimestampAtHeight() {
+	var height exported.Height
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: consensus state not found",
+			func() {
+				height = clienttypes.NewHeight(1, 100)
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID := suite.createClient()
+			height = suite.getClientState(clientID).LatestHeight
+			expTimestamp := uint64(suite.chainB.LastHeader.GetTime().UnixNano())
+
+			tc.malleate()
+
+			timestamp, err := suite.lightClientModule.TimestampAtHeight(suite.hostContext(), clientID, height)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTimestamp, timestamp)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestUpdateClient() {
+	var clientMsg exported.ClientMessage
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client message is not an 08-wasm client message",
+			func() {
+				clientMsg = &ibctm.Header{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: invalid header data",
+			func() {
+				clientMsg = types.NewClientMessage([]byte("invalid header"))
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: header outside of the trusting period",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID := suite.createClient()
+			clientMsg = suite.updateHeader(clientID)
+			expHeight := suite.chainB.LastHeader.GetHeight()
+
+			tc.malleate()
+
+			ctx := suite.hostContext()
+			err := suite.lightClientModule.VerifyClientMessage(ctx, clientID, clientMsg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.lightClientModule.CheckForMisbehaviour(ctx, clientID, clientMsg))
+
+				heights := suite.lightClientModule.UpdateState(ctx, clientID, clientMsg)
+				suite.Require().Equal([]exported.Height{expHeight}, heights)
+				suite.Require().Equal(expHeight, suite.getClientState(clientID).LatestHeight)
+
+				timestamp, err := suite.lightClientModule.TimestampAtHeight(ctx, clientID, expHeight)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(suite.chainB.LastHeader.GetTime().UnixNano()), timestamp)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestUpdateStateOnMisbehaviour() {
+	suite.SetupTest()
+
+	clientID := suite.createClient()
+	clientMsg := suite.updateHeader(clientID)
+
+	suite.lightClientModule.UpdateStateOnMisbehaviour(suite.hostContext(), clientID, clientMsg)
+
+	suite.Require().Equal(exported.Frozen, suite.lightClientModule.Status(suite.hostContext(), clientID))
+}
+
+func (suite *WasmTestSuite) TestVerifyMembership() {
+	var (
+		proof       []byte
+		proofHeight exported.Height
+		path        exported.Path
+		value       []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: value does not match the proof",
+			func() {
+				value = []byte("invalid value")
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: consensus state not found at the proof height",
+			func() {
+				proofHeight = clienttypes.NewHeight(1, 100)
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: invalid path type",
+			func() {
+				path = &commitmenttypes.MerklePath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID := suite.createClient()
+
+			key := host.FullClientStateKey(suite.path.EndpointB.ClientID)
+			proof, proofHeight = suite.chainB.QueryProof(key)
+
+			merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(suite.path.EndpointB.ClientID))
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			value = clienttypes.MustMarshalClientState(suite.chainB.Codec, suite.path.EndpointB.GetClientState())
+
+			tc.malleate()
+
+			err = suite.lightClientModule.VerifyMembership(suite.hostContext(), clientID, proofHeight, 0, 0, proof, path, value)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestVerifyNonMembership() {
+	var (
+		proof       []byte
+		proofHeight exported.Height
+		path        exported.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: proof of an existing key",
+			func() {
+				key := host.FullClientStateKey(suite.path.EndpointB.ClientID)
+				proof, proofHeight = suite.chainB.QueryProof(key)
+
+				merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(suite.path.EndpointB.ClientID))
+				var err error
+				path, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientID := suite.createClient()
+
+			missingClientID := clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			proof, proofHeight = suite.chainB.QueryProof(host.FullClientStateKey(missingClientID))
+
+			merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(missingClientID))
+			var err error
+			path, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.lightClientModule.VerifyNonMembership(suite.hostContext(), clientID, proofHeight, 0, 0, proof, path)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestRecoverClient() {
+	var substituteClientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: substitute client is not an 08-wasm client",
+			func() {
+				substituteClientID = clienttypes.FormatClientIdentifier(exported.Tendermint, 0)
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: substitute client not found",
+			func() {
+				substituteClientID = clienttypes.FormatClientIdentifier(exported.Wasm, 100)
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectClientID := suite.createClient()
+
+			// expire the subject client so that it may be recovered
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			suite.Require().Equal(exported.Expired, suite.lightClientModule.Status(suite.hostContext(), subjectClientID))
+
+			substituteClientID = suite.createClient()
+
+			tc.malleate()
+
+			err := suite.lightClientModule.RecoverClient(suite.hostContext(), subjectClientID, substituteClientID)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(exported.Active, suite.lightClientModule.Status(suite.hostContext(), subjectClientID))
+				suite.Require().Equal(suite.getClientState(substituteClientID).LatestHeight, suite.getClientState(subjectClientID).LatestHeight)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
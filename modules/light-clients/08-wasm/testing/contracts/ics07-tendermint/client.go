@@ -0,0 +1,734 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	keyClientState        = "clientState"
+	keyConsensusStates    = "consensusStates"
+	keyProcessedTime      = "processedTime"
+	keyProcessedHeight    = "processedHeight"
+	keyConsensusHeights   = "consensusHeights"
+	prefixSubject         = "subject/"
+	prefixSubstitute      = "substitute/"
+	keyUpgradedClient     = "upgradedClient"
+	keyUpgradedConsState  = "upgradedConsState"
+	sentinelRoot          = "sentinel_root"
+	statusActive          = "Active"
+	statusFrozen          = "Frozen"
+	statusExpired         = "Expired"
+	frozenRevisionHeight  = 1
+	processedTimeByteSize = 8
+)
+
+// kvStore is the key/value store of the client, provided by the host.
+type kvStore interface {
+	get(key []byte) []byte
+	set(key, value []byte)
+	remove(key []byte)
+}
+
+// prefixStore prefixes every key of the parent store.
+type prefixStore struct {
+	parent kvStore
+	prefix []byte
+}
+
+func (s prefixStore) key(key []byte) []byte {
+	return append(append([]byte{}, s.prefix...), key...)
+}
+
+func (s prefixStore) get(key []byte) []byte { return s.parent.get(s.key(key)) }
+func (s prefixStore) set(key, value []byte) { s.parent.set(s.key(key), value) }
+func (s prefixStore) remove(key []byte)     { s.parent.remove(s.key(key)) }
+
+func consensusStateKey(h height) []byte {
+	return []byte(fmt.Sprintf("%s/%s", keyConsensusStates, h))
+}
+
+func processedTimeKey(h height) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", keyConsensusStates, h, keyProcessedTime))
+}
+
+func processedHeightKey(h height) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", keyConsensusStates, h, keyProcessedHeight))
+}
+
+// lightClient implements the 07-tendermint light client logic on top of the client store.
+type lightClient struct {
+	store kvStore
+	env   env
+}
+
+// selfHeight returns the height of the host chain.
+func (c *lightClient) selfHeight() height {
+	return height{RevisionNumber: parseChainID(c.env.Block.ChainID), RevisionHeight: c.env.Block.Height}
+}
+
+func (c *lightClient) now() int64 {
+	return int64(c.env.Block.Time)
+}
+
+// getClientState returns the wasm client state stored by core IBC and the tendermint client state it wraps.
+func getClientState(store kvStore) (wasmClientState, *clientState, error) {
+	bz := store.get([]byte(keyClientState))
+	if bz == nil {
+		return wasmClientState{}, nil, errors.New("client state not found")
+	}
+
+	wcs, err := decodeWasmClientState(bz)
+	if err != nil {
+		return wasmClientState{}, nil, err
+	}
+
+	cs, err := decodeClientState(wcs.data)
+	if err != nil {
+		return wasmClientState{}, nil, err
+	}
+
+	return wcs, cs, nil
+}
+
+// setClientState stores the tendermint client state wrapped into the wasm client state.
+func setClientState(store kvStore, wcs wasmClientState, cs *clientState) {
+	wcs.data = cs.encode()
+	wcs.latestHeight = cs.latestHeight
+	store.set([]byte(keyClientState), wcs.encode())
+}
+
+func getConsensusState(store kvStore, h height) (*consensusState, error) {
+	bz := store.get(consensusStateKey(h))
+	if bz == nil {
+		return nil, nil
+	}
+
+	data, err := decodeWasmConsensusState(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeConsensusState(data)
+}
+
+// setConsensusState stores the consensus state with its processed time and height, and indexes its height.
+func setConsensusState(store kvStore, h height, cs *consensusState, processedTime int64, processedHeight height) {
+	store.set(consensusStateKey(h), encodeWasmConsensusState(cs.encode()))
+	store.set(processedTimeKey(h), binary.BigEndian.AppendUint64(nil, uint64(processedTime)))
+	store.set(processedHeightKey(h), []byte(processedHeight.String()))
+
+	heights := getConsensusHeights(store)
+	idx := sort.Search(len(heights), func(i int) bool { return heights[i].compare(h) >= 0 })
+	if idx < len(heights) && heights[idx].compare(h) == 0 {
+		return
+	}
+	heights = append(heights[:idx], append([]height{h}, heights[idx:]...)...)
+	setConsensusHeights(store, heights)
+}
+
+// deleteConsensusState removes the consensus state with its metadata.
+func deleteConsensusState(store kvStore, h height) {
+	store.remove(consensusStateKey(h))
+	store.remove(processedTimeKey(h))
+	store.remove(processedHeightKey(h))
+
+	heights := getConsensusHeights(store)
+	for i := range heights {
+		if heights[i].compare(h) == 0 {
+			setConsensusHeights(store, append(heights[:i], heights[i+1:]...))
+			return
+		}
+	}
+}
+
+// getConsensusHeights returns the heights of the stored consensus states in ascending order.
+func getConsensusHeights(store kvStore) []height {
+	bz := store.get([]byte(keyConsensusHeights))
+
+	heights := make([]height, 0, len(bz)/16)
+	for len(bz) >= 16 {
+		heights = append(heights, height{
+			RevisionNumber: binary.BigEndian.Uint64(bz),
+			RevisionHeight: binary.BigEndian.Uint64(bz[8:]),
+		})
+		bz = bz[16:]
+	}
+
+	return heights
+}
+
+func setConsensusHeights(store kvStore, heights []height) {
+	bz := make([]byte, 0, len(heights)*16)
+	for _, h := range heights {
+		bz = binary.BigEndian.AppendUint64(bz, h.RevisionNumber)
+		bz = binary.BigEndian.AppendUint64(bz, h.RevisionHeight)
+	}
+	store.set([]byte(keyConsensusHeights), bz)
+}
+
+// instantiate validates and stores the initial client and consensus states.
+func (c *lightClient) instantiate(msg instantiateMsg) error {
+	cs, err := decodeClientState(msg.ClientState)
+	if err != nil {
+		return fmt.Errorf("invalid client state: %w", err)
+	}
+	if err := cs.validate(); err != nil {
+		return err
+	}
+
+	consState, err := decodeConsensusState(msg.ConsensusState)
+	if err != nil {
+		return fmt.Errorf("invalid consensus state: %w", err)
+	}
+	if err := consState.validate(); err != nil {
+		return err
+	}
+
+	setClientState(c.store, wasmClientState{checksum: msg.Checksum}, cs)
+	setConsensusState(c.store, cs.latestHeight, consState, c.now(), c.selfHeight())
+
+	return nil
+}
+
+// status returns the status of the client.
+func (c *lightClient) status() (string, error) {
+	_, cs, err := getClientState(c.store)
+	if err != nil {
+		return "", err
+	}
+
+	if !cs.frozenHeight.isZero() {
+		return statusFrozen, nil
+	}
+
+	consState, err := getConsensusState(c.store, cs.latestHeight)
+	if err != nil {
+		return "", err
+	}
+	if consState == nil || cs.isExpired(consState.timestamp, c.now()) {
+		return statusExpired, nil
+	}
+
+	return statusActive, nil
+}
+
+// timestampAtHeight returns the timestamp of the consensus state at the given height.
+func (c *lightClient) timestampAtHeight(h height) (uint64, error) {
+	consState, err := getConsensusState(c.store, h)
+	if err != nil {
+		return 0, err
+	}
+	if consState == nil {
+		return 0, fmt.Errorf("consensus state not found: height (%s)", h)
+	}
+
+	return uint64(consState.timestamp), nil
+}
+
+// verifyClientMessage verifies a header or a misbehaviour.
+func (c *lightClient) verifyClientMessage(clientMessage []byte) error {
+	_, cs, err := getClientState(c.store)
+	if err != nil {
+		return err
+	}
+
+	typeURL, err := typeURLOf(clientMessage)
+	if err != nil {
+		return err
+	}
+
+	switch typeURL {
+	case typeURLHeader:
+		h, err := decodeHeader(clientMessage)
+		if err != nil {
+			return err
+		}
+		return c.verifyHeader(cs, h)
+	case typeURLMisbehaviour:
+		m, err := decodeMisbehaviour(clientMessage)
+		if err != nil {
+			return err
+		}
+		return c.verifyMisbehaviour(cs, m)
+	default:
+		return fmt.Errorf("unsupported client message type %s", typeURL)
+	}
+}
+
+// verifyHeader verifies the header against the trusted consensus state, skipping verification
+// is used for non-adjacent headers.
+func (c *lightClient) verifyHeader(cs *clientState, h *header) error {
+	if err := h.validateBasic(); err != nil {
+		return err
+	}
+
+	trusted, err := getConsensusState(c.store, h.trustedHeight)
+	if err != nil {
+		return err
+	}
+	if trusted == nil {
+		return fmt.Errorf("could not get trusted consensus state for Header at TrustedHeight: %s", h.trustedHeight)
+	}
+
+	if !bytes.Equal(h.trustedValidators.hash(), trusted.nextValidatorsHash) {
+		return errors.New("trusted validators does not hash to latest trusted validators")
+	}
+
+	chainID := cs.chainID
+	untrusted := h.header
+	if untrusted.chainID != chainID {
+		return fmt.Errorf("header belongs to another chain %q, not %q", untrusted.chainID, chainID)
+	}
+	if h.commit.height != untrusted.height {
+		return fmt.Errorf("header and commit height mismatch: %d vs %d", untrusted.height, h.commit.height)
+	}
+	if !bytes.Equal(h.commit.blockID.hash, untrusted.hash()) {
+		return fmt.Errorf("commit signs block %X, header is block %X", h.commit.blockID.hash, untrusted.hash())
+	}
+
+	trustedHeight := int64(h.trustedHeight.RevisionHeight)
+	if cs.isExpired(trusted.timestamp, c.now()) {
+		return errors.New("old header has expired")
+	}
+	if untrusted.height <= trustedHeight {
+		return fmt.Errorf("expected new header height %d to be greater than one of old header %d", untrusted.height, trustedHeight)
+	}
+	if untrusted.time <= trusted.timestamp {
+		return errors.New("expected new header time to be after old header time")
+	}
+	if untrusted.time >= c.now()+cs.maxClockDrift {
+		return errors.New("new header has a time from the future")
+	}
+
+	if untrusted.height == trustedHeight+1 {
+		if !bytes.Equal(untrusted.validatorsHash, trusted.nextValidatorsHash) {
+			return errors.New("expected old header next validators to match those from new header")
+		}
+	} else if err := h.trustedValidators.verifyCommitLightTrusting(chainID, h.commit, cs.trustLevel); err != nil {
+		return err
+	}
+
+	return h.validatorSet.verifyCommitLight(chainID, h.commit.blockID, untrusted.height, h.commit)
+}
+
+// verifyMisbehaviour verifies that both conflicting headers would have been accepted by the client.
+func (c *lightClient) verifyMisbehaviour(cs *clientState, m *misbehaviour) error {
+	for _, h := range []*header{m.header1, m.header2} {
+		if err := h.validateBasic(); err != nil {
+			return err
+		}
+		if err := h.validatorSet.verifyCommitLight(h.header.chainID, h.commit.blockID, h.header.height, h.commit); err != nil {
+			return err
+		}
+	}
+
+	if m.header1.header.chainID != m.header2.header.chainID {
+		return errors.New("headers must have identical chainIDs")
+	}
+	if m.header1.getHeight().compare(m.header2.getHeight()) < 0 {
+		return errors.New("Header1 height is less than Header2 height")
+	}
+
+	for _, h := range []*header{m.header1, m.header2} {
+		trusted, err := getConsensusState(c.store, h.trustedHeight)
+		if err != nil {
+			return err
+		}
+		if trusted == nil {
+			return fmt.Errorf("could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", h.trustedHeight)
+		}
+
+		if !bytes.Equal(h.trustedValidators.hash(), trusted.nextValidatorsHash) {
+			return errors.New("trusted validators does not hash to latest trusted validators")
+		}
+		if c.now()-trusted.timestamp >= cs.trustingPeriod {
+			return errors.New("current timestamp minus the latest consensus state timestamp is greater than or equal to the trusting period")
+		}
+		if err := h.trustedValidators.verifyCommitLightTrusting(cs.chainID, h.commit, cs.trustLevel); err != nil {
+			return fmt.Errorf("validator set in header has too much change from trusted validator set: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkForMisbehaviour detects conflicting headers and non monotonic consensus state timestamps.
+func (c *lightClient) checkForMisbehaviour(clientMessage []byte) (bool, error) {
+	typeURL, err := typeURLOf(clientMessage)
+	if err != nil {
+		return false, err
+	}
+
+	switch typeURL {
+	case typeURLHeader:
+		h, err := decodeHeader(clientMessage)
+		if err != nil {
+			return false, err
+		}
+
+		consState := h.consensusState()
+		existing, err := getConsensusState(c.store, h.getHeight())
+		if err != nil {
+			return false, err
+		}
+		if existing != nil {
+			// the header has already been submitted if the consensus states match
+			return !existing.equal(consState), nil
+		}
+
+		heights := getConsensusHeights(c.store)
+		idx := sort.Search(len(heights), func(i int) bool { return heights[i].compare(h.getHeight()) > 0 })
+		if idx > 0 {
+			prev, err := getConsensusState(c.store, heights[idx-1])
+			if err != nil {
+				return false, err
+			}
+			if prev != nil && prev.timestamp >= consState.timestamp {
+				return true, nil
+			}
+		}
+		if idx < len(heights) {
+			next, err := getConsensusState(c.store, heights[idx])
+			if err != nil {
+				return false, err
+			}
+			if next != nil && next.timestamp <= consState.timestamp {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	case typeURLMisbehaviour:
+		m, err := decodeMisbehaviour(clientMessage)
+		if err != nil {
+			return false, err
+		}
+
+		if m.header1.getHeight().compare(m.header2.getHeight()) == 0 {
+			return !bytes.Equal(m.header1.commit.blockID.hash, m.header2.commit.blockID.hash), nil
+		}
+
+		// header1 is at a greater height than header2, so it must also have a greater timestamp
+		return m.header1.header.time <= m.header2.header.time, nil
+	default:
+		return false, fmt.Errorf("unsupported client message type %s", typeURL)
+	}
+}
+
+// updateState stores the consensus state of the header and updates the latest height of the client.
+func (c *lightClient) updateState(clientMessage []byte) ([]height, error) {
+	typeURL, err := typeURLOf(clientMessage)
+	if err != nil {
+		return nil, err
+	}
+	if typeURL != typeURLHeader {
+		// misbehaviour does not update the client state
+		return []height{}, nil
+	}
+
+	h, err := decodeHeader(clientMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	wcs, cs, err := getClientState(c.store)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pruneOldestConsensusState(cs); err != nil {
+		return nil, err
+	}
+
+	headerHeight := h.getHeight()
+	existing, err := getConsensusState(c.store, headerHeight)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		// the header has already been submitted, no state needs to be updated
+		return []height{headerHeight}, nil
+	}
+
+	if headerHeight.compare(cs.latestHeight) > 0 {
+		cs.latestHeight = headerHeight
+	}
+
+	setClientState(c.store, wcs, cs)
+	setConsensusState(c.store, headerHeight, h.consensusState(), c.now(), c.selfHeight())
+
+	return []height{headerHeight}, nil
+}
+
+// pruneOldestConsensusState removes the oldest consensus state if it is expired.
+func (c *lightClient) pruneOldestConsensusState(cs *clientState) error {
+	heights := getConsensusHeights(c.store)
+	if len(heights) == 0 {
+		return nil
+	}
+
+	consState, err := getConsensusState(c.store, heights[0])
+	if err != nil {
+		return err
+	}
+	if consState == nil {
+		return fmt.Errorf("failed to retrieve consensus state at height: %s", heights[0])
+	}
+
+	if cs.isExpired(consState.timestamp, c.now()) {
+		deleteConsensusState(c.store, heights[0])
+	}
+
+	return nil
+}
+
+// updateStateOnMisbehaviour freezes the client.
+func (c *lightClient) updateStateOnMisbehaviour() error {
+	wcs, cs, err := getClientState(c.store)
+	if err != nil {
+		return err
+	}
+
+	cs.frozenHeight = height{RevisionHeight: frozenRevisionHeight}
+	setClientState(c.store, wcs, cs)
+
+	return nil
+}
+
+// verifyDelayPeriodPassed checks that the delay periods have passed since the consensus state was processed.
+func (c *lightClient) verifyDelayPeriodPassed(proofHeight height, delayTimePeriod, delayBlockPeriod uint64) error {
+	bz := c.store.get(processedTimeKey(proofHeight))
+	if len(bz) != processedTimeByteSize {
+		return fmt.Errorf("processed time not found for height: %s", proofHeight)
+	}
+
+	processedTime := binary.BigEndian.Uint64(bz)
+	validTime := processedTime + delayTimePeriod
+	// the delay time period is inclusive
+	if c.env.Block.Time < validTime {
+		return fmt.Errorf("cannot verify packet until time: %d, current time: %d", validTime, c.env.Block.Time)
+	}
+
+	if delayBlockPeriod != 0 {
+		bz := c.store.get(processedHeightKey(proofHeight))
+		if bz == nil {
+			return fmt.Errorf("processed height not found for height: %s", proofHeight)
+		}
+
+		var processedHeight height
+		if _, err := fmt.Sscanf(string(bz), "%d-%d", &processedHeight.RevisionNumber, &processedHeight.RevisionHeight); err != nil {
+			return fmt.Errorf("invalid processed height: %w", err)
+		}
+
+		validHeight := height{RevisionNumber: processedHeight.RevisionNumber, RevisionHeight: processedHeight.RevisionHeight + delayBlockPeriod}
+		if c.selfHeight().compare(validHeight) < 0 {
+			return fmt.Errorf("cannot verify packet until height: %s, current height: %s", validHeight, c.selfHeight())
+		}
+	}
+
+	return nil
+}
+
+// verifyMembership verifies the existence of the value at the given path.
+func (c *lightClient) verifyMembership(msg verifyMembershipMsg) error {
+	return c.verifyProof(msg.Height, msg.DelayTimePeriod, msg.DelayBlockPeriod, msg.Proof, msg.MerklePath, msg.Value, true)
+}
+
+// verifyNonMembership verifies the absence of a value at the given path.
+func (c *lightClient) verifyNonMembership(msg verifyNonMembershipMsg) error {
+	return c.verifyProof(msg.Height, msg.DelayTimePeriod, msg.DelayBlockPeriod, msg.Proof, msg.MerklePath, nil, false)
+}
+
+func (c *lightClient) verifyProof(proofHeight height, delayTimePeriod, delayBlockPeriod uint64, proof []byte, path merklePath, value []byte, membership bool) error {
+	_, cs, err := getClientState(c.store)
+	if err != nil {
+		return err
+	}
+
+	if cs.latestHeight.compare(proofHeight) < 0 {
+		return fmt.Errorf("client state height < proof height (%s < %s), please ensure the client has been updated", cs.latestHeight, proofHeight)
+	}
+
+	if err := c.verifyDelayPeriodPassed(proofHeight, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	proofs, err := decodeMerkleProof(proof)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal proof into ICS 23 commitment merkle proof: %w", err)
+	}
+
+	consState, err := getConsensusState(c.store, proofHeight)
+	if err != nil {
+		return err
+	}
+	if consState == nil {
+		return fmt.Errorf("please ensure the proof was constructed against a height that exists on the client: consensus state not found at height %s", proofHeight)
+	}
+
+	if membership {
+		return verifyMembership(cs.proofSpecs, consState.root, proofs, path.KeyPath, value)
+	}
+
+	return verifyNonMembership(cs.proofSpecs, consState.root, proofs, path.KeyPath)
+}
+
+// verifyUpgradeAndUpdateState verifies the upgraded client and consensus states committed to by the
+// upgrade plan of the counterparty chain and stores them.
+func (c *lightClient) verifyUpgradeAndUpdateState(msg verifyUpgradeAndUpdateStateMsg) error {
+	wcs, cs, err := getClientState(c.store)
+	if err != nil {
+		return err
+	}
+
+	if len(cs.upgradePath) == 0 {
+		return errors.New("cannot upgrade client, no upgrade path set")
+	}
+
+	upgradedClient, err := decodeClientState(msg.UpgradeClientState)
+	if err != nil {
+		return fmt.Errorf("invalid upgraded client state: %w", err)
+	}
+	upgradedConsState, err := decodeConsensusState(msg.UpgradeConsensusState)
+	if err != nil {
+		return fmt.Errorf("invalid upgraded consensus state: %w", err)
+	}
+
+	lastHeight := cs.latestHeight
+	if upgradedClient.latestHeight.compare(lastHeight) <= 0 {
+		return fmt.Errorf("upgraded client height %s must be greater than current client height %s", upgradedClient.latestHeight, lastHeight)
+	}
+
+	// the proofs must be verified against the latest consensus state to ensure the latest upgrade plan is used
+	consState, err := getConsensusState(c.store, lastHeight)
+	if err != nil {
+		return err
+	}
+	if consState == nil {
+		return fmt.Errorf("could not retrieve consensus state for lastHeight: %s", lastHeight)
+	}
+
+	clientProof, err := decodeMerkleProof(msg.ProofUpgradeClient)
+	if err != nil {
+		return fmt.Errorf("could not unmarshal client merkle proof: %w", err)
+	}
+	consStateProof, err := decodeMerkleProof(msg.ProofUpgradeConsensusState)
+	if err != nil {
+		return fmt.Errorf("could not unmarshal consensus state merkle proof: %w", err)
+	}
+
+	zeroedClient := &clientState{
+		chainID:         upgradedClient.chainID,
+		unbondingPeriod: upgradedClient.unbondingPeriod,
+		latestHeight:    upgradedClient.latestHeight,
+		proofSpecs:      upgradedClient.proofSpecs,
+		upgradePath:     upgradedClient.upgradePath,
+	}
+	clientPath := upgradeMerklePath(cs.upgradePath, lastHeight, keyUpgradedClient)
+	if err := verifyMembership(cs.proofSpecs, consState.root, clientProof, clientPath, zeroedClient.encode()); err != nil {
+		return fmt.Errorf("client state proof failed: %w", err)
+	}
+
+	consStatePath := upgradeMerklePath(cs.upgradePath, lastHeight, keyUpgradedConsState)
+	if err := verifyMembership(cs.proofSpecs, consState.root, consStateProof, consStatePath, upgradedConsState.encode()); err != nil {
+		return fmt.Errorf("consensus state proof failed: %w", err)
+	}
+
+	newClient := &clientState{
+		chainID:         upgradedClient.chainID,
+		trustLevel:      cs.trustLevel,
+		trustingPeriod:  cs.trustingPeriod,
+		unbondingPeriod: upgradedClient.unbondingPeriod,
+		maxClockDrift:   cs.maxClockDrift,
+		latestHeight:    upgradedClient.latestHeight,
+		proofSpecs:      upgradedClient.proofSpecs,
+		upgradePath:     upgradedClient.upgradePath,
+	}
+	if err := newClient.validate(); err != nil {
+		return fmt.Errorf("updated client state failed basic validation: %w", err)
+	}
+
+	// the root of the upgraded consensus state is set to a sentinel value as it cannot be used for proof verification
+	newConsState := &consensusState{
+		timestamp:          upgradedConsState.timestamp,
+		root:               []byte(sentinelRoot),
+		nextValidatorsHash: upgradedConsState.nextValidatorsHash,
+	}
+
+	setClientState(c.store, wcs, newClient)
+	setConsensusState(c.store, newClient.latestHeight, newConsState, c.now(), c.selfHeight())
+
+	return nil
+}
+
+// upgradeMerklePath returns the merkle path of the upgraded client or consensus state in the upgrade store.
+func upgradeMerklePath(upgradePath []string, lastHeight height, key string) []string {
+	path := make([]string, len(upgradePath)-1, len(upgradePath))
+	copy(path, upgradePath)
+
+	lastKey := upgradePath[len(upgradePath)-1]
+	return append(path, fmt.Sprintf("%s/%d/%s", lastKey, lastHeight.RevisionHeight, key))
+}
+
+// migrateClientStore recovers the subject client using the latest consensus state of the substitute client.
+func (c *lightClient) migrateClientStore() error {
+	subjectStore := prefixStore{parent: c.store, prefix: []byte(prefixSubject)}
+	substituteStore := prefixStore{parent: c.store, prefix: []byte(prefixSubstitute)}
+
+	wcs, subject, err := getClientState(subjectStore)
+	if err != nil {
+		return fmt.Errorf("subject: %w", err)
+	}
+	_, substitute, err := getClientState(substituteStore)
+	if err != nil {
+		return fmt.Errorf("substitute: %w", err)
+	}
+
+	if !isMatchingClientState(*subject, *substitute) {
+		return errors.New("subject client state does not match substitute client state")
+	}
+
+	substituteHeight := substitute.latestHeight
+	consState, err := getConsensusState(substituteStore, substituteHeight)
+	if err != nil {
+		return err
+	}
+	if consState == nil {
+		return fmt.Errorf("unable to retrieve latest consensus state for substitute client")
+	}
+
+	processedTime := substituteStore.get(processedTimeKey(substituteHeight))
+	if len(processedTime) != processedTimeByteSize {
+		return fmt.Errorf("unable to retrieve processed time for substitute client latest height")
+	}
+	var processedHeight height
+	if _, err := fmt.Sscanf(string(substituteStore.get(processedHeightKey(substituteHeight))), "%d-%d", &processedHeight.RevisionNumber, &processedHeight.RevisionHeight); err != nil {
+		return fmt.Errorf("unable to retrieve processed height for substitute client latest height")
+	}
+
+	subject.latestHeight = substituteHeight
+	subject.chainID = substitute.chainID
+	subject.trustingPeriod = substitute.trustingPeriod
+	subject.frozenHeight = height{}
+
+	setClientState(subjectStore, wcs, subject)
+	setConsensusState(subjectStore, substituteHeight, consState, int64(binary.BigEndian.Uint64(processedTime)), processedHeight)
+
+	return nil
+}
+
+// isMatchingClientState returns true if the client states only differ in the fields which may be recovered.
+func isMatchingClientState(subject, substitute clientState) bool {
+	for _, cs := range []*clientState{&subject, &substitute} {
+		cs.trustingPeriod = 0
+		cs.latestHeight = height{}
+		cs.frozenHeight = height{}
+		cs.chainID = ""
+	}
+
+	return bytes.Equal(subject.encode(), substitute.encode())
+}
@@ -0,0 +1,3 @@
+module github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing/contracts/ics07-tendermint
+
+go 1.24
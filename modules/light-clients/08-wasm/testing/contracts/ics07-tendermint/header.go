@@ -0,0 +1,578 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// block ID flags of the tendermint.types.CommitSig type.
+const (
+	blockIDFlagAbsent = 1
+	blockIDFlagCommit = 2
+	blockIDFlagNil    = 3
+)
+
+// precommitType is the tendermint.types.SignedMsgType of the votes included in a commit.
+const precommitType = 2
+
+// blockID mirrors the tendermint.types.BlockID type.
+type blockID struct {
+	hash         []byte
+	partSetTotal uint32
+	partSetHash  []byte
+}
+
+func decodeBlockID(bz []byte) (blockID, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return blockID{}, err
+	}
+
+	var id blockID
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			id.hash = f.bytes
+		case 2:
+			psh, err := decodeFields(f.bytes)
+			if err != nil {
+				return blockID{}, err
+			}
+			for _, pf := range psh {
+				switch pf.num {
+				case 1:
+					id.partSetTotal = uint32(pf.varint)
+				case 2:
+					id.partSetHash = pf.bytes
+				}
+			}
+		}
+	}
+
+	return id, nil
+}
+
+func (id blockID) isZero() bool {
+	return len(id.hash) == 0 && id.partSetTotal == 0 && len(id.partSetHash) == 0
+}
+
+func (id blockID) equal(other blockID) bool {
+	return bytes.Equal(id.hash, other.hash) && id.partSetTotal == other.partSetTotal && bytes.Equal(id.partSetHash, other.partSetHash)
+}
+
+// canonicalEncode encodes the block ID as a tendermint.types.CanonicalBlockID.
+func (id blockID) canonicalEncode() []byte {
+	var psh encoder
+	psh.uvarint(1, uint64(id.partSetTotal))
+	psh.bytes(2, id.partSetHash)
+
+	var e encoder
+	e.bytes(1, id.hash)
+	e.message(2, psh.buf)
+	return e.buf
+}
+
+// tmHeader mirrors the tendermint.types.Header type. The encoded fields which are
+// hashed as is are kept alongside the decoded values.
+type tmHeader struct {
+	version            []byte
+	chainID            string
+	height             int64
+	timeBytes          []byte
+	time               int64
+	lastBlockID        []byte
+	lastCommitHash     []byte
+	dataHash           []byte
+	validatorsHash     []byte
+	nextValidatorsHash []byte
+	consensusHash      []byte
+	appHash            []byte
+	lastResultsHash    []byte
+	evidenceHash       []byte
+	proposerAddress    []byte
+}
+
+func decodeTmHeader(bz []byte) (*tmHeader, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &tmHeader{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			h.version = f.bytes
+		case 2:
+			h.chainID = string(f.bytes)
+		case 3:
+			h.height = int64(f.varint)
+		case 4:
+			h.timeBytes = f.bytes
+			if h.time, err = decodeTime(f.bytes); err != nil {
+				return nil, err
+			}
+		case 5:
+			h.lastBlockID = f.bytes
+		case 6:
+			h.lastCommitHash = f.bytes
+		case 7:
+			h.dataHash = f.bytes
+		case 8:
+			h.validatorsHash = f.bytes
+		case 9:
+			h.nextValidatorsHash = f.bytes
+		case 10:
+			h.consensusHash = f.bytes
+		case 11:
+			h.appHash = f.bytes
+		case 12:
+			h.lastResultsHash = f.bytes
+		case 13:
+			h.evidenceHash = f.bytes
+		case 14:
+			h.proposerAddress = f.bytes
+		}
+	}
+
+	return h, nil
+}
+
+// hash returns the merkle root of the header fields, as computed by CometBFT.
+func (h *tmHeader) hash() []byte {
+	if len(h.validatorsHash) == 0 {
+		return nil
+	}
+
+	return hashFromByteSlices([][]byte{
+		h.version,
+		cdcEncodeBytes([]byte(h.chainID)),
+		cdcEncodeInt64(h.height),
+		h.timeBytes,
+		h.lastBlockID,
+		cdcEncodeBytes(h.lastCommitHash),
+		cdcEncodeBytes(h.dataHash),
+		cdcEncodeBytes(h.validatorsHash),
+		cdcEncodeBytes(h.nextValidatorsHash),
+		cdcEncodeBytes(h.consensusHash),
+		cdcEncodeBytes(h.appHash),
+		cdcEncodeBytes(h.lastResultsHash),
+		cdcEncodeBytes(h.evidenceHash),
+		cdcEncodeBytes(h.proposerAddress),
+	})
+}
+
+// cdcEncodeBytes encodes the value as a google.protobuf.BytesValue or google.protobuf.StringValue.
+func cdcEncodeBytes(bz []byte) []byte {
+	var e encoder
+	e.bytes(1, bz)
+	return e.buf
+}
+
+// cdcEncodeInt64 encodes the value as a google.protobuf.Int64Value.
+func cdcEncodeInt64(v int64) []byte {
+	var e encoder
+	e.uvarint(1, uint64(v))
+	return e.buf
+}
+
+// commitSig mirrors the tendermint.types.CommitSig type.
+type commitSig struct {
+	blockIDFlag      int32
+	validatorAddress []byte
+	timestamp        []byte
+	signature        []byte
+}
+
+// commit mirrors the tendermint.types.Commit type.
+type commit struct {
+	height     int64
+	round      int32
+	blockID    blockID
+	signatures []commitSig
+}
+
+func decodeCommit(bz []byte) (*commit, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &commit{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			c.height = int64(f.varint)
+		case 2:
+			c.round = int32(f.varint)
+		case 3:
+			if c.blockID, err = decodeBlockID(f.bytes); err != nil {
+				return nil, err
+			}
+		case 4:
+			sigFields, err := decodeFields(f.bytes)
+			if err != nil {
+				return nil, err
+			}
+			var sig commitSig
+			for _, sf := range sigFields {
+				switch sf.num {
+				case 1:
+					sig.blockIDFlag = int32(sf.varint)
+				case 2:
+					sig.validatorAddress = sf.bytes
+				case 3:
+					sig.timestamp = sf.bytes
+				case 4:
+					sig.signature = sf.bytes
+				}
+			}
+			c.signatures = append(c.signatures, sig)
+		}
+	}
+
+	return c, nil
+}
+
+// voteSignBytes returns the sign bytes of the precommit of the validator at the given index,
+// encoded as a length delimited tendermint.types.CanonicalVote.
+func (c *commit) voteSignBytes(chainID string, idx int) []byte {
+	sig := c.signatures[idx]
+
+	var e encoder
+	e.uvarint(1, precommitType)
+	e.sfixed64(2, c.height)
+	e.sfixed64(3, int64(c.round))
+	if sig.blockIDFlag == blockIDFlagCommit && !c.blockID.isZero() {
+		e.message(4, c.blockID.canonicalEncode())
+	}
+	e.message(5, sig.timestamp)
+	e.string(6, chainID)
+
+	return delimited(e.buf)
+}
+
+// validator mirrors the tendermint.types.Validator type.
+type validator struct {
+	address     []byte
+	pubKeyBytes []byte
+	pubKey      ed25519.PublicKey
+	votingPower int64
+}
+
+// validatorSet mirrors the tendermint.types.ValidatorSet type.
+type validatorSet struct {
+	validators       []validator
+	totalVotingPower int64
+}
+
+func decodeValidatorSet(bz []byte) (*validatorSet, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := &validatorSet{}
+	for _, f := range fields {
+		if f.num != 1 {
+			continue
+		}
+
+		valFields, err := decodeFields(f.bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		var val validator
+		for _, vf := range valFields {
+			switch vf.num {
+			case 1:
+				val.address = vf.bytes
+			case 2:
+				val.pubKeyBytes = vf.bytes
+				if val.pubKey, err = decodePubKey(vf.bytes); err != nil {
+					return nil, err
+				}
+			case 3:
+				val.votingPower = int64(vf.varint)
+			}
+		}
+
+		vals.validators = append(vals.validators, val)
+		vals.totalVotingPower += val.votingPower
+	}
+
+	return vals, nil
+}
+
+// decodePubKey decodes a tendermint.crypto.PublicKey, only ed25519 keys are supported.
+func decodePubKey(bz []byte) (ed25519.PublicKey, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fields {
+		if f.num == 1 && len(f.bytes) == ed25519.PublicKeySize {
+			return ed25519.PublicKey(f.bytes), nil
+		}
+	}
+
+	return nil, errors.New("unsupported public key type")
+}
+
+func (vals *validatorSet) validateBasic() error {
+	if len(vals.validators) == 0 {
+		return errors.New("validator set is nil or empty")
+	}
+
+	for i, val := range vals.validators {
+		if val.votingPower < 0 {
+			return fmt.Errorf("validator %d has negative voting power", i)
+		}
+		addr := sha256.Sum256(val.pubKey)
+		if !bytes.Equal(val.address, addr[:20]) {
+			return fmt.Errorf("validator %d address does not match its public key", i)
+		}
+	}
+
+	return nil
+}
+
+// hash returns the merkle root of the validators, as computed by CometBFT.
+func (vals *validatorSet) hash() []byte {
+	items := make([][]byte, len(vals.validators))
+	for i, val := range vals.validators {
+		var e encoder
+		e.message(1, val.pubKeyBytes)
+		e.uvarint(2, uint64(val.votingPower))
+		items[i] = e.buf
+	}
+
+	return hashFromByteSlices(items)
+}
+
+func (vals *validatorSet) getByAddress(address []byte) (int, *validator) {
+	for i := range vals.validators {
+		if bytes.Equal(vals.validators[i].address, address) {
+			return i, &vals.validators[i]
+		}
+	}
+
+	return -1, nil
+}
+
+// verifyCommitLight verifies that more than 2/3 of the validator set signed the given block.
+func (vals *validatorSet) verifyCommitLight(chainID string, id blockID, height int64, c *commit) error {
+	if len(vals.validators) != len(c.signatures) {
+		return fmt.Errorf("invalid commit -- wrong set size: %d vs %d", len(vals.validators), len(c.signatures))
+	}
+	if height != c.height {
+		return fmt.Errorf("invalid commit -- wrong height: %d vs %d", height, c.height)
+	}
+	if !id.equal(c.blockID) {
+		return errors.New("invalid commit -- wrong block ID")
+	}
+
+	var (
+		tallied           int64
+		votingPowerNeeded = vals.totalVotingPower * 2 / 3
+	)
+	for idx, sig := range c.signatures {
+		if sig.blockIDFlag != blockIDFlagCommit {
+			continue
+		}
+
+		val := vals.validators[idx]
+		if !ed25519.Verify(val.pubKey, c.voteSignBytes(chainID, idx), sig.signature) {
+			return fmt.Errorf("wrong signature (#%d): %X", idx, sig.signature)
+		}
+
+		tallied += val.votingPower
+		if tallied > votingPowerNeeded {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid commit -- insufficient voting power: got %d, needed more than %d", tallied, votingPowerNeeded)
+}
+
+// verifyCommitLightTrusting verifies that more than the trust level of the trusted validator set signed the given commit.
+func (vals *validatorSet) verifyCommitLightTrusting(chainID string, c *commit, trustLevel fraction) error {
+	var (
+		tallied           int64
+		votingPowerNeeded = int64(uint64(vals.totalVotingPower) * trustLevel.numerator / trustLevel.denominator)
+		seenVals          = make(map[int]int, len(c.signatures))
+	)
+	for idx, sig := range c.signatures {
+		if sig.blockIDFlag != blockIDFlagCommit {
+			continue
+		}
+
+		valIdx, val := vals.getByAddress(sig.validatorAddress)
+		if val == nil {
+			continue
+		}
+
+		if firstIdx, ok := seenVals[valIdx]; ok {
+			return fmt.Errorf("double vote from %X (%d and %d)", val.address, firstIdx, idx)
+		}
+		seenVals[valIdx] = idx
+
+		if !ed25519.Verify(val.pubKey, c.voteSignBytes(chainID, idx), sig.signature) {
+			return fmt.Errorf("wrong signature (#%d): %X", idx, sig.signature)
+		}
+
+		tallied += val.votingPower
+		if tallied > votingPowerNeeded {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot trust new validator set: got %d, needed more than %d", tallied, votingPowerNeeded)
+}
+
+// header mirrors the ibc.lightclients.tendermint.v1.Header type.
+type header struct {
+	header            *tmHeader
+	commit            *commit
+	validatorSet      *validatorSet
+	trustedHeight     height
+	trustedValidators *validatorSet
+}
+
+func decodeHeader(bz []byte) (*header, error) {
+	value, err := decodeAny(bz, typeURLHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeHeaderValue(value)
+}
+
+func decodeHeaderValue(bz []byte) (*header, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &header{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			signedHeader, err := decodeFields(f.bytes)
+			if err != nil {
+				return nil, err
+			}
+			for _, sf := range signedHeader {
+				switch sf.num {
+				case 1:
+					h.header, err = decodeTmHeader(sf.bytes)
+				case 2:
+					h.commit, err = decodeCommit(sf.bytes)
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+		case 2:
+			h.validatorSet, err = decodeValidatorSet(f.bytes)
+		case 3:
+			h.trustedHeight, err = decodeHeight(f.bytes)
+		case 4:
+			h.trustedValidators, err = decodeValidatorSet(f.bytes)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// getHeight returns the IBC height of the header.
+func (h *header) getHeight() height {
+	return height{RevisionNumber: parseChainID(h.header.chainID), RevisionHeight: uint64(h.header.height)}
+}
+
+// consensusState returns the consensus state committed to by the header.
+func (h *header) consensusState() *consensusState {
+	return &consensusState{
+		timestamp:          h.header.time,
+		root:               h.header.appHash,
+		nextValidatorsHash: h.header.nextValidatorsHash,
+	}
+}
+
+// validateBasic mirrors the stateless validation of the 07-tendermint Header.
+func (h *header) validateBasic() error {
+	if h.header == nil || h.commit == nil {
+		return errors.New("tendermint signed header cannot be nil")
+	}
+	if h.validatorSet == nil {
+		return errors.New("validator set is nil")
+	}
+	if h.trustedValidators == nil {
+		return errors.New("trusted validator set is nil")
+	}
+	if h.header.height <= 0 {
+		return errors.New("header height must be positive")
+	}
+	if h.trustedHeight.RevisionNumber != h.getHeight().RevisionNumber {
+		return errors.New("revision on trusted height does not match the revision of the header")
+	}
+	if h.trustedHeight.compare(h.getHeight()) >= 0 {
+		return fmt.Errorf("trusted height %s must be less than header height %s", h.trustedHeight, h.getHeight())
+	}
+	if err := h.validatorSet.validateBasic(); err != nil {
+		return fmt.Errorf("validator set is not tendermint validator set: %w", err)
+	}
+	if err := h.trustedValidators.validateBasic(); err != nil {
+		return fmt.Errorf("trusted validator set is not tendermint validator set: %w", err)
+	}
+	if !bytes.Equal(h.header.validatorsHash, h.validatorSet.hash()) {
+		return errors.New("validator set does not match hash")
+	}
+
+	return nil
+}
+
+// misbehaviour mirrors the ibc.lightclients.tendermint.v1.Misbehaviour type.
+type misbehaviour struct {
+	header1 *header
+	header2 *header
+}
+
+func decodeMisbehaviour(bz []byte) (*misbehaviour, error) {
+	value, err := decodeAny(bz, typeURLMisbehaviour)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := decodeFields(value)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &misbehaviour{}
+	for _, f := range fields {
+		switch f.num {
+		case 2:
+			m.header1, err = decodeHeaderValue(f.bytes)
+		case 3:
+			m.header2, err = decodeHeaderValue(f.bytes)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if m.header1 == nil || m.header2 == nil {
+		return nil, errors.New("misbehaviour headers cannot be nil")
+	}
+
+	return m, nil
+}
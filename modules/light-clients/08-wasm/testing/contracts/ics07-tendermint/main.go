@@ -0,0 +1,189 @@
+//go:build wasip1
+
+// Command ics07-tendermint is a light client contract for the 08-wasm client which mirrors
+// the 07-tendermint light client. It is built with:
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -trimpath -ldflags="-s -w" -o ics07_tendermint.wasm .
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// allocations keeps the buffers shared with the host alive until the instance is discarded.
+var allocations = make(map[uint32][]byte)
+
+//go:wasmimport env db_read
+func dbRead(keyPtr, keyLen, valuePtr, valueCap uint32) int64
+
+//go:wasmimport env db_write
+func dbWrite(keyPtr, keyLen, valuePtr, valueLen uint32)
+
+//go:wasmimport env db_remove
+func dbRemove(keyPtr, keyLen uint32)
+
+func ptrOf(bz []byte) uint32 {
+	if len(bz) == 0 {
+		return 0
+	}
+	return uint32(uintptr(unsafe.Pointer(&bz[0])))
+}
+
+// hostStore is the client store of the host.
+type hostStore struct{}
+
+func (hostStore) get(key []byte) []byte {
+	buf := make([]byte, 1024)
+	for {
+		n := dbRead(ptrOf(key), uint32(len(key)), ptrOf(buf), uint32(len(buf)))
+		runtime.KeepAlive(key)
+		runtime.KeepAlive(buf)
+		switch {
+		case n < 0:
+			return nil
+		case n <= int64(len(buf)):
+			return buf[:n]
+		default:
+			buf = make([]byte, n)
+		}
+	}
+}
+
+func (hostStore) set(key, value []byte) {
+	if value == nil {
+		value = []byte{}
+	}
+	dbWrite(ptrOf(key), uint32(len(key)), ptrOf(value), uint32(len(value)))
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(value)
+}
+
+func (hostStore) remove(key []byte) {
+	dbRemove(ptrOf(key), uint32(len(key)))
+	runtime.KeepAlive(key)
+}
+
+//go:wasmexport allocate
+func allocate(size uint32) uint32 {
+	buf := make([]byte, size+1)
+	ptr := ptrOf(buf)
+	allocations[ptr] = buf
+	return ptr
+}
+
+func read(ptr, size uint32) []byte {
+	if size == 0 {
+		return nil
+	}
+	return allocations[ptr][:size]
+}
+
+// result returns the address and length of the json encoded result, packed into a single value.
+func result(res any, err error) uint64 {
+	var out struct {
+		Ok    any    `json:"ok,omitempty"`
+		Error string `json:"error,omitempty"`
+	}
+	if err != nil {
+		out.Error = err.Error()
+	} else {
+		out.Ok = res
+	}
+
+	bz, err := json.Marshal(out)
+	if err != nil {
+		bz = []byte(fmt.Sprintf(`{"error":%q}`, err.Error()))
+	}
+
+	ptr := allocate(uint32(len(bz)))
+	copy(allocations[ptr], bz)
+	return uint64(ptr)<<32 | uint64(len(bz))
+}
+
+// call decodes the environment and message passed by the host and executes the handler.
+func call[T any](envPtr, envLen, msgPtr, msgLen uint32, handler func(c *lightClient, msg T) (any, error)) (packed uint64) {
+	defer func() {
+		if r := recover(); r != nil {
+			packed = result(nil, fmt.Errorf("contract panicked: %v", r))
+		}
+	}()
+
+	c := &lightClient{store: hostStore{}}
+	if err := json.Unmarshal(read(envPtr, envLen), &c.env); err != nil {
+		return result(nil, fmt.Errorf("invalid env: %w", err))
+	}
+
+	var msg T
+	if err := json.Unmarshal(read(msgPtr, msgLen), &msg); err != nil {
+		return result(nil, fmt.Errorf("invalid message: %w", err))
+	}
+
+	return result(handler(c, msg))
+}
+
+//go:wasmexport instantiate
+func instantiate(envPtr, envLen, msgPtr, msgLen uint32) uint64 {
+	return call(envPtr, envLen, msgPtr, msgLen, func(c *lightClient, msg instantiateMsg) (any, error) {
+		return emptyResult{}, c.instantiate(msg)
+	})
+}
+
+//go:wasmexport query
+func query(envPtr, envLen, msgPtr, msgLen uint32) uint64 {
+	return call(envPtr, envLen, msgPtr, msgLen, func(c *lightClient, msg queryMsg) (any, error) {
+		switch {
+		case msg.Status != nil:
+			status, err := c.status()
+			return statusResult{Status: status}, err
+		case msg.TimestampAtHeight != nil:
+			timestamp, err := c.timestampAtHeight(msg.TimestampAtHeight.Height)
+			return timestampAtHeightResult{Timestamp: timestamp}, err
+		case msg.VerifyClientMessage != nil:
+			return emptyResult{}, c.verifyClientMessage(msg.VerifyClientMessage.ClientMessage)
+		case msg.CheckForMisbehaviour != nil:
+			found, err := c.checkForMisbehaviour(msg.CheckForMisbehaviour.ClientMessage)
+			return checkForMisbehaviourResult{FoundMisbehaviour: found}, err
+		default:
+			return nil, errors.New("unknown query message")
+		}
+	})
+}
+
+//go:wasmexport sudo
+func sudo(envPtr, envLen, msgPtr, msgLen uint32) uint64 {
+	return call(envPtr, envLen, msgPtr, msgLen, func(c *lightClient, msg sudoMsg) (any, error) {
+		switch {
+		case msg.UpdateState != nil:
+			heights, err := c.updateState(msg.UpdateState.ClientMessage)
+			return updateStateResult{Heights: heights}, err
+		case msg.UpdateStateOnMisbehaviour != nil:
+			return emptyResult{}, c.updateStateOnMisbehaviour()
+		case msg.VerifyMembership != nil:
+			return emptyResult{}, c.verifyMembership(*msg.VerifyMembership)
+		case msg.VerifyNonMembership != nil:
+			return emptyResult{}, c.verifyNonMembership(*msg.VerifyNonMembership)
+		case msg.VerifyUpgradeAndUpdateState != nil:
+			return emptyResult{}, c.verifyUpgradeAndUpdateState(*msg.VerifyUpgradeAndUpdateState)
+		case msg.MigrateClientStore != nil:
+			return emptyResult{}, c.migrateClientStore()
+		default:
+			return nil, errors.New("unknown sudo message")
+		}
+	})
+}
+
+// migrate is called when the contract of a client is migrated to this code. The state
+// layout is the same across versions of this contract so no migration is performed.
+//
+//go:wasmexport migrate
+func migrate(envPtr, envLen, msgPtr, msgLen uint32) uint64 {
+	return call(envPtr, envLen, msgPtr, msgLen, func(_ *lightClient, _ json.RawMessage) (any, error) {
+		return emptyResult{}, nil
+	})
+}
+
+func main() {}
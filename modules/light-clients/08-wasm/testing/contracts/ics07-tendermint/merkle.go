@@ -0,0 +1,746 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+const hashSize = sha256.Size
+
+// hashFromByteSlices computes the RFC 6962 merkle root of the given items, as computed by CometBFT.
+func hashFromByteSlices(items [][]byte) []byte {
+	switch len(items) {
+	case 0:
+		h := sha256.Sum256(nil)
+		return h[:]
+	case 1:
+		h := sha256.Sum256(append([]byte{0}, items[0]...))
+		return h[:]
+	default:
+		k := 1 << uint(bits.Len(uint(len(items)))-1)
+		if k == len(items) {
+			k >>= 1
+		}
+		left := hashFromByteSlices(items[:k])
+		right := hashFromByteSlices(items[k:])
+		h := sha256.Sum256(append(append([]byte{1}, left...), right...))
+		return h[:]
+	}
+}
+
+// ics23 hash operations.
+const (
+	hashOpNoHash    = 0
+	hashOpSHA256    = 1
+	hashOpSHA512    = 2
+	hashOpSHA512256 = 6
+)
+
+// ics23 length operations.
+const (
+	lengthOpNoPrefix       = 0
+	lengthOpVarProto       = 1
+	lengthOpRequire32Bytes = 7
+	lengthOpRequire64Bytes = 8
+)
+
+// leafOp mirrors the cosmos.ics23.v1.LeafOp type.
+type leafOp struct {
+	hash         int32
+	prehashKey   int32
+	prehashValue int32
+	length       int32
+	prefix       []byte
+}
+
+func decodeLeafOp(bz []byte) (*leafOp, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &leafOp{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			op.hash = int32(f.varint)
+		case 2:
+			op.prehashKey = int32(f.varint)
+		case 3:
+			op.prehashValue = int32(f.varint)
+		case 4:
+			op.length = int32(f.varint)
+		case 5:
+			op.prefix = f.bytes
+		}
+	}
+
+	return op, nil
+}
+
+func (op *leafOp) apply(key, value []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("leaf op needs key")
+	}
+	if len(value) == 0 {
+		return nil, errors.New("leaf op needs value")
+	}
+
+	pkey, err := prepareLeafData(op.prehashKey, op.length, key)
+	if err != nil {
+		return nil, fmt.Errorf("prehash key: %w", err)
+	}
+	pvalue, err := prepareLeafData(op.prehashValue, op.length, value)
+	if err != nil {
+		return nil, fmt.Errorf("prehash value: %w", err)
+	}
+
+	data := append(append(append([]byte{}, op.prefix...), pkey...), pvalue...)
+	return doHash(op.hash, data)
+}
+
+// innerOp mirrors the cosmos.ics23.v1.InnerOp type.
+type innerOp struct {
+	hash   int32
+	prefix []byte
+	suffix []byte
+}
+
+func decodeInnerOp(bz []byte) (*innerOp, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &innerOp{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			op.hash = int32(f.varint)
+		case 2:
+			op.prefix = f.bytes
+		case 3:
+			op.suffix = f.bytes
+		}
+	}
+
+	return op, nil
+}
+
+func (op *innerOp) apply(child []byte) ([]byte, error) {
+	if len(child) == 0 {
+		return nil, errors.New("inner op needs child value")
+	}
+
+	preimage := append(append(append([]byte{}, op.prefix...), child...), op.suffix...)
+	return doHash(op.hash, preimage)
+}
+
+// innerSpec mirrors the cosmos.ics23.v1.InnerSpec type.
+type innerSpec struct {
+	childOrder      []int32
+	childSize       int32
+	minPrefixLength int32
+	maxPrefixLength int32
+	emptyChild      []byte
+	hash            int32
+}
+
+// proofSpec mirrors the cosmos.ics23.v1.ProofSpec type.
+type proofSpec struct {
+	raw                        []byte
+	leafSpec                   *leafOp
+	innerSpec                  *innerSpec
+	maxDepth                   int32
+	minDepth                   int32
+	prehashKeyBeforeComparison bool
+}
+
+func decodeProofSpec(bz []byte) (*proofSpec, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &proofSpec{raw: bz, leafSpec: &leafOp{}, innerSpec: &innerSpec{}}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			if spec.leafSpec, err = decodeLeafOp(f.bytes); err != nil {
+				return nil, err
+			}
+		case 2:
+			innerFields, err := decodeFields(f.bytes)
+			if err != nil {
+				return nil, err
+			}
+			for _, inf := range innerFields {
+				switch inf.num {
+				case 1:
+					if spec.innerSpec.childOrder, err = decodePackedInt32s(inf, spec.innerSpec.childOrder); err != nil {
+						return nil, err
+					}
+				case 2:
+					spec.innerSpec.childSize = int32(inf.varint)
+				case 3:
+					spec.innerSpec.minPrefixLength = int32(inf.varint)
+				case 4:
+					spec.innerSpec.maxPrefixLength = int32(inf.varint)
+				case 5:
+					spec.innerSpec.emptyChild = inf.bytes
+				case 6:
+					spec.innerSpec.hash = int32(inf.varint)
+				}
+			}
+		case 3:
+			spec.maxDepth = int32(f.varint)
+		case 4:
+			spec.minDepth = int32(f.varint)
+		case 5:
+			spec.prehashKeyBeforeComparison = f.varint != 0
+		}
+	}
+
+	if len(spec.innerSpec.childOrder) == 0 || spec.innerSpec.childSize <= 0 {
+		return nil, errors.New("invalid inner spec")
+	}
+
+	return spec, nil
+}
+
+// existenceProof mirrors the cosmos.ics23.v1.ExistenceProof type.
+type existenceProof struct {
+	key   []byte
+	value []byte
+	leaf  *leafOp
+	path  []*innerOp
+}
+
+func decodeExistenceProof(bz []byte) (*existenceProof, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &existenceProof{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			p.key = f.bytes
+		case 2:
+			p.value = f.bytes
+		case 3:
+			if p.leaf, err = decodeLeafOp(f.bytes); err != nil {
+				return nil, err
+			}
+		case 4:
+			op, err := decodeInnerOp(f.bytes)
+			if err != nil {
+				return nil, err
+			}
+			p.path = append(p.path, op)
+		}
+	}
+
+	return p, nil
+}
+
+// calculate returns the root hash committed to by the existence proof.
+func (p *existenceProof) calculate() ([]byte, error) {
+	if p.leaf == nil {
+		return nil, errors.New("existence proof must start with a leaf operation")
+	}
+
+	res, err := p.leaf.apply(p.key, p.value)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, step := range p.path {
+		if res, err = step.apply(res); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// checkAgainstSpec verifies that the proof operations are allowed by the proof spec.
+func (p *existenceProof) checkAgainstSpec(spec *proofSpec) error {
+	if p.leaf == nil {
+		return errors.New("existence proof must start with a leaf operation")
+	}
+
+	lspec := spec.leafSpec
+	if p.leaf.hash != lspec.hash || p.leaf.prehashKey != lspec.prehashKey ||
+		p.leaf.prehashValue != lspec.prehashValue || p.leaf.length != lspec.length {
+		return errors.New("leaf operation does not match the proof spec")
+	}
+	if !bytes.HasPrefix(p.leaf.prefix, lspec.prefix) {
+		return errors.New("leaf prefix does not match the proof spec")
+	}
+
+	if spec.minDepth > 0 && len(p.path) < int(spec.minDepth) {
+		return fmt.Errorf("inner path depth too short, got %d, expected at least %d", len(p.path), spec.minDepth)
+	}
+	if spec.maxDepth > 0 && len(p.path) > int(spec.maxDepth) {
+		return fmt.Errorf("inner path depth too long, got %d, expected at most %d", len(p.path), spec.maxDepth)
+	}
+
+	ispec := spec.innerSpec
+	maxLeftChildBytes := (len(ispec.childOrder) - 1) * int(ispec.childSize)
+	for _, step := range p.path {
+		if step.hash != ispec.hash {
+			return errors.New("inner operation hash does not match the proof spec")
+		}
+		if bytes.HasPrefix(step.prefix, lspec.prefix) {
+			return errors.New("inner operation prefix starts with the leaf prefix")
+		}
+		if len(step.prefix) < int(ispec.minPrefixLength) {
+			return errors.New("inner operation prefix too short")
+		}
+		if len(step.prefix) > int(ispec.maxPrefixLength)+maxLeftChildBytes {
+			return errors.New("inner operation prefix too long")
+		}
+		if len(step.suffix)%int(ispec.childSize) != 0 {
+			return errors.New("inner operation suffix malformed")
+		}
+	}
+
+	return nil
+}
+
+// verify verifies that the proof commits to the key/value pair under the given root.
+func (p *existenceProof) verify(spec *proofSpec, root, key, value []byte) error {
+	if err := p.checkAgainstSpec(spec); err != nil {
+		return err
+	}
+	if !bytes.Equal(key, p.key) {
+		return fmt.Errorf("provided key doesn't match proof")
+	}
+	if !bytes.Equal(value, p.value) {
+		return fmt.Errorf("provided value doesn't match proof")
+	}
+
+	calc, err := p.calculate()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, calc) {
+		return errors.New("calculated root doesn't match provided root")
+	}
+
+	return nil
+}
+
+// nonExistenceProof mirrors the cosmos.ics23.v1.NonExistenceProof type.
+type nonExistenceProof struct {
+	key   []byte
+	left  *existenceProof
+	right *existenceProof
+}
+
+func decodeNonExistenceProof(bz []byte) (*nonExistenceProof, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &nonExistenceProof{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			p.key = f.bytes
+		case 2:
+			p.left, err = decodeExistenceProof(f.bytes)
+		case 3:
+			p.right, err = decodeExistenceProof(f.bytes)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// calculate returns the root hash committed to by the non-existence proof.
+func (p *nonExistenceProof) calculate() ([]byte, error) {
+	switch {
+	case p.left != nil:
+		return p.left.calculate()
+	case p.right != nil:
+		return p.right.calculate()
+	default:
+		return nil, errors.New("non-existence proof has empty left and right proof")
+	}
+}
+
+// verify verifies that the proof proves the absence of the key under the given root.
+func (p *nonExistenceProof) verify(spec *proofSpec, root, key []byte) error {
+	if p.left == nil && p.right == nil {
+		return errors.New("both left and right proofs are missing")
+	}
+
+	keyForComparison := func(k []byte) []byte {
+		if !spec.prehashKeyBeforeComparison {
+			return k
+		}
+		hash, err := doHashOrNoop(spec.leafSpec.prehashKey, k)
+		if err != nil {
+			return k
+		}
+		return hash
+	}
+
+	if p.left != nil {
+		if err := p.left.verify(spec, root, p.left.key, p.left.value); err != nil {
+			return fmt.Errorf("left proof: %w", err)
+		}
+		if bytes.Compare(keyForComparison(key), keyForComparison(p.left.key)) <= 0 {
+			return errors.New("key is not right of left proof")
+		}
+	}
+
+	if p.right != nil {
+		if err := p.right.verify(spec, root, p.right.key, p.right.value); err != nil {
+			return fmt.Errorf("right proof: %w", err)
+		}
+		if bytes.Compare(keyForComparison(key), keyForComparison(p.right.key)) >= 0 {
+			return errors.New("key is not left of right proof")
+		}
+	}
+
+	switch {
+	case p.left == nil:
+		if !isLeftMost(spec.innerSpec, p.right.path) {
+			return errors.New("right proof is not left-most")
+		}
+	case p.right == nil:
+		if !isRightMost(spec.innerSpec, p.left.path) {
+			return errors.New("left proof is not right-most")
+		}
+	default:
+		if !isLeftNeighbor(spec.innerSpec, p.left.path, p.right.path) {
+			return errors.New("right proof is not the left neighbor of the left proof")
+		}
+	}
+
+	return nil
+}
+
+// isLeftMost returns true if the path only takes the left-most branches.
+func isLeftMost(spec *innerSpec, path []*innerOp) bool {
+	minPrefix, maxPrefix, suffix := getPadding(spec, 0)
+	for _, step := range path {
+		if !hasPadding(step, minPrefix, maxPrefix, suffix) && !leftBranchesAreEmpty(spec, step) {
+			return false
+		}
+	}
+	return true
+}
+
+// isRightMost returns true if the path only takes the right-most branches.
+func isRightMost(spec *innerSpec, path []*innerOp) bool {
+	last := int32(len(spec.childOrder) - 1)
+	minPrefix, maxPrefix, suffix := getPadding(spec, last)
+	for _, step := range path {
+		if !hasPadding(step, minPrefix, maxPrefix, suffix) && !rightBranchesAreEmpty(spec, step) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLeftNeighbor returns true if the right path is the closest path right of the left path.
+func isLeftNeighbor(spec *innerSpec, left, right []*innerOp) bool {
+	if len(left) == 0 || len(right) == 0 {
+		return false
+	}
+
+	// remove the common tail of both paths
+	top, left := left[len(left)-1], left[:len(left)-1]
+	topRight, right := right[len(right)-1], right[:len(right)-1]
+	for bytes.Equal(top.prefix, topRight.prefix) && bytes.Equal(top.suffix, topRight.suffix) {
+		if len(left) == 0 || len(right) == 0 {
+			return false
+		}
+		top, left = left[len(left)-1], left[:len(left)-1]
+		topRight, right = right[len(right)-1], right[:len(right)-1]
+	}
+
+	// the paths must diverge at adjacent branches of the same node
+	leftIdx, err := orderFromPadding(spec, top)
+	if err != nil {
+		return false
+	}
+	rightIdx, err := orderFromPadding(spec, topRight)
+	if err != nil {
+		return false
+	}
+	if rightIdx != leftIdx+1 {
+		return false
+	}
+
+	return isRightMost(spec, left) && isLeftMost(spec, right)
+}
+
+// getPadding returns the prefix and suffix lengths of an inner operation taking the given branch.
+func getPadding(spec *innerSpec, branch int32) (minPrefix, maxPrefix, suffix int) {
+	idx := getPosition(spec.childOrder, branch)
+	prefix := idx * int(spec.childSize)
+	minPrefix = prefix + int(spec.minPrefixLength)
+	maxPrefix = prefix + int(spec.maxPrefixLength)
+	suffix = (len(spec.childOrder) - 1 - idx) * int(spec.childSize)
+	return minPrefix, maxPrefix, suffix
+}
+
+func getPosition(order []int32, branch int32) int {
+	for i, item := range order {
+		if item == branch {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasPadding(op *innerOp, minPrefix, maxPrefix, suffix int) bool {
+	return len(op.prefix) >= minPrefix && len(op.prefix) <= maxPrefix && len(op.suffix) == suffix
+}
+
+// orderFromPadding returns the branch taken by the inner operation.
+func orderFromPadding(spec *innerSpec, op *innerOp) (int32, error) {
+	for branch := int32(0); branch < int32(len(spec.childOrder)); branch++ {
+		minPrefix, maxPrefix, suffix := getPadding(spec, branch)
+		if hasPadding(op, minPrefix, maxPrefix, suffix) {
+			return branch, nil
+		}
+	}
+	return 0, errors.New("cannot find any valid spacing for this node")
+}
+
+func leftBranchesAreEmpty(spec *innerSpec, op *innerOp) bool {
+	idx, err := orderFromPadding(spec, op)
+	if err != nil || idx == 0 {
+		return false
+	}
+
+	leftBranches := int(idx)
+	actualPrefix := len(op.prefix) - leftBranches*int(spec.childSize)
+	if actualPrefix < 0 {
+		return false
+	}
+
+	for i := 0; i < leftBranches; i++ {
+		from := actualPrefix + getPosition(spec.childOrder, int32(i))*int(spec.childSize)
+		if !bytes.Equal(spec.emptyChild, op.prefix[from:from+int(spec.childSize)]) {
+			return false
+		}
+	}
+	return true
+}
+
+func rightBranchesAreEmpty(spec *innerSpec, op *innerOp) bool {
+	idx, err := orderFromPadding(spec, op)
+	if err != nil {
+		return false
+	}
+
+	rightBranches := len(spec.childOrder) - 1 - int(idx)
+	if rightBranches == 0 || len(op.suffix) != rightBranches*int(spec.childSize) {
+		return false
+	}
+
+	for i := 0; i < rightBranches; i++ {
+		from := getPosition(spec.childOrder, int32(i)) * int(spec.childSize)
+		if from < 0 || from+int(spec.childSize) > len(op.suffix) {
+			return false
+		}
+		if !bytes.Equal(spec.emptyChild, op.suffix[from:from+int(spec.childSize)]) {
+			return false
+		}
+	}
+	return true
+}
+
+func prepareLeafData(hashOp, lengthOp int32, data []byte) ([]byte, error) {
+	hdata, err := doHashOrNoop(hashOp, data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch lengthOp {
+	case lengthOpNoPrefix:
+		return hdata, nil
+	case lengthOpVarProto:
+		return append(binary.AppendUvarint(nil, uint64(len(hdata))), hdata...), nil
+	case lengthOpRequire32Bytes:
+		if len(hdata) != 32 {
+			return nil, fmt.Errorf("data was %d bytes, not 32", len(hdata))
+		}
+		return hdata, nil
+	case lengthOpRequire64Bytes:
+		if len(hdata) != 64 {
+			return nil, fmt.Errorf("data was %d bytes, not 64", len(hdata))
+		}
+		return hdata, nil
+	default:
+		return nil, fmt.Errorf("unsupported length operation %d", lengthOp)
+	}
+}
+
+func doHashOrNoop(hashOp int32, data []byte) ([]byte, error) {
+	if hashOp == hashOpNoHash {
+		return data, nil
+	}
+	return doHash(hashOp, data)
+}
+
+func doHash(hashOp int32, data []byte) ([]byte, error) {
+	switch hashOp {
+	case hashOpSHA256:
+		h := sha256.Sum256(data)
+		return h[:], nil
+	case hashOpSHA512:
+		h := sha512.Sum512(data)
+		return h[:], nil
+	case hashOpSHA512256:
+		h := sha512.Sum512_256(data)
+		return h[:], nil
+	default:
+		return nil, fmt.Errorf("unsupported hash operation %d", hashOp)
+	}
+}
+
+// commitmentProof mirrors the cosmos.ics23.v1.CommitmentProof type, only existence and
+// non-existence proofs are supported.
+type commitmentProof struct {
+	exist    *existenceProof
+	nonExist *nonExistenceProof
+}
+
+func (p *commitmentProof) calculate() ([]byte, error) {
+	switch {
+	case p.exist != nil:
+		return p.exist.calculate()
+	case p.nonExist != nil:
+		return p.nonExist.calculate()
+	default:
+		return nil, errors.New("unsupported commitment proof type")
+	}
+}
+
+// decodeMerkleProof decodes a ibc.core.commitment.v1.MerkleProof.
+func decodeMerkleProof(bz []byte) ([]*commitmentProof, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var proofs []*commitmentProof
+	for _, f := range fields {
+		if f.num != 1 {
+			continue
+		}
+
+		proofFields, err := decodeFields(f.bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		proof := &commitmentProof{}
+		for _, pf := range proofFields {
+			switch pf.num {
+			case 1:
+				proof.exist, err = decodeExistenceProof(pf.bytes)
+			case 2:
+				proof.nonExist, err = decodeNonExistenceProof(pf.bytes)
+			default:
+				err = fmt.Errorf("unsupported commitment proof type %d", pf.num)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		proofs = append(proofs, proof)
+	}
+
+	return proofs, nil
+}
+
+// verifyMembership verifies the chained membership proof of the value at the merkle path under the given root.
+func verifyMembership(specs []*proofSpec, root []byte, proofs []*commitmentProof, path []string, value []byte) error {
+	if err := validateVerificationArgs(specs, root, proofs, path); err != nil {
+		return err
+	}
+
+	return verifyChainedMembershipProof(root, specs, proofs, path, value, 0)
+}
+
+// verifyNonMembership verifies the absence of the merkle path under the given root.
+func verifyNonMembership(specs []*proofSpec, root []byte, proofs []*commitmentProof, path []string) error {
+	if err := validateVerificationArgs(specs, root, proofs, path); err != nil {
+		return err
+	}
+
+	if proofs[0].nonExist == nil {
+		return errors.New("expected proof type non-existence proof")
+	}
+
+	subroot, err := proofs[0].calculate()
+	if err != nil {
+		return fmt.Errorf("could not calculate root for proof index 0, merkle tree is likely empty: %w", err)
+	}
+
+	key := []byte(path[len(path)-1])
+	if err := proofs[0].nonExist.verify(specs[0], subroot, key); err != nil {
+		return fmt.Errorf("failed to verify non-membership proof with key %s: %w", path[len(path)-1], err)
+	}
+
+	return verifyChainedMembershipProof(root, specs, proofs, path, subroot, 1)
+}
+
+func validateVerificationArgs(specs []*proofSpec, root []byte, proofs []*commitmentProof, path []string) error {
+	if len(root) == 0 {
+		return errors.New("root cannot be empty")
+	}
+	if len(specs) != len(proofs) {
+		return fmt.Errorf("length of specs: %d not equal to length of proof: %d", len(specs), len(proofs))
+	}
+	if len(path) != len(specs) {
+		return fmt.Errorf("path length %d not same as proof %d", len(path), len(specs))
+	}
+	return nil
+}
+
+func verifyChainedMembershipProof(root []byte, specs []*proofSpec, proofs []*commitmentProof, path []string, value []byte, index int) error {
+	subroot := value
+	for i := index; i < len(proofs); i++ {
+		if proofs[i].exist == nil {
+			return fmt.Errorf("expected proof type existence proof at index %d", i)
+		}
+
+		var err error
+		if subroot, err = proofs[i].exist.calculate(); err != nil {
+			return fmt.Errorf("could not calculate proof root at index %d, merkle tree may be empty: %w", i, err)
+		}
+
+		key := []byte(path[len(path)-1-i])
+		if err := proofs[i].exist.verify(specs[i], subroot, key, value); err != nil {
+			return fmt.Errorf("chained membership proof failed to verify membership of value: %X in subroot %X at index %d: %w", value, subroot, i, err)
+		}
+
+		value = subroot
+	}
+
+	if !bytes.Equal(root, subroot) {
+		return fmt.Errorf("proof did not commit to expected root: %X, got: %X", root, subroot)
+	}
+
+	return nil
+}
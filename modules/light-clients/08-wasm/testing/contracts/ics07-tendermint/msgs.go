@@ -0,0 +1,88 @@
+package main
+
+// env is the environment of the host chain passed with every call.
+type env struct {
+	Block blockInfo `json:"block"`
+}
+
+type blockInfo struct {
+	Height  uint64 `json:"height"`
+	Time    uint64 `json:"time"`
+	ChainID string `json:"chain_id"`
+}
+
+type merklePath struct {
+	KeyPath []string `json:"key_path"`
+}
+
+type instantiateMsg struct {
+	ClientState    []byte `json:"client_state"`
+	ConsensusState []byte `json:"consensus_state"`
+	Checksum       []byte `json:"checksum"`
+}
+
+type queryMsg struct {
+	Status               *struct{}             `json:"status,omitempty"`
+	TimestampAtHeight    *timestampAtHeightMsg `json:"timestamp_at_height,omitempty"`
+	VerifyClientMessage  *clientMessageMsg     `json:"verify_client_message,omitempty"`
+	CheckForMisbehaviour *clientMessageMsg     `json:"check_for_misbehaviour,omitempty"`
+}
+
+type sudoMsg struct {
+	UpdateState                 *clientMessageMsg               `json:"update_state,omitempty"`
+	UpdateStateOnMisbehaviour   *clientMessageMsg               `json:"update_state_on_misbehaviour,omitempty"`
+	VerifyMembership            *verifyMembershipMsg            `json:"verify_membership,omitempty"`
+	VerifyNonMembership         *verifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
+	VerifyUpgradeAndUpdateState *verifyUpgradeAndUpdateStateMsg `json:"verify_upgrade_and_update_state,omitempty"`
+	MigrateClientStore          *struct{}                       `json:"migrate_client_store,omitempty"`
+}
+
+type timestampAtHeightMsg struct {
+	Height height `json:"height"`
+}
+
+type clientMessageMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+type verifyMembershipMsg struct {
+	Height           height     `json:"height"`
+	DelayTimePeriod  uint64     `json:"delay_time_period"`
+	DelayBlockPeriod uint64     `json:"delay_block_period"`
+	Proof            []byte     `json:"proof"`
+	MerklePath       merklePath `json:"merkle_path"`
+	Value            []byte     `json:"value"`
+}
+
+type verifyNonMembershipMsg struct {
+	Height           height     `json:"height"`
+	DelayTimePeriod  uint64     `json:"delay_time_period"`
+	DelayBlockPeriod uint64     `json:"delay_block_period"`
+	Proof            []byte     `json:"proof"`
+	MerklePath       merklePath `json:"merkle_path"`
+}
+
+type verifyUpgradeAndUpdateStateMsg struct {
+	UpgradeClientState         []byte `json:"upgrade_client_state"`
+	UpgradeConsensusState      []byte `json:"upgrade_consensus_state"`
+	ProofUpgradeClient         []byte `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte `json:"proof_upgrade_consensus_state"`
+}
+
+type emptyResult struct{}
+
+type updateStateResult struct {
+	Heights []height `json:"heights"`
+}
+
+type statusResult struct {
+	Status string `json:"status"`
+}
+
+type timestampAtHeightResult struct {
+	Timestamp uint64 `json:"timestamp"`
+}
+
+type checkForMisbehaviourResult struct {
+	FoundMisbehaviour bool `json:"found_misbehaviour"`
+}
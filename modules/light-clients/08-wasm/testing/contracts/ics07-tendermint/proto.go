@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// protobuf wire types used by the messages decoded by the contract.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// field is a single protobuf field decoded from the wire format.
+type field struct {
+	num      int
+	wireType int
+	varint   uint64
+	bytes    []byte
+}
+
+// decodeFields decodes the top level fields of a protobuf encoded message.
+// Nested messages are left encoded in the bytes of their field.
+func decodeFields(bz []byte) ([]field, error) {
+	var fields []field
+	for len(bz) > 0 {
+		tag, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return nil, errors.New("invalid protobuf field tag")
+		}
+		bz = bz[n:]
+
+		f := field{num: int(tag >> 3), wireType: int(tag & 7)}
+		if f.num <= 0 {
+			return nil, fmt.Errorf("invalid protobuf field number %d", f.num)
+		}
+
+		switch f.wireType {
+		case wireVarint:
+			v, n := binary.Uvarint(bz)
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid varint for field %d", f.num)
+			}
+			f.varint = v
+			bz = bz[n:]
+		case wireFixed64:
+			if len(bz) < 8 {
+				return nil, fmt.Errorf("invalid fixed64 for field %d", f.num)
+			}
+			f.varint = binary.LittleEndian.Uint64(bz)
+			bz = bz[8:]
+		case wireBytes:
+			l, n := binary.Uvarint(bz)
+			if n <= 0 || l > uint64(len(bz)-n) {
+				return nil, fmt.Errorf("invalid length for field %d", f.num)
+			}
+			f.bytes = bz[n : n+int(l)]
+			bz = bz[n+int(l):]
+		case wireFixed32:
+			if len(bz) < 4 {
+				return nil, fmt.Errorf("invalid fixed32 for field %d", f.num)
+			}
+			f.varint = uint64(binary.LittleEndian.Uint32(bz))
+			bz = bz[4:]
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d for field %d", f.wireType, f.num)
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+// decodePackedInt32s decodes a repeated int32 field which may be either packed or unpacked.
+func decodePackedInt32s(f field, values []int32) ([]int32, error) {
+	if f.wireType == wireVarint {
+		return append(values, int32(f.varint)), nil
+	}
+
+	bz := f.bytes
+	for len(bz) > 0 {
+		v, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid packed varint for field %d", f.num)
+		}
+		values = append(values, int32(v))
+		bz = bz[n:]
+	}
+
+	return values, nil
+}
+
+// encoder encodes protobuf messages following the canonical encoding of gogoproto:
+// scalar fields with zero values are omitted while non-nullable messages are always written.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) tag(num, wireType int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(num)<<3|uint64(wireType))
+}
+
+// uvarint writes a varint field, it is omitted if the value is zero.
+func (e *encoder) uvarint(num int, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.tag(num, wireVarint)
+	e.buf = binary.AppendUvarint(e.buf, v)
+}
+
+// bool writes a bool field, it is omitted if the value is false.
+func (e *encoder) bool(num int, v bool) {
+	if v {
+		e.uvarint(num, 1)
+	}
+}
+
+// sfixed64 writes a sfixed64 field, it is omitted if the value is zero.
+func (e *encoder) sfixed64(num int, v int64) {
+	if v == 0 {
+		return
+	}
+	e.tag(num, wireFixed64)
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, uint64(v))
+}
+
+// bytes writes a bytes field, it is omitted if the value is empty.
+func (e *encoder) bytes(num int, bz []byte) {
+	if len(bz) == 0 {
+		return
+	}
+	e.message(num, bz)
+}
+
+// string writes a string field, it is omitted if the value is empty.
+func (e *encoder) string(num int, s string) {
+	e.bytes(num, []byte(s))
+}
+
+// message writes a non-nullable embedded message, it is always written.
+func (e *encoder) message(num int, bz []byte) {
+	e.tag(num, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(bz)))
+	e.buf = append(e.buf, bz...)
+}
+
+// delimited returns the given message prefixed by its length.
+func delimited(bz []byte) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(bz))), bz...)
+}
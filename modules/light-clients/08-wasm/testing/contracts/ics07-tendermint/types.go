@@ -0,0 +1,467 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	typeURLWasmClientState    = "/ibc.lightclients.wasm.v1.ClientState"
+	typeURLWasmConsensusState = "/ibc.lightclients.wasm.v1.ConsensusState"
+	typeURLClientState        = "/ibc.lightclients.tendermint.v1.ClientState"
+	typeURLConsensusState     = "/ibc.lightclients.tendermint.v1.ConsensusState"
+	typeURLHeader             = "/ibc.lightclients.tendermint.v1.Header"
+	typeURLMisbehaviour       = "/ibc.lightclients.tendermint.v1.Misbehaviour"
+)
+
+// height mirrors the ibc.core.client.v1.Height type.
+type height struct {
+	RevisionNumber uint64 `json:"revision_number,omitempty"`
+	RevisionHeight uint64 `json:"revision_height,omitempty"`
+}
+
+func (h height) String() string {
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
+}
+
+func (h height) isZero() bool {
+	return h.RevisionNumber == 0 && h.RevisionHeight == 0
+}
+
+// compare returns -1, 0 or 1 if the height is respectively lower, equal or greater than the other height.
+func (h height) compare(other height) int {
+	switch {
+	case h.RevisionNumber != other.RevisionNumber:
+		if h.RevisionNumber < other.RevisionNumber {
+			return -1
+		}
+		return 1
+	case h.RevisionHeight < other.RevisionHeight:
+		return -1
+	case h.RevisionHeight > other.RevisionHeight:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (h height) encode() []byte {
+	var e encoder
+	e.uvarint(1, h.RevisionNumber)
+	e.uvarint(2, h.RevisionHeight)
+	return e.buf
+}
+
+func decodeHeight(bz []byte) (height, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return height{}, err
+	}
+
+	var h height
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			h.RevisionNumber = f.varint
+		case 2:
+			h.RevisionHeight = f.varint
+		}
+	}
+
+	return h, nil
+}
+
+// parseChainID returns the revision number of a chain identifier in the {chainID}-{revision} format,
+// it returns 0 if the chain identifier is not in the revision format.
+func parseChainID(chainID string) uint64 {
+	idx := strings.LastIndex(chainID, "-")
+	if idx <= 0 || chainID[idx-1] == '-' || chainID[idx-1] == '\n' {
+		return 0
+	}
+
+	suffix := chainID[idx+1:]
+	if suffix == "" || suffix[0] == '0' {
+		return 0
+	}
+
+	revision, err := strconv.ParseUint(suffix, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return revision
+}
+
+// decodeTime decodes a google.protobuf.Timestamp or google.protobuf.Duration into nanoseconds.
+func decodeTime(bz []byte) (int64, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return 0, err
+	}
+
+	var seconds, nanos int64
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			seconds = int64(f.varint)
+		case 2:
+			nanos = int64(int32(f.varint))
+		}
+	}
+
+	return seconds*1e9 + nanos, nil
+}
+
+// encodeTime encodes nanoseconds into a google.protobuf.Timestamp or google.protobuf.Duration.
+func encodeTime(t int64) []byte {
+	var e encoder
+	e.uvarint(1, uint64(t/1e9))
+	e.uvarint(2, uint64(t%1e9))
+	return e.buf
+}
+
+// decodeAny decodes a google.protobuf.Any and checks its type URL.
+func decodeAny(bz []byte, typeURL string) ([]byte, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		url   string
+		value []byte
+	)
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			url = string(f.bytes)
+		case 2:
+			value = f.bytes
+		}
+	}
+
+	if url != typeURL {
+		return nil, fmt.Errorf("invalid type URL, expected %s, got %s", typeURL, url)
+	}
+
+	return value, nil
+}
+
+// typeURLOf returns the type URL of a google.protobuf.Any.
+func typeURLOf(bz []byte) (string, error) {
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return "", err
+	}
+
+	for _, f := range fields {
+		if f.num == 1 {
+			return string(f.bytes), nil
+		}
+	}
+
+	return "", errors.New("missing type URL")
+}
+
+func encodeAny(typeURL string, value []byte) []byte {
+	var e encoder
+	e.string(1, typeURL)
+	e.bytes(2, value)
+	return e.buf
+}
+
+// wasmClientState mirrors the ibc.lightclients.wasm.v1.ClientState stored by core IBC.
+type wasmClientState struct {
+	data         []byte
+	checksum     []byte
+	latestHeight height
+}
+
+func (cs wasmClientState) encode() []byte {
+	var e encoder
+	e.bytes(1, cs.data)
+	e.bytes(2, cs.checksum)
+	e.message(3, cs.latestHeight.encode())
+	return encodeAny(typeURLWasmClientState, e.buf)
+}
+
+func decodeWasmClientState(bz []byte) (wasmClientState, error) {
+	value, err := decodeAny(bz, typeURLWasmClientState)
+	if err != nil {
+		return wasmClientState{}, err
+	}
+
+	fields, err := decodeFields(value)
+	if err != nil {
+		return wasmClientState{}, err
+	}
+
+	var cs wasmClientState
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			cs.data = f.bytes
+		case 2:
+			cs.checksum = f.bytes
+		case 3:
+			if cs.latestHeight, err = decodeHeight(f.bytes); err != nil {
+				return wasmClientState{}, err
+			}
+		}
+	}
+
+	return cs, nil
+}
+
+// encodeWasmConsensusState wraps the given data in a ibc.lightclients.wasm.v1.ConsensusState.
+func encodeWasmConsensusState(data []byte) []byte {
+	var e encoder
+	e.bytes(1, data)
+	return encodeAny(typeURLWasmConsensusState, e.buf)
+}
+
+func decodeWasmConsensusState(bz []byte) ([]byte, error) {
+	value, err := decodeAny(bz, typeURLWasmConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := decodeFields(value)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fields {
+		if f.num == 1 {
+			return f.bytes, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// fraction mirrors the ibc.lightclients.tendermint.v1.Fraction type.
+type fraction struct {
+	numerator   uint64
+	denominator uint64
+}
+
+// clientState mirrors the ibc.lightclients.tendermint.v1.ClientState type.
+type clientState struct {
+	chainID         string
+	trustLevel      fraction
+	trustingPeriod  int64
+	unbondingPeriod int64
+	maxClockDrift   int64
+	frozenHeight    height
+	latestHeight    height
+	proofSpecs      []*proofSpec
+	upgradePath     []string
+
+	allowUpdateAfterExpiry       bool
+	allowUpdateAfterMisbehaviour bool
+}
+
+func decodeClientState(bz []byte) (*clientState, error) {
+	value, err := decodeAny(bz, typeURLClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := decodeFields(value)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &clientState{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			cs.chainID = string(f.bytes)
+		case 2:
+			tl, err := decodeFields(f.bytes)
+			if err != nil {
+				return nil, err
+			}
+			for _, tf := range tl {
+				switch tf.num {
+				case 1:
+					cs.trustLevel.numerator = tf.varint
+				case 2:
+					cs.trustLevel.denominator = tf.varint
+				}
+			}
+		case 3:
+			cs.trustingPeriod, err = decodeTime(f.bytes)
+		case 4:
+			cs.unbondingPeriod, err = decodeTime(f.bytes)
+		case 5:
+			cs.maxClockDrift, err = decodeTime(f.bytes)
+		case 6:
+			cs.frozenHeight, err = decodeHeight(f.bytes)
+		case 7:
+			cs.latestHeight, err = decodeHeight(f.bytes)
+		case 8:
+			var spec *proofSpec
+			if spec, err = decodeProofSpec(f.bytes); err == nil {
+				cs.proofSpecs = append(cs.proofSpecs, spec)
+			}
+		case 9:
+			cs.upgradePath = append(cs.upgradePath, string(f.bytes))
+		case 10:
+			cs.allowUpdateAfterExpiry = f.varint != 0
+		case 11:
+			cs.allowUpdateAfterMisbehaviour = f.varint != 0
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cs, nil
+}
+
+func (cs *clientState) encode() []byte {
+	var trustLevel encoder
+	trustLevel.uvarint(1, cs.trustLevel.numerator)
+	trustLevel.uvarint(2, cs.trustLevel.denominator)
+
+	var e encoder
+	e.string(1, cs.chainID)
+	e.message(2, trustLevel.buf)
+	e.message(3, encodeTime(cs.trustingPeriod))
+	e.message(4, encodeTime(cs.unbondingPeriod))
+	e.message(5, encodeTime(cs.maxClockDrift))
+	e.message(6, cs.frozenHeight.encode())
+	e.message(7, cs.latestHeight.encode())
+	for _, spec := range cs.proofSpecs {
+		e.message(8, spec.raw)
+	}
+	for _, key := range cs.upgradePath {
+		e.message(9, []byte(key))
+	}
+	e.bool(10, cs.allowUpdateAfterExpiry)
+	e.bool(11, cs.allowUpdateAfterMisbehaviour)
+
+	return encodeAny(typeURLClientState, e.buf)
+}
+
+// validate mirrors the validation of the 07-tendermint ClientState.
+func (cs *clientState) validate() error {
+	if strings.TrimSpace(cs.chainID) == "" {
+		return errors.New("chain id cannot be empty string")
+	}
+	if cs.trustLevel.denominator == 0 || cs.trustLevel.numerator*3 < cs.trustLevel.denominator || cs.trustLevel.numerator > cs.trustLevel.denominator {
+		return fmt.Errorf("trust level must be within [1/3, 1], got %d/%d", cs.trustLevel.numerator, cs.trustLevel.denominator)
+	}
+	if cs.trustingPeriod <= 0 {
+		return errors.New("trusting period must be greater than zero")
+	}
+	if cs.unbondingPeriod <= 0 {
+		return errors.New("unbonding period must be greater than zero")
+	}
+	if cs.maxClockDrift <= 0 {
+		return errors.New("max clock drift must be greater than zero")
+	}
+	if cs.trustingPeriod >= cs.unbondingPeriod {
+		return errors.New("trusting period should be < unbonding period")
+	}
+	if cs.latestHeight.RevisionHeight == 0 {
+		return errors.New("tendermint client's latest height revision height cannot be zero")
+	}
+	if cs.latestHeight.RevisionNumber != parseChainID(cs.chainID) {
+		return errors.New("latest height revision number must match chain id revision number")
+	}
+	if len(cs.proofSpecs) == 0 {
+		return errors.New("proof specs cannot be empty for tm client")
+	}
+	for _, key := range cs.upgradePath {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("key in upgrade path cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// isExpired returns true if the given timestamp is outside of the trusting period of the client.
+func (cs *clientState) isExpired(timestamp, now int64) bool {
+	return timestamp+cs.trustingPeriod <= now
+}
+
+// consensusState mirrors the ibc.lightclients.tendermint.v1.ConsensusState type.
+type consensusState struct {
+	timestamp          int64
+	root               []byte
+	nextValidatorsHash []byte
+}
+
+func decodeConsensusState(bz []byte) (*consensusState, error) {
+	value, err := decodeAny(bz, typeURLConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := decodeFields(value)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &consensusState{}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			if cs.timestamp, err = decodeTime(f.bytes); err != nil {
+				return nil, err
+			}
+		case 2:
+			root, err := decodeFields(f.bytes)
+			if err != nil {
+				return nil, err
+			}
+			for _, rf := range root {
+				if rf.num == 1 {
+					cs.root = rf.bytes
+				}
+			}
+		case 3:
+			cs.nextValidatorsHash = f.bytes
+		}
+	}
+
+	return cs, nil
+}
+
+func (cs *consensusState) encode() []byte {
+	var root encoder
+	root.bytes(1, cs.root)
+
+	var e encoder
+	e.message(1, encodeTime(cs.timestamp))
+	e.message(2, root.buf)
+	e.bytes(3, cs.nextValidatorsHash)
+
+	return encodeAny(typeURLConsensusState, e.buf)
+}
+
+// validate mirrors the validation of the 07-tendermint ConsensusState.
+func (cs *consensusState) validate() error {
+	if len(cs.root) == 0 {
+		return errors.New("root cannot be empty")
+	}
+	if len(cs.nextValidatorsHash) != hashSize {
+		return errors.New("next validators hash is invalid")
+	}
+	if cs.timestamp <= 0 {
+		return errors.New("timestamp must be a positive Unix time")
+	}
+
+	return nil
+}
+
+// equal returns true if both consensus states are identical.
+func (cs *consensusState) equal(other *consensusState) bool {
+	return string(cs.encode()) == string(other.encode())
+}
@@ -0,0 +1,102 @@
+package wasmtesting
+
+import (
+	"context"
+	"encoding/hex"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+var _ types.WasmEngine = (*MockWasmEngine)(nil)
+
+// EntryPointFn is the signature of the entry points of a contract executed by the MockWasmEngine.
+type EntryPointFn func(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore) ([]byte, error)
+
+// MockWasmEngine is a WasmEngine which accepts any byte code and executes the configured entry
+// point functions instead of a contract. Entry points which are not configured return an empty result.
+type MockWasmEngine struct {
+	InstantiateFn EntryPointFn
+	QueryFn       EntryPointFn
+	SudoFn        EntryPointFn
+	MigrateFn     EntryPointFn
+
+	// GasUsed is the gas reported as consumed by every entry point call.
+	GasUsed uint64
+
+	// GasLimit is the gas limit of the last entry point call.
+	GasLimit uint64
+
+	codes map[string]bool
+}
+
+// NewMockWasmEngine creates a new MockWasmEngine with no configured entry points.
+func NewMockWasmEngine() *MockWasmEngine {
+	return &MockWasmEngine{
+		codes: make(map[string]bool),
+	}
+}
+
+// StoreCode implements types.WasmEngine.
+func (m *MockWasmEngine) StoreCode(code []byte) (types.Checksum, error) {
+	checksum, err := types.CreateChecksum(code)
+	if err != nil {
+		return nil, err
+	}
+
+	m.codes[hex.EncodeToString(checksum)] = true
+
+	return checksum, nil
+}
+
+// HasCode implements types.WasmEngine.
+func (m *MockWasmEngine) HasCode(checksum types.Checksum) bool {
+	return m.codes[hex.EncodeToString(checksum)]
+}
+
+// RemoveCode implements types.WasmEngine.
+func (m *MockWasmEngine) RemoveCode(checksum types.Checksum) error {
+	if !m.HasCode(checksum) {
+		return types.ErrWasmChecksumNotFound
+	}
+
+	delete(m.codes, hex.EncodeToString(checksum))
+
+	return nil
+}
+
+// Instantiate implements types.WasmEngine.
+func (m *MockWasmEngine) Instantiate(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return m.call(ctx, m.InstantiateFn, checksum, env, msg, store, gasLimit)
+}
+
+// Query implements types.WasmEngine.
+func (m *MockWasmEngine) Query(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return m.call(ctx, m.QueryFn, checksum, env, msg, store, gasLimit)
+}
+
+// Sudo implements types.WasmEngine.
+func (m *MockWasmEngine) Sudo(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return m.call(ctx, m.SudoFn, checksum, env, msg, store, gasLimit)
+}
+
+// Migrate implements types.WasmEngine.
+func (m *MockWasmEngine) Migrate(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return m.call(ctx, m.MigrateFn, checksum, env, msg, store, gasLimit)
+}
+
+func (m *MockWasmEngine) call(ctx context.Context, fn EntryPointFn, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	m.GasLimit = gasLimit
+
+	if !m.HasCode(checksum) {
+		return nil, 0, types.ErrWasmChecksumNotFound
+	}
+
+	if fn == nil {
+		return []byte("{}"), m.GasUsed, nil
+	}
+
+	res, err := fn(ctx, checksum, env, msg, store)
+	return res, m.GasUsed, err
+}
@@ -0,0 +1,13 @@
+// Package wasmtesting provides the light client contracts and the mock WasmEngine used
+// to test the 08-wasm light client.
+package wasmtesting
+
+import (
+	_ "embed"
+)
+
+// Code is the gzip compressed byte code of the ics07-tendermint light client contract, which
+// mirrors the 07-tendermint light client. It is built from the sources in contracts/ics07-tendermint.
+//
+//go:embed data/ics07_tendermint.wasm.gz
+var Code []byte
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*ClientMessage)(nil)
+
+// NewClientMessage creates a new 08-wasm ClientMessage instance wrapping a header or
+// misbehaviour of the light client contract.
+func NewClientMessage(data []byte) *ClientMessage {
+	return &ClientMessage{
+		Data: data,
+	}
+}
+
+// ClientType returns the 08-wasm client type.
+func (ClientMessage) ClientType() string {
+	return exported.Wasm
+}
+
+// ValidateBasic defines a basic validation for the 08-wasm client message.
+func (c ClientMessage) ValidateBasic() error {
+	if len(c.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new 08-wasm ClientState instance wrapping the client state
+// of the light client contract with the given checksum.
+func NewClientState(data []byte, checksum Checksum, latestHeight clienttypes.Height) *ClientState {
+	return &ClientState{
+		Data:         data,
+		Checksum:     checksum,
+		LatestHeight: latestHeight,
+	}
+}
+
+// ClientType returns the 08-wasm client type.
+func (ClientState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetLatestHeight returns the latest height of the client as reported by the contract.
+func (cs ClientState) GetLatestHeight() exported.Height {
+	return cs.LatestHeight
+}
+
+// Validate performs a basic validation of the client state fields. The contract
+// client state is opaque and validated by the contract upon instantiation.
+func (cs ClientState) Validate() error {
+	if len(cs.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	if err := ValidateWasmChecksum(cs.Checksum); err != nil {
+		return err
+	}
+
+	if cs.LatestHeight.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidHeight, "latest height cannot be zero")
+	}
+
+	return nil
+}
+
+// ZeroCustomFields returns the same client state since the client customizable fields are
+// encoded within the opaque contract client state.
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return &cs
+}
+
+// ExportMetadata exports every key of the client store other than the client state and
+// the consensus states, such as the metadata stored by the contract.
+func (ClientState) ExportMetadata(clientStore storetypes.KVStore) []exported.GenesisMetadata {
+	var gm []exported.GenesisMetadata
+
+	iterator := clientStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if bytes.Equal(key, host.ClientStateKey()) || isConsensusStateKey(key) || len(iterator.Value()) == 0 {
+			continue
+		}
+
+		gm = append(gm, clienttypes.NewGenesisMetadata(key, iterator.Value()))
+	}
+
+	return gm
+}
+
+// isConsensusStateKey returns true if the key is of the form consensusStates/{height}.
+func isConsensusStateKey(key []byte) bool {
+	suffix, found := strings.CutPrefix(string(key), host.KeyConsensusStatePrefix+"/")
+	return found && !strings.Contains(suffix, "/")
+}
+
+// The methods below are unsupported by the 08-wasm client state: the client logic is
+// implemented by the light client contract and reached through the LightClientModule.
+
+// Status returns Unknown, the status of an 08-wasm client is queried through the LightClientModule.
+func (ClientState) Status(_ sdk.Context, _ storetypes.KVStore, _ codec.BinaryCodec) exported.Status {
+	return exported.Unknown
+}
+
+// GetTimestampAtHeight is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) GetTimestampAtHeight(_ sdk.Context, _ storetypes.KVStore, _ codec.BinaryCodec, _ exported.Height) (uint64, error) {
+	return 0, ErrUnsupportedOperation
+}
+
+// Initialize is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) Initialize(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ConsensusState) error {
+	return ErrUnsupportedOperation
+}
+
+// VerifyMembership is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) VerifyMembership(_ sdk.Context, _ storetypes.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ []byte, _ exported.Path, _ []byte) error {
+	return ErrUnsupportedOperation
+}
+
+// VerifyNonMembership is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) VerifyNonMembership(_ sdk.Context, _ storetypes.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ []byte, _ exported.Path) error {
+	return ErrUnsupportedOperation
+}
+
+// VerifyClientMessage is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) VerifyClientMessage(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ClientMessage) error {
+	return ErrUnsupportedOperation
+}
+
+// CheckForMisbehaviour is unsupported by the 08-wasm client state and performs a no-op, returning false.
+func (ClientState) CheckForMisbehaviour(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ClientMessage) bool {
+	return false
+}
+
+// UpdateStateOnMisbehaviour is unsupported by the 08-wasm client state and performs a no-op.
+func (ClientState) UpdateStateOnMisbehaviour(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ClientMessage) {
+}
+
+// UpdateState is unsupported by the 08-wasm client state and panics.
+func (ClientState) UpdateState(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ClientMessage) []exported.Height {
+	panic(ErrUnsupportedOperation)
+}
+
+// CheckSubstituteAndUpdateState is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) CheckSubstituteAndUpdateState(_ sdk.Context, _ codec.BinaryCodec, _, _ storetypes.KVStore, _ exported.ClientState) error {
+	return ErrUnsupportedOperation
+}
+
+// VerifyUpgradeAndUpdateState is unsupported by the 08-wasm client state and returns an error.
+func (ClientState) VerifyUpgradeAndUpdateState(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ClientState, _ exported.ConsensusState, _, _ []byte) error {
+	return ErrUnsupportedOperation
+}
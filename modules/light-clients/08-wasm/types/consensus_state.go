@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new 08-wasm ConsensusState instance wrapping the consensus
+// state of the light client contract.
+func NewConsensusState(data []byte) *ConsensusState {
+	return &ConsensusState{
+		Data: data,
+	}
+}
+
+// ClientType returns the 08-wasm client type.
+func (ConsensusState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetTimestamp returns zero since the timestamp is encoded within the opaque contract
+// consensus state. The timestamp at a height is queried through the LightClientModule.
+func (ConsensusState) GetTimestamp() uint64 {
+	return 0
+}
+
+// ValidateBasic defines a basic validation for the 08-wasm consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if len(cs.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	return nil
+}
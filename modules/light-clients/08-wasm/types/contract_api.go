@@ -0,0 +1,143 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+)
+
+// The types in this file define the json payloads exchanged with light client contracts.
+// Exactly one field of a QueryMsg or SudoMsg must be set for every call.
+
+// Env is the environment of the host chain passed to the contract with every call.
+type Env struct {
+	Block BlockInfo `json:"block"`
+}
+
+// BlockInfo describes the current block of the host chain.
+type BlockInfo struct {
+	// Height is the height of the current block.
+	Height uint64 `json:"height"`
+	// Time is the block time in nanoseconds since the unix epoch.
+	Time uint64 `json:"time"`
+	// ChainID is the chain identifier of the host chain.
+	ChainID string `json:"chain_id"`
+}
+
+// InstantiateMessage is the message passed to the contract when a client is created.
+type InstantiateMessage struct {
+	ClientState    []byte `json:"client_state"`
+	ConsensusState []byte `json:"consensus_state"`
+	Checksum       []byte `json:"checksum"`
+}
+
+// QueryMsg is the message passed to the read-only query entry point of the contract.
+type QueryMsg struct {
+	Status               *StatusMsg               `json:"status,omitempty"`
+	TimestampAtHeight    *TimestampAtHeightMsg    `json:"timestamp_at_height,omitempty"`
+	VerifyClientMessage  *VerifyClientMessageMsg  `json:"verify_client_message,omitempty"`
+	CheckForMisbehaviour *CheckForMisbehaviourMsg `json:"check_for_misbehaviour,omitempty"`
+}
+
+// StatusMsg is a query for the status of the client.
+type StatusMsg struct{}
+
+// TimestampAtHeightMsg is a query for the timestamp of the consensus state at the given height.
+type TimestampAtHeightMsg struct {
+	Height clienttypes.Height `json:"height"`
+}
+
+// VerifyClientMessageMsg is a query verifying the given client message.
+type VerifyClientMessageMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// CheckForMisbehaviourMsg is a query checking the given client message for misbehaviour.
+type CheckForMisbehaviourMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// SudoMsg is the message passed to the sudo entry point of the contract, which may modify the client store.
+type SudoMsg struct {
+	UpdateState                 *UpdateStateMsg                 `json:"update_state,omitempty"`
+	UpdateStateOnMisbehaviour   *UpdateStateOnMisbehaviourMsg   `json:"update_state_on_misbehaviour,omitempty"`
+	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
+	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
+	VerifyUpgradeAndUpdateState *VerifyUpgradeAndUpdateStateMsg `json:"verify_upgrade_and_update_state,omitempty"`
+	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
+}
+
+// UpdateStateMsg updates the client state and consensus states with the given client message.
+type UpdateStateMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// UpdateStateOnMisbehaviourMsg freezes the client upon misbehaviour.
+type UpdateStateOnMisbehaviourMsg struct {
+	ClientMessage []byte `json:"client_message"`
+}
+
+// VerifyMembershipMsg verifies the existence of a value at the given merkle path.
+type VerifyMembershipMsg struct {
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	MerklePath       commitmenttypes.MerklePath `json:"merkle_path"`
+	Value            []byte                     `json:"value"`
+}
+
+// VerifyNonMembershipMsg verifies the absence of a value at the given merkle path.
+type VerifyNonMembershipMsg struct {
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	MerklePath       commitmenttypes.MerklePath `json:"merkle_path"`
+}
+
+// VerifyUpgradeAndUpdateStateMsg verifies the upgraded client and consensus states and updates the client.
+type VerifyUpgradeAndUpdateStateMsg struct {
+	UpgradeClientState         []byte `json:"upgrade_client_state"`
+	UpgradeConsensusState      []byte `json:"upgrade_consensus_state"`
+	ProofUpgradeClient         []byte `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte `json:"proof_upgrade_consensus_state"`
+}
+
+// MigrateClientStoreMsg recovers the subject client with the substitute client. The subject
+// and substitute client stores are exposed to the contract under the "subject/" and "substitute/"
+// key prefixes.
+type MigrateClientStoreMsg struct{}
+
+// ContractResult is implemented by the results returned by the contract.
+type ContractResult interface {
+	contractResult()
+}
+
+// EmptyResult is the result of the calls which do not return any data.
+type EmptyResult struct{}
+
+// StatusResult is the result of a StatusMsg query.
+type StatusResult struct {
+	Status string `json:"status"`
+}
+
+// TimestampAtHeightResult is the result of a TimestampAtHeightMsg query.
+type TimestampAtHeightResult struct {
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// CheckForMisbehaviourResult is the result of a CheckForMisbehaviourMsg query.
+type CheckForMisbehaviourResult struct {
+	FoundMisbehaviour bool `json:"found_misbehaviour"`
+}
+
+// UpdateStateResult is the result of an UpdateStateMsg call.
+type UpdateStateResult struct {
+	Heights []clienttypes.Height `json:"heights"`
+}
+
+func (EmptyResult) contractResult()                {}
+func (StatusResult) contractResult()               {}
+func (TimestampAtHeightResult) contractResult()    {}
+func (CheckForMisbehaviourResult) contractResult() {}
+func (UpdateStateResult) contractResult()          {}
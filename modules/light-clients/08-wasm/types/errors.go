@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IBC wasm client sentinel errors
+var (
+	ErrInvalidData                     = errorsmod.Register(ModuleName, 2, "invalid data")
+	ErrInvalidChecksum                 = errorsmod.Register(ModuleName, 3, "invalid checksum")
+	ErrInvalidClientMessage            = errorsmod.Register(ModuleName, 4, "invalid client message")
+	ErrRetrieveClientID                = errorsmod.Register(ModuleName, 5, "failed to retrieve client id")
+	ErrUnsupportedOperation            = errorsmod.Register(ModuleName, 6, "operation not supported by the wasm client state, use the wasm light client module")
+	ErrWasmEmptyCode                   = errorsmod.Register(ModuleName, 7, "empty wasm code")
+	ErrWasmCodeTooLarge                = errorsmod.Register(ModuleName, 8, "wasm code too large")
+	ErrWasmCodeExists                  = errorsmod.Register(ModuleName, 9, "wasm code already exists")
+	ErrWasmChecksumNotFound            = errorsmod.Register(ModuleName, 10, "wasm checksum not found")
+	ErrWasmInvalidCode                 = errorsmod.Register(ModuleName, 11, "invalid wasm code")
+	ErrWasmContractCallFailed          = errorsmod.Register(ModuleName, 12, "wasm contract call failed")
+	ErrWasmInvalidResponseData         = errorsmod.Register(ModuleName, 13, "wasm contract returned invalid response data")
+	ErrWasmInvalidContractModification = errorsmod.Register(ModuleName, 14, "wasm contract made invalid state modifications")
+	ErrVMError                         = errorsmod.Register(ModuleName, 15, "wasm VM error")
+	ErrWasmOutOfGas                    = errorsmod.Register(ModuleName, 16, "wasm contract ran out of gas")
+)
@@ -0,0 +1,14 @@
+package types
+
+// 08-wasm events
+const (
+	EventTypeStoreWasmCode   = "store_wasm_code"
+	EventTypeRemoveChecksum  = "remove_checksum"
+	EventTypeMigrateContract = "migrate_contract"
+
+	AttributeKeyChecksum    = "checksum"
+	AttributeKeyClientID    = "client_id"
+	AttributeKeyNewChecksum = "new_checksum"
+
+	AttributeValueCategory = ModuleName
+)
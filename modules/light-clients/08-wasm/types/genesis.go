@@ -0,0 +1,22 @@
+package types
+
+// NewGenesisState creates an 08-wasm GenesisState instance.
+func NewGenesisState(contracts []Contract) *GenesisState {
+	return &GenesisState{Contracts: contracts}
+}
+
+// DefaultGenesisState returns the default 08-wasm GenesisState, which holds no contracts.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]Contract{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	for _, contract := range gs.Contracts {
+		if err := ValidateWasmCode(contract.CodeBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
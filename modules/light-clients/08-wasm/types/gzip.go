@@ -0,0 +1,42 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// gzipIdent is the magic number prefixing gzip compressed data (RFC 1952), followed by the deflate method.
+var gzipIdent = []byte("\x1F\x8B\x08")
+
+// IsGzip returns true if the given bytes are gzip compressed.
+func IsGzip(input []byte) bool {
+	return bytes.HasPrefix(input, gzipIdent)
+}
+
+// Uncompress decompresses the gzip compressed input, failing if the decompressed
+// data exceeds the given limit in bytes. Uncompressed input is returned as is.
+func Uncompress(input []byte, limit int64) ([]byte, error) {
+	if !IsGzip(input) {
+		return input, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(input))
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrWasmInvalidCode, err.Error())
+	}
+	zr.Multistream(false)
+	defer zr.Close()
+
+	output, err := io.ReadAll(io.LimitReader(zr, limit+1))
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrWasmInvalidCode, err.Error())
+	}
+	if int64(len(output)) > limit {
+		return nil, errorsmod.Wrapf(ErrWasmCodeTooLarge, "uncompressed code exceeds %d bytes", limit)
+	}
+
+	return output, nil
+}
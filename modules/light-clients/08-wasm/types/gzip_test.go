@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+func compress(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func TestUncompress(t *testing.T) {
+	code := []byte("\x00asm\x01\x00\x00\x00")
+
+	testCases := []struct {
+		name      string
+		input     []byte
+		limit     int64
+		expOutput []byte
+		expError  error
+	}{
+		{
+			"success: uncompressed input",
+			code,
+			types.MaxWasmSize,
+			code,
+			nil,
+		},
+		{
+			"success: compressed input",
+			compress(t, code),
+			types.MaxWasmSize,
+			code,
+			nil,
+		},
+		{
+			"success: uncompressed size equal to the limit",
+			compress(t, code),
+			int64(len(code)),
+			code,
+			nil,
+		},
+		{
+			"failure: uncompressed size exceeds the limit",
+			compress(t, code),
+			int64(len(code) - 1),
+			nil,
+			types.ErrWasmCodeTooLarge,
+		},
+		{
+			"failure: invalid gzip data",
+			[]byte("\x1F\x8B\x08invalid"),
+			types.MaxWasmSize,
+			nil,
+			types.ErrWasmInvalidCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		output, err := types.Uncompress(tc.input, tc.limit)
+
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expOutput, output, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName for the wasm client
+	ModuleName = "08-wasm"
+
+	// StoreKey is the store key string for 08-wasm
+	StoreKey = ModuleName
+
+	// KeyCodePrefix is the key prefix under which the light client contract byte codes are stored
+	KeyCodePrefix = "code"
+)
+
+// CodeKey returns the store key under which the light client contract byte code with the
+// given checksum is stored.
+func CodeKey(checksum Checksum) []byte {
+	return append([]byte(KeyCodePrefix+"/"), checksum...)
+}
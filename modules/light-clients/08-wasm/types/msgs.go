@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgStoreCode)(nil)
+	_ sdk.Msg = (*MsgRemoveChecksum)(nil)
+	_ sdk.Msg = (*MsgMigrateContract)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgStoreCode)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateContract)(nil)
+)
+
+// NewMsgStoreCode creates a new MsgStoreCode instance
+func NewMsgStoreCode(signer string, code []byte) *MsgStoreCode {
+	return &MsgStoreCode{
+		Signer:       signer,
+		WasmByteCode: code,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg *MsgStoreCode) ValidateBasic() error {
+	if err := ValidateWasmCode(msg.WasmByteCode); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgRemoveChecksum creates a new MsgRemoveChecksum instance
+func NewMsgRemoveChecksum(signer string, checksum Checksum) *MsgRemoveChecksum {
+	return &MsgRemoveChecksum{
+		Signer:   signer,
+		Checksum: checksum,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg *MsgRemoveChecksum) ValidateBasic() error {
+	if err := ValidateWasmChecksum(msg.Checksum); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgMigrateContract creates a new MsgMigrateContract instance
+func NewMsgMigrateContract(signer, clientID string, checksum Checksum, migrateMsg []byte) *MsgMigrateContract {
+	return &MsgMigrateContract{
+		Signer:   signer,
+		ClientId: clientID,
+		Checksum: checksum,
+		Msg:      migrateMsg,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (msg *MsgMigrateContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := ValidateWasmChecksum(msg.Checksum); err != nil {
+		return err
+	}
+
+	if !json.Valid(msg.Msg) {
+		return errorsmod.Wrap(ErrInvalidData, "migrate message must be valid json")
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var checksum = func() types.Checksum {
+	hash := sha256.Sum256([]byte("code"))
+	return hash[:]
+}()
+
+func TestMsgStoreCodeValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgStoreCode
+		expError error
+	}{
+		{
+			"success",
+			types.NewMsgStoreCode(ibctesting.TestAccAddress, []byte("code")),
+			nil,
+		},
+		{
+			"failure: empty code",
+			types.NewMsgStoreCode(ibctesting.TestAccAddress, nil),
+			types.ErrWasmEmptyCode,
+		},
+		{
+			"failure: code too large",
+			types.NewMsgStoreCode(ibctesting.TestAccAddress, make([]byte, types.MaxWasmSize+1)),
+			types.ErrWasmCodeTooLarge,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgStoreCode(ibctesting.InvalidID, []byte("code")),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveChecksumValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRemoveChecksum
+		expError error
+	}{
+		{
+			"success",
+			types.NewMsgRemoveChecksum(ibctesting.TestAccAddress, checksum),
+			nil,
+		},
+		{
+			"failure: invalid checksum",
+			types.NewMsgRemoveChecksum(ibctesting.TestAccAddress, []byte("checksum")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgRemoveChecksum(ibctesting.InvalidID, checksum),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
+
+func TestMsgMigrateContractValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgMigrateContract
+		expError error
+	}{
+		{
+			"success",
+			types.NewMsgMigrateContract(ibctesting.TestAccAddress, "08-wasm-0", checksum, []byte("{}")),
+			nil,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgMigrateContract(ibctesting.InvalidID, "08-wasm-0", checksum, []byte("{}")),
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid checksum",
+			types.NewMsgMigrateContract(ibctesting.TestAccAddress, "08-wasm-0", []byte("checksum"), []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: migrate message is not valid json",
+			types.NewMsgMigrateContract(ibctesting.TestAccAddress, "08-wasm-0", checksum, []byte("invalid")),
+			types.ErrInvalidData,
+		},
+		{
+			"failure: invalid client identifier",
+			types.NewMsgMigrateContract(ibctesting.TestAccAddress, "", checksum, []byte("{}")),
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
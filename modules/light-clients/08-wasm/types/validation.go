@@ -0,0 +1,51 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxWasmSize is the maximum size in bytes of an uncompressed light client contract byte code.
+// Contracts built with the standard Go toolchain carry the Go runtime, so the limit is larger
+// than the one commonly used for Rust contracts.
+const MaxWasmSize = 10 * 1024 * 1024
+
+// MaxQueryGas is the maximum gas limit of a call to the query entry point of a light client contract.
+// Queries may be executed with an infinite gas meter, such as by the client status and timestamp gRPC
+// queries, whose gas remaining would otherwise let a contract run unbounded.
+const MaxQueryGas = 3_000_000
+
+// Checksum is the sha256 hash of a light client contract byte code.
+type Checksum = []byte
+
+// CreateChecksum creates a sha256 checksum from the given wasm byte code.
+func CreateChecksum(code []byte) (Checksum, error) {
+	if len(code) == 0 {
+		return nil, ErrWasmEmptyCode
+	}
+
+	hash := sha256.Sum256(code)
+	return hash[:], nil
+}
+
+// ValidateWasmCode performs stateless validation of the wasm byte code.
+func ValidateWasmCode(code []byte) error {
+	if len(code) == 0 {
+		return ErrWasmEmptyCode
+	}
+	if len(code) > MaxWasmSize {
+		return errorsmod.Wrapf(ErrWasmCodeTooLarge, "expected at most %d bytes, got %d", MaxWasmSize, len(code))
+	}
+
+	return nil
+}
+
+// ValidateWasmChecksum performs stateless validation of a checksum.
+func ValidateWasmChecksum(checksum Checksum) error {
+	if len(checksum) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidChecksum, "expected %d bytes, got %d", sha256.Size, len(checksum))
+	}
+
+	return nil
+}
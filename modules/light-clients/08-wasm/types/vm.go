@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// WasmEngine defines the virtual machine executing light client contracts. Every entry point
+// is executed against the store of the client the contract is called for with a gas limit, and
+// returns the json encoded result of the contract or an error if the contract failed, along with
+// the gas consumed by the execution of the contract.
+//
+// Implementations must execute and meter contracts deterministically. An execution exceeding the
+// gas limit must be stopped and return ErrWasmOutOfGas. Panics raised by the store, such as out of
+// gas panics, must be propagated to the caller.
+type WasmEngine interface {
+	// StoreCode compiles the given wasm byte code, keeps the compiled code in the engine cache
+	// and returns its checksum.
+	StoreCode(code []byte) (Checksum, error)
+
+	// HasCode returns true if the code with the given checksum is compiled and cached by the engine.
+	HasCode(checksum Checksum) bool
+
+	// RemoveCode removes the compiled code with the given checksum from the engine cache.
+	RemoveCode(checksum Checksum) error
+
+	// Instantiate calls the instantiate entry point of the contract.
+	Instantiate(ctx context.Context, checksum Checksum, env Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+
+	// Query calls the query entry point of the contract. The contract must not modify the store.
+	Query(ctx context.Context, checksum Checksum, env Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+
+	// Sudo calls the sudo entry point of the contract.
+	Sudo(ctx context.Context, checksum Checksum, env Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+
+	// Migrate calls the migrate entry point of the contract with the given checksum.
+	Migrate(ctx context.Context, checksum Checksum, env Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error)
+}
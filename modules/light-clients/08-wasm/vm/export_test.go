@@ -0,0 +1,9 @@
+package vm
+
+// GasGlobalExport is the name under which instrumented contracts export their gas global.
+const GasGlobalExport = gasGlobalExport
+
+// Instrument is a wrapper around instrument to allow the function to be directly called in tests.
+func Instrument(code []byte) ([]byte, error) {
+	return instrument(code)
+}
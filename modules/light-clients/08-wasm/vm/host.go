@@ -0,0 +1,100 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tetratelabs/wazero/api"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// callStateKey is the context key of the callState of a contract call.
+type callStateKey struct{}
+
+// callState holds the client store accessed by a contract call and the value of any panic
+// raised by the store while the contract was executing.
+type callState struct {
+	store    storetypes.KVStore
+	panicked any
+}
+
+// do executes the store operation, recording any panic before it unwinds the contract execution.
+func (s *callState) do(op func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.panicked = r
+			panic(r)
+		}
+	}()
+
+	op()
+}
+
+// abort returns the error of a failed contract execution. Errors raised by the store are returned
+// as is, while any other panic value, such as an out of gas panic, is propagated to the caller.
+func (s *callState) abort(err error) error {
+	if s.panicked == nil {
+		return err
+	}
+
+	if panicErr, ok := s.panicked.(error); ok {
+		return panicErr
+	}
+
+	panic(s.panicked)
+}
+
+func getCallState(ctx context.Context) *callState {
+	state, ok := ctx.Value(callStateKey{}).(*callState)
+	if !ok {
+		panic(errorsmod.Wrap(types.ErrVMError, "host function called outside of a contract call"))
+	}
+
+	return state
+}
+
+// readMemory returns a copy of the given range of the contract memory.
+func readMemory(m api.Module, ptr, length uint32) []byte {
+	bz, ok := m.Memory().Read(ptr, length)
+	if !ok {
+		panic(fmt.Errorf("memory range [%d, %d) out of bounds", ptr, uint64(ptr)+uint64(length)))
+	}
+
+	return append([]byte{}, bz...)
+}
+
+func dbRead(ctx context.Context, m api.Module, keyPtr, keyLen, valuePtr, valueCap uint32) int64 {
+	state := getCallState(ctx)
+	key := readMemory(m, keyPtr, keyLen)
+
+	var value []byte
+	state.do(func() { value = state.store.Get(key) })
+	if value == nil {
+		return -1
+	}
+
+	if uint64(len(value)) <= uint64(valueCap) && !m.Memory().Write(valuePtr, value) {
+		panic(fmt.Errorf("memory range [%d, %d) out of bounds", valuePtr, uint64(valuePtr)+uint64(len(value))))
+	}
+
+	return int64(len(value))
+}
+
+func dbWrite(ctx context.Context, m api.Module, keyPtr, keyLen, valuePtr, valueLen uint32) {
+	state := getCallState(ctx)
+	key := readMemory(m, keyPtr, keyLen)
+	value := readMemory(m, valuePtr, valueLen)
+
+	state.do(func() { state.store.Set(key, value) })
+}
+
+func dbRemove(ctx context.Context, m api.Module, keyPtr, keyLen uint32) {
+	state := getCallState(ctx)
+	key := readMemory(m, keyPtr, keyLen)
+
+	state.do(func() { state.store.Delete(key) })
+}
@@ -0,0 +1,793 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The contracts are metered by instrumenting their byte code before it is compiled. A mutable i64 global,
+// exported as gasGlobalExport, holds the gas remaining for the execution. Every function body is split into
+// sequences of instructions ending with a control instruction, and each sequence is preceded by code which
+// subtracts its cost from the global and traps once the global is negative. The bulk memory instructions,
+// whose execution time depends on their length operand, are additionally charged for their length as
+// given by bulkMemoryGasShift. The gas consumed by an execution is therefore determined by the instructions
+// executed only.
+//
+// The bit pattern of a NaN produced by floating point arithmetic is not fully specified by the wasm
+// specification and differs between platforms, so it could leak into the state written by a contract. The
+// result of every floating point instruction which may produce such a NaN is therefore replaced with the
+// canonical NaN of its type if it is a NaN. The other floating point instructions only move, compare or
+// flip the sign of their operands and are deterministic.
+
+const (
+	// gasGlobalExport is the name under which instrumented contracts export their gas global.
+	gasGlobalExport = "__ibc_wasm_gas_remaining"
+
+	// instructionGasCost is the gas cost of every instruction executed by a contract.
+	instructionGasCost = 1
+
+	// bulkMemoryGasShift is the base 2 logarithm of the number of bytes copied, filled or initialized by
+	// a bulk memory instruction for each unit of gas charged on top of its instruction cost.
+	bulkMemoryGasShift = 6
+)
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionCode     = 10
+
+	importKindGlobal = 0x03
+	exportKindGlobal = 0x03
+
+	valTypeI32     = 0x7F
+	valTypeI64     = 0x7E
+	valTypeF32     = 0x7D
+	valTypeF64     = 0x7C
+	valTypeFuncRef = 0x70
+	valTypeExtRef  = 0x6F
+	blockTypeEmpty = 0x40
+
+	opUnreachable    = 0x00
+	opBlock          = 0x02
+	opLoop           = 0x03
+	opIf             = 0x04
+	opElse           = 0x05
+	opEnd            = 0x0B
+	opBr             = 0x0C
+	opBrIf           = 0x0D
+	opBrTable        = 0x0E
+	opReturn         = 0x0F
+	opCall           = 0x10
+	opCallIndirect   = 0x11
+	opSelect         = 0x1B
+	opSelectTyped    = 0x1C
+	opLocalGet       = 0x20
+	opLocalTee       = 0x22
+	opGlobalGet      = 0x23
+	opGlobalSet      = 0x24
+	opTableGet       = 0x25
+	opTableSet       = 0x26
+	opI64Const       = 0x42
+	opF32Const       = 0x43
+	opF64Const       = 0x44
+	opI64LtS         = 0x53
+	opF32Eq          = 0x5B
+	opF64Eq          = 0x61
+	opI64Sub         = 0x7D
+	opI64ShrU        = 0x88
+	opI64ExtendI32U  = 0xAD
+	opRefNull        = 0xD0
+	opRefFunc        = 0xD2
+	opPrefixMisc     = 0xFC
+	opMiscMemoryInit = 8
+	opMiscMemoryCopy = 10
+	opMiscMemoryFill = 11
+)
+
+// sectionOrder is the position of each known non-custom section within a module.
+var sectionOrder = map[byte]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 6: 6, 7: 7, 8: 8, 9: 9, 12: 10, 10: 11, 11: 12}
+
+var errUnexpectedEnd = errors.New("unexpected end of wasm byte code")
+
+// section is a section of a wasm module.
+type section struct {
+	id      byte
+	content []byte
+}
+
+// instrument returns the given wasm byte code instrumented to meter its execution.
+func instrument(code []byte) ([]byte, error) {
+	sections, err := readSections(code)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		paramCounts   []int
+		funcTypes     []uint32
+		globalImports uint32
+		globalCount   uint32
+	)
+	for _, s := range sections {
+		switch s.id {
+		case sectionType:
+			if paramCounts, err = readParamCounts(s.content); err != nil {
+				return nil, err
+			}
+		case sectionImport:
+			if globalImports, err = countGlobalImports(s.content); err != nil {
+				return nil, err
+			}
+		case sectionFunction:
+			if funcTypes, err = readU32Vec(s.content); err != nil {
+				return nil, err
+			}
+		case sectionGlobal:
+			if globalCount, err = newReader(s.content).u32(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the gas global is appended to the globals defined by the module, so no global index is shifted
+	gasGlobal := globalImports + globalCount
+
+	// mutable i64 initialized to zero, the gas remaining is set by the host before every execution
+	globalEntry := []byte{valTypeI64, 0x01, opI64Const, 0x00, opEnd}
+	exportEntry := append(appendName(nil, gasGlobalExport), exportKindGlobal)
+	exportEntry = binary.AppendUvarint(exportEntry, uint64(gasGlobal))
+
+	if sections, err = appendToVecSection(sections, sectionGlobal, globalEntry, nil); err != nil {
+		return nil, err
+	}
+
+	if sections, err = appendToVecSection(sections, sectionExport, exportEntry, checkExportName); err != nil {
+		return nil, err
+	}
+
+	for i, s := range sections {
+		if s.id != sectionCode {
+			continue
+		}
+
+		content, err := instrumentCode(s.content, gasGlobal, func(funcIndex int) (int, error) {
+			if funcIndex >= len(funcTypes) || int(funcTypes[funcIndex]) >= len(paramCounts) {
+				return 0, fmt.Errorf("no type for function %d", funcIndex)
+			}
+			return paramCounts[funcTypes[funcIndex]], nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sections[i].content = content
+	}
+
+	out := append([]byte(nil), code[:8]...)
+	for _, s := range sections {
+		out = append(out, s.id)
+		out = binary.AppendUvarint(out, uint64(len(s.content)))
+		out = append(out, s.content...)
+	}
+
+	return out, nil
+}
+
+// readSections splits the wasm byte code into its sections.
+func readSections(code []byte) ([]section, error) {
+	if len(code) < 8 || !bytes.Equal(code[:4], []byte("\x00asm")) {
+		return nil, errors.New("missing wasm magic number")
+	}
+
+	r := newReader(code[8:])
+
+	var sections []section
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, known := sectionOrder[id]; !known && id != sectionCustom {
+			return nil, fmt.Errorf("unknown section %d", id)
+		}
+
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, section{id: id, content: content})
+	}
+
+	return sections, nil
+}
+
+// appendToVecSection appends the entry to the vector held by the section with the given id, creating the
+// section at its position within the module if it does not exist. The optional check is called with every
+// existing entry of the section.
+func appendToVecSection(sections []section, id byte, entry []byte, check func(r *reader) error) ([]section, error) {
+	for i, s := range sections {
+		if s.id != id {
+			continue
+		}
+
+		r := newReader(s.content)
+		count, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		entries := r.remaining()
+		if check != nil {
+			for j := uint32(0); j < count; j++ {
+				if err := check(r); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		content := binary.AppendUvarint(nil, uint64(count)+1)
+		content = append(content, entries...)
+		sections[i].content = append(content, entry...)
+
+		return sections, nil
+	}
+
+	// the new section is inserted before the first section which must follow it
+	pos := len(sections)
+	for i, s := range sections {
+		if s.id != sectionCustom && sectionOrder[s.id] > sectionOrder[id] {
+			pos = i
+			break
+		}
+	}
+
+	newSection := section{id: id, content: append([]byte{0x01}, entry...)}
+	return append(sections[:pos], append([]section{newSection}, sections[pos:]...)...), nil
+}
+
+// checkExportName reads an export entry and returns an error if it uses the name of the gas global export.
+func checkExportName(r *reader) error {
+	name, err := r.name()
+	if err != nil {
+		return err
+	}
+
+	if name == gasGlobalExport {
+		return fmt.Errorf("reserved export name %s", gasGlobalExport)
+	}
+
+	if _, err := r.byte(); err != nil {
+		return err
+	}
+
+	_, err = r.u32()
+	return err
+}
+
+// readParamCounts returns the number of parameters of every function type of the type section.
+func readParamCounts(content []byte) ([]int, error) {
+	r := newReader(content)
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	paramCounts := make([]int, 0, count)
+	for i := uint32(0); i < count; i++ {
+		if form, err := r.byte(); err != nil || form != 0x60 {
+			return nil, fmt.Errorf("invalid function type %d", i)
+		}
+
+		params, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := r.bytes(int(params)); err != nil {
+			return nil, err
+		}
+
+		results, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := r.bytes(int(results)); err != nil {
+			return nil, err
+		}
+
+		paramCounts = append(paramCounts, int(params))
+	}
+
+	return paramCounts, nil
+}
+
+// countGlobalImports returns the number of globals imported by the import section.
+func countGlobalImports(content []byte) (uint32, error) {
+	r := newReader(content)
+	count, err := r.u32()
+	if err != nil {
+		return 0, err
+	}
+
+	var globals uint32
+	for i := uint32(0); i < count; i++ {
+		if _, err := r.name(); err != nil {
+			return 0, err
+		}
+		if _, err := r.name(); err != nil {
+			return 0, err
+		}
+
+		kind, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		switch kind {
+		case 0x00: // function: type index
+			_, err = r.u32()
+		case 0x01: // table: reference type and limits
+			if _, err = r.byte(); err == nil {
+				err = r.skipLimits()
+			}
+		case 0x02: // memory: limits
+			err = r.skipLimits()
+		case importKindGlobal: // global: value type and mutability
+			_, err = r.bytes(2)
+			globals++
+		default:
+			err = fmt.Errorf("unknown import kind %d", kind)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return globals, nil
+}
+
+// readU32Vec reads a vector of u32 values.
+func readU32Vec(content []byte) ([]uint32, error) {
+	r := newReader(content)
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]uint32, 0, count)
+	for i := uint32(0); i < count; i++ {
+		value, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// instrumentCode instruments every function body of the code section.
+func instrumentCode(content []byte, gasGlobal uint32, paramCount func(funcIndex int) (int, error)) ([]byte, error) {
+	r := newReader(content)
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	out := binary.AppendUvarint(nil, uint64(count))
+	for i := 0; i < int(count); i++ {
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+
+		params, err := paramCount(i)
+		if err != nil {
+			return nil, err
+		}
+
+		instrumented, err := instrumentBody(body, gasGlobal, params)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", i, err)
+		}
+
+		out = binary.AppendUvarint(out, uint64(len(instrumented)))
+		out = append(out, instrumented...)
+	}
+
+	return out, nil
+}
+
+// instrumentBody instruments a function body with the given number of parameters. The body is split into
+// sequences of instructions ending with a control instruction, each preceded by the charge of its cost.
+func instrumentBody(body []byte, gasGlobal uint32, params int) ([]byte, error) {
+	r := newReader(body)
+
+	localDecls, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+
+	declsStart := len(body) - len(r.remaining())
+	locals := params
+	for i := uint32(0); i < localDecls; i++ {
+		n, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := r.byte(); err != nil {
+			return nil, err
+		}
+
+		locals += int(n)
+	}
+
+	// a scratch i32 local holding the length operand of the bulk memory instructions and scratch f32 and f64
+	// locals holding the results to canonicalize are declared after the existing locals
+	scratchLocal := uint32(locals)
+	scratchFloatLocals := map[byte]uint32{valTypeF32: scratchLocal + 1, valTypeF64: scratchLocal + 2}
+	declsEnd := len(body) - len(r.remaining())
+
+	var (
+		out     []byte
+		seq     []byte
+		seqCost int64
+	)
+	for !r.done() {
+		start := len(body) - len(r.remaining())
+		op, misc, control, err := r.instruction()
+		if err != nil {
+			return nil, err
+		}
+
+		if op == opPrefixMisc && (misc == opMiscMemoryInit || misc == opMiscMemoryCopy || misc == opMiscMemoryFill) {
+			seq = appendBulkMemoryCharge(seq, gasGlobal, scratchLocal)
+		}
+
+		seq = append(seq, body[start:len(body)-len(r.remaining())]...)
+		seqCost += instructionGasCost
+
+		if valType, ok := nanResultType(op); ok {
+			seq = appendCanonicalizeNaN(seq, valType, scratchFloatLocals[valType])
+		}
+
+		if control {
+			out = appendCharge(out, gasGlobal, seqCost)
+			out = append(out, seq...)
+			seq, seqCost = seq[:0], 0
+		}
+	}
+
+	if len(seq) != 0 {
+		return nil, errors.New("function body does not end with a control instruction")
+	}
+
+	decls := binary.AppendUvarint(nil, uint64(localDecls)+3)
+	decls = append(decls, body[declsStart:declsEnd]...)
+	decls = append(decls, 0x01, valTypeI32, 0x01, valTypeF32, 0x01, valTypeF64)
+
+	return append(decls, out...), nil
+}
+
+// nanResultType returns the type of the result of the floating point instruction if it may produce a NaN
+// whose bit pattern is not deterministic: the rounding, square root, arithmetic, minimum, maximum, demotion
+// and promotion instructions.
+func nanResultType(op byte) (byte, bool) {
+	switch {
+	case (op >= 0x8D && op <= 0x97) || op == 0xB6:
+		return valTypeF32, true
+	case (op >= 0x9B && op <= 0xA5) || op == 0xBB:
+		return valTypeF64, true
+	default:
+		return 0, false
+	}
+}
+
+// appendCanonicalizeNaN appends the code replacing the floating point value on top of the stack with the
+// canonical NaN of its type if it is a NaN, using the scratch local of its type.
+func appendCanonicalizeNaN(out []byte, valType byte, scratchLocal uint32) []byte {
+	out = append(out, opLocalTee)
+	out = binary.AppendUvarint(out, uint64(scratchLocal))
+
+	eq := byte(opF64Eq)
+	if valType == valTypeF32 {
+		out = append(out, opF32Const, 0x00, 0x00, 0xC0, 0x7F)
+		eq = opF32Eq
+	} else {
+		out = append(out, opF64Const, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xF8, 0x7F)
+	}
+
+	// a NaN is the only value which is not equal to itself
+	out = append(out, opLocalGet)
+	out = binary.AppendUvarint(out, uint64(scratchLocal))
+	out = append(out, opLocalGet)
+	out = binary.AppendUvarint(out, uint64(scratchLocal))
+	return append(out, eq, opSelect)
+}
+
+// appendCharge appends the code subtracting the cost from the gas global and trapping if the gas is exhausted.
+func appendCharge(out []byte, gasGlobal uint32, cost int64) []byte {
+	out = append(out, opGlobalGet)
+	out = binary.AppendUvarint(out, uint64(gasGlobal))
+	out = append(out, opI64Const)
+	out = appendSLEB(out, cost)
+	out = append(out, opI64Sub, opGlobalSet)
+	out = binary.AppendUvarint(out, uint64(gasGlobal))
+
+	return appendTrapIfExhausted(out, gasGlobal)
+}
+
+// appendBulkMemoryCharge appends the code charging a bulk memory instruction for its length operand, which
+// is on top of the stack. The stack is left unchanged.
+func appendBulkMemoryCharge(out []byte, gasGlobal, scratchLocal uint32) []byte {
+	out = append(out, opLocalTee)
+	out = binary.AppendUvarint(out, uint64(scratchLocal))
+	out = append(out, opGlobalGet)
+	out = binary.AppendUvarint(out, uint64(gasGlobal))
+	out = append(out, opLocalGet)
+	out = binary.AppendUvarint(out, uint64(scratchLocal))
+	out = append(out, opI64ExtendI32U, opI64Const, bulkMemoryGasShift, opI64ShrU, opI64Sub, opGlobalSet)
+	out = binary.AppendUvarint(out, uint64(gasGlobal))
+
+	return appendTrapIfExhausted(out, gasGlobal)
+}
+
+// appendTrapIfExhausted appends the code trapping if the gas global is negative.
+func appendTrapIfExhausted(out []byte, gasGlobal uint32) []byte {
+	out = append(out, opGlobalGet)
+	out = binary.AppendUvarint(out, uint64(gasGlobal))
+	return append(out, opI64Const, 0x00, opI64LtS, opIf, blockTypeEmpty, opUnreachable, opEnd)
+}
+
+// appendSLEB appends the signed LEB128 encoding of the value.
+func appendSLEB(out []byte, value int64) []byte {
+	for {
+		b := byte(value & 0x7F)
+		value >>= 7
+		if (value == 0 && b&0x40 == 0) || (value == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+// appendName appends the wasm encoding of the name.
+func appendName(out []byte, name string) []byte {
+	out = binary.AppendUvarint(out, uint64(len(name)))
+	return append(out, name...)
+}
+
+// reader decodes wasm byte code.
+type reader struct {
+	bz []byte
+}
+
+func newReader(bz []byte) *reader {
+	return &reader{bz: bz}
+}
+
+func (r *reader) done() bool {
+	return len(r.bz) == 0
+}
+
+func (r *reader) remaining() []byte {
+	return r.bz
+}
+
+func (r *reader) byte() (byte, error) {
+	if len(r.bz) == 0 {
+		return 0, errUnexpectedEnd
+	}
+
+	b := r.bz[0]
+	r.bz = r.bz[1:]
+	return b, nil
+}
+
+func (r *reader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.bz) {
+		return nil, errUnexpectedEnd
+	}
+
+	bz := r.bz[:n]
+	r.bz = r.bz[n:]
+	return bz, nil
+}
+
+// u32 reads an unsigned LEB128 encoded 32 bit integer.
+func (r *reader) u32() (uint32, error) {
+	value, n := binary.Uvarint(r.bz)
+	if n <= 0 || n > 5 || value > 1<<32-1 {
+		return 0, errors.New("invalid u32")
+	}
+
+	r.bz = r.bz[n:]
+	return uint32(value), nil
+}
+
+// skipSLEB skips a signed LEB128 encoded integer of at most maxBytes bytes.
+func (r *reader) skipSLEB(maxBytes int) error {
+	for i := 0; i < maxBytes; i++ {
+		b, err := r.byte()
+		if err != nil {
+			return err
+		}
+
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+
+	return errors.New("invalid signed integer")
+}
+
+func (r *reader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := r.bytes(int(n))
+	return string(bz), err
+}
+
+func (r *reader) skipLimits() error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.u32(); err != nil {
+		return err
+	}
+
+	if flags&0x01 != 0 {
+		_, err = r.u32()
+	}
+
+	return err
+}
+
+// skipU32s skips n u32 immediates.
+func (r *reader) skipU32s(n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instruction reads an instruction and its immediates. It returns the opcode, the sub opcode of prefixed
+// instructions and whether the instruction is a control instruction ending an instruction sequence.
+// Instructions of the SIMD, threads, exception handling and tail call proposals are not supported.
+func (r *reader) instruction() (byte, uint32, bool, error) {
+	op, err := r.byte()
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	switch {
+	case op == opUnreachable || op == opElse || op == opEnd || op == opReturn:
+		return op, 0, true, nil
+
+	case op == opBlock || op == opLoop || op == opIf:
+		if r.done() {
+			return 0, 0, false, errUnexpectedEnd
+		}
+
+		switch r.bz[0] {
+		case blockTypeEmpty, valTypeI32, valTypeI64, valTypeF32, valTypeF64, valTypeFuncRef, valTypeExtRef:
+			r.bz = r.bz[1:]
+		default:
+			// the block type is a type index encoded as a signed 33 bit integer
+			if err := r.skipSLEB(5); err != nil {
+				return 0, 0, false, err
+			}
+		}
+
+		return op, 0, true, nil
+
+	case op == opBr || op == opBrIf:
+		return op, 0, true, r.skipU32s(1)
+
+	case op == opBrTable:
+		n, err := r.u32()
+		if err != nil {
+			return 0, 0, false, err
+		}
+		return op, 0, true, r.skipU32s(int(n) + 1)
+
+	case op == 0x01 || op == 0x1A || op == 0x1B || op == 0xD1:
+		// nop, drop, select and ref.is_null
+		return op, 0, false, nil
+
+	case op == opCall || op == opRefFunc || op == opTableGet || op == opTableSet || (op >= opLocalGet && op <= opGlobalSet):
+		return op, 0, false, r.skipU32s(1)
+
+	case op == opCallIndirect:
+		return op, 0, false, r.skipU32s(2)
+
+	case op == opSelectTyped:
+		n, err := r.u32()
+		if err != nil {
+			return 0, 0, false, err
+		}
+		_, err = r.bytes(int(n))
+		return op, 0, false, err
+
+	case op >= 0x28 && op <= 0x3E:
+		// memory access: alignment and offset
+		return op, 0, false, r.skipU32s(2)
+
+	case op == 0x3F || op == 0x40:
+		// memory.size and memory.grow: memory index
+		return op, 0, false, r.skipU32s(1)
+
+	case op == 0x41:
+		return op, 0, false, r.skipSLEB(5)
+
+	case op == opI64Const:
+		return op, 0, false, r.skipSLEB(10)
+
+	case op == opF32Const:
+		_, err := r.bytes(4)
+		return op, 0, false, err
+
+	case op == opF64Const:
+		_, err := r.bytes(8)
+		return op, 0, false, err
+
+	case op >= 0x45 && op <= 0xC4:
+		// numeric and sign extension instructions
+		return op, 0, false, nil
+
+	case op == opRefNull:
+		_, err := r.byte()
+		return op, 0, false, err
+
+	case op == opPrefixMisc:
+		misc, err := r.u32()
+		if err != nil {
+			return 0, 0, false, err
+		}
+
+		switch {
+		case misc <= 7:
+			// saturating truncation
+		case misc == opMiscMemoryInit || misc == 12 || misc == opMiscMemoryCopy || misc == 14:
+			// memory.init, table.init, memory.copy and table.copy
+			err = r.skipU32s(2)
+		case misc == 9 || misc == opMiscMemoryFill || misc == 13 || (misc >= 15 && misc <= 17):
+			// data.drop, memory.fill, elem.drop, table.grow, table.size and table.fill
+			err = r.skipU32s(1)
+		default:
+			err = fmt.Errorf("unsupported instruction 0xfc %d", misc)
+		}
+
+		return op, misc, false, err
+
+	default:
+		return 0, 0, false, fmt.Errorf("unsupported instruction 0x%x", op)
+	}
+}
@@ -0,0 +1,331 @@
+// Package vm implements the default 08-wasm WasmEngine, a pure-Go virtual machine built on
+// wazero which does not require cgo.
+//
+// Light client contracts are WebAssembly modules targeting wasip1 which export the following
+// functions:
+//
+//	allocate(size u32) -> u32
+//	instantiate(env_ptr, env_len, msg_ptr, msg_len u32) -> u64
+//	query(env_ptr, env_len, msg_ptr, msg_len u32) -> u64
+//	sudo(env_ptr, env_len, msg_ptr, msg_len u32) -> u64
+//	migrate(env_ptr, env_len, msg_ptr, msg_len u32) -> u64
+//
+// The host writes the json encoded environment and message into buffers obtained with allocate.
+// Every entry point returns the address of its json encoded result in the upper 32 bits and its
+// length in the lower 32 bits. The result is either {"ok": <data>} or {"error": "<reason>"}.
+//
+// Contracts access the client store through the functions imported from the "env" module:
+//
+//	db_read(key_ptr, key_len, value_ptr, value_cap u32) -> i64
+//	db_write(key_ptr, key_len, value_ptr, value_len u32)
+//	db_remove(key_ptr, key_len u32)
+//
+// db_read returns -1 if the key does not exist, otherwise the length of the value. The value is
+// only written if it fits within value_cap bytes.
+//
+// Every call is executed within a fresh instance of the contract. The WASI clocks and random source
+// are deterministic and the contract has no access to the file system or the network.
+//
+// The execution of contracts is metered deterministically: the byte code of every contract is instrumented
+// when it is stored to charge the instructions it executes, and a call is stopped once its gas limit is
+// exhausted. InstructionsPerGas instructions are executed for each unit of gas. The NaN values produced by
+// floating point instructions are canonicalized, as their bit patterns are not deterministic across platforms.
+package vm
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+const (
+	// MemoryLimitPages is the maximum number of 64KiB pages of linear memory of a contract instance.
+	MemoryLimitPages = 4096
+
+	// InstructionsPerGas is the number of instructions a contract executes for each unit of gas.
+	InstructionsPerGas = 100
+
+	hostModuleName = "env"
+	wasiModuleName = wasi_snapshot_preview1.ModuleName
+
+	exportAllocate    = "allocate"
+	exportInstantiate = "instantiate"
+	exportQuery       = "query"
+	exportSudo        = "sudo"
+	exportMigrate     = "migrate"
+	exportInitialize  = "_initialize"
+)
+
+var (
+	_ types.WasmEngine = (*VM)(nil)
+
+	// requiredExports are the functions every light client contract must export.
+	requiredExports = []string{exportAllocate, exportInstantiate, exportQuery, exportSudo, exportMigrate, exportInitialize}
+
+	// hostFunctions are the functions of the host module which contracts may import.
+	hostFunctions = map[string]bool{"db_read": true, "db_write": true, "db_remove": true}
+)
+
+// VM is a WasmEngine executing light client contracts with the wazero runtime.
+type VM struct {
+	runtime wazero.Runtime
+
+	mu    sync.RWMutex
+	codes map[string]wazero.CompiledModule
+}
+
+// NewVM creates a new VM. The VM keeps the compiled contracts in memory and is
+// safe for concurrent use.
+func NewVM() *VM {
+	ctx := context.Background()
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithMemoryLimitPages(MemoryLimitPages))
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+
+	_, err := runtime.NewHostModuleBuilder(hostModuleName).
+		NewFunctionBuilder().WithFunc(dbRead).Export("db_read").
+		NewFunctionBuilder().WithFunc(dbWrite).Export("db_write").
+		NewFunctionBuilder().WithFunc(dbRemove).Export("db_remove").
+		Instantiate(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate host module: %w", err))
+	}
+
+	return &VM{
+		runtime: runtime,
+		codes:   make(map[string]wazero.CompiledModule),
+	}
+}
+
+// StoreCode implements types.WasmEngine. The code is validated to import only the
+// host and WASI functions and to export every entry point of a light client contract,
+// and is instrumented to meter its execution before it is compiled.
+func (vm *VM) StoreCode(code []byte) (types.Checksum, error) {
+	checksum, err := types.CreateChecksum(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if vm.HasCode(checksum) {
+		return checksum, nil
+	}
+
+	instrumented, err := instrument(code)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrWasmInvalidCode, "failed to instrument code: %v", err)
+	}
+
+	compiled, err := vm.runtime.CompileModule(context.Background(), instrumented)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrWasmInvalidCode, err.Error())
+	}
+
+	if err := validateModule(compiled); err != nil {
+		compiled.Close(context.Background())
+		return nil, err
+	}
+
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	vm.codes[hex.EncodeToString(checksum)] = compiled
+
+	return checksum, nil
+}
+
+// HasCode implements types.WasmEngine.
+func (vm *VM) HasCode(checksum types.Checksum) bool {
+	_, found := vm.getCode(checksum)
+	return found
+}
+
+// RemoveCode implements types.WasmEngine.
+func (vm *VM) RemoveCode(checksum types.Checksum) error {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	key := hex.EncodeToString(checksum)
+	compiled, found := vm.codes[key]
+	if !found {
+		return errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum %s", key)
+	}
+
+	delete(vm.codes, key)
+
+	return compiled.Close(context.Background())
+}
+
+// Instantiate implements types.WasmEngine.
+func (vm *VM) Instantiate(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return vm.call(ctx, checksum, exportInstantiate, env, msg, store, gasLimit)
+}
+
+// Query implements types.WasmEngine.
+func (vm *VM) Query(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return vm.call(ctx, checksum, exportQuery, env, msg, store, gasLimit)
+}
+
+// Sudo implements types.WasmEngine.
+func (vm *VM) Sudo(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return vm.call(ctx, checksum, exportSudo, env, msg, store, gasLimit)
+}
+
+// Migrate implements types.WasmEngine.
+func (vm *VM) Migrate(ctx context.Context, checksum types.Checksum, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	return vm.call(ctx, checksum, exportMigrate, env, msg, store, gasLimit)
+}
+
+func (vm *VM) getCode(checksum types.Checksum) (wazero.CompiledModule, bool) {
+	vm.mu.RLock()
+	defer vm.mu.RUnlock()
+
+	compiled, found := vm.codes[hex.EncodeToString(checksum)]
+	return compiled, found
+}
+
+// call executes the entry point of the contract with the given checksum within a fresh instance
+// and returns the data of its result and the gas consumed.
+func (vm *VM) call(ctx context.Context, checksum types.Checksum, entryPoint string, env types.Env, msg []byte, store storetypes.KVStore, gasLimit uint64) ([]byte, uint64, error) {
+	compiled, found := vm.getCode(checksum)
+	if !found {
+		return nil, 0, errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum %s", hex.EncodeToString(checksum))
+	}
+
+	envBz, err := json.Marshal(env)
+	if err != nil {
+		return nil, 0, errorsmod.Wrap(types.ErrVMError, err.Error())
+	}
+
+	state := &callState{store: store}
+	ctx = context.WithValue(ctx, callStateKey{}, state)
+
+	// an empty name allows instances of the same contract to run concurrently, e.g. for grpc queries.
+	// The start function is called once the gas limit is set.
+	config := wazero.NewModuleConfig().WithName("").WithStartFunctions()
+	instance, err := vm.runtime.InstantiateModule(ctx, compiled, config)
+	if err != nil {
+		return nil, 0, state.abort(errorsmod.Wrapf(types.ErrVMError, "failed to instantiate contract: %v", err))
+	}
+	defer instance.Close(ctx)
+
+	gas, ok := instance.ExportedGlobal(gasGlobalExport).(api.MutableGlobal)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(types.ErrVMError, "contract is not metered")
+	}
+
+	instructionLimit := int64(math.MaxInt64)
+	if gasLimit < math.MaxInt64/InstructionsPerGas {
+		instructionLimit = int64(gasLimit * InstructionsPerGas)
+	}
+	gas.Set(uint64(instructionLimit))
+
+	res, err := execute(ctx, instance, entryPoint, envBz, msg)
+
+	remaining := int64(gas.Get())
+	if remaining < 0 {
+		return nil, gasLimit, state.abort(errorsmod.Wrapf(types.ErrWasmOutOfGas, "gas limit %d exceeded", gasLimit))
+	}
+
+	// the gas consumed by the instructions executed is rounded up
+	gasUsed := uint64(instructionLimit-remaining+InstructionsPerGas-1) / InstructionsPerGas
+	if err != nil {
+		return nil, gasUsed, state.abort(err)
+	}
+
+	return res, gasUsed, nil
+}
+
+// execute calls the start function and the entry point of the contract instance and returns the data of its result.
+func execute(ctx context.Context, instance api.Module, entryPoint string, envBz, msg []byte) ([]byte, error) {
+	if _, err := instance.ExportedFunction(exportInitialize).Call(ctx); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrVMError, "failed to initialize contract: %v", err)
+	}
+
+	envPtr, err := writeBuffer(ctx, instance, envBz)
+	if err != nil {
+		return nil, err
+	}
+
+	msgPtr, err := writeBuffer(ctx, instance, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := instance.ExportedFunction(entryPoint).Call(ctx, uint64(envPtr), uint64(len(envBz)), uint64(msgPtr), uint64(len(msg)))
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrVMError, "contract execution failed: %v", err)
+	}
+
+	resultPtr, resultLen := uint32(results[0]>>32), uint32(results[0])
+	resultBz, ok := instance.Memory().Read(resultPtr, resultLen)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrWasmInvalidResponseData, "result out of memory bounds")
+	}
+
+	var result struct {
+		Ok    json.RawMessage `json:"ok"`
+		Error string          `json:"error"`
+	}
+	if err := json.Unmarshal(resultBz, &result); err != nil {
+		return nil, errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	if result.Error != "" {
+		return nil, errorsmod.Wrap(types.ErrWasmContractCallFailed, result.Error)
+	}
+
+	if len(result.Ok) == 0 {
+		return nil, errorsmod.Wrap(types.ErrWasmInvalidResponseData, "contract returned neither a result nor an error")
+	}
+
+	return append([]byte(nil), result.Ok...), nil
+}
+
+// writeBuffer allocates a buffer within the contract memory and writes the given data into it.
+func writeBuffer(ctx context.Context, instance api.Module, data []byte) (uint32, error) {
+	results, err := instance.ExportedFunction(exportAllocate).Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrVMError, "failed to allocate contract memory: %v", err)
+	}
+
+	ptr := uint32(results[0])
+	if !instance.Memory().Write(ptr, data) {
+		return 0, errorsmod.Wrap(types.ErrVMError, "allocated buffer out of memory bounds")
+	}
+
+	return ptr, nil
+}
+
+// validateModule checks the imports and exports of a compiled contract.
+func validateModule(compiled wazero.CompiledModule) error {
+	for _, def := range compiled.ImportedFunctions() {
+		moduleName, name, _ := def.Import()
+		switch {
+		case moduleName == wasiModuleName:
+		case moduleName == hostModuleName && hostFunctions[name]:
+		default:
+			return errorsmod.Wrapf(types.ErrWasmInvalidCode, "unsupported import %s.%s", moduleName, name)
+		}
+	}
+
+	exports := compiled.ExportedFunctions()
+	for _, name := range requiredExports {
+		if _, found := exports[name]; !found {
+			return errorsmod.Wrapf(types.ErrWasmInvalidCode, "missing export %s", name)
+		}
+	}
+
+	return nil
+}
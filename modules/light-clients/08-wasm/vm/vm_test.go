@@ -0,0 +1,248 @@
+package vm_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"math"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/gaskv"
+	storetypes "cosmossdk.io/store/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/vm"
+)
+
+var env = types.Env{Block: types.BlockInfo{Height: 1, Time: 1, ChainID: "testchain-1"}}
+
+// contractCode returns the uncompressed byte code of the ics07-tendermint contract.
+func contractCode(t *testing.T) []byte {
+	t.Helper()
+
+	code, err := types.Uncompress(wasmtesting.Code, types.MaxWasmSize)
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestStoreCode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		code     []byte
+		expError error
+	}{
+		{
+			"success",
+			contractCode(t),
+			nil,
+		},
+		{
+			"failure: empty code",
+			nil,
+			types.ErrWasmEmptyCode,
+		},
+		{
+			"failure: not a wasm module",
+			[]byte("invalid wasm code"),
+			types.ErrWasmInvalidCode,
+		},
+		{
+			"failure: wasm module without the light client entry points",
+			// the smallest valid wasm module: the magic number followed by the version
+			[]byte("\x00asm\x01\x00\x00\x00"),
+			types.ErrWasmInvalidCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			wasmVM := vm.NewVM()
+
+			checksum, err := wasmVM.StoreCode(tc.code)
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+
+				expChecksum := sha256.Sum256(tc.code)
+				require.Equal(t, expChecksum[:], checksum)
+				require.True(t, wasmVM.HasCode(checksum))
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestRemoveCode(t *testing.T) {
+	wasmVM := vm.NewVM()
+
+	checksum, err := wasmVM.StoreCode(contractCode(t))
+	require.NoError(t, err)
+
+	require.NoError(t, wasmVM.RemoveCode(checksum))
+	require.False(t, wasmVM.HasCode(checksum))
+
+	err = wasmVM.RemoveCode(checksum)
+	require.ErrorIs(t, err, types.ErrWasmChecksumNotFound)
+
+	_, _, err = wasmVM.Query(context.Background(), checksum, env, []byte("{}"), dbadapter.Store{DB: dbm.NewMemDB()}, math.MaxUint64)
+	require.ErrorIs(t, err, types.ErrWasmChecksumNotFound)
+}
+
+func TestContractCall(t *testing.T) {
+	wasmVM := vm.NewVM()
+
+	checksum, err := wasmVM.StoreCode(contractCode(t))
+	require.NoError(t, err)
+
+	statusMsg, err := json.Marshal(types.QueryMsg{Status: &types.StatusMsg{}})
+	require.NoError(t, err)
+
+	t.Run("contract error is returned", func(t *testing.T) {
+		// the client state has not been instantiated
+		_, _, err := wasmVM.Query(context.Background(), checksum, env, statusMsg, dbadapter.Store{DB: dbm.NewMemDB()}, math.MaxUint64)
+		require.ErrorIs(t, err, types.ErrWasmContractCallFailed)
+		require.ErrorContains(t, err, "client state not found")
+	})
+
+	t.Run("invalid message is rejected by the contract", func(t *testing.T) {
+		_, _, err := wasmVM.Sudo(context.Background(), checksum, env, []byte("invalid json"), dbadapter.Store{DB: dbm.NewMemDB()}, math.MaxUint64)
+		require.ErrorIs(t, err, types.ErrWasmContractCallFailed)
+	})
+
+	t.Run("out of gas panic is propagated", func(t *testing.T) {
+		store := gaskv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, storetypes.NewGasMeter(1), storetypes.KVGasConfig())
+
+		require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: storetypes.GasReadCostFlatDesc}, func() {
+			_, _, _ = wasmVM.Query(context.Background(), checksum, env, statusMsg, store, math.MaxUint64)
+		})
+	})
+
+	t.Run("contract execution is metered deterministically", func(t *testing.T) {
+		_, gasUsed, err := wasmVM.Query(context.Background(), checksum, env, statusMsg, dbadapter.Store{DB: dbm.NewMemDB()}, math.MaxUint64)
+		require.Error(t, err)
+		require.NotZero(t, gasUsed)
+
+		_, gasUsedAgain, err := wasmVM.Query(context.Background(), checksum, env, statusMsg, dbadapter.Store{DB: dbm.NewMemDB()}, math.MaxUint64)
+		require.Error(t, err)
+		require.Equal(t, gasUsed, gasUsedAgain)
+
+		// the execution is stopped once the gas limit is exhausted
+		_, gasUsedLimited, err := wasmVM.Query(context.Background(), checksum, env, statusMsg, dbadapter.Store{DB: dbm.NewMemDB()}, gasUsed-1)
+		require.ErrorIs(t, err, types.ErrWasmOutOfGas)
+		require.Equal(t, gasUsed-1, gasUsedLimited)
+	})
+}
+
+func TestContractCallGasLimit(t *testing.T) {
+	wasmVM := vm.NewVM()
+
+	checksum, err := wasmVM.StoreCode(loopContractCode())
+	require.NoError(t, err)
+
+	// the query entry point of the contract never returns
+	_, gasUsed, err := wasmVM.Query(context.Background(), checksum, env, []byte("{}"), dbadapter.Store{DB: dbm.NewMemDB()}, 1_000)
+	require.ErrorIs(t, err, types.ErrWasmOutOfGas)
+	require.Equal(t, uint64(1_000), gasUsed)
+}
+
+func TestInstrumentCanonicalizesNaN(t *testing.T) {
+	section := func(id byte, content ...byte) []byte {
+		return append([]byte{id, byte(len(content))}, content...)
+	}
+
+	code := []byte("\x00asm\x01\x00\x00\x00")
+	// types: () -> i64
+	code = append(code, section(0x01, 0x01, 0x60, 0x00, 0x01, 0x7E)...)
+	code = append(code, section(0x03, 0x03, 0x00, 0x00, 0x00)...)
+	code = append(code, section(0x07, 0x03,
+		0x03, 'd', 'i', 'v', 0x00, 0x00,
+		0x04, 's', 'q', 'r', 't', 0x00, 0x01,
+		0x03, 'a', 'd', 'd', 0x00, 0x02,
+	)...)
+	code = append(code, section(0x0A,
+		0x03,
+		// div: i64.reinterpret_f64 (f64.div (f64.const 0) (f64.const 0))
+		0x16, 0x00, 0x44, 0, 0, 0, 0, 0, 0, 0, 0, 0x44, 0, 0, 0, 0, 0, 0, 0, 0, 0xA3, 0xBD, 0x0B,
+		// sqrt: i64.extend_i32_u (i32.reinterpret_f32 (f32.sqrt (f32.const -1)))
+		0x0A, 0x00, 0x43, 0x00, 0x00, 0x80, 0xBF, 0x91, 0xBC, 0xAD, 0x0B,
+		// add: i64.reinterpret_f64 (f64.add (f64.const nan:0x1) (f64.const 1))
+		0x16, 0x00, 0x44, 0x01, 0, 0, 0, 0, 0, 0xF0, 0x7F, 0x44, 0, 0, 0, 0, 0, 0, 0xF0, 0x3F, 0xA0, 0xBD, 0x0B,
+	)...)
+
+	instrumented, err := vm.Instrument(code)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	runtime := wazero.NewRuntime(ctx)
+	defer runtime.Close(ctx)
+
+	instance, err := runtime.Instantiate(ctx, instrumented)
+	require.NoError(t, err)
+
+	gas, ok := instance.ExportedGlobal(vm.GasGlobalExport).(api.MutableGlobal)
+	require.True(t, ok)
+
+	for name, expBits := range map[string]uint64{
+		"div":  0x7FF8000000000000,
+		"sqrt": 0x7FC00000,
+		"add":  0x7FF8000000000000,
+	} {
+		gas.Set(1_000)
+
+		results, err := instance.ExportedFunction(name).Call(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expBits, results[0], name)
+	}
+}
+
+// loopContractCode returns the byte code of a contract exporting every entry point of a light client
+// contract, whose query entry point loops forever.
+func loopContractCode() []byte {
+	section := func(id byte, content ...byte) []byte {
+		return append([]byte{id, byte(len(content))}, content...)
+	}
+	export := func(name string, kind, index byte) []byte {
+		return append(append([]byte{byte(len(name))}, name...), kind, index)
+	}
+
+	var exports []byte
+	for i, name := range []string{"allocate", "instantiate", "query", "sudo", "migrate", "_initialize"} {
+		exports = append(exports, export(name, 0x00, byte(i))...)
+	}
+	exports = append(exports, export("memory", 0x02, 0)...)
+
+	code := []byte("\x00asm\x01\x00\x00\x00")
+	// types: (i32) -> i32, (i32, i32, i32, i32) -> i64 and () -> ()
+	code = append(code, section(0x01, 0x03, 0x60, 0x01, 0x7F, 0x01, 0x7F, 0x60, 0x04, 0x7F, 0x7F, 0x7F, 0x7F, 0x01, 0x7E, 0x60, 0x00, 0x00)...)
+	code = append(code, section(0x03, 0x06, 0x00, 0x01, 0x01, 0x01, 0x01, 0x02)...)
+	// a single memory of one page
+	code = append(code, section(0x05, 0x01, 0x00, 0x01)...)
+	code = append(code, section(0x07, append([]byte{0x07}, exports...)...)...)
+	code = append(code, section(0x0A,
+		0x06,
+		// allocate: i32.const 1024
+		0x05, 0x00, 0x41, 0x80, 0x08, 0x0B,
+		// instantiate: i64.const 0
+		0x04, 0x00, 0x42, 0x00, 0x0B,
+		// query: loop br 0 end unreachable
+		0x08, 0x00, 0x03, 0x40, 0x0C, 0x00, 0x0B, 0x00, 0x0B,
+		// sudo and migrate: i64.const 0
+		0x04, 0x00, 0x42, 0x00, 0x0B,
+		0x04, 0x00, 0x42, 0x00, 0x0B,
+		// _initialize
+		0x02, 0x00, 0x0B,
+	)...)
+
+	return code
+}
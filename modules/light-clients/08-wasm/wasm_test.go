@@ -0,0 +1,151 @@
+package wasm_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasm "github.com/cosmos/ibc-go/modules/light-clients/08-wasm"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/vm"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// hostChainID is the chain identifier of the chain hosting the 08-wasm clients.
+const hostChainID = "wasm-host-1"
+
+// wasmVM is shared by the tests so the contract is only compiled once.
+var wasmVM = vm.NewVM()
+
+// WasmTestSuite tests the 08-wasm light client executing the ics07-tendermint contract. The 08-wasm
+// clients are hosted on a standalone context and track chainB, which has a 07-tendermint client of
+// chainA whose state is used to verify proofs.
+type WasmTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	ctx               sdk.Context
+	cdc               codec.Codec
+	storeProvider     ibcexported.ClientStoreProvider
+	keeper            keeper.Keeper
+	lightClientModule wasm.LightClientModule
+	checksum          types.Checksum
+
+	clientSequence uint64
+}
+
+func TestWasmTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(WasmTestSuite))
+}
+
+func (suite *WasmTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(suite.path)
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	clienttypes.RegisterInterfaces(registry)
+	ibctm.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	suite.cdc = codec.NewProtoCodec(registry)
+
+	ibcKey := storetypes.NewKVStoreKey(ibcexported.StoreKey)
+	wasmKey := storetypes.NewKVStoreKey(types.StoreKey)
+	keys := map[string]*storetypes.KVStoreKey{ibcexported.StoreKey: ibcKey, types.StoreKey: wasmKey}
+	suite.ctx = testutil.DefaultContextWithKeys(keys, nil, nil).WithChainID(hostChainID).WithBlockHeight(1)
+
+	suite.storeProvider = clienttypes.NewStoreProvider(ibcKey)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.keeper = keeper.NewKeeperWithVM(suite.cdc, wasmKey, suite.storeProvider, authority, wasmVM)
+	suite.lightClientModule = wasm.NewLightClientModule(suite.keeper, suite.storeProvider)
+	suite.clientSequence = 0
+
+	res, err := suite.keeper.StoreCode(suite.ctx, types.NewMsgStoreCode(authority, wasmtesting.Code))
+	suite.Require().NoError(err)
+	suite.checksum = res.Checksum
+}
+
+// hostContext returns the context of the host chain at the current time of the coordinator.
+func (suite *WasmTestSuite) hostContext() sdk.Context {
+	return suite.ctx.WithBlockTime(suite.coordinator.CurrentTime)
+}
+
+// createClient creates an 08-wasm client of chainB at its latest height and returns its identifier.
+func (suite *WasmTestSuite) createClient() string {
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	height := suite.chainB.LastHeader.GetHeight().(clienttypes.Height)
+	tmClientState := ibctm.NewClientState(
+		suite.chainB.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+	tmConsensusState := suite.chainB.LastHeader.ConsensusState()
+
+	clientState := types.NewClientState(clienttypes.MustMarshalClientState(suite.cdc, tmClientState), suite.checksum, height)
+	consensusState := types.NewConsensusState(clienttypes.MustMarshalConsensusState(suite.cdc, tmConsensusState))
+
+	clientID := clienttypes.FormatClientIdentifier(ibcexported.Wasm, suite.clientSequence)
+	suite.clientSequence++
+
+	err := suite.lightClientModule.Initialize(suite.hostContext(), clientID, clientState, consensusState)
+	suite.Require().NoError(err)
+
+	return clientID
+}
+
+// updateHeader returns a client message holding the latest header of chainB, trusting the latest height of the client.
+func (suite *WasmTestSuite) updateHeader(clientID string) *types.ClientMessage {
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	header := suite.chainB.LastHeader
+	header.TrustedHeight = suite.getClientState(clientID).LatestHeight
+
+	trustedVals, ok := suite.chainB.GetValsAtHeight(int64(header.TrustedHeight.RevisionHeight))
+	suite.Require().True(ok)
+
+	var err error
+	header.TrustedValidators, err = trustedVals.ToProto()
+	suite.Require().NoError(err)
+
+	bz, err := suite.cdc.MarshalInterface(header)
+	suite.Require().NoError(err)
+
+	return types.NewClientMessage(bz)
+}
+
+// getClientState returns the 08-wasm client state stored for the client.
+func (suite *WasmTestSuite) getClientState(clientID string) *types.ClientState {
+	bz := suite.storeProvider.ClientStore(suite.ctx, clientID).Get(host.ClientStateKey())
+	clientState, err := clienttypes.UnmarshalClientState(suite.cdc, bz)
+	suite.Require().NoError(err)
+
+	return clientState.(*types.ClientState)
+}
@@ -0,0 +1,163 @@
+package localhost
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC exported.LightClientModule interface for the 09-localhost client type.
+// Membership proofs are verified against the core IBC store, which the module accesses through its store key.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	key           storetypes.StoreKey
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewLightClientModule creates and returns a new 09-localhost LightClientModule for the core IBC store with the given key.
+func NewLightClientModule(cdc codec.BinaryCodec, key storetypes.StoreKey) LightClientModule {
+	return LightClientModule{
+		cdc:           cdc,
+		key:           key,
+		storeProvider: clienttypes.NewStoreProvider(key),
+	}
+}
+
+// Initialize checks that the initial client state is a 09-localhost client state and initializes it in the client store.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState) error {
+	localhostClientState, ok := clientState.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, clientState)
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	return localhostClientState.Initialize(ctx, l.cdc, clientStore, consensusState)
+}
+
+// VerifyClientMessage is unsupported by the 09-localhost client type and returns an error.
+func (LightClientModule) VerifyClientMessage(_ sdk.Context, _ string, _ exported.ClientMessage) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "client message verification is unsupported by the localhost client")
+}
+
+// CheckForMisbehaviour is unsupported by the 09-localhost client type and performs a no-op, returning false.
+func (LightClientModule) CheckForMisbehaviour(_ sdk.Context, _ string, _ exported.ClientMessage) bool {
+	return false
+}
+
+// UpdateStateOnMisbehaviour is unsupported by the 09-localhost client type and performs a no-op.
+func (LightClientModule) UpdateStateOnMisbehaviour(_ sdk.Context, _ string, _ exported.ClientMessage) {
+}
+
+// UpdateState obtains the 09-localhost client state and updates it to the latest block height.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// VerifyMembership obtains the 09-localhost client state and verifies the existence of the value at the given path within the core IBC store.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyMembership(ctx, ctx.KVStore(l.key), l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembership obtains the 09-localhost client state and verifies the absence of the given path within the core IBC store.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyNonMembership(ctx, ctx.KVStore(l.key), l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// Status returns the 09-localhost client status. Unknown is returned if the client state is not found.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return exported.Unknown
+	}
+
+	return clientState.Status(ctx, clientStore, l.cdc)
+}
+
+// TimestampAtHeight returns the current block time retrieved from the application context.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.GetTimestampAtHeight(ctx, clientStore, l.cdc, height)
+}
+
+// RecoverClient returns an error. The localhost cannot be modified by proposals.
+func (LightClientModule) RecoverClient(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "cannot update localhost client with a proposal")
+}
+
+// VerifyUpgradeAndUpdateState returns an error since localhost cannot be upgraded.
+func (LightClientModule) VerifyUpgradeAndUpdateState(
+	_ sdk.Context,
+	_ string,
+	_ exported.ClientState,
+	_ exported.ConsensusState,
+	_,
+	_ []byte,
+) error {
+	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
+}
+
+// getClientState retrieves the client state from the store.
+// If the ClientState does not exist in state a nil value and false boolean flag is returned
+func getClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := store.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientStateI := clienttypes.MustUnmarshalClientState(cdc, bz)
+	clientState, ok := clientStateI.(*ClientState)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T into %T", clientStateI, clientState))
+	}
+
+	return clientState, true
+}
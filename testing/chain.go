@@ -249,6 +249,34 @@ func (chain *TestChain) QueryProofForStore(storeKey string, key []byte, height i
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryBatchProofAtHeight performs abci queries with the given keys of the IBC store and returns the proto
+// encoded merkle proof combining the proofs of all the keys, and the height at which the proof will succeed
+// on a tendermint verifier.
+func (chain *TestChain) QueryBatchProofAtHeight(keys [][]byte, height int64) ([]byte, clienttypes.Height) {
+	var (
+		proofs      []commitmenttypes.MerkleProof
+		proofHeight clienttypes.Height
+	)
+	for _, key := range keys {
+		var (
+			proofBz []byte
+			proof   commitmenttypes.MerkleProof
+		)
+		proofBz, proofHeight = chain.QueryProofAtHeight(key, height)
+		require.NoError(chain.TB, chain.App.AppCodec().Unmarshal(proofBz, &proof))
+
+		proofs = append(proofs, proof)
+	}
+
+	merkleProof, err := commitmenttypes.CombineProofs(proofs)
+	require.NoError(chain.TB, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&merkleProof)
+	require.NoError(chain.TB, err)
+
+	return proof, proofHeight
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
@@ -79,6 +79,14 @@ func (endpoint *Endpoint) QueryProofAtHeight(key []byte, height uint64) ([]byte,
 	return endpoint.Chain.QueryProofAtHeight(key, int64(height))
 }
 
+// QueryBatchProof queries a single proof of the given keys associated with this endpoint
+// using the latest client state height on the counterparty chain.
+func (endpoint *Endpoint) QueryBatchProof(keys [][]byte) ([]byte, clienttypes.Height) {
+	clientState := endpoint.Counterparty.Chain.GetClientState(endpoint.Counterparty.ClientID)
+
+	return endpoint.Chain.QueryBatchProofAtHeight(keys, int64(clientState.GetLatestHeight().GetRevisionHeight()))
+}
+
 // CreateClient creates an IBC client on the endpoint. It will update the
 // clientID for the endpoint if the message is successfully executed.
 // NOTE: a solo machine client will be created with an empty diversifier.
@@ -288,6 +296,163 @@ func (endpoint *Endpoint) ConnOpenConfirm() error {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
+// ConnCloseInit will construct and execute a MsgConnectionCloseInit on the associated endpoint
+// using the authority of the IBC keeper.
+func (endpoint *Endpoint) ConnCloseInit() error {
+	msg := connectiontypes.NewMsgConnectionCloseInit(
+		endpoint.ConnectionID,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+	if _, err := endpoint.Chain.App.GetIBCKeeper().ConnectionCloseInit(endpoint.Chain.GetContext(), msg); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+	return nil
+}
+
+// ConnCloseConfirm will construct and execute a MsgConnectionCloseConfirm on the associated endpoint.
+func (endpoint *Endpoint) ConnCloseConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	proof, height := endpoint.Counterparty.Chain.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionCloseConfirm(
+		endpoint.ConnectionID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.GetConnection().UpgradeSequence,
+		endpoint.Counterparty.ConnectionID,
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// QueryConnectionUpgradeProof returns the proofs necessary to execute ConnUpgradeTry/ConnUpgradeAck.
+// It returns the proof for the connection on the endpoint's chain, the proof for the upgrade attempt
+// on the endpoint's chain, and the height at which the proof was queried.
+func (endpoint *Endpoint) QueryConnectionUpgradeProof() ([]byte, []byte, clienttypes.Height) {
+	connectionKey := host.ConnectionKey(endpoint.ConnectionID)
+	connectionProof, height := endpoint.QueryProof(connectionKey)
+
+	upgradeKey := host.ConnectionUpgradeKey(endpoint.ConnectionID)
+	upgradeProof, _ := endpoint.QueryProof(upgradeKey)
+
+	return connectionProof, upgradeProof, height
+}
+
+// ConnUpgradeInit will construct and execute a MsgConnectionUpgradeInit on the associated endpoint
+// using the authority of the IBC keeper.
+func (endpoint *Endpoint) ConnUpgradeInit(upgrade connectiontypes.Upgrade) error {
+	msg := connectiontypes.NewMsgConnectionUpgradeInit(
+		endpoint.ConnectionID,
+		upgrade,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+	if _, err := endpoint.Chain.App.GetIBCKeeper().ConnectionUpgradeInit(endpoint.Chain.GetContext(), msg); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+	return nil
+}
+
+// ConnUpgradeTry will construct and execute a MsgConnectionUpgradeTry on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTry() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, upgradeProof, height := endpoint.Counterparty.QueryConnectionUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTry(
+		endpoint.ConnectionID,
+		counterpartyUpgrade,
+		endpoint.Counterparty.GetConnection().UpgradeSequence,
+		connectionProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeAck will construct and execute a MsgConnectionUpgradeAck on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeAck() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, upgradeProof, height := endpoint.Counterparty.QueryConnectionUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeAck(
+		endpoint.ConnectionID,
+		counterpartyUpgrade,
+		connectionProof,
+		upgradeProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeConfirm will construct and execute a MsgConnectionUpgradeConfirm on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	proof, height := endpoint.Counterparty.Chain.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeConfirm(
+		endpoint.ConnectionID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeCancel will construct and execute a MsgConnectionUpgradeCancel on the associated endpoint
+// using a proof of the counterparty error receipt.
+func (endpoint *Endpoint) ConnUpgradeCancel() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	errorReceiptKey := host.ConnectionUpgradeErrorKey(endpoint.Counterparty.ConnectionID)
+	proofErrorReceipt, height := endpoint.Counterparty.Chain.QueryProof(errorReceiptKey)
+
+	errorReceipt, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeCancel(
+		endpoint.ConnectionID,
+		errorReceipt,
+		proofErrorReceipt,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeTimeout will construct and execute a MsgConnectionUpgradeTimeout on the associated endpoint.
+// The client on the endpoint's chain is updated so that the counterparty timestamp used is the latest one.
+func (endpoint *Endpoint) ConnUpgradeTimeout() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTimeout(
+		endpoint.ConnectionID,
+		endpoint.GetClientState().GetLatestHeight().(clienttypes.Height),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
 // QueryConnectionHandshakeProof returns all the proofs necessary to execute OpenTry or Open Ack of
 // the connection handshakes. It returns the counterparty client state, proof of the counterparty
 // client state, proof of the counterparty consensus state, the consensus state height, proof of
@@ -516,6 +681,10 @@ func (endpoint *Endpoint) AcknowledgePacket(packet channeltypes.Packet, ack []by
 
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	counterparty := endpoint.Counterparty
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
 	// get proof for timeout based on channel order
 	var packetKey []byte
 
@@ -524,14 +693,13 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = host.PacketTimeoutProofKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), nextSeqRecv)
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
 
-	counterparty := endpoint.Counterparty
 	proof, proofHeight := counterparty.QueryProof(packetKey)
-	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
-	require.True(endpoint.Chain.TB, found)
 
 	timeoutMsg := channeltypes.NewMsgTimeout(
 		packet, nextSeqRecv,
@@ -541,8 +709,78 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	return endpoint.Chain.sendMsgs(timeoutMsg)
 }
 
+// RecvPackets receives the packets on the associated endpoint with a single MsgRecvPackets.
+// The counterparty client is updated.
+func (endpoint *Endpoint) RecvPackets(packets []channeltypes.Packet) error {
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+
+	// get a single proof of the packet commitments on source
+	proof, proofHeight := endpoint.Counterparty.QueryBatchProof(keys)
+
+	recvMsg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	// receive on counterparty and update source client
+	if err := endpoint.Chain.sendMsgs(recvMsg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
+// AcknowledgePackets sends a MsgAcknowledgements to the channel associated with the endpoint.
+func (endpoint *Endpoint) AcknowledgePackets(packets []channeltypes.Packet, acks [][]byte) error {
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+
+	// get a single proof of the acknowledgements on counterparty
+	proof, proofHeight := endpoint.Counterparty.QueryBatchProof(keys)
+
+	ackMsg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
+// TimeoutPackets sends a MsgTimeouts to the channel associated with the endpoint.
+func (endpoint *Endpoint) TimeoutPackets(packets []channeltypes.Packet) error {
+	counterparty := endpoint.Counterparty
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	var (
+		proof       []byte
+		proofHeight clienttypes.Height
+	)
+
+	// the packets of ORDERED_ALLOW_TIMEOUT channels which have not been received yet are proven
+	// with the next sequence receive of the counterparty channel
+	if endpoint.ChannelConfig.Order == channeltypes.ORDERED_ALLOW_TIMEOUT && nextSeqRecv <= packets[0].GetSequence() {
+		proof, proofHeight = counterparty.QueryProof(host.NextSequenceRecvKey(packets[0].GetDestPort(), packets[0].GetDestChannel()))
+	} else {
+		keys := make([][]byte, len(packets))
+		for i, packet := range packets {
+			keys[i] = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		}
+
+		// get a single proof of the absence of the packet receipts, or of the timeout
+		// receipts on ORDERED_ALLOW_TIMEOUT channels
+		proof, proofHeight = counterparty.QueryBatchProof(keys)
+	}
+
+	timeoutMsg := channeltypes.NewMsgTimeouts(packets, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(timeoutMsg)
+}
+
 // TimeoutOnClose sends a MsgTimeoutOnClose to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
 	// get proof for timeout based on channel order
 	var packetKey []byte
 
@@ -551,6 +789,8 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = host.PacketTimeoutProofKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), nextSeqRecv)
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
@@ -560,9 +800,6 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
 	closedProof, _ := endpoint.Counterparty.QueryProof(channelKey)
 
-	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
-	require.True(endpoint.Chain.TB, found)
-
 	timeoutOnCloseMsg := channeltypes.NewMsgTimeoutOnCloseWithCounterpartyUpgradeSequence(
 		packet, nextSeqRecv,
 		proof, closedProof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
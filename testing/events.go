@@ -1,12 +1,16 @@
 package ibctesting
 
 import (
+	"bytes"
 	"fmt"
 	"slices"
 	"strconv"
 
+	"github.com/cosmos/gogoproto/proto"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -210,6 +214,53 @@ func AssertEvents(
 	}
 }
 
+// ParseTypedEvents parses the typed events contained in the given events. Events which are
+// not typed events are ignored.
+func ParseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	var typedEvents []proto.Message
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		typedEvents = append(typedEvents, typedEvent)
+	}
+
+	return typedEvents, nil
+}
+
+// AssertTypedEvents asserts that the expected typed events are present in the actual events.
+// Typed events are compared by their encoded bytes, so that events containing Any fields are
+// matched regardless of the cached values of the Any fields.
+func AssertTypedEvents(
+	suite *testifysuite.Suite,
+	expected []proto.Message,
+	actual []abci.Event,
+) {
+	typedEvents, err := ParseTypedEvents(actual)
+	suite.Require().NoError(err)
+
+	for _, expectedEvent := range expected {
+		expectedBz, err := proto.Marshal(expectedEvent)
+		suite.Require().NoError(err)
+
+		found := slices.ContainsFunc(typedEvents, func(typedEvent proto.Message) bool {
+			if proto.MessageName(typedEvent) != proto.MessageName(expectedEvent) {
+				return false
+			}
+
+			bz, err := proto.Marshal(typedEvent)
+			return err == nil && bytes.Equal(expectedBz, bz)
+		})
+		suite.Require().True(found, "typed event: %s was not found in events", proto.MessageName(expectedEvent))
+	}
+}
+
 // shouldProcessEvent returns true if the given expected event should be processed based on event type.
 func shouldProcessEvent(expectedEvent abci.Event, actualEvent abci.Event) bool {
 	if expectedEvent.Type != actualEvent.Type {
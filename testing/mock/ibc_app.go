@@ -86,6 +86,11 @@ type IBCApp struct {
 		relayer sdk.AccAddress,
 	) error
 
+	OnAcknowledgementExpired func(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+	) (exported.Acknowledgement, error)
+
 	OnChanUpgradeInit func(
 		ctx sdk.Context,
 		portID, channelID string,
@@ -17,9 +17,10 @@ import (
 )
 
 var (
-	_ porttypes.IBCModule             = (*IBCModule)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCModule)(nil)
-	_ porttypes.UpgradableModule      = (*IBCModule)(nil)
+	_ porttypes.IBCModule                   = (*IBCModule)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCModule)(nil)
+	_ porttypes.UpgradableModule            = (*IBCModule)(nil)
+	_ porttypes.AcknowledgementExpiryModule = (*IBCModule)(nil)
 )
 
 // applicationCallbackError is a custom error type that will be unique for testing purposes.
@@ -183,6 +184,15 @@ func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet,
 	return nil
 }
 
+// OnAcknowledgementExpired implements the AcknowledgementExpiryModule interface.
+func (im IBCModule) OnAcknowledgementExpired(ctx sdk.Context, packet channeltypes.Packet) (exported.Acknowledgement, error) {
+	if im.IBCApp.OnAcknowledgementExpired != nil {
+		return im.IBCApp.OnAcknowledgementExpired(ctx, packet)
+	}
+
+	return channeltypes.NewErrorAcknowledgement(channeltypes.ErrAcknowledgementExpired), nil
+}
+
 // OnChanUpgradeInit implements the IBCModule interface
 func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
 	if im.IBCApp.OnChanUpgradeInit != nil {